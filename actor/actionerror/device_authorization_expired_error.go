@@ -0,0 +1,9 @@
+package actionerror
+
+// DeviceAuthorizationExpiredError is returned when the user does not approve a
+// device code login before the device code expires.
+type DeviceAuthorizationExpiredError struct{}
+
+func (DeviceAuthorizationExpiredError) Error() string {
+	return "Device code expired before the login was approved"
+}
@@ -0,0 +1,18 @@
+package actionerror
+
+import "fmt"
+
+// InvalidScheduledTaskError is returned when a task in the manifest cannot be
+// run on a schedule.
+type InvalidScheduledTaskError struct {
+	AppName  string
+	TaskName string
+	Reason   string
+}
+
+func (e InvalidScheduledTaskError) Error() string {
+	if e.TaskName == "" {
+		return fmt.Sprintf("Invalid task for app '%s': %s", e.AppName, e.Reason)
+	}
+	return fmt.Sprintf("Invalid task '%s' for app '%s': %s", e.TaskName, e.AppName, e.Reason)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// PushDependencyFailedError is returned for an app that was not pushed because
+// an app it depends on failed to push.
+type PushDependencyFailedError struct {
+	AppName    string
+	Dependency string
+}
+
+func (e PushDependencyFailedError) Error() string {
+	return fmt.Sprintf("App '%s' was not pushed because app '%s' failed to push", e.AppName, e.Dependency)
+}
@@ -0,0 +1,11 @@
+package actionerror
+
+// ServiceCredentialBindingParamsFetchingNotSupportedError is returned when
+// the service broker does not support fetching the parameters of a binding or
+// a key.
+type ServiceCredentialBindingParamsFetchingNotSupportedError struct {
+}
+
+func (e ServiceCredentialBindingParamsFetchingNotSupportedError) Error() string {
+	return "This service does not support fetching service binding parameters."
+}
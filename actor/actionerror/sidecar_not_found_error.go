@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// SidecarNotFoundError is returned when a requested sidecar is not found on
+// an application.
+type SidecarNotFoundError struct {
+	Name    string
+	AppName string
+}
+
+func (e SidecarNotFoundError) Error() string {
+	return fmt.Sprintf("Sidecar '%s' not found for app '%s'", e.Name, e.AppName)
+}
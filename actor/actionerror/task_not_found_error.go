@@ -5,8 +5,12 @@ import "fmt"
 // TaskNotFoundError is returned when no tasks matching the filters are found.
 type TaskNotFoundError struct {
 	SequenceID int
+	Name       string
 }
 
 func (e TaskNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Task '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Task sequence ID %d not found.", e.SequenceID)
 }
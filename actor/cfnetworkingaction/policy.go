@@ -135,6 +135,208 @@ func (actor Actor) RemoveNetworkPolicy(srcSpaceGUID, srcAppName, destSpaceGUID,
 	return allWarnings, actionerror.PolicyDoesNotExistError{}
 }
 
+// NetworkPolicyChanges lists the policies ApplyNetworkPolicies added and
+// removed.
+type NetworkPolicyChanges struct {
+	Added   []Policy
+	Removed []Policy
+}
+
+// ApplyNetworkPolicies makes the policies of the apps in the space match the
+// given ones. Missing policies are added; policies that are not in the list are
+// only removed when prune is set. Every app is looked up before any policy is
+// changed, so a typo in the list leaves the live policies untouched.
+func (actor Actor) ApplyNetworkPolicies(spaceGUID string, policies []Policy, prune bool) (NetworkPolicyChanges, Warnings, error) {
+	var allWarnings Warnings
+
+	livePolicies, warnings, err := actor.NetworkPoliciesBySpace(spaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return NetworkPolicyChanges{}, allWarnings, err
+	}
+
+	var changes NetworkPolicyChanges
+	changes.Added = subtractPolicies(policies, livePolicies)
+	if prune {
+		changes.Removed = subtractPolicies(livePolicies, policies)
+	}
+
+	resolver := newPolicyResolver(actor.CloudControllerClient, spaceGUID)
+
+	policiesToAdd, warnings, err := resolver.resolve(changes.Added)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return NetworkPolicyChanges{}, allWarnings, err
+	}
+
+	policiesToRemove, warnings, err := resolver.resolve(changes.Removed)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return NetworkPolicyChanges{}, allWarnings, err
+	}
+
+	if len(policiesToAdd) > 0 {
+		err = actor.NetworkingClient.CreatePolicies(policiesToAdd)
+		if err != nil {
+			return NetworkPolicyChanges{}, allWarnings, err
+		}
+	}
+
+	if len(policiesToRemove) > 0 {
+		err = actor.NetworkingClient.RemovePolicies(policiesToRemove)
+		if err != nil {
+			return NetworkPolicyChanges{Added: changes.Added}, allWarnings, err
+		}
+	}
+
+	return changes, allWarnings, nil
+}
+
+// subtractPolicies returns the policies in from that are not in policies,
+// without duplicates.
+func subtractPolicies(from []Policy, policies []Policy) []Policy {
+	seen := map[Policy]struct{}{}
+	for _, policy := range policies {
+		seen[policy] = struct{}{}
+	}
+
+	var difference []Policy
+	for _, policy := range from {
+		if _, ok := seen[policy]; !ok {
+			difference = append(difference, policy)
+			seen[policy] = struct{}{}
+		}
+	}
+
+	return difference
+}
+
+// policyResolver turns policies identified by app, space and org names into
+// networking policies identified by app GUIDs. Source apps are looked up in
+// the space being applied to. Lookups are cached for the lifetime of the
+// resolver.
+type policyResolver struct {
+	client          CloudControllerClient
+	sourceSpaceGUID string
+	orgGUIDs        map[string]string
+	spaceGUIDs      map[[2]string]string
+	appGUIDs        map[[2]string]string
+}
+
+func newPolicyResolver(client CloudControllerClient, sourceSpaceGUID string) *policyResolver {
+	return &policyResolver{
+		client:          client,
+		sourceSpaceGUID: sourceSpaceGUID,
+		orgGUIDs:        map[string]string{},
+		spaceGUIDs:      map[[2]string]string{},
+		appGUIDs:        map[[2]string]string{},
+	}
+}
+
+func (resolver *policyResolver) resolve(policies []Policy) ([]cfnetv1.Policy, Warnings, error) {
+	var allWarnings Warnings
+	var v1Policies []cfnetv1.Policy
+
+	for _, policy := range policies {
+		srcAppGUID, warnings, err := resolver.appGUID(policy.SourceName, resolver.sourceSpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		destSpaceGUID, warnings, err := resolver.spaceGUID(policy.DestinationSpaceName, policy.DestinationOrgName)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		destAppGUID, warnings, err := resolver.appGUID(policy.DestinationName, destSpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		v1Policies = append(v1Policies, cfnetv1.Policy{
+			Source: cfnetv1.PolicySource{
+				ID: srcAppGUID,
+			},
+			Destination: cfnetv1.PolicyDestination{
+				ID:       destAppGUID,
+				Protocol: cfnetv1.PolicyProtocol(policy.Protocol),
+				Ports: cfnetv1.Ports{
+					Start: policy.StartPort,
+					End:   policy.EndPort,
+				},
+			},
+		})
+	}
+
+	return v1Policies, allWarnings, nil
+}
+
+func (resolver *policyResolver) appGUID(appName string, spaceGUID string) (string, Warnings, error) {
+	key := [2]string{spaceGUID, appName}
+	if guid, ok := resolver.appGUIDs[key]; ok {
+		return guid, nil, nil
+	}
+
+	app, warnings, err := resolver.client.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return "", Warnings(warnings), err
+	}
+
+	resolver.appGUIDs[key] = app.GUID
+	return app.GUID, Warnings(warnings), nil
+}
+
+func (resolver *policyResolver) spaceGUID(spaceName string, orgName string) (string, Warnings, error) {
+	key := [2]string{orgName, spaceName}
+	if guid, ok := resolver.spaceGUIDs[key]; ok {
+		return guid, nil, nil
+	}
+
+	var allWarnings Warnings
+
+	orgGUID, ok := resolver.orgGUIDs[orgName]
+	if !ok {
+		orgs, warnings, err := resolver.client.GetOrganizations(ccv3.Query{
+			Key:    ccv3.NameFilter,
+			Values: []string{orgName},
+		})
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return "", allWarnings, err
+		}
+		if len(orgs) == 0 {
+			return "", allWarnings, actionerror.OrganizationNotFoundError{Name: orgName}
+		}
+
+		orgGUID = orgs[0].GUID
+		resolver.orgGUIDs[orgName] = orgGUID
+	}
+
+	spaces, _, warnings, err := resolver.client.GetSpaces(
+		ccv3.Query{
+			Key:    ccv3.NameFilter,
+			Values: []string{spaceName},
+		},
+		ccv3.Query{
+			Key:    ccv3.OrganizationGUIDFilter,
+			Values: []string{orgGUID},
+		},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return "", allWarnings, err
+	}
+	if len(spaces) == 0 {
+		return "", allWarnings, actionerror.SpaceNotFoundError{Name: spaceName}
+	}
+
+	resolver.spaceGUIDs[key] = spaces[0].GUID
+	return spaces[0].GUID, allWarnings, nil
+}
+
 func filterPoliciesWithoutMatchingSourceGUIDs(v1Policies []cfnetv1.Policy, srcAppGUIDs []string) []cfnetv1.Policy {
 	srcGUIDsSet := map[string]struct{}{}
 	for _, srcGUID := range srcAppGUIDs {
@@ -766,4 +766,288 @@ var _ = Describe("Policy", func() {
 			})
 		})
 	})
+
+	Describe("ApplyNetworkPolicies", func() {
+		var (
+			policies []Policy
+			prune    bool
+			changes  NetworkPolicyChanges
+		)
+
+		BeforeEach(func() {
+			prune = false
+
+			fakeNetworkingClient.ListPoliciesReturns([]cfnetv1.Policy{
+				{
+					Source: cfnetv1.PolicySource{
+						ID: "appAGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appBGUID",
+						Protocol: "tcp",
+						Ports: cfnetv1.Ports{
+							Start: 8080,
+							End:   8080,
+						},
+					},
+				},
+				{
+					Source: cfnetv1.PolicySource{
+						ID: "appAGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appCGUID",
+						Protocol: "udp",
+						Ports: cfnetv1.Ports{
+							Start: 9000,
+							End:   9001,
+						},
+					},
+				},
+			}, nil)
+
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(0, []resources.Application{
+				{Name: "appA", GUID: "appAGUID", SpaceGUID: "spaceAGUID"},
+				{Name: "appB", GUID: "appBGUID", SpaceGUID: "spaceAGUID"},
+			}, []string{"filter-apps-by-space-warning"}, nil)
+
+			fakeCloudControllerClient.GetApplicationsReturnsOnCall(1, []resources.Application{
+				{Name: "appB", GUID: "appBGUID", SpaceGUID: "spaceAGUID"},
+				{Name: "appC", GUID: "appCGUID", SpaceGUID: "spaceCGUID"},
+			}, nil, nil)
+
+			fakeCloudControllerClient.GetSpacesStub = func(query ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
+				spaces := []resources.Space{
+					{
+						GUID: "spaceAGUID",
+						Name: "spaceA",
+						Relationships: map[constant.RelationshipType]resources.Relationship{
+							constant.RelationshipTypeOrganization: {GUID: "orgAGUID"},
+						},
+					},
+					{
+						GUID: "spaceCGUID",
+						Name: "spaceC",
+						Relationships: map[constant.RelationshipType]resources.Relationship{
+							constant.RelationshipTypeOrganization: {GUID: "orgCGUID"},
+						},
+					},
+				}
+				if query[0].Key == ccv3.NameFilter {
+					for _, space := range spaces {
+						if space.Name == query[0].Values[0] && space.Relationships[constant.RelationshipTypeOrganization].GUID == query[1].Values[0] {
+							return []resources.Space{space}, ccv3.IncludedResources{}, []string{"get-space-by-name-warning"}, nil
+						}
+					}
+					return nil, ccv3.IncludedResources{}, nil, nil
+				}
+				return spaces, ccv3.IncludedResources{}, nil, nil
+			}
+
+			fakeCloudControllerClient.GetOrganizationsStub = func(query ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error) {
+				orgs := []resources.Organization{
+					{GUID: "orgAGUID", Name: "orgA"},
+					{GUID: "orgCGUID", Name: "orgC"},
+				}
+				if query[0].Key == ccv3.NameFilter {
+					for _, org := range orgs {
+						if org.Name == query[0].Values[0] {
+							return []resources.Organization{org}, []string{"get-org-by-name-warning"}, nil
+						}
+					}
+					return nil, nil, nil
+				}
+				return orgs, nil, nil
+			}
+
+			fakeCloudControllerClient.GetApplicationByNameAndSpaceStub = func(appName string, spaceGUID string) (resources.Application, ccv3.Warnings, error) {
+				switch {
+				case appName == "appA" && spaceGUID == "spaceAGUID":
+					return resources.Application{GUID: "appAGUID"}, nil, nil
+				case appName == "appB" && spaceGUID == "spaceAGUID":
+					return resources.Application{GUID: "appBGUID"}, nil, nil
+				case appName == "appC" && spaceGUID == "spaceCGUID":
+					return resources.Application{GUID: "appCGUID"}, nil, nil
+				}
+				return resources.Application{}, []string{"get-app-warning"}, actionerror.ApplicationNotFoundError{Name: appName}
+			}
+
+			policies = []Policy{
+				{
+					SourceName:           "appA",
+					DestinationName:      "appB",
+					Protocol:             "tcp",
+					StartPort:            8080,
+					EndPort:              8080,
+					DestinationSpaceName: "spaceA",
+					DestinationOrgName:   "orgA",
+				},
+				{
+					SourceName:           "appB",
+					DestinationName:      "appA",
+					Protocol:             "tcp",
+					StartPort:            8081,
+					EndPort:              8081,
+					DestinationSpaceName: "spaceA",
+					DestinationOrgName:   "orgA",
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.ApplyNetworkPolicies("spaceAGUID", policies, prune)
+		})
+
+		It("adds the missing policies and keeps the others", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("filter-apps-by-space-warning", "get-org-by-name-warning", "get-space-by-name-warning"))
+
+			Expect(changes).To(Equal(NetworkPolicyChanges{
+				Added: []Policy{policies[1]},
+			}))
+
+			Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+			Expect(fakeNetworkingClient.CreatePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+				{
+					Source: cfnetv1.PolicySource{
+						ID: "appBGUID",
+					},
+					Destination: cfnetv1.PolicyDestination{
+						ID:       "appAGUID",
+						Protocol: "tcp",
+						Ports: cfnetv1.Ports{
+							Start: 8081,
+							End:   8081,
+						},
+					},
+				},
+			}))
+			Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+		})
+
+		When("prune is set", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("removes the policies that are not in the list", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(changes.Removed).To(Equal([]Policy{
+					{
+						SourceName:           "appA",
+						DestinationName:      "appC",
+						Protocol:             "udp",
+						StartPort:            9000,
+						EndPort:              9001,
+						DestinationSpaceName: "spaceC",
+						DestinationOrgName:   "orgC",
+					},
+				}))
+
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(1))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(1))
+				Expect(fakeNetworkingClient.RemovePoliciesArgsForCall(0)).To(Equal([]cfnetv1.Policy{
+					{
+						Source: cfnetv1.PolicySource{
+							ID: "appAGUID",
+						},
+						Destination: cfnetv1.PolicyDestination{
+							ID:       "appCGUID",
+							Protocol: "udp",
+							Ports: cfnetv1.Ports{
+								Start: 9000,
+								End:   9001,
+							},
+						},
+					},
+				}))
+			})
+
+			When("removing the policies fails", func() {
+				BeforeEach(func() {
+					fakeNetworkingClient.RemovePoliciesReturns(errors.New("apple"))
+				})
+
+				It("returns the error and the policies that were added", func() {
+					Expect(executeErr).To(MatchError("apple"))
+					Expect(changes).To(Equal(NetworkPolicyChanges{
+						Added: []Policy{policies[1]},
+					}))
+				})
+			})
+		})
+
+		When("the live policies already match", func() {
+			BeforeEach(func() {
+				policies = policies[:1]
+			})
+
+			It("does not change anything", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(changes).To(Equal(NetworkPolicyChanges{}))
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an app in the list does not exist", func() {
+			BeforeEach(func() {
+				prune = true
+				policies[1].DestinationName = "missing-app"
+			})
+
+			It("returns the error without changing any policy", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "missing-app"}))
+				Expect(warnings).To(ContainElement("get-app-warning"))
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a destination org does not exist", func() {
+			BeforeEach(func() {
+				policies[1].DestinationOrgName = "missing-org"
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "missing-org"}))
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a destination space does not exist", func() {
+			BeforeEach(func() {
+				policies[1].DestinationSpaceName = "missing-space"
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "missing-space"}))
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("listing the live policies fails", func() {
+			BeforeEach(func() {
+				fakeNetworkingClient.ListPoliciesReturns(nil, errors.New("apple"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("apple"))
+				Expect(fakeNetworkingClient.CreatePoliciesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("creating the policies fails", func() {
+			BeforeEach(func() {
+				prune = true
+				fakeNetworkingClient.CreatePoliciesReturns(errors.New("apple"))
+			})
+
+			It("returns the error without removing any policy", func() {
+				Expect(executeErr).To(MatchError("apple"))
+				Expect(fakeNetworkingClient.RemovePoliciesCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -10,6 +10,10 @@ type AuthActor interface {
 type Actor struct {
 	Config Config
 	AuthActor
+
+	// ResourceCache, when set, is used to skip hashing files that have not
+	// changed since they were last gathered.
+	ResourceCache *ResourceCache
 }
 
 // NewActor returns an Actor with default settings
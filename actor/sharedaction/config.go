@@ -11,6 +11,7 @@ type Config interface {
 	HasTargetedSpace() bool
 	IsCFOnK8s() bool
 	RefreshToken() string
+	ReproducibleArchives() bool
 	TargetedOrganizationName() string
 	Verbose() (bool, []string)
 }
@@ -5,12 +5,13 @@ import (
 	"time"
 
 	logcache "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
 	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . LogCacheClient
 
-// LogCacheClient is a client for getting logs.
+// LogCacheClient is a client for getting logs and metrics.
 type LogCacheClient interface {
 	Read(
 		ctx context.Context,
@@ -18,4 +19,9 @@ type LogCacheClient interface {
 		start time.Time,
 		opts ...logcache.ReadOption,
 	) ([]*loggregator_v2.Envelope, error)
+	PromQL(
+		ctx context.Context,
+		query string,
+		opts ...logcache.PromQLOption,
+	) (*logcache_v1.PromQL_InstantQueryResult, error)
 }
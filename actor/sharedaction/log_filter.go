@@ -0,0 +1,57 @@
+package sharedaction
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LogFilter selects log messages by time window, source and content. Zero
+// values match every message.
+type LogFilter struct {
+	// Since and Until bound the time window of recent logs.
+	Since time.Time
+	Until time.Time
+	// Lines is the maximum number of recent log messages to return. It
+	// defaults to RecentLogsLines.
+	Lines int
+
+	// SourceType matches the source type of a message, e.g. APP or RTR.
+	// APP matches every app source type such as APP/PROC/WEB.
+	SourceType string
+	// Instance matches the source instance of a message.
+	Instance string
+	// ProcessType matches messages from the app instances of the given
+	// process type.
+	ProcessType string
+	// Pattern matches the message text.
+	Pattern *regexp.Regexp
+}
+
+// Matches reports whether message passes the source, instance, process type
+// and pattern filters. The time window and line count are applied when the
+// logs are read from Log Cache.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	sourceType := strings.ToUpper(message.SourceType())
+
+	if filter.SourceType != "" {
+		wanted := strings.ToUpper(filter.SourceType)
+		if sourceType != wanted && !strings.HasPrefix(sourceType, wanted+"/") {
+			return false
+		}
+	}
+
+	if filter.ProcessType != "" && sourceType != "APP/PROC/"+strings.ToUpper(filter.ProcessType) {
+		return false
+	}
+
+	if filter.Instance != "" && message.SourceInstance() != filter.Instance {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.Message()) {
+		return false
+	}
+
+	return true
+}
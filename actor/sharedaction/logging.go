@@ -240,6 +240,98 @@ func GetRecentLogs(appGUID string, client LogCacheClient) ([]LogMessage, error)
 	return reorderedLogMessages, nil
 }
 
+// GetFilteredRecentLogs returns up to filter.Lines of the most recent log
+// messages that match filter, oldest first. It walks backward through Log
+// Cache one page at a time until enough messages match or the start of the
+// window is reached.
+func GetFilteredRecentLogs(appGUID string, client LogCacheClient, filter LogFilter) ([]LogMessage, error) {
+	lines := filter.Lines
+	if lines <= 0 {
+		lines = RecentLogsLines
+	}
+
+	pageSize := RecentLogsLines
+	endTime := filter.Until
+	var matched []LogMessage
+
+	// Envelopes at the timestamp of the oldest envelope read so far, which the
+	// next page reads again.
+	var (
+		boundary     int64
+		seenEnvelope = map[string]bool{}
+	)
+
+	for len(matched) < lines {
+		options := []logcache.ReadOption{
+			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_LOG),
+			logcache.WithLimit(pageSize),
+			logcache.WithDescending(),
+		}
+		if !endTime.IsZero() {
+			options = append(options, logcache.WithEndTime(endTime))
+		}
+
+		envelopes, err := client.Read(context.Background(), appGUID, filter.Since, options...)
+		if err != nil && err.Error() == "unexpected status code 429" && pageSize > 1 {
+			pageSize /= 2
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve logs from Log Cache: %s", err)
+		}
+
+		var unseen []*loggregator_v2.Envelope
+		for _, envelope := range envelopes {
+			if envelope.GetTimestamp() != boundary || !seenEnvelope[envelope.String()] {
+				unseen = append(unseen, envelope)
+			}
+		}
+
+		for _, logMessage := range convertEnvelopesToLogMessages(unseen) {
+			if filter.Matches(*logMessage) {
+				matched = append(matched, *logMessage)
+				if len(matched) == lines {
+					break
+				}
+			}
+		}
+
+		if len(envelopes) < pageSize {
+			break
+		}
+
+		oldest := envelopes[len(envelopes)-1].GetTimestamp()
+		if len(unseen) == 0 {
+			// The whole page shares a timestamp that has been read before, so
+			// the remaining envelopes at that timestamp cannot be reached.
+			endTime = time.Unix(0, oldest)
+			continue
+		}
+
+		if oldest != boundary {
+			boundary = oldest
+			seenEnvelope = map[string]bool{}
+		}
+		for _, envelope := range envelopes {
+			if envelope.GetTimestamp() == oldest {
+				seenEnvelope[envelope.String()] = true
+			}
+		}
+
+		// The end time is exclusive, so the next page ends right after the
+		// oldest envelope of this one, as other envelopes may share its
+		// timestamp. Those already read are skipped.
+		endTime = time.Unix(0, oldest+1)
+	}
+
+	var reorderedLogMessages []LogMessage
+	for i := len(matched) - 1; i >= 0; i-- {
+		reorderedLogMessages = append(reorderedLogMessages, matched[i])
+	}
+
+	return reorderedLogMessages, nil
+}
+
 func convertEnvelopesToLogMessages(envelopes []*loggregator_v2.Envelope) []*LogMessage {
 	var logMessages []*LogMessage
 	for _, envelope := range envelopes {
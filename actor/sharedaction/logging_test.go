@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -366,4 +367,213 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("LogFilter", func() {
+		var message sharedaction.LogMessage
+
+		BeforeEach(func() {
+			message = *sharedaction.NewLogMessage("GET /health 200", "OUT", time.Unix(0, 0), "APP/PROC/WEB", "1")
+		})
+
+		It("matches everything when empty", func() {
+			Expect(sharedaction.LogFilter{}.Matches(message)).To(BeTrue())
+		})
+
+		It("matches the source type and its sub-types", func() {
+			Expect(sharedaction.LogFilter{SourceType: "APP"}.Matches(message)).To(BeTrue())
+			Expect(sharedaction.LogFilter{SourceType: "APP/PROC/WEB"}.Matches(message)).To(BeTrue())
+			Expect(sharedaction.LogFilter{SourceType: "RTR"}.Matches(message)).To(BeFalse())
+			Expect(sharedaction.LogFilter{SourceType: "AP"}.Matches(message)).To(BeFalse())
+		})
+
+		It("matches the process type", func() {
+			Expect(sharedaction.LogFilter{ProcessType: "web"}.Matches(message)).To(BeTrue())
+			Expect(sharedaction.LogFilter{ProcessType: "worker"}.Matches(message)).To(BeFalse())
+		})
+
+		It("matches the instance", func() {
+			Expect(sharedaction.LogFilter{Instance: "1"}.Matches(message)).To(BeTrue())
+			Expect(sharedaction.LogFilter{Instance: "0"}.Matches(message)).To(BeFalse())
+		})
+
+		It("matches the pattern against the message", func() {
+			Expect(sharedaction.LogFilter{Pattern: regexp.MustCompile(` 2[0-9]{2}$`)}.Matches(message)).To(BeTrue())
+			Expect(sharedaction.LogFilter{Pattern: regexp.MustCompile(` 5[0-9]{2}$`)}.Matches(message)).To(BeFalse())
+		})
+	})
+
+	Describe("GetFilteredRecentLogs", func() {
+		var (
+			filter   sharedaction.LogFilter
+			messages []sharedaction.LogMessage
+			err      error
+		)
+
+		logEnvelope := func(timestamp int64, payload string, sourceType string) *loggregator_v2.Envelope {
+			return &loggregator_v2.Envelope{
+				Timestamp:  timestamp,
+				SourceId:   "some-app-guid",
+				InstanceId: "0",
+				Message: &loggregator_v2.Envelope_Log{
+					Log: &loggregator_v2.Log{Payload: []byte(payload), Type: loggregator_v2.Log_OUT},
+				},
+				Tags: map[string]string{"source_type": sourceType},
+			}
+		}
+
+		readQuery := func(call int) url.Values {
+			_, _, _, readOptions := fakeLogCacheClient.ReadArgsForCall(call)
+			query := make(url.Values)
+			for _, readOption := range readOptions {
+				readOption(new(url.URL), query)
+			}
+			return query
+		}
+
+		BeforeEach(func() {
+			filter = sharedaction.LogFilter{}
+		})
+
+		JustBeforeEach(func() {
+			messages, err = sharedaction.GetFilteredRecentLogs("some-app-guid", fakeLogCacheClient, filter)
+		})
+
+		When("the time window is set", func() {
+			BeforeEach(func() {
+				filter.Since = time.Unix(0, 100)
+				filter.Until = time.Unix(0, 200)
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					logEnvelope(150, "message-2", "APP/PROC/WEB"),
+					logEnvelope(120, "message-1", "RTR"),
+				}, nil)
+			})
+
+			It("reads the window from Log Cache and returns the logs oldest first", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+
+				_, sourceID, start, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				Expect(start).To(Equal(time.Unix(0, 100)))
+				Expect(readQuery(0).Get("end_time")).To(Equal("200"))
+				Expect(readQuery(0).Get("descending")).To(Equal("true"))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("message-1"))
+				Expect(messages[1].Message()).To(Equal("message-2"))
+			})
+		})
+
+		When("the first page does not have enough matching logs", func() {
+			BeforeEach(func() {
+				filter.SourceType = "RTR"
+				filter.Lines = 2
+
+				firstPage := make([]*loggregator_v2.Envelope, sharedaction.RecentLogsLines)
+				for i := range firstPage {
+					firstPage[i] = logEnvelope(int64(5000-i), "app-message", "APP/PROC/WEB")
+				}
+				firstPage[10] = logEnvelope(4990, "router-message-3", "RTR")
+
+				fakeLogCacheClient.ReadReturnsOnCall(0, firstPage, nil)
+				fakeLogCacheClient.ReadReturnsOnCall(1, []*loggregator_v2.Envelope{
+					logEnvelope(3000, "router-message-2", "RTR"),
+					logEnvelope(2000, "router-message-1", "RTR"),
+				}, nil)
+			})
+
+			It("walks backward through the pages until enough logs match", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+				Expect(readQuery(0).Get("end_time")).To(BeEmpty())
+				Expect(readQuery(1).Get("end_time")).To(Equal("4002"))
+
+				Expect(messages).To(HaveLen(2))
+				Expect(messages[0].Message()).To(Equal("router-message-2"))
+				Expect(messages[1].Message()).To(Equal("router-message-3"))
+			})
+		})
+
+		When("envelopes at the oldest timestamp of a page continue on the next page", func() {
+			BeforeEach(func() {
+				filter.SourceType = "RTR"
+
+				firstPage := make([]*loggregator_v2.Envelope, sharedaction.RecentLogsLines)
+				for i := range firstPage {
+					firstPage[i] = logEnvelope(int64(5000-i), "app-message", "APP/PROC/WEB")
+				}
+				firstPage[len(firstPage)-2] = logEnvelope(4001, "router-message-3", "RTR")
+				firstPage[len(firstPage)-1] = logEnvelope(4001, "router-message-4", "RTR")
+
+				fakeLogCacheClient.ReadReturnsOnCall(0, firstPage, nil)
+				fakeLogCacheClient.ReadReturnsOnCall(1, []*loggregator_v2.Envelope{
+					logEnvelope(4001, "router-message-4", "RTR"),
+					logEnvelope(4001, "router-message-2", "RTR"),
+					logEnvelope(4001, "router-message-3", "RTR"),
+					logEnvelope(3000, "router-message-1", "RTR"),
+				}, nil)
+			})
+
+			It("reads the next page up to and including that timestamp and drops the envelopes already read", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+				Expect(readQuery(1).Get("end_time")).To(Equal("4002"))
+
+				var payloads []string
+				for _, message := range messages {
+					payloads = append(payloads, message.Message())
+				}
+				Expect(payloads).To(Equal([]string{"router-message-1", "router-message-2", "router-message-4", "router-message-3"}))
+			})
+		})
+
+		When("a whole page shares a timestamp that has been read before", func() {
+			BeforeEach(func() {
+				page := make([]*loggregator_v2.Envelope, sharedaction.RecentLogsLines)
+				for i := range page {
+					page[i] = logEnvelope(4000, fmt.Sprintf("message-%d", i), "APP/PROC/WEB")
+				}
+
+				fakeLogCacheClient.ReadReturnsOnCall(0, page, nil)
+				fakeLogCacheClient.ReadReturnsOnCall(1, page, nil)
+				fakeLogCacheClient.ReadReturnsOnCall(2, []*loggregator_v2.Envelope{
+					logEnvelope(3000, "older-message", "APP/PROC/WEB"),
+				}, nil)
+				filter.Lines = sharedaction.RecentLogsLines + 1
+			})
+
+			It("continues before that timestamp", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(3))
+				Expect(readQuery(1).Get("end_time")).To(Equal("4001"))
+				Expect(readQuery(2).Get("end_time")).To(Equal("4000"))
+
+				Expect(messages).To(HaveLen(sharedaction.RecentLogsLines + 1))
+				Expect(messages[0].Message()).To(Equal("older-message"))
+			})
+		})
+
+		When("Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("some-recent-logs-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: some-recent-logs-error"))
+			})
+		})
+
+		When("Log Cache returns a resource-exhausted error", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturnsOnCall(0, nil, errors.New("unexpected status code 429"))
+				fakeLogCacheClient.ReadReturnsOnCall(1, []*loggregator_v2.Envelope{logEnvelope(10, "message-1", "APP/PROC/WEB")}, nil)
+			})
+
+			It("halves the page size and tries again", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(readQuery(0).Get("limit")).To(Equal("1000"))
+				Expect(readQuery(1).Get("limit")).To(Equal("500"))
+				Expect(messages).To(HaveLen(1))
+			})
+		})
+	})
 })
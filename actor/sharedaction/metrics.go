@@ -0,0 +1,215 @@
+package sharedaction
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	logcache "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+)
+
+// RecentMetricsEnvelopes is the number of gauge and counter envelopes read
+// from Log Cache to find the latest value of each metric.
+const RecentMetricsEnvelopes = 1000
+
+// GaugeValue is a single reading of a gauge metric.
+type GaugeValue struct {
+	Value float64
+	Unit  string
+}
+
+// InstanceMetrics holds the latest value of each gauge and counter metric
+// emitted for one instance of one process of an application.
+type InstanceMetrics struct {
+	ProcessType string
+	Instance    string
+	Timestamp   time.Time
+	Gauges      map[string]GaugeValue
+	Counters    map[string]uint64
+}
+
+// processInstance identifies an instance of one process of an application.
+// Instance indexes are only unique within a process type.
+type processInstance struct {
+	processType string
+	instance    string
+}
+
+// MetricSample is one value of a PromQL query result.
+type MetricSample struct {
+	Labels    map[string]string
+	Timestamp time.Time
+	Value     float64
+}
+
+// GetLatestMetrics returns the latest gauge and counter values of each
+// instance of the given source, ordered by process type and instance index.
+func GetLatestMetrics(sourceID string, client LogCacheClient) ([]InstanceMetrics, error) {
+	limit := RecentMetricsEnvelopes
+	var envelopes []*loggregator_v2.Envelope
+	var err error
+
+	for limit >= 1 {
+		envelopes, err = client.Read(
+			context.Background(),
+			sourceID,
+			time.Time{},
+			logcache.WithEnvelopeTypes(logcache_v1.EnvelopeType_GAUGE, logcache_v1.EnvelopeType_COUNTER),
+			logcache.WithLimit(limit),
+			logcache.WithDescending(),
+		)
+		if err == nil || err.Error() != "unexpected status code 429" {
+			break
+		}
+		limit /= 2
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve metrics from Log Cache: %s", err)
+	}
+
+	return latestInstanceMetrics(envelopes), nil
+}
+
+// GetStreamingMetrics reads the latest metrics of the given source every
+// interval until the returned cancel function is called.
+func GetStreamingMetrics(sourceID string, client LogCacheClient, interval time.Duration) (<-chan []InstanceMetrics, <-chan error, context.CancelFunc) {
+	outgoingMetricsStream := make(chan []InstanceMetrics)
+	outgoingErrStream := make(chan error)
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	go func() {
+		defer close(outgoingMetricsStream)
+		defer close(outgoingErrStream)
+
+		for {
+			metrics, err := GetLatestMetrics(sourceID, client)
+			if err != nil {
+				select {
+				case outgoingErrStream <- err:
+				case <-ctx.Done():
+					return
+				}
+			} else {
+				select {
+				case outgoingMetricsStream <- metrics:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return outgoingMetricsStream, outgoingErrStream, cancelFunc
+}
+
+// QueryMetrics runs a PromQL instant query against Log Cache.
+func QueryMetrics(query string, client LogCacheClient) ([]MetricSample, error) {
+	result, err := client.PromQL(context.Background(), query)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to query metrics from Log Cache: %s", err)
+	}
+
+	var samples []MetricSample
+	if scalar := result.GetScalar(); scalar != nil {
+		samples = append(samples, MetricSample{
+			Timestamp: parsePromQLTime(scalar.GetTime()),
+			Value:     scalar.GetValue(),
+		})
+	}
+	for _, sample := range result.GetVector().GetSamples() {
+		samples = append(samples, MetricSample{
+			Labels:    sample.GetMetric(),
+			Timestamp: parsePromQLTime(sample.GetPoint().GetTime()),
+			Value:     sample.GetPoint().GetValue(),
+		})
+	}
+	for _, series := range result.GetMatrix().GetSeries() {
+		for _, point := range series.GetPoints() {
+			samples = append(samples, MetricSample{
+				Labels:    series.GetMetric(),
+				Timestamp: parsePromQLTime(point.GetTime()),
+				Value:     point.GetValue(),
+			})
+		}
+	}
+
+	return samples, nil
+}
+
+// latestInstanceMetrics groups envelopes, newest first, by process type and
+// instance and keeps the newest value of each metric.
+func latestInstanceMetrics(envelopes []*loggregator_v2.Envelope) []InstanceMetrics {
+	byInstance := map[processInstance]*InstanceMetrics{}
+	var instances []processInstance
+
+	for _, envelope := range envelopes {
+		instance := processInstance{
+			processType: envelope.GetTags()["process_type"],
+			instance:    envelope.GetInstanceId(),
+		}
+		metrics, ok := byInstance[instance]
+		if !ok {
+			metrics = &InstanceMetrics{
+				ProcessType: instance.processType,
+				Instance:    instance.instance,
+				Timestamp:   time.Unix(0, envelope.GetTimestamp()),
+				Gauges:      map[string]GaugeValue{},
+				Counters:    map[string]uint64{},
+			}
+			byInstance[instance] = metrics
+			instances = append(instances, instance)
+		}
+
+		switch message := envelope.GetMessage().(type) {
+		case *loggregator_v2.Envelope_Gauge:
+			for name, value := range message.Gauge.GetMetrics() {
+				if _, seen := metrics.Gauges[name]; !seen {
+					metrics.Gauges[name] = GaugeValue{Value: value.GetValue(), Unit: value.GetUnit()}
+				}
+			}
+		case *loggregator_v2.Envelope_Counter:
+			name := message.Counter.GetName()
+			if _, seen := metrics.Counters[name]; !seen {
+				metrics.Counters[name] = message.Counter.GetTotal()
+			}
+		}
+	}
+
+	sort.Slice(instances, func(i, j int) bool {
+		if instances[i].processType != instances[j].processType {
+			return instances[i].processType < instances[j].processType
+		}
+		left, leftErr := strconv.Atoi(instances[i].instance)
+		right, rightErr := strconv.Atoi(instances[j].instance)
+		if leftErr != nil || rightErr != nil {
+			return instances[i].instance < instances[j].instance
+		}
+		return left < right
+	})
+
+	var latest []InstanceMetrics
+	for _, instance := range instances {
+		latest = append(latest, *byInstance[instance])
+	}
+	return latest
+}
+
+// parsePromQLTime parses the fractional Unix seconds used for PromQL result
+// times.
+func parsePromQLTime(value string) time.Time {
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
@@ -0,0 +1,262 @@
+package sharedaction_test
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metrics Actions", func() {
+	var fakeLogCacheClient *sharedactionfakes.FakeLogCacheClient
+
+	BeforeEach(func() {
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	gaugeEnvelope := func(timestamp int64, instance string, values map[string]float64) *loggregator_v2.Envelope {
+		metrics := map[string]*loggregator_v2.GaugeValue{}
+		for name, value := range values {
+			metrics[name] = &loggregator_v2.GaugeValue{Value: value, Unit: "bytes"}
+		}
+		return &loggregator_v2.Envelope{
+			Timestamp:  timestamp,
+			InstanceId: instance,
+			Message:    &loggregator_v2.Envelope_Gauge{Gauge: &loggregator_v2.Gauge{Metrics: metrics}},
+		}
+	}
+
+	withProcessType := func(envelope *loggregator_v2.Envelope, processType string) *loggregator_v2.Envelope {
+		envelope.Tags = map[string]string{"process_type": processType}
+		return envelope
+	}
+
+	counterEnvelope := func(timestamp int64, instance string, name string, total uint64) *loggregator_v2.Envelope {
+		return &loggregator_v2.Envelope{
+			Timestamp:  timestamp,
+			InstanceId: instance,
+			Message:    &loggregator_v2.Envelope_Counter{Counter: &loggregator_v2.Counter{Name: name, Total: total}},
+		}
+	}
+
+	Describe("GetLatestMetrics", func() {
+		var (
+			metrics []sharedaction.InstanceMetrics
+			err     error
+		)
+
+		JustBeforeEach(func() {
+			metrics, err = sharedaction.GetLatestMetrics("some-app-guid", fakeLogCacheClient)
+		})
+
+		When("log cache returns gauge and counter envelopes", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					gaugeEnvelope(300, "10", map[string]float64{"memory": 30}),
+					counterEnvelope(290, "2", "requests", 7),
+					gaugeEnvelope(280, "2", map[string]float64{"memory": 20, "disk": 5}),
+					gaugeEnvelope(200, "2", map[string]float64{"memory": 10}),
+					counterEnvelope(190, "2", "requests", 3),
+				}, nil)
+			})
+
+			It("reads the latest gauges and counters from log cache", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(1))
+
+				_, sourceID, _, readOptions := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+				query := make(url.Values)
+				for _, readOption := range readOptions {
+					readOption(new(url.URL), query)
+				}
+				Expect(query["envelope_types"]).To(ConsistOf("GAUGE", "COUNTER"))
+				Expect(query.Get("descending")).To(Equal("true"))
+				Expect(query.Get("limit")).To(Equal("1000"))
+			})
+
+			It("keeps the newest value of each metric per instance, ordered by instance index", func() {
+				Expect(metrics).To(Equal([]sharedaction.InstanceMetrics{
+					{
+						Instance:  "2",
+						Timestamp: time.Unix(0, 290),
+						Gauges: map[string]sharedaction.GaugeValue{
+							"memory": {Value: 20, Unit: "bytes"},
+							"disk":   {Value: 5, Unit: "bytes"},
+						},
+						Counters: map[string]uint64{"requests": 7},
+					},
+					{
+						Instance:  "10",
+						Timestamp: time.Unix(0, 300),
+						Gauges: map[string]sharedaction.GaugeValue{
+							"memory": {Value: 30, Unit: "bytes"},
+						},
+						Counters: map[string]uint64{},
+					},
+				}))
+			})
+		})
+
+		When("the app has several process types", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					withProcessType(gaugeEnvelope(300, "0", map[string]float64{"memory": 30}), "worker"),
+					withProcessType(gaugeEnvelope(290, "0", map[string]float64{"memory": 20}), "web"),
+					withProcessType(gaugeEnvelope(280, "1", map[string]float64{"memory": 10}), "web"),
+				}, nil)
+			})
+
+			It("keeps the instances of each process type apart, ordered by process type", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(metrics).To(Equal([]sharedaction.InstanceMetrics{
+					{
+						ProcessType: "web",
+						Instance:    "0",
+						Timestamp:   time.Unix(0, 290),
+						Gauges:      map[string]sharedaction.GaugeValue{"memory": {Value: 20, Unit: "bytes"}},
+						Counters:    map[string]uint64{},
+					},
+					{
+						ProcessType: "web",
+						Instance:    "1",
+						Timestamp:   time.Unix(0, 280),
+						Gauges:      map[string]sharedaction.GaugeValue{"memory": {Value: 10, Unit: "bytes"}},
+						Counters:    map[string]uint64{},
+					},
+					{
+						ProcessType: "worker",
+						Instance:    "0",
+						Timestamp:   time.Unix(0, 300),
+						Gauges:      map[string]sharedaction.GaugeValue{"memory": {Value: 30, Unit: "bytes"}},
+						Counters:    map[string]uint64{},
+					},
+				}))
+			})
+		})
+
+		When("log cache is rate limiting requests", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturnsOnCall(0, nil, errors.New("unexpected status code 429"))
+				fakeLogCacheClient.ReadReturnsOnCall(1, nil, nil)
+			})
+
+			It("retries with a smaller limit", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(2))
+				Expect(metrics).To(BeEmpty())
+			})
+		})
+
+		When("log cache returns an error", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve metrics from Log Cache: some-error"))
+			})
+		})
+	})
+
+	Describe("GetStreamingMetrics", func() {
+		It("sends the latest metrics until it is cancelled", func() {
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				gaugeEnvelope(100, "0", map[string]float64{"cpu": 1}),
+			}, nil)
+
+			metrics, errs, cancel := sharedaction.GetStreamingMetrics("some-app-guid", fakeLogCacheClient, time.Millisecond)
+
+			Eventually(metrics).Should(Receive(HaveLen(1)))
+			Eventually(metrics).Should(Receive(HaveLen(1)))
+
+			cancel()
+			Eventually(metrics).Should(BeClosed())
+			Eventually(errs).Should(BeClosed())
+		})
+
+		It("sends read errors on the error channel", func() {
+			fakeLogCacheClient.ReadReturns(nil, errors.New("some-error"))
+
+			_, errs, cancel := sharedaction.GetStreamingMetrics("some-app-guid", fakeLogCacheClient, time.Millisecond)
+			defer cancel()
+
+			Eventually(errs).Should(Receive(MatchError("Failed to retrieve metrics from Log Cache: some-error")))
+		})
+	})
+
+	Describe("QueryMetrics", func() {
+		var (
+			samples []sharedaction.MetricSample
+			err     error
+		)
+
+		JustBeforeEach(func() {
+			samples, err = sharedaction.QueryMetrics(`cpu{source_id="some-app-guid"}`, fakeLogCacheClient)
+		})
+
+		When("the query returns a vector", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.PromQLReturns(&logcache_v1.PromQL_InstantQueryResult{
+					Result: &logcache_v1.PromQL_InstantQueryResult_Vector{
+						Vector: &logcache_v1.PromQL_Vector{
+							Samples: []*logcache_v1.PromQL_Sample{
+								{
+									Metric: map[string]string{"__name__": "cpu", "instance_id": "0"},
+									Point:  &logcache_v1.PromQL_Point{Time: "1.5", Value: 2.5},
+								},
+							},
+						},
+					},
+				}, nil)
+			})
+
+			It("returns one sample per vector element", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				_, query, _ := fakeLogCacheClient.PromQLArgsForCall(0)
+				Expect(query).To(Equal(`cpu{source_id="some-app-guid"}`))
+
+				Expect(samples).To(Equal([]sharedaction.MetricSample{{
+					Labels:    map[string]string{"__name__": "cpu", "instance_id": "0"},
+					Timestamp: time.Unix(1, 500000000),
+					Value:     2.5,
+				}}))
+			})
+		})
+
+		When("the query returns a scalar", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.PromQLReturns(&logcache_v1.PromQL_InstantQueryResult{
+					Result: &logcache_v1.PromQL_InstantQueryResult_Scalar{
+						Scalar: &logcache_v1.PromQL_Scalar{Time: "2", Value: 4},
+					},
+				}, nil)
+			})
+
+			It("returns a single unlabelled sample", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(samples).To(Equal([]sharedaction.MetricSample{{
+					Timestamp: time.Unix(2, 0),
+					Value:     4,
+				}}))
+			})
+		})
+
+		When("the query fails", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.PromQLReturns(nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to query metrics from Log Cache: some-error"))
+			})
+		})
+	})
+})
@@ -0,0 +1,142 @@
+package sharedaction
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// InstanceSSHOptions are the connection details for a single app instance.
+// Each instance needs its own one-time passcode, which GetPasscode is called
+// for right before connecting so that it does not expire while the instance
+// waits for its turn.
+type InstanceSSHOptions struct {
+	Index              uint
+	Username           string
+	GetPasscode        func() (string, error)
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+}
+
+// InstanceCommandResult is the outcome of running a command on one instance.
+// Err is set when the command could not be run at all, and ExitStatus is the
+// exit status of the command otherwise.
+type InstanceCommandResult struct {
+	Index      uint
+	ExitStatus int
+	Err        error
+}
+
+// ExecuteSecureShellCommandOnInstances runs commands on each of the given
+// instances, at most maxParallel at a time, with a new client from
+// newSSHClient for every instance. Each line of output is prefixed with the
+// index of the instance that wrote it. The results are in the same order as
+// instances.
+func (actor Actor) ExecuteSecureShellCommandOnInstances(
+	newSSHClient func() SecureShellClient,
+	instances []InstanceSSHOptions,
+	commands []string,
+	maxParallel int,
+	stdout io.Writer,
+	stderr io.Writer,
+) []InstanceCommandResult {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+
+	results := make([]InstanceCommandResult, len(instances))
+	outputLock := &sync.Mutex{}
+	semaphore := make(chan struct{}, maxParallel)
+	wg := &sync.WaitGroup{}
+
+	for i, instance := range instances {
+		wg.Add(1)
+		go func(i int, instance InstanceSSHOptions) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			prefix := fmt.Sprintf("[%d] ", instance.Index)
+			instanceStdout := &prefixedLineWriter{prefix: prefix, dest: stdout, lock: outputLock}
+			instanceStderr := &prefixedLineWriter{prefix: prefix, dest: stderr, lock: outputLock}
+
+			err := runInstanceCommand(newSSHClient(), instance, commands, instanceStdout, instanceStderr)
+			instanceStdout.Flush()
+			instanceStderr.Flush()
+
+			results[i] = InstanceCommandResult{Index: instance.Index}
+			var exitErr *ssh.ExitError
+			if errors.As(err, &exitErr) {
+				results[i].ExitStatus = exitErr.ExitStatus()
+			} else if err != nil {
+				results[i].Err = err
+			}
+		}(i, instance)
+	}
+
+	wg.Wait()
+	return results
+}
+
+func runInstanceCommand(sshClient SecureShellClient, instance InstanceSSHOptions, commands []string, stdout io.Writer, stderr io.Writer) error {
+	passcode, err := instance.GetPasscode()
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.Connect(instance.Username, passcode, instance.Endpoint, instance.HostKeyFingerprint, instance.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	return sshClient.ExecuteCommand(commands, stdout, stderr)
+}
+
+// prefixedLineWriter writes complete lines to dest with prefix in front of
+// them. The lock is shared between writers so that lines from different
+// instances are not interleaved.
+type prefixedLineWriter struct {
+	prefix  string
+	dest    io.Writer
+	lock    *sync.Mutex
+	partial []byte
+}
+
+func (w *prefixedLineWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+
+	for {
+		newline := bytes.IndexByte(w.partial, '\n')
+		if newline < 0 {
+			return len(p), nil
+		}
+
+		err := w.writeLine(w.partial[:newline+1])
+		if err != nil {
+			return len(p), err
+		}
+		w.partial = w.partial[newline+1:]
+	}
+}
+
+// Flush writes any output that did not end in a newline.
+func (w *prefixedLineWriter) Flush() {
+	if len(w.partial) > 0 {
+		_ = w.writeLine(append(w.partial, '\n'))
+		w.partial = nil
+	}
+}
+
+func (w *prefixedLineWriter) writeLine(line []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err := w.dest.Write(append([]byte(w.prefix), line...))
+	return err
+}
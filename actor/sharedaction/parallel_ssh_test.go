@@ -0,0 +1,199 @@
+package sharedaction_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Parallel SSH Actions", func() {
+	var (
+		actor     *Actor
+		clients   []*sharedactionfakes.FakeSecureShellClient
+		newClient func() SecureShellClient
+		stdout    *Buffer
+		stderr    *Buffer
+		instances []InstanceSSHOptions
+		results   []InstanceCommandResult
+	)
+
+	passcodeGetter := func(passcode string) func() (string, error) {
+		return func() (string, error) { return passcode, nil }
+	}
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+		stdout = NewBuffer()
+		stderr = NewBuffer()
+
+		clients = nil
+		clientsLock := &sync.Mutex{}
+		newClient = func() SecureShellClient {
+			client := new(sharedactionfakes.FakeSecureShellClient)
+			client.ExecuteCommandStub = func(commands []string, stdout io.Writer, stderr io.Writer) error {
+				username, _, _, _, _ := client.ConnectArgsForCall(0)
+				fmt.Fprintf(stdout, "out from %s\nsecond line", username)
+				fmt.Fprintf(stderr, "err from %s\n", username)
+				return nil
+			}
+
+			clientsLock.Lock()
+			defer clientsLock.Unlock()
+			clients = append(clients, client)
+			return client
+		}
+
+		instances = []InstanceSSHOptions{
+			{Index: 0, Username: "cf:some-process-guid/0", GetPasscode: passcodeGetter("passcode-0"), Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint"},
+			{Index: 2, Username: "cf:some-process-guid/2", GetPasscode: passcodeGetter("passcode-2"), Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint"},
+		}
+	})
+
+	Describe("ExecuteSecureShellCommandOnInstances", func() {
+		JustBeforeEach(func() {
+			results = actor.ExecuteSecureShellCommandOnInstances(newClient, instances, []string{"ps", "aux"}, 2, stdout, stderr)
+		})
+
+		It("connects to every instance with its own passcode and runs the command", func() {
+			Expect(clients).To(HaveLen(2))
+
+			var passcodes []string
+			for _, client := range clients {
+				Expect(client.ConnectCallCount()).To(Equal(1))
+				_, passcode, endpoint, fingerprint, _ := client.ConnectArgsForCall(0)
+				Expect(endpoint).To(Equal("some-endpoint"))
+				Expect(fingerprint).To(Equal("some-fingerprint"))
+				passcodes = append(passcodes, passcode)
+
+				Expect(client.ExecuteCommandCallCount()).To(Equal(1))
+				commands, _, _ := client.ExecuteCommandArgsForCall(0)
+				Expect(commands).To(Equal([]string{"ps", "aux"}))
+				Expect(client.CloseCallCount()).To(Equal(1))
+			}
+			Expect(passcodes).To(ConsistOf("passcode-0", "passcode-2"))
+		})
+
+		It("prefixes each line of output with the instance index", func() {
+			Expect(string(stdout.Contents())).To(ContainSubstring("[0] out from cf:some-process-guid/0\n"))
+			Expect(string(stdout.Contents())).To(ContainSubstring("[0] second line\n"))
+			Expect(string(stdout.Contents())).To(ContainSubstring("[2] out from cf:some-process-guid/2\n"))
+			Expect(string(stdout.Contents())).To(ContainSubstring("[2] second line\n"))
+			Expect(string(stderr.Contents())).To(ContainSubstring("[0] err from cf:some-process-guid/0\n"))
+			Expect(string(stderr.Contents())).To(ContainSubstring("[2] err from cf:some-process-guid/2\n"))
+		})
+
+		It("returns a successful result per instance in order", func() {
+			Expect(results).To(Equal([]InstanceCommandResult{
+				{Index: 0},
+				{Index: 2},
+			}))
+		})
+
+		When("connecting to an instance fails", func() {
+			BeforeEach(func() {
+				instances[1].GetPasscode = passcodeGetter("bad-passcode")
+				wrappedNewClient := newClient
+				newClient = func() SecureShellClient {
+					client := wrappedNewClient().(*sharedactionfakes.FakeSecureShellClient)
+					client.ConnectStub = func(_ string, passcode string, _ string, _ string, _ bool) error {
+						if passcode == "bad-passcode" {
+							return errors.New("unable to authenticate")
+						}
+						return nil
+					}
+					return client
+				}
+			})
+
+			It("reports the error for that instance only", func() {
+				Expect(results[0]).To(Equal(InstanceCommandResult{Index: 0}))
+				Expect(results[1].Index).To(Equal(uint(2)))
+				Expect(results[1].Err).To(MatchError("unable to authenticate"))
+			})
+		})
+
+		When("getting the passcode for an instance fails", func() {
+			BeforeEach(func() {
+				instances[1].GetPasscode = func() (string, error) {
+					return "", errors.New("some-passcode-error")
+				}
+			})
+
+			It("reports the error for that instance without connecting to it", func() {
+				Expect(results[0]).To(Equal(InstanceCommandResult{Index: 0}))
+				Expect(results[1].Err).To(MatchError("some-passcode-error"))
+
+				connected := 0
+				for _, client := range clients {
+					connected += client.ConnectCallCount()
+				}
+				Expect(connected).To(Equal(1))
+			})
+		})
+
+		When("there are more instances than the parallel limit", func() {
+			var (
+				maxRunning       int32
+				passcodesInUse   int32
+				maxPasscodesHeld int32
+			)
+
+			BeforeEach(func() {
+				var running int32
+				maxRunning = 0
+				passcodesInUse = 0
+				maxPasscodesHeld = 0
+				instances = nil
+				for i := uint(0); i < 6; i++ {
+					instances = append(instances, InstanceSSHOptions{
+						Index: i,
+						GetPasscode: func() (string, error) {
+							recordMax(&maxPasscodesHeld, atomic.AddInt32(&passcodesInUse, 1))
+							return "some-passcode", nil
+						},
+					})
+				}
+
+				newClient = func() SecureShellClient {
+					client := new(sharedactionfakes.FakeSecureShellClient)
+					client.ExecuteCommandStub = func([]string, io.Writer, io.Writer) error {
+						recordMax(&maxRunning, atomic.AddInt32(&running, 1))
+						atomic.AddInt32(&running, -1)
+						return nil
+					}
+					client.CloseStub = func() error {
+						atomic.AddInt32(&passcodesInUse, -1)
+						return nil
+					}
+					return client
+				}
+			})
+
+			It("runs at most that many commands at once", func() {
+				Expect(results).To(HaveLen(6))
+				Expect(atomic.LoadInt32(&maxRunning)).To(BeNumerically("<=", 2))
+			})
+
+			It("gets each passcode only when its instance's turn comes", func() {
+				Expect(atomic.LoadInt32(&maxPasscodesHeld)).To(BeNumerically("<=", 2))
+			})
+		})
+	})
+})
+
+func recordMax(max *int32, current int32) {
+	for {
+		seen := atomic.LoadInt32(max)
+		if current <= seen || atomic.CompareAndSwapInt32(max, seen, current) {
+			return
+		}
+	}
+}
@@ -8,7 +8,11 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 
@@ -24,6 +28,10 @@ const (
 	MaxResourceMatchChunkSize     = 1000
 )
 
+// ReproducibleArchiveModTime is the modification time given to every entry of
+// a reproducible archive. It is the earliest time a zip file can represent.
+var ReproducibleArchiveModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
 var DefaultIgnoreLines = []string{
 	".cfignore",
 	".DS_Store",
@@ -127,10 +135,13 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 	return resources, nil
 }
 
-// GatherDirectoryResources returns a list of resources for a directory.
+// GatherDirectoryResources returns a list of resources for a directory. The
+// files are hashed in parallel, and when the actor has a ResourceCache,
+// unchanged files reuse the SHA1 from the previous run.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
 	var (
 		resources []Resource
+		toHash    []fileToHash
 		gitIgnore *ignore.GitIgnore
 	)
 
@@ -146,6 +157,13 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, err
 	}
 
+	// The resource cache is keyed by absolute paths, so that pushing the same
+	// directory from elsewhere still finds its entries.
+	evalDir, err = filepath.Abs(evalDir)
+	if err != nil {
+		return nil, err
+	}
+
 	walkErr := filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -179,23 +197,11 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 			// any resource matching on symlinks.
 			resource.Mode = fixMode(info.Mode())
 		default:
-			// If the file is regular we want to open
-			// and calculate the sha of the file
-			file, err := os.Open(fullPath)
-			if err != nil {
-				return err
-			}
-			defer file.Close()
-
-			sum := sha1.New()
-			_, err = io.Copy(sum, file)
-			if err != nil {
-				return err
-			}
-
+			// If the file is regular we want to calculate the sha of the
+			// file, which is done once the walk is complete
 			resource.Mode = fixMode(info.Mode())
-			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
 			resource.Size = info.Size()
+			toHash = append(toHash, fileToHash{index: len(resources), fullPath: fullPath, info: info})
 		}
 
 		resources = append(resources, resource)
@@ -206,7 +212,93 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, actionerror.EmptyDirectoryError{Path: sourceDir}
 	}
 
-	return resources, walkErr
+	hashErr := actor.hashFiles(resources, toHash)
+
+	if actor.ResourceCache != nil {
+		if err := actor.ResourceCache.Save(); err != nil {
+			log.Warnln("saving resource cache:", err)
+		}
+	}
+
+	if walkErr != nil {
+		return resources, walkErr
+	}
+	return resources, hashErr
+}
+
+type fileToHash struct {
+	index    int
+	fullPath string
+	info     os.FileInfo
+}
+
+// hashFiles fills in the SHA1 of each file in toHash using a pool of
+// workers, and returns the first error encountered.
+func (actor Actor) hashFiles(resources []Resource, toHash []fileToHash) error {
+	var (
+		wg       sync.WaitGroup
+		errLock  sync.Mutex
+		firstErr error
+	)
+
+	work := make(chan fileToHash)
+	workers := runtime.NumCPU()
+	if workers > len(toHash) {
+		workers = len(toHash)
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range work {
+				sha, err := actor.fileSHA1(file.fullPath, file.info)
+				if err != nil {
+					errLock.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errLock.Unlock()
+					continue
+				}
+				resources[file.index].SHA1 = sha
+			}
+		}()
+	}
+
+	for _, file := range toHash {
+		work <- file
+	}
+	close(work)
+	wg.Wait()
+
+	return firstErr
+}
+
+func (actor Actor) fileSHA1(fullPath string, info os.FileInfo) (string, error) {
+	if actor.ResourceCache != nil {
+		if sha, ok := actor.ResourceCache.Lookup(fullPath, info); ok {
+			return sha, nil
+		}
+	}
+
+	file, err := os.Open(fullPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	sum := sha1.New()
+	_, err = io.Copy(sum, file)
+	if err != nil {
+		return "", err
+	}
+
+	sha := fmt.Sprintf("%x", sum.Sum(nil))
+	if actor.ResourceCache != nil {
+		actor.ResourceCache.Store(fullPath, info, sha)
+	}
+	return sha, nil
 }
 
 // ZipArchiveResources zips an archive and a sorted (based on full
@@ -235,7 +327,15 @@ func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude
 		return zipPath, err
 	}
 
-	for _, archiveFile := range reader.File {
+	archiveFiles := reader.File
+	if actor.Config.ReproducibleArchives() {
+		archiveFiles = append([]*zip.File(nil), archiveFiles...)
+		sort.Slice(archiveFiles, func(i, j int) bool {
+			return archiveFiles[i].Name < archiveFiles[j].Name
+		})
+	}
+
+	for _, archiveFile := range archiveFiles {
 		resource, ok := actor.findInResources(archiveFile.Name, filesToInclude)
 		if !ok {
 			log.WithField("archiveFileName", archiveFile.Name).Debug("skipping file")
@@ -284,6 +384,13 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 	writer := zip.NewWriter(zipFile)
 	defer writer.Close()
 
+	if actor.Config.ReproducibleArchives() {
+		filesToInclude = append([]Resource(nil), filesToInclude...)
+		sort.Slice(filesToInclude, func(i, j int) bool {
+			return filesToInclude[i].Filename < filesToInclude[j].Filename
+		})
+	}
+
 	for _, resource := range filesToInclude {
 		fullPath := filepath.Join(sourceDir, resource.Filename)
 		log.WithField("fullPath", fullPath).Debug("zipping file")
@@ -329,7 +436,7 @@ func (actor Actor) ZipDirectoryResources(sourceDir string, filesToInclude []Reso
 	return zipPath, nil
 }
 
-func (Actor) addLinkToZipFromFileSystem(srcPath string,
+func (actor Actor) addLinkToZipFromFileSystem(srcPath string,
 	fileInfo os.FileInfo, resource Resource,
 	zipFile *zip.Writer,
 ) error {
@@ -341,6 +448,9 @@ func (Actor) addLinkToZipFromFileSystem(srcPath string,
 
 	header.Name = resource.Filename
 	header.Method = zip.Deflate
+	if actor.Config.ReproducibleArchives() {
+		normalizeHeader(header)
+	}
 
 	log.WithFields(log.Fields{
 		"srcPath":  srcPath,
@@ -368,7 +478,7 @@ func (Actor) addLinkToZipFromFileSystem(srcPath string,
 	return nil
 }
 
-func (Actor) addFileToZipFromFileSystem(srcPath string,
+func (actor Actor) addFileToZipFromFileSystem(srcPath string,
 	srcFile io.Reader, fileInfo os.FileInfo, resource Resource,
 	zipFile *zip.Writer,
 ) error {
@@ -386,6 +496,9 @@ func (Actor) addFileToZipFromFileSystem(srcPath string,
 	}
 	header.Method = zip.Deflate
 	header.SetMode(resource.Mode)
+	if actor.Config.ReproducibleArchives() {
+		normalizeHeader(header)
+	}
 
 	log.WithFields(log.Fields{
 		"srcPath":  srcPath,
@@ -425,6 +538,28 @@ func (Actor) addFileToZipFromFileSystem(srcPath string,
 	return nil
 }
 
+// normalizeHeader removes everything from a zip header that depends on the
+// machine building the archive: the modification time, and permissions beyond
+// whether the entry is executable. A file counts as executable when its group
+// or others may execute it, as fixMode sets the owner bits of every file on
+// windows.
+func normalizeHeader(header *zip.FileHeader) {
+	header.Modified = ReproducibleArchiveModTime
+	header.Extra = nil
+
+	mode := header.Mode()
+	switch {
+	case mode.IsDir():
+		header.SetMode(DefaultFolderPermissions)
+	case mode&os.ModeSymlink == os.ModeSymlink:
+		header.SetMode(os.ModeSymlink | 0777)
+	case mode&0011 != 0:
+		header.SetMode(0755)
+	default:
+		header.SetMode(0644)
+	}
+}
+
 func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnore, error) {
 	for _, item := range files {
 		if strings.HasSuffix(item.Name, ".cfignore") {
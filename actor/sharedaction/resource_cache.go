@@ -0,0 +1,178 @@
+package sharedaction
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// ResourceCacheEntryLifetime is how long an unused entry is kept in the
+// resource cache before it is pruned.
+const ResourceCacheEntryLifetime = 30 * 24 * time.Hour
+
+// resourceCacheLastUsedInterval is how old the last use of an entry has to be
+// before a hit refreshes it, so that pushes of unchanged files do not rewrite
+// the whole cache every time.
+const resourceCacheLastUsedInterval = 24 * time.Hour
+
+type resourceCacheEntry struct {
+	Size     int64  `json:"size"`
+	ModTime  int64  `json:"mtime"`
+	Inode    uint64 `json:"inode"`
+	SHA1     string `json:"sha1"`
+	LastUsed int64  `json:"last_used"`
+}
+
+// ResourceCache remembers the SHA1 of files on disk so that unchanged files
+// do not need to be hashed again on the next push. A file is considered
+// unchanged when its absolute path, size, modification time and inode all
+// match the cached entry.
+type ResourceCache struct {
+	path     string
+	entries  map[string]resourceCacheEntry
+	changed  bool
+	readOnly bool
+	lock     sync.Mutex
+}
+
+// NewResourceCache loads the resource cache stored at path. A missing or
+// unreadable cache file results in an empty cache.
+func NewResourceCache(path string) *ResourceCache {
+	cache := &ResourceCache{
+		path:    path,
+		entries: map[string]resourceCacheEntry{},
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithField("path", path).Warnln("reading resource cache:", err)
+		}
+		return cache
+	}
+
+	err = json.Unmarshal(raw, &cache.entries)
+	if err != nil {
+		log.WithField("path", path).Warnln("ignoring corrupt resource cache:", err)
+		cache.entries = map[string]resourceCacheEntry{}
+	}
+
+	return cache
+}
+
+// NewReadOnlyResourceCache loads the resource cache stored at path like
+// NewResourceCache, but never writes it back, for commands that must not
+// change anything.
+func NewReadOnlyResourceCache(path string) *ResourceCache {
+	cache := NewResourceCache(path)
+	cache.readOnly = true
+	return cache
+}
+
+// Lookup returns the cached SHA1 for the file at fullPath if the file has not
+// changed since it was stored.
+func (cache *ResourceCache) Lookup(fullPath string, info os.FileInfo) (string, bool) {
+	key, err := filepath.Abs(fullPath)
+	if err != nil {
+		return "", false
+	}
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	entry, ok := cache.entries[key]
+	if !ok || entry.Size != info.Size() || entry.ModTime != info.ModTime().UnixNano() || entry.Inode != fileInode(info) {
+		return "", false
+	}
+
+	now := time.Now()
+	if time.Unix(entry.LastUsed, 0).Before(now.Add(-resourceCacheLastUsedInterval)) {
+		entry.LastUsed = now.Unix()
+		cache.entries[key] = entry
+		cache.changed = true
+	}
+	return entry.SHA1, true
+}
+
+// Store records the SHA1 of the file at fullPath.
+func (cache *ResourceCache) Store(fullPath string, info os.FileInfo, sha1 string) {
+	key, err := filepath.Abs(fullPath)
+	if err != nil {
+		return
+	}
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	cache.entries[key] = resourceCacheEntry{
+		Size:     info.Size(),
+		ModTime:  info.ModTime().UnixNano(),
+		Inode:    fileInode(info),
+		SHA1:     sha1,
+		LastUsed: time.Now().Unix(),
+	}
+	cache.changed = true
+}
+
+// Save prunes entries that have not been used within
+// ResourceCacheEntryLifetime and writes the cache back to disk, if entries
+// were added, refreshed or pruned since it was loaded. A read-only cache is
+// never written.
+func (cache *ResourceCache) Save() error {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	if cache.readOnly {
+		return nil
+	}
+
+	cutoff := time.Now().Add(-ResourceCacheEntryLifetime).Unix()
+	for path, entry := range cache.entries {
+		if entry.LastUsed < cutoff {
+			delete(cache.entries, path)
+			cache.changed = true
+		}
+	}
+
+	if !cache.changed {
+		return nil
+	}
+
+	raw, err := json.Marshal(cache.entries)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(cache.path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(dir, "temp-resource-cache")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(raw)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tempFile.Name(), cache.path)
+	if err != nil {
+		return err
+	}
+
+	cache.changed = false
+	return nil
+}
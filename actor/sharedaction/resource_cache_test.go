@@ -0,0 +1,180 @@
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceCache", func() {
+	var (
+		cacheDir  string
+		cachePath string
+		filePath  string
+		cache     *ResourceCache
+	)
+
+	statFile := func() os.FileInfo {
+		info, err := os.Stat(filePath)
+		Expect(err).ToNot(HaveOccurred())
+		return info
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "resource-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		cachePath = filepath.Join(cacheDir, ".cf", "resource_cache.json")
+		filePath = filepath.Join(cacheDir, "some-file")
+		Expect(ioutil.WriteFile(filePath, []byte("some-contents"), 0644)).To(Succeed())
+
+		cache = NewResourceCache(cachePath)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	When("the file has not been stored", func() {
+		It("misses", func() {
+			_, ok := cache.Lookup(filePath, statFile())
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	When("the file has been stored", func() {
+		BeforeEach(func() {
+			cache.Store(filePath, statFile(), "some-sha")
+		})
+
+		It("returns the stored sha", func() {
+			sha, ok := cache.Lookup(filePath, statFile())
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+		})
+
+		It("persists the sha across saves", func() {
+			Expect(cache.Save()).To(Succeed())
+
+			sha, ok := NewResourceCache(cachePath).Lookup(filePath, statFile())
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+		})
+
+		It("finds the sha through a relative path", func() {
+			workingDir, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			relativePath, err := filepath.Rel(workingDir, filePath)
+			Expect(err).ToNot(HaveOccurred())
+
+			sha, ok := cache.Lookup(relativePath, statFile())
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+		})
+
+		When("the cache has been saved", func() {
+			BeforeEach(func() {
+				Expect(cache.Save()).To(Succeed())
+				cache = NewResourceCache(cachePath)
+				Expect(os.Remove(cachePath)).To(Succeed())
+			})
+
+			It("does not write the cache file again when only looking up recently used entries", func() {
+				_, ok := cache.Lookup(filePath, statFile())
+				Expect(ok).To(BeTrue())
+
+				Expect(cache.Save()).To(Succeed())
+				_, err := os.Stat(cachePath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		When("the modification time changes", func() {
+			BeforeEach(func() {
+				later := time.Now().Add(time.Hour)
+				Expect(os.Chtimes(filePath, later, later)).To(Succeed())
+			})
+
+			It("misses", func() {
+				_, ok := cache.Lookup(filePath, statFile())
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the size changes", func() {
+			BeforeEach(func() {
+				modTime := statFile().ModTime()
+				Expect(ioutil.WriteFile(filePath, []byte("some-longer-contents"), 0644)).To(Succeed())
+				Expect(os.Chtimes(filePath, modTime, modTime)).To(Succeed())
+			})
+
+			It("misses", func() {
+				_, ok := cache.Lookup(filePath, statFile())
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	When("the cache file is corrupt", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(cachePath, []byte("{not json"), 0600)).To(Succeed())
+			cache = NewResourceCache(cachePath)
+		})
+
+		It("starts with an empty cache", func() {
+			_, ok := cache.Lookup(filePath, statFile())
+			Expect(ok).To(BeFalse())
+
+			cache.Store(filePath, statFile(), "some-sha")
+			Expect(cache.Save()).To(Succeed())
+		})
+	})
+
+	When("the cache has entries that have not been used for a long time", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(cachePath, []byte(`{"/some-old-file":{"sha1":"some-sha","last_used":1}}`), 0600)).To(Succeed())
+			cache = NewResourceCache(cachePath)
+		})
+
+		It("prunes them from the cache file", func() {
+			Expect(cache.Save()).To(Succeed())
+
+			raw, err := ioutil.ReadFile(cachePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(raw)).To(Equal("{}"))
+		})
+	})
+
+	When("the cache is read-only", func() {
+		BeforeEach(func() {
+			cache = NewReadOnlyResourceCache(cachePath)
+			cache.Store(filePath, statFile(), "some-sha")
+		})
+
+		It("looks up the stored sha without writing the cache file", func() {
+			sha, ok := cache.Lookup(filePath, statFile())
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+
+			Expect(cache.Save()).To(Succeed())
+			_, err := os.Stat(cachePath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	When("nothing has changed", func() {
+		It("does not write the cache file", func() {
+			Expect(cache.Save()).To(Succeed())
+			_, err := os.Stat(cachePath)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+})
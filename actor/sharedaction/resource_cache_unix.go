@@ -0,0 +1,18 @@
+//go:build !windows
+// +build !windows
+
+package sharedaction
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileInode returns the inode of the file, so that a file replaced by another
+// with the same size and modification time is not mistaken for the original.
+func fileInode(info os.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(stat.Ino)
+	}
+	return 0
+}
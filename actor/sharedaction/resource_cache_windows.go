@@ -0,0 +1,12 @@
+//go:build windows
+// +build windows
+
+package sharedaction
+
+import "os"
+
+// fileInode returns 0 on windows, where os.FileInfo does not expose a file
+// index; the cache relies on the size and modification time alone.
+func fileInode(info os.FileInfo) uint64 {
+	return 0
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 
@@ -406,6 +407,98 @@ var _ = Describe("Resource Actions", func() {
 			})
 		})
 
+		When("reproducible archives are enabled", func() {
+			BeforeEach(func() {
+				fakeConfig.ReproducibleArchivesReturns(true)
+				resources = []Resource{
+					{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Mode: 0600},
+					{Filename: "level1", Mode: DefaultFolderPermissions},
+					{Filename: "symlink1", Mode: os.ModeSymlink},
+					{Filename: "level1/level2", Mode: DefaultFolderPermissions},
+					{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Mode: 0750},
+				}
+			})
+
+			It("sorts the entries and normalizes their timestamps and permissions", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				reader, err := zip.OpenReader(resultZip)
+				Expect(err).ToNot(HaveOccurred())
+				defer reader.Close()
+
+				var names []string
+				for _, file := range reader.File {
+					names = append(names, file.Name)
+					Expect(file.Modified.Equal(ReproducibleArchiveModTime)).To(BeTrue())
+				}
+				Expect(names).To(Equal([]string{"level1/", "level1/level2/", "level1/level2/tmpFile1", "symlink1", "tmpFile2"}))
+
+				Expect(reader.File[2].Mode()).To(Equal(os.FileMode(0755)))
+				Expect(reader.File[3].Mode()).To(Equal(os.ModeSymlink | 0777))
+				Expect(reader.File[4].Mode()).To(Equal(os.FileMode(0644)))
+			})
+
+			It("produces the same archive when the files are touched", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				firstZip, err := ioutil.ReadFile(resultZip)
+				Expect(err).ToNot(HaveOccurred())
+
+				later := time.Now().Add(time.Hour)
+				Expect(os.Chtimes(filepath.Join(srcDir, "tmpFile2"), later, later)).To(Succeed())
+				Expect(os.Chtimes(filepath.Join(srcDir, "level1"), later, later)).To(Succeed())
+
+				secondZipPath, err := actor.ZipDirectoryResources(srcDir, resources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(secondZipPath)
+
+				secondZip, err := ioutil.ReadFile(secondZipPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(secondZip).To(Equal(firstZip))
+			})
+
+			It("produces the same archive whether or not the owner permissions are forced as on windows", func() {
+				Expect(os.Chmod(filepath.Join(srcDir, "tmpFile3"), 0755)).To(Succeed())
+
+				unixResources, err := actor.GatherDirectoryResources(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				// fixMode makes every file readable, writable and executable by
+				// its owner on windows.
+				var windowsResources []Resource
+				for _, resource := range unixResources {
+					if !resource.Mode.IsDir() {
+						resource.Mode |= 0700
+					}
+					windowsResources = append(windowsResources, resource)
+				}
+
+				unixZipPath, err := actor.ZipDirectoryResources(srcDir, unixResources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(unixZipPath)
+
+				windowsZipPath, err := actor.ZipDirectoryResources(srcDir, windowsResources)
+				Expect(err).ToNot(HaveOccurred())
+				defer os.RemoveAll(windowsZipPath)
+
+				unixZip, err := ioutil.ReadFile(unixZipPath)
+				Expect(err).ToNot(HaveOccurred())
+				windowsZip, err := ioutil.ReadFile(windowsZipPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(windowsZip).To(Equal(unixZip))
+
+				reader, err := zip.OpenReader(unixZipPath)
+				Expect(err).ToNot(HaveOccurred())
+				defer reader.Close()
+
+				modes := map[string]os.FileMode{}
+				for _, file := range reader.File {
+					modes[file.Name] = file.Mode()
+				}
+				Expect(modes).To(HaveKeyWithValue("tmpFile2", os.FileMode(0644)))
+				Expect(modes).To(HaveKeyWithValue("tmpFile3", os.FileMode(0755)))
+			})
+		})
+
 		When("the files have changed since the scanning", func() {
 			BeforeEach(func() {
 				resources = []Resource{
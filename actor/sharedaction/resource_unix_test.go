@@ -319,6 +319,75 @@ var _ = Describe("Resource Actions", func() {
 						}))
 				})
 			})
+			When("the actor has a resource cache", func() {
+				var cachePath string
+
+				BeforeEach(func() {
+					cacheDir, err := ioutil.TempDir("", "resource-cache")
+					Expect(err).ToNot(HaveOccurred())
+					cachePath = filepath.Join(cacheDir, "resource_cache.json")
+					actor.ResourceCache = NewResourceCache(cachePath)
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(filepath.Dir(cachePath))).To(Succeed())
+				})
+
+				It("gathers the same resources as without the cache and saves their shas", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(gatheredResources).To(Equal(
+						[]Resource{
+							{Filename: "level1", Mode: DefaultFolderPermissions},
+							{Filename: "level1/level2", Mode: DefaultFolderPermissions},
+							{Filename: "level1/level2/tmpFile1", SHA1: "9e36efec86d571de3a38389ea799a796fe4782f4", Size: 9, Mode: 0644},
+							{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+							{Filename: "tmpFile3", SHA1: "f4c9ca85f3e084ffad3abbdabbd2a890c034c879", Size: 10, Mode: 0655},
+						}))
+					Expect(cachePath).To(BeAnExistingFile())
+				})
+
+				When("a file is unchanged since it was cached", func() {
+					BeforeEach(func() {
+						evalDir, err := filepath.EvalSymlinks(srcDir)
+						Expect(err).ToNot(HaveOccurred())
+						fullPath := filepath.Join(evalDir, "tmpFile2")
+						info, err := os.Stat(fullPath)
+						Expect(err).ToNot(HaveOccurred())
+						actor.ResourceCache.Store(fullPath, info, "some-cached-sha")
+					})
+
+					It("uses the cached sha instead of hashing the file", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(gatheredResources).To(ContainElement(
+							Resource{Filename: "tmpFile2", SHA1: "some-cached-sha", Size: 12, Mode: 0751},
+						))
+					})
+				})
+
+				When("a file has been replaced by another with the same size and modification time", func() {
+					BeforeEach(func() {
+						evalDir, err := filepath.EvalSymlinks(srcDir)
+						Expect(err).ToNot(HaveOccurred())
+						fullPath := filepath.Join(evalDir, "tmpFile2")
+						info, err := os.Stat(fullPath)
+						Expect(err).ToNot(HaveOccurred())
+						actor.ResourceCache.Store(fullPath, info, "some-cached-sha")
+
+						replacement := filepath.Join(evalDir, "replacement")
+						Expect(ioutil.WriteFile(replacement, []byte("Hello, Binky"), 0751)).To(Succeed())
+						Expect(os.Chtimes(replacement, info.ModTime(), info.ModTime())).To(Succeed())
+						Expect(os.Rename(replacement, fullPath)).To(Succeed())
+					})
+
+					It("hashes the file again", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(gatheredResources).To(ContainElement(
+							Resource{Filename: "tmpFile2", SHA1: "e594bdc795bb293a0e55724137e53a36dc0d9e95", Size: 12, Mode: 0751},
+						))
+					})
+				})
+			})
 		})
 
 		When("the directory is empty", func() {
@@ -0,0 +1,36 @@
+package sharedaction
+
+import "code.cloudfoundry.org/cli/util/clissh"
+
+type SCPDirection int
+
+const (
+	SCPUpload SCPDirection = iota
+	SCPDownload
+)
+
+type SCPOptions struct {
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+	SkipHostValidation bool
+	Direction          SCPDirection
+	LocalPath          string
+	RemotePath         string
+	Recursive          bool
+	Progress           clissh.TransferProgress
+}
+
+func (actor Actor) ExecuteSecureCopy(sshClient SecureShellClient, scpOptions SCPOptions) error {
+	err := sshClient.Connect(scpOptions.Username, scpOptions.Passcode, scpOptions.Endpoint, scpOptions.HostKeyFingerprint, scpOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	if scpOptions.Direction == SCPDownload {
+		return sshClient.Download(scpOptions.RemotePath, scpOptions.LocalPath, scpOptions.Recursive, scpOptions.Progress)
+	}
+	return sshClient.Upload(scpOptions.LocalPath, scpOptions.RemotePath, scpOptions.Recursive, scpOptions.Progress)
+}
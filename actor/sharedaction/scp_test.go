@@ -0,0 +1,113 @@
+package sharedaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCP Actions", func() {
+	var (
+		actor                 *Actor
+		fakeSecureShellClient *sharedactionfakes.FakeSecureShellClient
+		fakeProgress          *clisshfakes.FakeTransferProgress
+	)
+
+	BeforeEach(func() {
+		fakeSecureShellClient = new(sharedactionfakes.FakeSecureShellClient)
+		fakeProgress = new(clisshfakes.FakeTransferProgress)
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+	})
+
+	Describe("ExecuteSecureCopy", func() {
+		var (
+			scpOptions SCPOptions
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			scpOptions = SCPOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+				LocalPath:          "some-local-path",
+				RemotePath:         "some-remote-path",
+				Recursive:          true,
+				Progress:           fakeProgress,
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureCopy(fakeSecureShellClient, scpOptions)
+		})
+
+		It("connects with the provided authorization info", func() {
+			Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(1))
+			usernameArg, passcodeArg, endpointArg, fingerprintArg, skipHostValidationArg := fakeSecureShellClient.ConnectArgsForCall(0)
+			Expect(usernameArg).To(Equal("some-user"))
+			Expect(passcodeArg).To(Equal("some-passcode"))
+			Expect(endpointArg).To(Equal("some-endpoint"))
+			Expect(fingerprintArg).To(Equal("some-fingerprint"))
+			Expect(skipHostValidationArg).To(BeTrue())
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without copying", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(0))
+			})
+		})
+
+		When("uploading", func() {
+			BeforeEach(func() {
+				scpOptions.Direction = SCPUpload
+				fakeSecureShellClient.UploadReturns(errors.New("some-upload-error"))
+			})
+
+			It("uploads the local path and closes the connection", func() {
+				Expect(executeErr).To(MatchError("some-upload-error"))
+
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(1))
+				localPath, remotePath, recursive, progress := fakeSecureShellClient.UploadArgsForCall(0)
+				Expect(localPath).To(Equal("some-local-path"))
+				Expect(remotePath).To(Equal("some-remote-path"))
+				Expect(recursive).To(BeTrue())
+				Expect(progress).To(Equal(fakeProgress))
+
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("downloading", func() {
+			BeforeEach(func() {
+				scpOptions.Direction = SCPDownload
+			})
+
+			It("downloads the remote path and closes the connection", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeSecureShellClient.DownloadCallCount()).To(Equal(1))
+				remotePath, localPath, recursive, progress := fakeSecureShellClient.DownloadArgsForCall(0)
+				Expect(remotePath).To(Equal("some-remote-path"))
+				Expect(localPath).To(Equal("some-local-path"))
+				Expect(recursive).To(BeTrue())
+				Expect(progress).To(Equal(fakeProgress))
+
+				Expect(fakeSecureShellClient.UploadCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -80,6 +80,16 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ReproducibleArchivesStub        func() bool
+	reproducibleArchivesMutex       sync.RWMutex
+	reproducibleArchivesArgsForCall []struct {
+	}
+	reproducibleArchivesReturns struct {
+		result1 bool
+	}
+	reproducibleArchivesReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	TargetedOrganizationNameStub        func() string
 	targetedOrganizationNameMutex       sync.RWMutex
 	targetedOrganizationNameArgsForCall []struct {
@@ -111,15 +121,16 @@ func (fake *FakeConfig) AccessToken() string {
 	ret, specificReturn := fake.accessTokenReturnsOnCall[len(fake.accessTokenArgsForCall)]
 	fake.accessTokenArgsForCall = append(fake.accessTokenArgsForCall, struct {
 	}{})
+	stub := fake.AccessTokenStub
+	fakeReturns := fake.accessTokenReturns
 	fake.recordInvocation("AccessToken", []interface{}{})
 	fake.accessTokenMutex.Unlock()
-	if fake.AccessTokenStub != nil {
-		return fake.AccessTokenStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.accessTokenReturns
 	return fakeReturns.result1
 }
 
@@ -163,15 +174,16 @@ func (fake *FakeConfig) BinaryName() string {
 	ret, specificReturn := fake.binaryNameReturnsOnCall[len(fake.binaryNameArgsForCall)]
 	fake.binaryNameArgsForCall = append(fake.binaryNameArgsForCall, struct {
 	}{})
+	stub := fake.BinaryNameStub
+	fakeReturns := fake.binaryNameReturns
 	fake.recordInvocation("BinaryName", []interface{}{})
 	fake.binaryNameMutex.Unlock()
-	if fake.BinaryNameStub != nil {
-		return fake.BinaryNameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.binaryNameReturns
 	return fakeReturns.result1
 }
 
@@ -215,15 +227,16 @@ func (fake *FakeConfig) CurrentUserName() (string, error) {
 	ret, specificReturn := fake.currentUserNameReturnsOnCall[len(fake.currentUserNameArgsForCall)]
 	fake.currentUserNameArgsForCall = append(fake.currentUserNameArgsForCall, struct {
 	}{})
+	stub := fake.CurrentUserNameStub
+	fakeReturns := fake.currentUserNameReturns
 	fake.recordInvocation("CurrentUserName", []interface{}{})
 	fake.currentUserNameMutex.Unlock()
-	if fake.CurrentUserNameStub != nil {
-		return fake.CurrentUserNameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.currentUserNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -270,15 +283,16 @@ func (fake *FakeConfig) HasTargetedOrganization() bool {
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
 	fake.hasTargetedOrganizationArgsForCall = append(fake.hasTargetedOrganizationArgsForCall, struct {
 	}{})
+	stub := fake.HasTargetedOrganizationStub
+	fakeReturns := fake.hasTargetedOrganizationReturns
 	fake.recordInvocation("HasTargetedOrganization", []interface{}{})
 	fake.hasTargetedOrganizationMutex.Unlock()
-	if fake.HasTargetedOrganizationStub != nil {
-		return fake.HasTargetedOrganizationStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.hasTargetedOrganizationReturns
 	return fakeReturns.result1
 }
 
@@ -322,15 +336,16 @@ func (fake *FakeConfig) HasTargetedSpace() bool {
 	ret, specificReturn := fake.hasTargetedSpaceReturnsOnCall[len(fake.hasTargetedSpaceArgsForCall)]
 	fake.hasTargetedSpaceArgsForCall = append(fake.hasTargetedSpaceArgsForCall, struct {
 	}{})
+	stub := fake.HasTargetedSpaceStub
+	fakeReturns := fake.hasTargetedSpaceReturns
 	fake.recordInvocation("HasTargetedSpace", []interface{}{})
 	fake.hasTargetedSpaceMutex.Unlock()
-	if fake.HasTargetedSpaceStub != nil {
-		return fake.HasTargetedSpaceStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.hasTargetedSpaceReturns
 	return fakeReturns.result1
 }
 
@@ -374,15 +389,16 @@ func (fake *FakeConfig) IsCFOnK8s() bool {
 	ret, specificReturn := fake.isCFOnK8sReturnsOnCall[len(fake.isCFOnK8sArgsForCall)]
 	fake.isCFOnK8sArgsForCall = append(fake.isCFOnK8sArgsForCall, struct {
 	}{})
+	stub := fake.IsCFOnK8sStub
+	fakeReturns := fake.isCFOnK8sReturns
 	fake.recordInvocation("IsCFOnK8s", []interface{}{})
 	fake.isCFOnK8sMutex.Unlock()
-	if fake.IsCFOnK8sStub != nil {
-		return fake.IsCFOnK8sStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.isCFOnK8sReturns
 	return fakeReturns.result1
 }
 
@@ -426,15 +442,16 @@ func (fake *FakeConfig) RefreshToken() string {
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
 	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct {
 	}{})
+	stub := fake.RefreshTokenStub
+	fakeReturns := fake.refreshTokenReturns
 	fake.recordInvocation("RefreshToken", []interface{}{})
 	fake.refreshTokenMutex.Unlock()
-	if fake.RefreshTokenStub != nil {
-		return fake.RefreshTokenStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.refreshTokenReturns
 	return fakeReturns.result1
 }
 
@@ -473,20 +490,74 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ReproducibleArchives() bool {
+	fake.reproducibleArchivesMutex.Lock()
+	ret, specificReturn := fake.reproducibleArchivesReturnsOnCall[len(fake.reproducibleArchivesArgsForCall)]
+	fake.reproducibleArchivesArgsForCall = append(fake.reproducibleArchivesArgsForCall, struct {
+	}{})
+	stub := fake.ReproducibleArchivesStub
+	fakeReturns := fake.reproducibleArchivesReturns
+	fake.recordInvocation("ReproducibleArchives", []interface{}{})
+	fake.reproducibleArchivesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ReproducibleArchivesCallCount() int {
+	fake.reproducibleArchivesMutex.RLock()
+	defer fake.reproducibleArchivesMutex.RUnlock()
+	return len(fake.reproducibleArchivesArgsForCall)
+}
+
+func (fake *FakeConfig) ReproducibleArchivesCalls(stub func() bool) {
+	fake.reproducibleArchivesMutex.Lock()
+	defer fake.reproducibleArchivesMutex.Unlock()
+	fake.ReproducibleArchivesStub = stub
+}
+
+func (fake *FakeConfig) ReproducibleArchivesReturns(result1 bool) {
+	fake.reproducibleArchivesMutex.Lock()
+	defer fake.reproducibleArchivesMutex.Unlock()
+	fake.ReproducibleArchivesStub = nil
+	fake.reproducibleArchivesReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ReproducibleArchivesReturnsOnCall(i int, result1 bool) {
+	fake.reproducibleArchivesMutex.Lock()
+	defer fake.reproducibleArchivesMutex.Unlock()
+	fake.ReproducibleArchivesStub = nil
+	if fake.reproducibleArchivesReturnsOnCall == nil {
+		fake.reproducibleArchivesReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.reproducibleArchivesReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) TargetedOrganizationName() string {
 	fake.targetedOrganizationNameMutex.Lock()
 	ret, specificReturn := fake.targetedOrganizationNameReturnsOnCall[len(fake.targetedOrganizationNameArgsForCall)]
 	fake.targetedOrganizationNameArgsForCall = append(fake.targetedOrganizationNameArgsForCall, struct {
 	}{})
+	stub := fake.TargetedOrganizationNameStub
+	fakeReturns := fake.targetedOrganizationNameReturns
 	fake.recordInvocation("TargetedOrganizationName", []interface{}{})
 	fake.targetedOrganizationNameMutex.Unlock()
-	if fake.TargetedOrganizationNameStub != nil {
-		return fake.TargetedOrganizationNameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.targetedOrganizationNameReturns
 	return fakeReturns.result1
 }
 
@@ -530,15 +601,16 @@ func (fake *FakeConfig) Verbose() (bool, []string) {
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
 	fake.verboseArgsForCall = append(fake.verboseArgsForCall, struct {
 	}{})
+	stub := fake.VerboseStub
+	fakeReturns := fake.verboseReturns
 	fake.recordInvocation("Verbose", []interface{}{})
 	fake.verboseMutex.Unlock()
-	if fake.VerboseStub != nil {
-		return fake.VerboseStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.verboseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -597,6 +669,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.isCFOnK8sMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.reproducibleArchivesMutex.RLock()
+	defer fake.reproducibleArchivesMutex.RUnlock()
 	fake.targetedOrganizationNameMutex.RLock()
 	defer fake.targetedOrganizationNameMutex.RUnlock()
 	fake.verboseMutex.RLock()
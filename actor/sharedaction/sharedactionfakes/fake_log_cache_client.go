@@ -8,10 +8,26 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	client "code.cloudfoundry.org/go-log-cache/v2"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
 	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
 )
 
 type FakeLogCacheClient struct {
+	PromQLStub        func(context.Context, string, ...client.PromQLOption) (*logcache_v1.PromQL_InstantQueryResult, error)
+	promQLMutex       sync.RWMutex
+	promQLArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.PromQLOption
+	}
+	promQLReturns struct {
+		result1 *logcache_v1.PromQL_InstantQueryResult
+		result2 error
+	}
+	promQLReturnsOnCall map[int]struct {
+		result1 *logcache_v1.PromQL_InstantQueryResult
+		result2 error
+	}
 	ReadStub        func(context.Context, string, time.Time, ...client.ReadOption) ([]*loggregator_v2.Envelope, error)
 	readMutex       sync.RWMutex
 	readArgsForCall []struct {
@@ -32,6 +48,72 @@ type FakeLogCacheClient struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeLogCacheClient) PromQL(arg1 context.Context, arg2 string, arg3 ...client.PromQLOption) (*logcache_v1.PromQL_InstantQueryResult, error) {
+	fake.promQLMutex.Lock()
+	ret, specificReturn := fake.promQLReturnsOnCall[len(fake.promQLArgsForCall)]
+	fake.promQLArgsForCall = append(fake.promQLArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []client.PromQLOption
+	}{arg1, arg2, arg3})
+	stub := fake.PromQLStub
+	fakeReturns := fake.promQLReturns
+	fake.recordInvocation("PromQL", []interface{}{arg1, arg2, arg3})
+	fake.promQLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeLogCacheClient) PromQLCallCount() int {
+	fake.promQLMutex.RLock()
+	defer fake.promQLMutex.RUnlock()
+	return len(fake.promQLArgsForCall)
+}
+
+func (fake *FakeLogCacheClient) PromQLCalls(stub func(context.Context, string, ...client.PromQLOption) (*logcache_v1.PromQL_InstantQueryResult, error)) {
+	fake.promQLMutex.Lock()
+	defer fake.promQLMutex.Unlock()
+	fake.PromQLStub = stub
+}
+
+func (fake *FakeLogCacheClient) PromQLArgsForCall(i int) (context.Context, string, []client.PromQLOption) {
+	fake.promQLMutex.RLock()
+	defer fake.promQLMutex.RUnlock()
+	argsForCall := fake.promQLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLogCacheClient) PromQLReturns(result1 *logcache_v1.PromQL_InstantQueryResult, result2 error) {
+	fake.promQLMutex.Lock()
+	defer fake.promQLMutex.Unlock()
+	fake.PromQLStub = nil
+	fake.promQLReturns = struct {
+		result1 *logcache_v1.PromQL_InstantQueryResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogCacheClient) PromQLReturnsOnCall(i int, result1 *logcache_v1.PromQL_InstantQueryResult, result2 error) {
+	fake.promQLMutex.Lock()
+	defer fake.promQLMutex.Unlock()
+	fake.PromQLStub = nil
+	if fake.promQLReturnsOnCall == nil {
+		fake.promQLReturnsOnCall = make(map[int]struct {
+			result1 *logcache_v1.PromQL_InstantQueryResult
+			result2 error
+		})
+	}
+	fake.promQLReturnsOnCall[i] = struct {
+		result1 *logcache_v1.PromQL_InstantQueryResult
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLogCacheClient) Read(arg1 context.Context, arg2 string, arg3 time.Time, arg4 ...client.ReadOption) ([]*loggregator_v2.Envelope, error) {
 	fake.readMutex.Lock()
 	ret, specificReturn := fake.readReturnsOnCall[len(fake.readArgsForCall)]
@@ -41,15 +123,16 @@ func (fake *FakeLogCacheClient) Read(arg1 context.Context, arg2 string, arg3 tim
 		arg3 time.Time
 		arg4 []client.ReadOption
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.ReadStub
+	fakeReturns := fake.readReturns
 	fake.recordInvocation("Read", []interface{}{arg1, arg2, arg3, arg4})
 	fake.readMutex.Unlock()
-	if fake.ReadStub != nil {
-		return fake.ReadStub(arg1, arg2, arg3, arg4...)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4...)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.readReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -101,6 +184,8 @@ func (fake *FakeLogCacheClient) ReadReturnsOnCall(i int, result1 []*loggregator_
 func (fake *FakeLogCacheClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.promQLMutex.RLock()
+	defer fake.promQLMutex.RUnlock()
 	fake.readMutex.RLock()
 	defer fake.readMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -13,16 +13,22 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
+type DynamicPortForward clissh.DynamicPortForward
+
 type SSHOptions struct {
-	Commands              []string
-	Username              string
-	Passcode              string
-	Endpoint              string
-	HostKeyFingerprint    string
-	SkipHostValidation    bool
-	SkipRemoteExecution   bool
-	TTYOption             TTYOption
-	LocalPortForwardSpecs []LocalPortForward
+	Commands                []string
+	Username                string
+	Passcode                string
+	Endpoint                string
+	HostKeyFingerprint      string
+	SkipHostValidation      bool
+	SkipRemoteExecution     bool
+	TTYOption               TTYOption
+	LocalPortForwardSpecs   []LocalPortForward
+	RemotePortForwardSpecs  []RemotePortForward
+	DynamicPortForwardSpecs []DynamicPortForward
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -37,6 +43,28 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 		return err
 	}
 
+	if len(sshOptions.RemotePortForwardSpecs) > 0 {
+		var remoteSpecs []clissh.RemotePortForward
+		for _, spec := range sshOptions.RemotePortForwardSpecs {
+			remoteSpecs = append(remoteSpecs, clissh.RemotePortForward(spec))
+		}
+		err = sshClient.RemotePortForward(remoteSpecs)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(sshOptions.DynamicPortForwardSpecs) > 0 {
+		var dynamicSpecs []clissh.DynamicPortForward
+		for _, spec := range sshOptions.DynamicPortForwardSpecs {
+			dynamicSpecs = append(dynamicSpecs, clissh.DynamicPortForward(spec))
+		}
+		err = sshClient.DynamicPortForward(dynamicSpecs)
+		if err != nil {
+			return err
+		}
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
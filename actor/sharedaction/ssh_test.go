@@ -99,6 +99,55 @@ var _ = Describe("SSH Actions", func() {
 				})
 			})
 
+			It("does not forward remote or dynamic ports when none are given", func() {
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(0))
+				Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+			})
+
+			When("remote and dynamic port forwards are given", func() {
+				BeforeEach(func() {
+					sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+						{RemoteAddress: "remote-address-1", LocalAddress: "local-address-1"},
+					}
+					sshOptions.DynamicPortForwardSpecs = []DynamicPortForward{
+						{LocalAddress: "local-address-2"},
+					}
+				})
+
+				It("forwards the remote and dynamic ports", func() {
+					Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+						[]clissh.RemotePortForward{{RemoteAddress: "remote-address-1", LocalAddress: "local-address-1"}},
+					))
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal(
+						[]clissh.DynamicPortForward{{LocalAddress: "local-address-2"}},
+					))
+				})
+
+				When("remote port forwarding fails", func() {
+					BeforeEach(func() {
+						fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+						Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+					})
+				})
+
+				When("dynamic port forwarding fails", func() {
+					BeforeEach(func() {
+						fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+						Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			When("local port forwarding succeeds", func() {
 				When("skipping remote execution", func() {
 					BeforeEach(func() {
@@ -0,0 +1,105 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+func (actor *Actor) GetApplicationAnnotations(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.applicationMetadataOwner(appName, spaceGUID))
+}
+
+func (actor *Actor) GetDomainAnnotations(domainName string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.domainMetadataOwner(domainName))
+}
+
+func (actor *Actor) GetOrganizationAnnotations(orgName string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.organizationMetadataOwner(orgName))
+}
+
+func (actor *Actor) GetRouteAnnotations(routeName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.routeMetadataOwner(routeName, spaceGUID))
+}
+
+func (actor *Actor) GetServiceBrokerAnnotations(serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.serviceBrokerMetadataOwner(serviceBrokerName))
+}
+
+func (actor *Actor) GetServiceInstanceAnnotations(serviceInstanceName, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.serviceInstanceMetadataOwner(serviceInstanceName, spaceGUID))
+}
+
+func (actor *Actor) GetServiceOfferingAnnotations(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.serviceOfferingMetadataOwner(serviceOfferingName, serviceBrokerName))
+}
+
+func (actor *Actor) GetServicePlanAnnotations(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.servicePlanMetadataOwner(servicePlanName, serviceOfferingName, serviceBrokerName))
+}
+
+func (actor *Actor) GetSpaceAnnotations(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.spaceMetadataOwner(spaceName, orgGUID))
+}
+
+func (actor *Actor) GetStackAnnotations(stackName string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.stackMetadataOwner(stackName))
+}
+
+func (actor *Actor) GetBuildpackAnnotations(buildpackName string, buildpackStack string) (map[string]types.NullString, Warnings, error) {
+	return extractAnnotations(actor.buildpackMetadataOwner(buildpackName, buildpackStack))
+}
+
+func (actor *Actor) UpdateApplicationAnnotationsByApplicationName(appName string, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.applicationMetadataOwner(appName, spaceGUID)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateBuildpackAnnotationsByBuildpackNameAndStack(buildpackName string, stack string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.buildpackMetadataOwner(buildpackName, stack)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateDomainAnnotationsByDomainName(domainName string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.domainMetadataOwner(domainName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateOrganizationAnnotationsByOrganizationName(orgName string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.organizationMetadataOwner(orgName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateRouteAnnotations(routeName string, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.routeMetadataOwner(routeName, spaceGUID)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateSpaceAnnotationsBySpaceName(spaceName string, orgGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.spaceMetadataOwner(spaceName, orgGUID)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateStackAnnotationsByStackName(stackName string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.stackMetadataOwner(stackName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateServiceBrokerAnnotationsByServiceBrokerName(serviceBrokerName string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.serviceBrokerMetadataOwner(serviceBrokerName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateServiceInstanceAnnotations(serviceInstanceName, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.serviceInstanceMetadataOwner(serviceInstanceName, spaceGUID)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateServiceOfferingAnnotations(serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.serviceOfferingMetadataOwner(serviceOfferingName, serviceBrokerName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
+
+func (actor *Actor) UpdateServicePlanAnnotations(servicePlanName string, serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (Warnings, error) {
+	owner, warnings, err := actor.servicePlanMetadataOwner(servicePlanName, serviceOfferingName, serviceBrokerName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Annotations: annotations}, warnings, err)
+}
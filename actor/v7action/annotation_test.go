@@ -0,0 +1,208 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("annotations", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+		resourceName              string
+		spaceGUID                 string
+		annotations               map[string]types.NullString
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, new(v7actionfakes.FakeConfig), new(v7actionfakes.FakeSharedActor), nil, nil, nil)
+		resourceName = "some-resource"
+		spaceGUID = "some-space-guid"
+		annotations = map[string]types.NullString{
+			"contact":     types.NewNullString("team@example.com"),
+			"description": types.NewNullString("line one\nline two"),
+			"obsolete":    types.NewNullString(),
+		}
+	})
+
+	Describe("UpdateApplicationAnnotationsByApplicationName", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationAnnotationsByApplicationName(resourceName, spaceGUID, annotations)
+		})
+
+		When("there are no client errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "some-guid"}},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(
+					"",
+					ccv3.Warnings{"set-app-annotations-warnings"},
+					nil,
+				)
+			})
+
+			It("gets the application", func() {
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{resourceName}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+				))
+			})
+
+			It("sets only the app annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+				resourceType, appGUID, sentMetadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resourceType).To(Equal("app"))
+				Expect(appGUID).To(Equal("some-guid"))
+				Expect(sentMetadata.Annotations).To(Equal(annotations))
+				Expect(sentMetadata.Labels).To(BeNil())
+			})
+
+			It("aggregates warnings", func() {
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "set-app-annotations-warnings"))
+			})
+		})
+
+		When("the update returns a job", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "some-guid"}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(
+					"some-job-url",
+					ccv3.Warnings{"set-app-annotations-warnings"},
+					nil,
+				)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, errors.New("poll-error"))
+			})
+
+			It("polls the job and returns its error and all warnings", func() {
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+				Expect(executeErr).To(MatchError("poll-error"))
+				Expect(warnings).To(ConsistOf("warning-1", "set-app-annotations-warnings", "poll-warning"))
+			})
+		})
+
+		When("getting the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					nil,
+					ccv3.Warnings{"warning-failure-1"},
+					errors.New("get-apps-error"),
+				)
+			})
+
+			It("returns the error and warnings without updating", func() {
+				Expect(executeErr).To(MatchError("get-apps-error"))
+				Expect(warnings).To(ConsistOf("warning-failure-1"))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UpdateServicePlanAnnotations", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateServicePlanAnnotations(resourceName, "some-offering", "some-broker", annotations)
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]resources.ServicePlan{{GUID: "some-plan-guid"}},
+				ccv3.Warnings{"get-plans-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateResourceMetadataReturns(
+				"",
+				ccv3.Warnings{"set-plan-annotations-warning"},
+				nil,
+			)
+		})
+
+		It("sets the service plan annotations", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-plans-warning", "set-plan-annotations-warning"))
+
+			resourceType, planGUID, sentMetadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resourceType).To(Equal("service-plan"))
+			Expect(planGUID).To(Equal("some-plan-guid"))
+			Expect(sentMetadata.Annotations).To(Equal(annotations))
+		})
+	})
+
+	Describe("GetStackAnnotations", func() {
+		var returnedAnnotations map[string]types.NullString
+
+		JustBeforeEach(func() {
+			returnedAnnotations, warnings, executeErr = actor.GetStackAnnotations(resourceName)
+		})
+
+		When("the stack has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]resources.Stack{{GUID: "some-guid"}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns an empty map", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(returnedAnnotations).To(BeEmpty())
+			})
+		})
+
+		When("the stack has annotations", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]resources.Stack{{
+						GUID: "some-guid",
+						Metadata: &resources.Metadata{
+							Labels:      map[string]types.NullString{"label": types.NewNullString("ignored")},
+							Annotations: annotations,
+						},
+					}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns only the annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(returnedAnnotations).To(Equal(annotations))
+			})
+		})
+
+		When("there is a client error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					nil,
+					ccv3.Warnings{"warning-1"},
+					errors.New("get-stacks-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-stacks-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
@@ -200,6 +200,36 @@ func (actor Actor) SetApplicationProcessHealthCheckTypeByNameAndSpace(
 	return app, append(getWarnings, setWarnings...), err
 }
 
+// SetApplicationProcessReadinessHealthCheckByNameAndSpace sets the readiness
+// health check information of the provided processType for an application
+// with the given name and space GUID.
+func (actor Actor) SetApplicationProcessReadinessHealthCheckByNameAndSpace(
+	appName string,
+	spaceGUID string,
+	healthCheckType constant.HealthCheckType,
+	httpEndpoint string,
+	processType string,
+	invocationTimeout int64,
+	interval int64,
+) (resources.Application, Warnings, error) {
+
+	app, getWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Application{}, getWarnings, err
+	}
+
+	setWarnings, err := actor.UpdateProcessByTypeAndApplication(
+		processType,
+		app.GUID,
+		resources.Process{
+			ReadinessHealthCheckType:              healthCheckType,
+			ReadinessHealthCheckEndpoint:          httpEndpoint,
+			ReadinessHealthCheckInvocationTimeout: invocationTimeout,
+			ReadinessHealthCheckInterval:          interval,
+		})
+	return app, append(getWarnings, setWarnings...), err
+}
+
 // StopApplication stops an application.
 func (actor Actor) StopApplication(appGUID string) (Warnings, error) {
 	_, warnings, err := actor.CloudControllerClient.UpdateApplicationStop(appGUID)
@@ -298,9 +328,9 @@ func (actor Actor) PollStart(app resources.Application, noWait bool, handleInsta
 	}
 }
 
-// PollStartForRolling polls a deploying application's processes until some are started. It does the same thing as PollStart, except it accounts for rolling deployments and whether
-// they have failed or been canceled during polling.
-func (actor Actor) PollStartForRolling(app resources.Application, deploymentGUID string, noWait bool, handleInstanceDetails func(string)) (Warnings, error) {
+// PollStartForDeployment polls a deploying application's processes until some are started. It does the same thing as PollStart, except it accounts for deployments and whether
+// they have failed or been canceled during polling. Canary deployments stop polling once their canary instances are running and the deployment is paused.
+func (actor Actor) PollStartForDeployment(app resources.Application, deploymentGUID string, noWait bool, handleInstanceDetails func(string)) (Warnings, error) {
 	var (
 		deployment  resources.Deployment
 		processes   []resources.Process
@@ -321,7 +351,7 @@ func (actor Actor) PollStartForRolling(app resources.Application, deploymentGUID
 			}
 			return allWarnings, actionerror.StartupTimeoutError{Name: app.Name}
 		case <-timer.C():
-			if !isDeployed(deployment) {
+			if !isDeployed(deployment) && !isPaused(deployment) {
 				ccDeployment, warnings, err := actor.getDeployment(deploymentGUID)
 				allWarnings = append(allWarnings, warnings...)
 				if err != nil {
@@ -335,7 +365,7 @@ func (actor Actor) PollStartForRolling(app resources.Application, deploymentGUID
 				}
 			}
 
-			if noWait || isDeployed(deployment) {
+			if noWait || isDeployed(deployment) || isPaused(deployment) {
 				stopPolling, warnings, err := actor.PollProcesses(processes, handleInstanceDetails)
 				allWarnings = append(allWarnings, warnings...)
 				if stopPolling || err != nil {
@@ -352,6 +382,10 @@ func isDeployed(d resources.Deployment) bool {
 	return d.StatusValue == constant.DeploymentStatusValueFinalized && d.StatusReason == constant.DeploymentStatusReasonDeployed
 }
 
+func isPaused(d resources.Deployment) bool {
+	return d.StatusValue == constant.DeploymentStatusValueActive && d.StatusReason == constant.DeploymentStatusReasonPaused
+}
+
 // PollProcesses - return true if there's no need to keep polling
 func (actor Actor) PollProcesses(processes []resources.Process, handleInstanceDetails func(string)) (bool, Warnings, error) {
 	numProcesses := len(processes)
@@ -432,7 +466,8 @@ func (actor Actor) getDeployment(deploymentGUID string) (resources.Deployment, W
 }
 
 func (actor Actor) getProcesses(deployment resources.Deployment, appGUID string, noWait bool) ([]resources.Process, Warnings, error) {
-	if noWait {
+	// a paused canary deployment only has the canary instances of the new web process to wait for
+	if noWait || isPaused(deployment) {
 		// these are only web processes for now so we can just use these
 		return deployment.NewProcesses, nil, nil
 	}
@@ -1,8 +1,12 @@
 package v7action
 
 import (
+	"bytes"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"gopkg.in/yaml.v2"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ManifestParser
@@ -66,5 +70,75 @@ func (actor Actor) GetRawApplicationManifestByNameAndSpace(appName string, space
 	}
 
 	rawManifest, manifestWarnings, err := actor.CloudControllerClient.GetApplicationManifest(app.GUID)
-	return rawManifest, append(warnings, manifestWarnings...), err
+	warnings = append(warnings, manifestWarnings...)
+	if err != nil {
+		return rawManifest, warnings, err
+	}
+
+	if app.LifecycleType == constant.AppLifecycleTypeCNB {
+		rawManifest, err = addManifestLifecycle(rawManifest, app.LifecycleType)
+	}
+	return rawManifest, warnings, err
+}
+
+// addManifestLifecycle sets the lifecycle on the apps of a generated manifest
+// that do not have one, so that pushing the manifest again keeps the
+// lifecycle the app was created with.
+func addManifestLifecycle(rawManifest []byte, lifecycle constant.AppLifecycleType) ([]byte, error) {
+	var manifest yaml.MapSlice
+	err := yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range manifest {
+		if item.Key != "applications" {
+			continue
+		}
+
+		apps, ok := item.Value.([]interface{})
+		if !ok {
+			continue
+		}
+
+		for i, rawApp := range apps {
+			app, ok := rawApp.(yaml.MapSlice)
+			if !ok || hasManifestKey(app, "lifecycle") {
+				continue
+			}
+
+			// Keep the lifecycle right after the name, like the other app
+			// level properties
+			withLifecycle := make(yaml.MapSlice, 0, len(app)+1)
+			for _, property := range app {
+				withLifecycle = append(withLifecycle, property)
+				if property.Key == "name" {
+					withLifecycle = append(withLifecycle, yaml.MapItem{Key: "lifecycle", Value: string(lifecycle)})
+				}
+			}
+			if len(withLifecycle) == len(app) {
+				withLifecycle = append(withLifecycle, yaml.MapItem{Key: "lifecycle", Value: string(lifecycle)})
+			}
+			apps[i] = withLifecycle
+		}
+	}
+
+	updatedManifest, err := yaml.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(rawManifest, []byte("---")) {
+		updatedManifest = append([]byte("---\n"), updatedManifest...)
+	}
+	return updatedManifest, nil
+}
+
+func hasManifestKey(properties yaml.MapSlice, key string) bool {
+	for _, property := range properties {
+		if property.Key == key {
+			return true
+		}
+	}
+	return false
 }
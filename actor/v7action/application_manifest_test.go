@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/clock"
 
@@ -269,6 +270,58 @@ var _ = Describe("Application Manifest Actions", func() {
 				})
 			})
 
+			When("the application uses the cnb lifecycle", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(
+						[]resources.Application{
+							{Name: appName, GUID: "some-app-guid", LifecycleType: constant.AppLifecycleTypeCNB},
+						},
+						ccv3.Warnings{"get-application-warning"},
+						nil,
+					)
+				})
+
+				When("the manifest does not set the lifecycle", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetApplicationManifestReturns(
+							[]byte("---\napplications:\n- name: some-app-name\n  buildpacks:\n  - docker://docker.io/paketobuildpacks/nodejs\n  stack: cflinuxfs4\n"),
+							ccv3.Warnings{"get-manifest-warnings"},
+							nil,
+						)
+					})
+
+					It("adds the lifecycle after the app name", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("get-application-warning", "get-manifest-warnings"))
+						Expect(string(manifestBytes)).To(Equal("---\napplications:\n- name: some-app-name\n  lifecycle: cnb\n  buildpacks:\n  - docker://docker.io/paketobuildpacks/nodejs\n  stack: cflinuxfs4\n"))
+					})
+				})
+
+				When("the manifest already sets the lifecycle", func() {
+					var rawManifest []byte
+
+					BeforeEach(func() {
+						rawManifest = []byte("---\napplications:\n- name: some-app-name\n  lifecycle: cnb\n")
+						fakeCloudControllerClient.GetApplicationManifestReturns(rawManifest, nil, nil)
+					})
+
+					It("returns the manifest unchanged", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(manifestBytes).To(MatchYAML(rawManifest))
+					})
+				})
+
+				When("the manifest is not valid YAML", func() {
+					BeforeEach(func() {
+						fakeCloudControllerClient.GetApplicationManifestReturns([]byte("applications: ["), nil, nil)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(HaveOccurred())
+					})
+				})
+			})
+
 			When("getting the manifest returns an error", func() {
 				var expectedErr error
 
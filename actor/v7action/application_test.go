@@ -1033,7 +1033,7 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
-	Describe("PollStartForRolling", func() {
+	Describe("PollStartForDeployment", func() {
 		var (
 			app                   resources.Application
 			deploymentGUID        string
@@ -1065,7 +1065,7 @@ var _ = Describe("Application Actions", func() {
 
 		JustBeforeEach(func() {
 			go func() {
-				warnings, executeErr = actor.PollStartForRolling(app, deploymentGUID, noWait, handleInstanceDetails)
+				warnings, executeErr = actor.PollStartForDeployment(app, deploymentGUID, noWait, handleInstanceDetails)
 				done <- true
 			}()
 		})
@@ -1521,6 +1521,73 @@ var _ = Describe("Application Actions", func() {
 
 			})
 
+			When("the deployment is a canary deployment that pauses", func() {
+				BeforeEach(func() {
+					// in total three loops 1: deployment still deploying 2: deployment paused canary starting 3: canary started
+					fakeCloudControllerClient.GetDeploymentReturnsOnCall(0,
+						resources.Deployment{StatusValue: constant.DeploymentStatusValueActive, StatusReason: constant.DeploymentStatusReasonDeploying},
+						ccv3.Warnings{"get-deployment-warning-1"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetDeploymentReturnsOnCall(1,
+						resources.Deployment{
+							StatusValue:  constant.DeploymentStatusValueActive,
+							StatusReason: constant.DeploymentStatusReasonPaused,
+							NewProcesses: []resources.Process{{GUID: "canary-process-guid"}},
+						},
+						ccv3.Warnings{"get-deployment-warning-2"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(0,
+						[]ccv3.ProcessInstance{{State: constant.ProcessInstanceStarting}},
+						ccv3.Warnings{"poll-processes-warning-1"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+						[]ccv3.ProcessInstance{{State: constant.ProcessInstanceRunning}},
+						ccv3.Warnings{"poll-processes-warning-2"},
+						nil,
+					)
+				})
+
+				It("stops polling once the canary instances are running", func() {
+					// Initial tick
+					fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+
+					Eventually(fakeCloudControllerClient.GetDeploymentCallCount).Should(Equal(1))
+					Eventually(fakeConfig.PollingIntervalCallCount).Should(Equal(1))
+
+					// second loop: the deployment is paused so we poll the canary process
+					fakeClock.Increment(1 * time.Second)
+
+					Eventually(fakeCloudControllerClient.GetDeploymentCallCount).Should(Equal(2))
+					Eventually(fakeCloudControllerClient.GetProcessInstancesCallCount).Should(Equal(1))
+					Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("canary-process-guid"))
+					Eventually(fakeConfig.PollingIntervalCallCount).Should(Equal(2))
+
+					// third loop: the paused deployment is not fetched again
+					fakeClock.Increment(1 * time.Second)
+
+					Eventually(done).Should(Receive(BeTrue()))
+
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(warnings).To(ConsistOf(
+						"get-deployment-warning-1",
+						"get-deployment-warning-2",
+						"poll-processes-warning-1",
+						"poll-processes-warning-2",
+					))
+
+					Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+					Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+					Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(1)).To(Equal("canary-process-guid"))
+				})
+			})
+
 		})
 	})
 
@@ -1640,6 +1707,89 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("SetApplicationProcessReadinessHealthCheckByNameAndSpace", func() {
+		var (
+			healthCheckType     constant.HealthCheckType
+			healthCheckEndpoint string
+
+			warnings Warnings
+			err      error
+			app      resources.Application
+		)
+
+		BeforeEach(func() {
+			healthCheckType = constant.HTTP
+			healthCheckEndpoint = "/ready"
+		})
+
+		JustBeforeEach(func() {
+			app, warnings, err = actor.SetApplicationProcessReadinessHealthCheckByNameAndSpace(
+				"some-app-name",
+				"some-space-guid",
+				healthCheckType,
+				healthCheckEndpoint,
+				"some-process-type",
+				5,
+				10,
+			)
+		})
+
+		When("getting application returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{},
+					ccv3.Warnings{"some-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(Equal(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		When("application process exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+					resources.Process{GUID: "some-process-guid"},
+					ccv3.Warnings{"some-process-warning"},
+					nil,
+				)
+
+				fakeCloudControllerClient.UpdateProcessReturns(
+					resources.Process{GUID: "some-process-guid"},
+					ccv3.Warnings{"some-health-check-warning"},
+					nil,
+				)
+			})
+
+			It("updates only the readiness health check of the process", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "some-health-check-warning"))
+				Expect(app).To(Equal(resources.Application{GUID: "some-app-guid"}))
+
+				Expect(fakeCloudControllerClient.UpdateProcessCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.UpdateProcessArgsForCall(0)).To(Equal(resources.Process{
+					GUID:                                  "some-process-guid",
+					ReadinessHealthCheckType:              constant.HTTP,
+					ReadinessHealthCheckEndpoint:          "/ready",
+					ReadinessHealthCheckInvocationTimeout: 5,
+					ReadinessHealthCheckInterval:          10,
+				}))
+			})
+		})
+	})
+
 	Describe("StopApplication", func() {
 		var (
 			warnings   Warnings
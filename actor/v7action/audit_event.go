@@ -0,0 +1,169 @@
+package v7action
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// AuditEvent is an entry of the Cloud Controller audit log.
+type AuditEvent struct {
+	GUID             string
+	Time             time.Time
+	Type             string
+	ActorName        string
+	ActorType        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Description      string
+}
+
+// AuditEventFilter narrows down the audit events returned. Empty fields do not
+// filter.
+type AuditEventFilter struct {
+	OrganizationGUIDs []string
+	SpaceGUIDs        []string
+	TargetGUIDs       []string
+	Types             []string
+	Since             time.Time
+	Until             time.Time
+}
+
+// GetAuditEvents returns all audit events matching the filter, oldest first,
+// across all pages of results.
+func (actor Actor) GetAuditEvents(filter AuditEventFilter) ([]AuditEvent, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(filter.queries()...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	events := make([]AuditEvent, 0, len(ccEvents))
+	for _, ccEvent := range ccEvents {
+		events = append(events, newAuditEvent(ccEvent))
+	}
+
+	return events, Warnings(warnings), nil
+}
+
+// StreamAuditEvents polls for audit events matching the filter every
+// pollInterval and sends each new event once, oldest first. Events created
+// before filter.Since are skipped; when Since is not set, streaming starts
+// from now. Polling stops when the returned CancelFunc is called.
+func (actor Actor) StreamAuditEvents(filter AuditEventFilter, pollInterval time.Duration) (<-chan AuditEvent, <-chan Warnings, <-chan error, context.CancelFunc) {
+	eventStream := make(chan AuditEvent)
+	warningsStream := make(chan Warnings)
+	errorStream := make(chan error)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if filter.Since.IsZero() {
+		filter.Since = actor.Clock.Now()
+	}
+
+	go func() {
+		defer close(eventStream)
+		defer close(warningsStream)
+		defer close(errorStream)
+
+		// Timestamps only have a resolution of a second, so every poll asks for
+		// events at or after the newest one seen and skips the ones already
+		// sent.
+		seen := map[string]time.Time{}
+
+		for {
+			events, warnings, err := actor.GetAuditEvents(filter)
+			if len(warnings) > 0 {
+				select {
+				case warningsStream <- warnings:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				select {
+				case errorStream <- err:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			for _, event := range events {
+				if _, ok := seen[event.GUID]; ok {
+					continue
+				}
+				seen[event.GUID] = event.Time
+
+				select {
+				case eventStream <- event:
+				case <-ctx.Done():
+					return
+				}
+
+				if event.Time.After(filter.Since) {
+					filter.Since = event.Time
+				}
+			}
+
+			for guid, eventTime := range seen {
+				if eventTime.Before(filter.Since) {
+					delete(seen, guid)
+				}
+			}
+
+			select {
+			case <-actor.Clock.After(pollInterval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return eventStream, warningsStream, errorStream, cancel
+}
+
+func (filter AuditEventFilter) queries() []ccv3.Query {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+
+	if len(filter.OrganizationGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: filter.OrganizationGUIDs})
+	}
+	if len(filter.SpaceGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: filter.SpaceGUIDs})
+	}
+	if len(filter.TargetGUIDs) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: filter.TargetGUIDs})
+	}
+	if len(filter.Types) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.EventTypesFilter, Values: filter.Types})
+	}
+	if !filter.Since.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{filter.Since.UTC().Format(time.RFC3339)}})
+	}
+	if !filter.Until.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{filter.Until.UTC().Format(time.RFC3339)}})
+	}
+
+	return queries
+}
+
+func newAuditEvent(ccEvent ccv3.Event) AuditEvent {
+	return AuditEvent{
+		GUID:             ccEvent.GUID,
+		Time:             ccEvent.CreatedAt,
+		Type:             ccEvent.Type,
+		ActorName:        ccEvent.ActorName,
+		ActorType:        ccEvent.ActorType,
+		TargetGUID:       ccEvent.TargetGUID,
+		TargetType:       ccEvent.TargetType,
+		TargetName:       ccEvent.TargetName,
+		SpaceGUID:        ccEvent.SpaceGUID,
+		OrganizationGUID: ccEvent.OrganizationGUID,
+		Description:      generateDescription(ccEvent.Data),
+	}
+}
@@ -0,0 +1,220 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeClock                 *fakeclock.FakeClock
+		startTime                 time.Time
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, fakeClock = NewTestActor()
+		startTime = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	Describe("GetAuditEvents", func() {
+		var (
+			filter     AuditEventFilter
+			events     []AuditEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			filter = AuditEventFilter{}
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetAuditEvents(filter)
+		})
+
+		When("the events are found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(
+					[]ccv3.Event{
+						{
+							GUID:             "event-guid-1",
+							CreatedAt:        startTime,
+							Type:             "audit.app.update",
+							ActorName:        "admin",
+							ActorType:        "user",
+							TargetGUID:       "app-guid",
+							TargetType:       "app",
+							TargetName:       "dora",
+							SpaceGUID:        "space-guid",
+							OrganizationGUID: "org-guid",
+							Data:             map[string]interface{}{"request": map[string]interface{}{"instances": float64(2)}},
+						},
+					},
+					ccv3.Warnings{"get-events-warning"},
+					nil,
+				)
+			})
+
+			It("returns the events and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-events-warning"))
+				Expect(events).To(Equal([]AuditEvent{
+					{
+						GUID:             "event-guid-1",
+						Time:             startTime,
+						Type:             "audit.app.update",
+						ActorName:        "admin",
+						ActorType:        "user",
+						TargetGUID:       "app-guid",
+						TargetType:       "app",
+						TargetName:       "dora",
+						SpaceGUID:        "space-guid",
+						OrganizationGUID: "org-guid",
+						Description:      "instances: 2",
+					},
+				}))
+			})
+
+			It("requests every page of events, oldest first", func() {
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+		})
+
+		When("filters are given", func() {
+			BeforeEach(func() {
+				filter = AuditEventFilter{
+					OrganizationGUIDs: []string{"org-guid"},
+					SpaceGUIDs:        []string{"space-guid"},
+					TargetGUIDs:       []string{"target-guid-1", "target-guid-2"},
+					Types:             []string{"audit.app.create", "audit.app.delete-request"},
+					Since:             startTime,
+					Until:             startTime.Add(time.Hour),
+				}
+			})
+
+			It("passes them on as query parameters", func() {
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtAscendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+					ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"target-guid-1", "target-guid-2"}},
+					ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.create", "audit.app.delete-request"}},
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2026-10-01T12:00:00Z"}},
+					ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{"2026-10-01T13:00:00Z"}},
+				))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv3.Warnings{"get-events-warning"}, errors.New("events-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("events-error"))
+				Expect(warnings).To(ConsistOf("get-events-warning"))
+			})
+		})
+	})
+
+	Describe("StreamAuditEvents", func() {
+		var (
+			filter         AuditEventFilter
+			eventStream    <-chan AuditEvent
+			warningsStream <-chan Warnings
+			errorStream    <-chan error
+			cancel         func()
+		)
+
+		BeforeEach(func() {
+			fakeClock.Increment(startTime.Sub(fakeClock.Now()))
+			filter = AuditEventFilter{SpaceGUIDs: []string{"space-guid"}}
+		})
+
+		JustBeforeEach(func() {
+			eventStream, warningsStream, errorStream, cancel = actor.StreamAuditEvents(filter, 5*time.Second)
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		When("new events keep arriving", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturnsOnCall(0,
+					[]ccv3.Event{{GUID: "event-1", CreatedAt: startTime.Add(time.Second)}},
+					nil, nil,
+				)
+				fakeCloudControllerClient.GetEventsReturnsOnCall(1,
+					[]ccv3.Event{
+						{GUID: "event-1", CreatedAt: startTime.Add(time.Second)},
+						{GUID: "event-2", CreatedAt: startTime.Add(time.Second)},
+						{GUID: "event-3", CreatedAt: startTime.Add(3 * time.Second)},
+					},
+					ccv3.Warnings{"poll-warning"}, nil,
+				)
+			})
+
+			It("sends each event once and polls from the newest event seen", func() {
+				Eventually(eventStream).Should(Receive(HaveField("GUID", "event-1")))
+
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+				Eventually(warningsStream).Should(Receive(ConsistOf("poll-warning")))
+				Eventually(eventStream).Should(Receive(HaveField("GUID", "event-2")))
+				Eventually(eventStream).Should(Receive(HaveField("GUID", "event-3")))
+
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElements(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2026-10-01T12:00:00Z"}},
+				))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(1)).To(ContainElement(
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2026-10-01T12:00:01Z"}},
+				))
+
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+				Eventually(fakeCloudControllerClient.GetEventsCallCount).Should(Equal(3))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(2)).To(ContainElement(
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2026-10-01T12:00:03Z"}},
+				))
+				Consistently(eventStream).ShouldNot(Receive())
+			})
+		})
+
+		When("a start time is given", func() {
+			BeforeEach(func() {
+				filter.Since = startTime.Add(-time.Hour)
+			})
+
+			It("starts streaming from that time", func() {
+				Eventually(fakeCloudControllerClient.GetEventsCallCount).Should(Equal(1))
+				Expect(fakeCloudControllerClient.GetEventsArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2026-10-01T11:00:00Z"}},
+				))
+			})
+		})
+
+		When("polling fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, nil, errors.New("poll-error"))
+			})
+
+			It("sends the error and stops", func() {
+				Eventually(errorStream).Should(Receive(MatchError("poll-error")))
+				Eventually(eventStream).Should(BeClosed())
+			})
+		})
+	})
+})
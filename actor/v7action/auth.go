@@ -25,7 +25,7 @@ func NewDefaultAuthActor(config Config, uaaClient UAAClient) AuthActor {
 }
 
 func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) error {
-	if grantType == constant.GrantTypePassword && actor.config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+	if isUserGrantType(grantType) && actor.config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
 		return actionerror.PasswordGrantTypeLogoutRequiredError{}
 	}
 
@@ -39,7 +39,7 @@ func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin
 	accessToken = fmt.Sprintf("bearer %s", accessToken)
 	actor.config.SetTokenInformation(accessToken, refreshToken, "")
 
-	if grantType == constant.GrantTypePassword {
+	if isUserGrantType(grantType) {
 		actor.config.SetUAAGrantType("")
 	} else {
 		actor.config.SetUAAGrantType(string(grantType))
@@ -52,6 +52,16 @@ func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin
 	return nil
 }
 
+// isUserGrantType returns true for grants that log in as a user, whose tokens
+// are refreshed the same way as password grant tokens.
+func isUserGrantType(grantType constant.GrantType) bool {
+	switch grantType {
+	case constant.GrantTypePassword, constant.GrantTypeAuthorizationCode, constant.GrantTypeDeviceCode:
+		return true
+	}
+	return false
+}
+
 func (actor defaultAuthActor) GetLoginPrompts() (map[string]coreconfig.AuthPrompt, error) {
 	rawPrompts, err := actor.uaaClient.GetLoginPrompts()
 	if err != nil {
@@ -94,6 +94,32 @@ var _ = Describe("Default Auth Actions", func() {
 				})
 			})
 
+			When("the grant type is a browser or device code grant", func() {
+				BeforeEach(func() {
+					grantType = constant.GrantTypeDeviceCode
+				})
+
+				It("stores the tokens so they are refreshed like a password grant", func() {
+					Expect(actualErr).NotTo(HaveOccurred())
+					Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+					accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+					Expect(accessToken).To(Equal("bearer some-access-token"))
+					Expect(refreshToken).To(Equal("some-refresh-token"))
+					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(""))
+					Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+				})
+
+				When("a previous user authenticated with a client grant type", func() {
+					BeforeEach(func() {
+						fakeConfig.UAAGrantTypeReturns("client_credentials")
+					})
+
+					It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+						Expect(actualErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+					})
+				})
+			})
+
 			When("the grant type is not password", func() {
 				BeforeEach(func() {
 					grantType = constant.GrantTypeClientCredentials
@@ -18,11 +18,13 @@ type CloudControllerClient interface {
 	ApplySpaceQuota(quotaGUID string, spaceGUID string) (resources.RelationshipList, ccv3.Warnings, error)
 	CheckRoute(domainGUID string, hostname string, path string, port int) (bool, ccv3.Warnings, error)
 	CancelDeployment(deploymentGUID string) (ccv3.Warnings, error)
+	ContinueDeployment(deploymentGUID string) (ccv3.Warnings, error)
 	CopyPackage(sourcePackageGUID string, targetAppGUID string) (resources.Package, ccv3.Warnings, error)
 	CreateApplication(app resources.Application) (resources.Application, ccv3.Warnings, error)
-	CreateApplicationDeployment(appGUID string, dropletGUID string) (string, ccv3.Warnings, error)
-	CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (string, ccv3.Warnings, error)
+	CreateApplicationDeployment(appGUID string, dropletGUID string, strategy constant.DeploymentStrategy) (string, ccv3.Warnings, error)
+	CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string, strategy constant.DeploymentStrategy) (string, ccv3.Warnings, error)
 	CreateApplicationProcessScale(appGUID string, process resources.Process) (resources.Process, ccv3.Warnings, error)
+	CreateApplicationSidecar(appGUID string, sidecar resources.Sidecar) (resources.Sidecar, ccv3.Warnings, error)
 	CreateApplicationTask(appGUID string, task resources.Task) (resources.Task, ccv3.Warnings, error)
 	CreateBuild(build resources.Build) (resources.Build, ccv3.Warnings, error)
 	CreateBuildpack(bp resources.Buildpack) (resources.Buildpack, ccv3.Warnings, error)
@@ -58,6 +60,7 @@ type CloudControllerClient interface {
 	DeleteServiceCredentialBinding(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceBroker(serviceBrokerGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteServiceInstance(serviceInstanceGUID string, query ...ccv3.Query) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteSidecar(sidecarGUID string) (ccv3.Warnings, error)
 	DeleteSpaceQuota(spaceQuotaGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteSpace(guid string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteUser(userGUID string) (ccv3.JobURL, ccv3.Warnings, error)
@@ -72,8 +75,10 @@ type CloudControllerClient interface {
 	GetApplicationRevisions(appGUID string, query ...ccv3.Query) ([]resources.Revision, ccv3.Warnings, error)
 	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, ccv3.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, ccv3.Warnings, error)
+	GetApplicationSidecars(appGUID string) ([]resources.Sidecar, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	GetApplications(query ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error)
+	GetAppUsageEvents(query ...ccv3.Query) ([]resources.AppUsageEvent, ccv3.Warnings, error)
 	GetBuild(guid string) (resources.Build, ccv3.Warnings, error)
 	GetBuildpacks(query ...ccv3.Query) ([]resources.Buildpack, ccv3.Warnings, error)
 	GetDefaultDomain(orgGuid string) (resources.Domain, ccv3.Warnings, error)
@@ -114,6 +119,7 @@ type CloudControllerClient interface {
 	GetServiceBrokers(query ...ccv3.Query) ([]resources.ServiceBroker, ccv3.Warnings, error)
 	GetServiceCredentialBindings(query ...ccv3.Query) ([]resources.ServiceCredentialBinding, ccv3.Warnings, error)
 	GetServiceCredentialBindingDetails(guid string) (resources.ServiceCredentialBindingDetails, ccv3.Warnings, error)
+	GetServiceCredentialBindingParameters(guid string) (types.JSONObject, ccv3.Warnings, error)
 	GetServiceInstanceByNameAndSpace(name, spaceGUID string, query ...ccv3.Query) (resources.ServiceInstance, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetServiceInstanceParameters(serviceInstanceGUID string) (types.JSONObject, ccv3.Warnings, error)
 	GetServiceInstanceSharedSpaces(serviceInstanceGUID string) ([]ccv3.SpaceWithOrganization, ccv3.Warnings, error)
@@ -126,6 +132,7 @@ type CloudControllerClient interface {
 	GetServicePlans(query ...ccv3.Query) ([]resources.ServicePlan, ccv3.Warnings, error)
 	GetServicePlansWithOfferings(query ...ccv3.Query) ([]ccv3.ServiceOfferingWithPlans, ccv3.Warnings, error)
 	GetServicePlansWithSpaceAndOrganization(query ...ccv3.Query) ([]ccv3.ServicePlanWithSpaceAndOrganization, ccv3.Warnings, error)
+	GetServiceUsageEvents(query ...ccv3.Query) ([]resources.ServiceUsageEvent, ccv3.Warnings, error)
 	GetSpaceFeature(spaceGUID string, featureName string) (bool, ccv3.Warnings, error)
 	GetSpaceIsolationSegment(spaceGUID string) (resources.Relationship, ccv3.Warnings, error)
 	GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) (resources.ManifestDiff, ccv3.Warnings, error)
@@ -175,6 +182,7 @@ type CloudControllerClient interface {
 	UpdateOrganizationDefaultIsolationSegmentRelationship(orgGUID string, isolationSegmentGUID string) (resources.Relationship, ccv3.Warnings, error)
 	UpdateOrganizationQuota(orgQuota resources.OrganizationQuota) (resources.OrganizationQuota, ccv3.Warnings, error)
 	UpdateProcess(process resources.Process) (resources.Process, ccv3.Warnings, error)
+	UpdateRoute(routeGUID string, options map[string]*string) (resources.Route, ccv3.Warnings, error)
 	UpdateResourceMetadata(resource string, resourceGUID string, metadata resources.Metadata) (ccv3.JobURL, ccv3.Warnings, error)
 	UpdateSecurityGroupRunningSpace(securityGroupGUID string, spaceGUIDs []string) (ccv3.Warnings, error)
 	UpdateSecurityGroupStagingSpace(securityGroupGUID string, spaceGUIDs []string) (ccv3.Warnings, error)
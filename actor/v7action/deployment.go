@@ -7,14 +7,14 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 )
 
-func (actor Actor) CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string) (string, Warnings, error) {
-	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID)
+func (actor Actor) CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string, strategy constant.DeploymentStrategy) (string, Warnings, error) {
+	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeployment(appGUID, dropletGUID, strategy)
 
 	return deploymentGUID, Warnings(warnings), err
 }
 
-func (actor Actor) CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, Warnings, error) {
-	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeploymentByRevision(appGUID, revisionGUID)
+func (actor Actor) CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string, strategy constant.DeploymentStrategy) (string, Warnings, error) {
+	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeploymentByRevision(appGUID, revisionGUID, strategy)
 
 	return deploymentGUID, Warnings(warnings), err
 }
@@ -43,3 +43,8 @@ func (actor Actor) CancelDeployment(deploymentGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.CancelDeployment(deploymentGUID)
 	return Warnings(warnings), err
 }
+
+func (actor Actor) ContinueDeployment(deploymentGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.ContinueDeployment(deploymentGUID)
+	return Warnings(warnings), err
+}
@@ -34,7 +34,7 @@ var _ = Describe("Deployment Actions", func() {
 
 	Describe("CreateDeploymentByApplicationAndRevision", func() {
 		JustBeforeEach(func() {
-			returnedDeploymentGUID, warnings, executeErr = actor.CreateDeploymentByApplicationAndRevision("some-app-guid", "some-revision-guid")
+			returnedDeploymentGUID, warnings, executeErr = actor.CreateDeploymentByApplicationAndRevision("some-app-guid", "some-revision-guid", constant.DeploymentStrategyRolling)
 		})
 
 		When("the client fails", func() {
@@ -55,10 +55,11 @@ var _ = Describe("Deployment Actions", func() {
 		It("delegates to the cloud controller client", func() {
 
 			Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(1), "CreateApplicationDeploymentByRevision call count")
-			givenAppGUID, givenRevisionGUID := fakeCloudControllerClient.CreateApplicationDeploymentByRevisionArgsForCall(0)
+			givenAppGUID, givenRevisionGUID, givenStrategy := fakeCloudControllerClient.CreateApplicationDeploymentByRevisionArgsForCall(0)
 
 			Expect(givenAppGUID).To(Equal("some-app-guid"))
 			Expect(givenRevisionGUID).To(Equal("some-revision-guid"))
+			Expect(givenStrategy).To(Equal(constant.DeploymentStrategyRolling))
 
 			Expect(returnedDeploymentGUID).To(Equal("some-deployment-guid"))
 			Expect(warnings).To(Equal(Warnings{"create-warning-1", "create-warning-2"}))
@@ -69,13 +70,14 @@ var _ = Describe("Deployment Actions", func() {
 		It("delegates to the cloud controller client", func() {
 			fakeCloudControllerClient.CreateApplicationDeploymentReturns("some-deployment-guid", ccv3.Warnings{"create-warning-1", "create-warning-2"}, errors.New("create-error"))
 
-			returnedDeploymentGUID, warnings, executeErr := actor.CreateDeploymentByApplicationAndDroplet("some-app-guid", "some-droplet-guid")
+			returnedDeploymentGUID, warnings, executeErr := actor.CreateDeploymentByApplicationAndDroplet("some-app-guid", "some-droplet-guid", constant.DeploymentStrategyCanary)
 
 			Expect(fakeCloudControllerClient.CreateApplicationDeploymentCallCount()).To(Equal(1))
-			givenAppGUID, givenDropletGUID := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
+			givenAppGUID, givenDropletGUID, givenStrategy := fakeCloudControllerClient.CreateApplicationDeploymentArgsForCall(0)
 
 			Expect(givenAppGUID).To(Equal("some-app-guid"))
 			Expect(givenDropletGUID).To(Equal("some-droplet-guid"))
+			Expect(givenStrategy).To(Equal(constant.DeploymentStrategyCanary))
 
 			Expect(returnedDeploymentGUID).To(Equal("some-deployment-guid"))
 			Expect(warnings).To(Equal(Warnings{"create-warning-1", "create-warning-2"}))
@@ -206,4 +208,48 @@ var _ = Describe("Deployment Actions", func() {
 			})
 		})
 	})
+
+	Describe("ContinueDeployment", func() {
+		var (
+			deploymentGUID string
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			deploymentGUID = "dep-guid"
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ContinueDeployment(deploymentGUID)
+		})
+
+		It("delegates to the cc client", func() {
+			Expect(fakeCloudControllerClient.ContinueDeploymentCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.ContinueDeploymentArgsForCall(0)).To(Equal(deploymentGUID))
+		})
+
+		When("the client fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-deployment-warnings"}, errors.New("continue-deployment-error"))
+			})
+
+			It("returns the warnings and error", func() {
+				Expect(executeErr).To(MatchError("continue-deployment-error"))
+				Expect(warnings).To(ConsistOf("continue-deployment-warnings"))
+			})
+		})
+
+		When("the client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ContinueDeploymentReturns(ccv3.Warnings{"continue-deployment-warnings"}, nil)
+			})
+
+			It("returns the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("continue-deployment-warnings"))
+			})
+		})
+	})
 })
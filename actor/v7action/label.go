@@ -1,180 +1,105 @@
 package v7action
 
 import (
-	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 )
 
 func (actor *Actor) GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
-	resource, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
-	return actor.extractLabels((*resources.Metadata)(resource.Metadata), warnings, err)
+	return extractLabels(actor.applicationMetadataOwner(appName, spaceGUID))
 }
 
 func (actor *Actor) GetDomainLabels(domainName string) (map[string]types.NullString, Warnings, error) {
-	resource, warnings, err := actor.GetDomainByName(domainName)
-	return actor.extractLabels(resource.Metadata, warnings, err)
+	return extractLabels(actor.domainMetadataOwner(domainName))
 }
 
 func (actor *Actor) GetOrganizationLabels(orgName string) (map[string]types.NullString, Warnings, error) {
-	resource, warnings, err := actor.GetOrganizationByName(orgName)
-	return actor.extractLabels(resource.Metadata, warnings, err)
+	return extractLabels(actor.organizationMetadataOwner(orgName))
 }
 
 func (actor *Actor) GetRouteLabels(routeName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
-	resource, warnings, err := actor.GetRoute(routeName, spaceGUID)
-	return actor.extractLabels((*resources.Metadata)(resource.Metadata), warnings, err)
+	return extractLabels(actor.routeMetadataOwner(routeName, spaceGUID))
 }
 
 func (actor *Actor) GetServiceBrokerLabels(serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
-	serviceBroker, warnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
-	return actor.extractLabels(serviceBroker.Metadata, warnings, err)
+	return extractLabels(actor.serviceBrokerMetadataOwner(serviceBrokerName))
 }
 
 func (actor *Actor) GetServiceInstanceLabels(serviceInstanceName, spaceGUID string) (map[string]types.NullString, Warnings, error) {
-	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
-	return actor.extractLabels(serviceInstance.Metadata, warnings, err)
+	return extractLabels(actor.serviceInstanceMetadataOwner(serviceInstanceName, spaceGUID))
 }
 
 func (actor *Actor) GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
-	serviceOffering, warnings, err := actor.CloudControllerClient.GetServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName)
-	return actor.extractLabels(serviceOffering.Metadata, Warnings(warnings), actionerror.EnrichAPIErrors(err))
+	return extractLabels(actor.serviceOfferingMetadataOwner(serviceOfferingName, serviceBrokerName))
 }
 
 func (actor *Actor) GetServicePlanLabels(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
-	servicePlan, warnings, err := actor.GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName)
-	return actor.extractLabels(servicePlan.Metadata, warnings, err)
+	return extractLabels(actor.servicePlanMetadataOwner(servicePlanName, serviceOfferingName, serviceBrokerName))
 }
 
 func (actor *Actor) GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
-	resource, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
-	return actor.extractLabels(resource.Metadata, warnings, err)
+	return extractLabels(actor.spaceMetadataOwner(spaceName, orgGUID))
 }
 
 func (actor *Actor) GetStackLabels(stackName string) (map[string]types.NullString, Warnings, error) {
-	resource, warnings, err := actor.GetStackByName(stackName)
-	return actor.extractLabels(resource.Metadata, warnings, err)
+	return extractLabels(actor.stackMetadataOwner(stackName))
 }
 
 func (actor *Actor) GetBuildpackLabels(buildpackName string, buildpackStack string) (map[string]types.NullString, Warnings, error) {
-	resource, warnings, err := actor.GetBuildpackByNameAndStack(buildpackName, buildpackStack)
-	return actor.extractLabels(resource.Metadata, warnings, err)
-}
-
-func (actor *Actor) extractLabels(metadata *resources.Metadata, warnings Warnings, err error) (map[string]types.NullString, Warnings, error) {
-	var labels map[string]types.NullString
-
-	if err != nil {
-		return labels, warnings, err
-	}
-	if metadata != nil {
-		labels = metadata.Labels
-	}
-	return labels, warnings, nil
+	return extractLabels(actor.buildpackMetadataOwner(buildpackName, buildpackStack))
 }
 
 func (actor *Actor) UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
-	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("app", app.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.applicationMetadataOwner(appName, spaceGUID)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateBuildpackLabelsByBuildpackNameAndStack(buildpackName string, stack string, labels map[string]types.NullString) (Warnings, error) {
-	buildpack, warnings, err := actor.GetBuildpackByNameAndStack(buildpackName, stack)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("buildpack", buildpack.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.buildpackMetadataOwner(buildpackName, stack)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateDomainLabelsByDomainName(domainName string, labels map[string]types.NullString) (Warnings, error) {
-	domain, warnings, err := actor.GetDomainByName(domainName)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("domain", domain.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.domainMetadataOwner(domainName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (Warnings, error) {
-	org, warnings, err := actor.GetOrganizationByName(orgName)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("org", org.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.organizationMetadataOwner(orgName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateRouteLabels(routeName string, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
-	route, warnings, err := actor.GetRoute(routeName, spaceGUID)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("route", route.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.routeMetadataOwner(routeName, spaceGUID)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (Warnings, error) {
-	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("space", space.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.spaceMetadataOwner(spaceName, orgGUID)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateStackLabelsByStackName(stackName string, labels map[string]types.NullString) (Warnings, error) {
-	stack, warnings, err := actor.GetStackByName(stackName)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("stack", stack.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.stackMetadataOwner(stackName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateServiceBrokerLabelsByServiceBrokerName(serviceBrokerName string, labels map[string]types.NullString) (Warnings, error) {
-	serviceBroker, warnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("service-broker", serviceBroker.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.serviceBrokerMetadataOwner(serviceBrokerName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateServiceInstanceLabels(serviceInstanceName, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
-	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("service-instance", serviceInstance.GUID, resources.Metadata{Labels: labels}, warnings)
+	owner, warnings, err := actor.serviceInstanceMetadataOwner(serviceInstanceName, spaceGUID)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateServiceOfferingLabels(serviceOfferingName string, serviceBrokerName string, labels map[string]types.NullString) (Warnings, error) {
-	serviceOffering, warnings, err := actor.CloudControllerClient.GetServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName)
-	if err != nil {
-		return Warnings(warnings), actionerror.EnrichAPIErrors(err)
-	}
-	return actor.updateResourceMetadata("service-offering", serviceOffering.GUID, resources.Metadata{Labels: labels}, Warnings(warnings))
+	owner, warnings, err := actor.serviceOfferingMetadataOwner(serviceOfferingName, serviceBrokerName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
 
 func (actor *Actor) UpdateServicePlanLabels(servicePlanName string, serviceOfferingName string, serviceBrokerName string, labels map[string]types.NullString) (Warnings, error) {
-	servicePlan, warnings, err := actor.GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName)
-	if err != nil {
-		return warnings, err
-	}
-	return actor.updateResourceMetadata("service-plan", servicePlan.GUID, resources.Metadata{Labels: labels}, warnings)
-}
-
-func (actor *Actor) updateResourceMetadata(resourceType string, resourceGUID string, payload resources.Metadata, warnings Warnings) (Warnings, error) {
-	jobURL, updateWarnings, err := actor.CloudControllerClient.UpdateResourceMetadata(resourceType, resourceGUID, payload)
-	warnings = append(warnings, updateWarnings...)
-	if err != nil {
-		return warnings, err
-	}
-
-	if jobURL != "" {
-		pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
-		warnings = append(warnings, pollWarnings...)
-		if err != nil {
-			return warnings, err
-		}
-	}
-
-	return warnings, nil
+	owner, warnings, err := actor.servicePlanMetadataOwner(servicePlanName, serviceOfferingName, serviceBrokerName)
+	return actor.updateOwnerMetadata(owner, resources.Metadata{Labels: labels}, warnings, err)
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/resources"
 	"github.com/SermoDigital/jose/jws"
 )
 
@@ -47,6 +48,112 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 	return logMessages, allWarnings, nil
 }
 
+// GetFilteredStreamingLogsForApplicationByNameAndSpace streams the logs of
+// the app that match the source, instance, process type and pattern of filter.
+func (actor Actor) GetFilteredStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, Warnings, error) {
+	appMessages, logErrs, cancelFunc, warnings, err := actor.GetStreamingLogsForApplicationByNameAndSpace(appName, spaceGUID, client)
+	if err != nil {
+		return nil, nil, nil, warnings, err
+	}
+
+	messages, cancelFunc := filterStreamingLogs(appMessages, cancelFunc, filter.Matches)
+	return messages, logErrs, cancelFunc, warnings, nil
+}
+
+// GetFilteredRecentLogsForApplicationByNameAndSpace returns the recent logs
+// of the app that match filter.
+func (actor Actor) GetFilteredRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) ([]sharedaction.LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	logMessages, err := sharedaction.GetFilteredRecentLogs(app.GUID, client, filter)
+	return logMessages, allWarnings, err
+}
+
+// GetStreamingLogsForTask streams the logs of the given task. Only envelopes
+// emitted by the task after it was created are passed through.
+func (actor Actor) GetStreamingLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+	appMessages, logErrs, cancelFunc := sharedaction.GetStreamingLogs(appGUID, client)
+
+	messages, cancelFunc := filterStreamingLogs(appMessages, cancelFunc, func(message sharedaction.LogMessage) bool {
+		return isTaskLogMessage(message, task)
+	})
+	return messages, logErrs, cancelFunc
+}
+
+// GetRecentLogsForTask returns the recent logs of the app that were emitted
+// by the given task.
+func (actor Actor) GetRecentLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error) {
+	appMessages, err := sharedaction.GetRecentLogs(appGUID, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var logMessages []sharedaction.LogMessage
+	for _, message := range appMessages {
+		if isTaskLogMessage(message, task) {
+			logMessages = append(logMessages, message)
+		}
+	}
+
+	return logMessages, nil
+}
+
+// isTaskLogMessage reports whether message was emitted by task. Diego tags
+// task logs with the task name, which is not unique, so messages from before
+// the task was created are discarded as belonging to an earlier run.
+func isTaskLogMessage(message sharedaction.LogMessage, task resources.Task) bool {
+	if message.SourceType() != "APP/TASK/"+task.Name {
+		return false
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, task.CreatedAt)
+	if err != nil {
+		return true
+	}
+
+	return !message.Timestamp().Before(createdAt)
+}
+
+// filterStreamingLogs passes the messages that match on to a new channel. The
+// returned cancel func stops the stream, and also stops the forwarding when
+// the caller no longer reads the messages.
+func filterStreamingLogs(appMessages <-chan sharedaction.LogMessage, cancelStream context.CancelFunc, matches func(sharedaction.LogMessage) bool) (<-chan sharedaction.LogMessage, context.CancelFunc) {
+	messages := make(chan sharedaction.LogMessage, cap(appMessages))
+	ctx, stop := context.WithCancel(context.Background())
+
+	go func() {
+		defer close(messages)
+		for message := range appMessages {
+			if !matches(message) {
+				continue
+			}
+
+			select {
+			case messages <- message:
+				continue
+			default:
+			}
+
+			select {
+			case messages <- message:
+			case <-ctx.Done():
+				// Drain the stream so that it is not blocked until it closes.
+				for range appMessages {
+				}
+				return
+			}
+		}
+	}()
+
+	return messages, func() {
+		stop()
+		cancelStream()
+	}
+}
+
 func (actor Actor) ScheduleTokenRefresh(
 	after func(time.Duration) <-chan time.Time,
 	stop chan struct{},
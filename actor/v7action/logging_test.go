@@ -248,4 +248,249 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetFilteredRecentLogsForApplicationByNameAndSpace", func() {
+		var (
+			messages []sharedaction.LogMessage
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			messages, warnings, err = actor.GetFilteredRecentLogsForApplicationByNameAndSpace(
+				"some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{SourceType: "RTR"},
+			)
+		})
+
+		When("the application can be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warnings"},
+					nil,
+				)
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+					taskEnvelope("router-output", "RTR", time.Unix(0, 20)),
+					taskEnvelope("web-output", "APP/PROC/WEB", time.Unix(0, 10)),
+				}, nil)
+			})
+
+			It("returns the matching logs of the app and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(messages).To(HaveLen(1))
+				Expect(messages[0].Message()).To(Equal("router-output"))
+
+				_, sourceID, _, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("finding the application errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, errors.New("some-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("some-app-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetFilteredStreamingLogsForApplicationByNameAndSpace", func() {
+		var (
+			messages        <-chan sharedaction.LogMessage
+			logErrs         <-chan error
+			stopStreamingCh chan context.CancelFunc
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv3.Warnings{"some-app-warnings"},
+				nil,
+			)
+
+			// The cancel func is handed over to the goroutine reading from Log
+			// Cache, as it is only known once streaming has started.
+			stopStreamingCh = make(chan context.CancelFunc, 1)
+			var stopStreaming context.CancelFunc
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				if fakeLogCacheClient.ReadCallCount() > 2 && stopStreaming == nil {
+					select {
+					case stopStreaming = <-stopStreamingCh:
+						stopStreaming()
+					default:
+					}
+				}
+
+				// 2 seconds in the past to get past Walk delay
+				return []*loggregator_v2.Envelope{
+					taskEnvelope("web-output", "APP/PROC/WEB", time.Now().Add(-3*time.Second)),
+					taskEnvelope("router-output", "RTR", time.Now().Add(-2*time.Second)),
+				}, ctx.Err()
+			}
+		})
+
+		AfterEach(func() {
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		It("passes through only the matching logs", func() {
+			var (
+				stopStreaming context.CancelFunc
+				warnings      Warnings
+				err           error
+			)
+			messages, logErrs, stopStreaming, warnings, err = actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(
+				"some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{SourceType: "RTR"},
+			)
+			stopStreamingCh <- stopStreaming
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+			var message sharedaction.LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("router-output"))
+		})
+
+		It("stops forwarding when streaming is stopped while the messages are not read", func() {
+			var (
+				stopStreaming context.CancelFunc
+				err           error
+			)
+			messages, logErrs, stopStreaming, _, err = actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(
+				"some-app", "some-space-guid", fakeLogCacheClient, sharedaction.LogFilter{},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(func() int { return len(messages) }).Should(Equal(cap(messages)))
+			stopStreaming()
+
+			Eventually(logErrs).Should(BeClosed())
+			for range messages {
+			}
+		})
+	})
+
+	Describe("GetRecentLogsForTask", func() {
+		var (
+			task     resources.Task
+			messages []sharedaction.LogMessage
+			err      error
+		)
+
+		BeforeEach(func() {
+			task = resources.Task{Name: "migrate", CreatedAt: "2026-01-02T03:04:05Z"}
+			createdAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+			fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{
+				taskEnvelope("after-task-output", "APP/TASK/migrate", createdAt.Add(time.Minute)),
+				taskEnvelope("web-output", "APP/PROC/WEB", createdAt.Add(30*time.Second)),
+				taskEnvelope("other-task-output", "APP/TASK/seed", createdAt.Add(20*time.Second)),
+				taskEnvelope("task-output", "APP/TASK/migrate", createdAt.Add(10*time.Second)),
+				taskEnvelope("earlier-run-output", "APP/TASK/migrate", createdAt.Add(-time.Hour)),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			messages, err = actor.GetRecentLogsForTask("some-app-guid", task, fakeLogCacheClient)
+		})
+
+		It("returns only the logs emitted by the task since it was created", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(HaveLen(2))
+			Expect(messages[0].Message()).To(Equal("task-output"))
+			Expect(messages[1].Message()).To(Equal("after-task-output"))
+
+			_, sourceID, _, _ := fakeLogCacheClient.ReadArgsForCall(0)
+			Expect(sourceID).To(Equal("some-app-guid"))
+		})
+
+		When("Log Cache errors", func() {
+			BeforeEach(func() {
+				fakeLogCacheClient.ReadReturns(nil, errors.New("failure-to-read-from-log-cache"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("Failed to retrieve logs from Log Cache: failure-to-read-from-log-cache"))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForTask", func() {
+		var (
+			messages        <-chan sharedaction.LogMessage
+			logErrs         <-chan error
+			stopStreamingCh chan context.CancelFunc
+		)
+
+		BeforeEach(func() {
+			// The cancel func is handed over to the goroutine reading from Log
+			// Cache, as it is only known once streaming has started.
+			stopStreamingCh = make(chan context.CancelFunc, 1)
+			var stopStreaming context.CancelFunc
+			fakeLogCacheClient.ReadStub = func(
+				ctx context.Context,
+				sourceID string,
+				start time.Time,
+				opts ...logcache.ReadOption,
+			) ([]*loggregator_v2.Envelope, error) {
+				if fakeLogCacheClient.ReadCallCount() > 2 && stopStreaming == nil {
+					select {
+					case stopStreaming = <-stopStreamingCh:
+						stopStreaming()
+					default:
+					}
+				}
+
+				// 2 seconds in the past to get past Walk delay
+				return []*loggregator_v2.Envelope{
+					taskEnvelope("web-output", "APP/PROC/WEB", time.Now().Add(-3*time.Second)),
+					taskEnvelope("task-output", "APP/TASK/migrate", time.Now().Add(-2*time.Second)),
+				}, ctx.Err()
+			}
+		})
+
+		AfterEach(func() {
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		It("passes through only the logs emitted by the task", func() {
+			var stopStreaming context.CancelFunc
+			messages, logErrs, stopStreaming = actor.GetStreamingLogsForTask("some-app-guid", resources.Task{Name: "migrate"}, fakeLogCacheClient)
+			stopStreamingCh <- stopStreaming
+
+			var message sharedaction.LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("task-output"))
+			Expect(message.SourceType()).To(Equal("APP/TASK/migrate"))
+		})
+	})
 })
+
+func taskEnvelope(payload string, sourceType string, timestamp time.Time) *loggregator_v2.Envelope {
+	return &loggregator_v2.Envelope{
+		Timestamp:  timestamp.UnixNano(),
+		SourceId:   "some-app-guid",
+		InstanceId: "0",
+		Message: &loggregator_v2.Envelope_Log{
+			Log: &loggregator_v2.Log{
+				Payload: []byte(payload),
+				Type:    loggregator_v2.Log_OUT,
+			},
+		},
+		Tags: map[string]string{
+			"source_type": sourceType,
+		},
+	}
+}
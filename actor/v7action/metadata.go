@@ -0,0 +1,109 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// metadataOwner is a resource whose labels and annotations can be read and
+// updated.
+type metadataOwner struct {
+	resourceType string
+	guid         string
+	metadata     *resources.Metadata
+}
+
+func (actor *Actor) applicationMetadataOwner(appName string, spaceGUID string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	return metadataOwner{"app", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) buildpackMetadataOwner(buildpackName string, buildpackStack string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetBuildpackByNameAndStack(buildpackName, buildpackStack)
+	return metadataOwner{"buildpack", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) domainMetadataOwner(domainName string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetDomainByName(domainName)
+	return metadataOwner{"domain", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) organizationMetadataOwner(orgName string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetOrganizationByName(orgName)
+	return metadataOwner{"org", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) routeMetadataOwner(routeName string, spaceGUID string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetRoute(routeName, spaceGUID)
+	return metadataOwner{"route", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) serviceBrokerMetadataOwner(serviceBrokerName string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
+	return metadataOwner{"service-broker", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) serviceInstanceMetadataOwner(serviceInstanceName string, spaceGUID string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	return metadataOwner{"service-instance", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) serviceOfferingMetadataOwner(serviceOfferingName string, serviceBrokerName string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.CloudControllerClient.GetServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName)
+	return metadataOwner{"service-offering", resource.GUID, resource.Metadata}, Warnings(warnings), actionerror.EnrichAPIErrors(err)
+}
+
+func (actor *Actor) servicePlanMetadataOwner(servicePlanName string, serviceOfferingName string, serviceBrokerName string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName)
+	return metadataOwner{"service-plan", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) spaceMetadataOwner(spaceName string, orgGUID string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	return metadataOwner{"space", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func (actor *Actor) stackMetadataOwner(stackName string) (metadataOwner, Warnings, error) {
+	resource, warnings, err := actor.GetStackByName(stackName)
+	return metadataOwner{"stack", resource.GUID, resource.Metadata}, warnings, err
+}
+
+func extractLabels(owner metadataOwner, warnings Warnings, err error) (map[string]types.NullString, Warnings, error) {
+	if err != nil || owner.metadata == nil {
+		return nil, warnings, err
+	}
+	return owner.metadata.Labels, warnings, nil
+}
+
+func extractAnnotations(owner metadataOwner, warnings Warnings, err error) (map[string]types.NullString, Warnings, error) {
+	if err != nil || owner.metadata == nil {
+		return nil, warnings, err
+	}
+	return owner.metadata.Annotations, warnings, nil
+}
+
+func (actor *Actor) updateOwnerMetadata(owner metadataOwner, payload resources.Metadata, warnings Warnings, err error) (Warnings, error) {
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata(owner.resourceType, owner.guid, payload, warnings)
+}
+
+func (actor *Actor) updateResourceMetadata(resourceType string, resourceGUID string, payload resources.Metadata, warnings Warnings) (Warnings, error) {
+	jobURL, updateWarnings, err := actor.CloudControllerClient.UpdateResourceMetadata(resourceType, resourceGUID, payload)
+	warnings = append(warnings, updateWarnings...)
+	if err != nil {
+		return warnings, err
+	}
+
+	if jobURL != "" {
+		pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+		warnings = append(warnings, pollWarnings...)
+		if err != nil {
+			return warnings, err
+		}
+	}
+
+	return warnings, nil
+}
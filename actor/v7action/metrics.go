@@ -0,0 +1,41 @@
+package v7action
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+)
+
+// AppGUIDPlaceholder is replaced with the GUID of the app in metric queries.
+const AppGUIDPlaceholder = "$APP_GUID"
+
+// GetStreamingMetricsForApplicationByNameAndSpace streams the latest gauge
+// and counter values of each instance of the app, read again every interval.
+func (actor Actor) GetStreamingMetricsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, interval time.Duration) (<-chan []sharedaction.InstanceMetrics, <-chan error, context.CancelFunc, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, nil, nil, allWarnings, err
+	}
+
+	metrics, metricErrs, cancelFunc := sharedaction.GetStreamingMetrics(app.GUID, client, interval)
+
+	return metrics, metricErrs, cancelFunc, allWarnings, nil
+}
+
+// QueryMetricsForApplicationByNameAndSpace runs a PromQL query against Log
+// Cache after replacing AppGUIDPlaceholder with the GUID of the app.
+func (actor Actor) QueryMetricsForApplicationByNameAndSpace(appName string, spaceGUID string, query string, client sharedaction.LogCacheClient) ([]sharedaction.MetricSample, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	samples, err := sharedaction.QueryMetrics(strings.ReplaceAll(query, AppGUIDPlaceholder, app.GUID), client)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	return samples, allWarnings, nil
+}
@@ -0,0 +1,163 @@
+package v7action_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/go-log-cache/v2/rpc/logcache_v1"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metrics Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *sharedactionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		fakeLogCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+	})
+
+	Describe("GetStreamingMetricsForApplicationByNameAndSpace", func() {
+		var (
+			metrics    <-chan []sharedaction.InstanceMetrics
+			metricErrs <-chan error
+			cancelFunc context.CancelFunc
+			warnings   Warnings
+			err        error
+		)
+
+		JustBeforeEach(func() {
+			metrics, metricErrs, cancelFunc, warnings, err = actor.GetStreamingMetricsForApplicationByNameAndSpace(
+				"some-app", "some-space-guid", fakeLogCacheClient, time.Millisecond,
+			)
+		})
+
+		AfterEach(func() {
+			if cancelFunc != nil {
+				cancelFunc()
+			}
+		})
+
+		When("the application can be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warnings"},
+					nil,
+				)
+				fakeLogCacheClient.ReadReturns([]*loggregator_v2.Envelope{{
+					InstanceId: "0",
+					Message: &loggregator_v2.Envelope_Gauge{Gauge: &loggregator_v2.Gauge{
+						Metrics: map[string]*loggregator_v2.GaugeValue{"cpu": {Value: 12.5, Unit: "percentage"}},
+					}},
+				}}, nil)
+			})
+
+			It("streams the metrics of the app and returns all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(metricErrs).NotTo(BeNil())
+
+				var instances []sharedaction.InstanceMetrics
+				Eventually(metrics).Should(Receive(&instances))
+				Expect(instances).To(HaveLen(1))
+				Expect(instances[0].Gauges["cpu"].Value).To(Equal(12.5))
+
+				_, sourceID, _, _ := fakeLogCacheClient.ReadArgsForCall(0)
+				Expect(sourceID).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("finding the application errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, errors.New("some-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("some-app-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeLogCacheClient.ReadCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("QueryMetricsForApplicationByNameAndSpace", func() {
+		var (
+			samples  []sharedaction.MetricSample
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			samples, warnings, err = actor.QueryMetricsForApplicationByNameAndSpace(
+				"some-app", "some-space-guid", `avg(cpu{source_id="$APP_GUID"})`, fakeLogCacheClient,
+			)
+		})
+
+		When("the application can be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warnings"},
+					nil,
+				)
+				fakeLogCacheClient.PromQLReturns(&logcache_v1.PromQL_InstantQueryResult{
+					Result: &logcache_v1.PromQL_InstantQueryResult_Scalar{
+						Scalar: &logcache_v1.PromQL_Scalar{Time: "1", Value: 3},
+					},
+				}, nil)
+			})
+
+			It("runs the query with the app GUID substituted", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+				_, query, _ := fakeLogCacheClient.PromQLArgsForCall(0)
+				Expect(query).To(Equal(`avg(cpu{source_id="some-app-guid"})`))
+
+				Expect(samples).To(HaveLen(1))
+				Expect(samples[0].Value).To(Equal(3.0))
+			})
+		})
+
+		When("the query fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-app-warnings"},
+					nil,
+				)
+				fakeLogCacheClient.PromQLReturns(nil, errors.New("bad query"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("Failed to query metrics from Log Cache: bad query"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+
+		When("finding the application errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"some-app-warnings"}, errors.New("some-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("some-app-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeLogCacheClient.PromQLCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package v7action
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+)
+
+// DeviceAuthorization holds the codes of a pending device code login.
+type DeviceAuthorization uaa.DeviceAuthorization
+
+// defaultDevicePollingInterval is used when UAA does not say how often a
+// device code may be polled.
+const defaultDevicePollingInterval = 5 * time.Second
+
+// authorizationCodeTimeout is how long to wait for the browser to be
+// redirected back during an authorization code login.
+const authorizationCodeTimeout = 5 * time.Minute
+
+// AuthenticateWithAuthorizationCode logs in with the authorization code flow
+// and PKCE. It passes the URL the user has to visit to openURL, then waits for
+// the browser to be redirected back to a loopback listener, giving up after
+// authorizationCodeTimeout.
+func (actor Actor) AuthenticateWithAuthorizationCode(origin string, openURL func(authorizationURL string)) error {
+	codeVerifier, err := randomURLSafeString()
+	if err != nil {
+		return err
+	}
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	codeChallenge := base64.RawURLEncoding.EncodeToString(challenge[:])
+
+	state, err := randomURLSafeString()
+	if err != nil {
+		return err
+	}
+
+	listener, err := uaa.NewLoopbackRedirectListener(state)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	authorizationURL, err := actor.UAAClient.AuthorizationCodeURL(listener.RedirectURI(), state, codeChallenge, origin)
+	if err != nil {
+		return err
+	}
+	openURL(authorizationURL)
+
+	code, err := listener.WaitForCode(authorizationCodeTimeout)
+	if err != nil {
+		return err
+	}
+
+	credentials := map[string]string{
+		"code":          code,
+		"code_verifier": codeVerifier,
+		"redirect_uri":  listener.RedirectURI(),
+	}
+	return actor.Authenticate(credentials, origin, constant.GrantTypeAuthorizationCode)
+}
+
+// StartDeviceAuthorization requests the codes needed for a device code login.
+func (actor Actor) StartDeviceAuthorization() (DeviceAuthorization, error) {
+	deviceAuthorization, err := actor.UAAClient.StartDeviceAuthorization()
+	return DeviceAuthorization(deviceAuthorization), err
+}
+
+// AuthenticateWithDeviceCode polls UAA until the user has approved the device
+// code login, or the device code expires.
+func (actor Actor) AuthenticateWithDeviceCode(deviceAuthorization DeviceAuthorization) error {
+	interval := time.Duration(deviceAuthorization.Interval) * time.Second
+	if interval <= 0 {
+		interval = defaultDevicePollingInterval
+	}
+	deadline := actor.Clock.Now().Add(time.Duration(deviceAuthorization.ExpiresIn) * time.Second)
+
+	credentials := map[string]string{
+		"device_code": deviceAuthorization.DeviceCode,
+	}
+
+	for {
+		actor.Clock.Sleep(interval)
+
+		err := actor.Authenticate(credentials, "", constant.GrantTypeDeviceCode)
+		switch err.(type) {
+		case nil:
+			return nil
+		case uaa.AuthorizationPendingError:
+		case uaa.SlowDownError:
+			interval += defaultDevicePollingInterval
+		default:
+			return err
+		}
+
+		if deviceAuthorization.ExpiresIn > 0 && !actor.Clock.Now().Before(deadline) {
+			return actionerror.DeviceAuthorizationExpiredError{}
+		}
+	}
+}
+
+func randomURLSafeString() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
@@ -0,0 +1,169 @@
+package v7action_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OAuth Login Actions", func() {
+	var (
+		actor         *Actor
+		fakeConfig    *v7actionfakes.FakeConfig
+		fakeUAAClient *v7actionfakes.FakeUAAClient
+		fakeClock     *fakeclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		actor, _, fakeConfig, _, fakeUAAClient, _, fakeClock = NewTestActor()
+		fakeUAAClient.AuthenticateReturns("some-access-token", "some-refresh-token", nil)
+	})
+
+	Describe("AuthenticateWithAuthorizationCode", func() {
+		var (
+			redirectQuery string
+			executeErr    error
+		)
+
+		BeforeEach(func() {
+			fakeUAAClient.AuthorizationCodeURLStub = func(redirectURI string, state string, _ string, _ string) (string, error) {
+				return "https://login.example.com/oauth/authorize?state=" + state + "&redirect_uri=" + url.QueryEscape(redirectURI), nil
+			}
+			redirectQuery = "code=some-code"
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.AuthenticateWithAuthorizationCode("some-origin", func(authorizationURL string) {
+				parsedURL, err := url.Parse(authorizationURL)
+				Expect(err).ToNot(HaveOccurred())
+				query := parsedURL.Query()
+
+				// Play the part of the browser being redirected back.
+				go func() {
+					defer GinkgoRecover()
+					response, err := http.Get(query.Get("redirect_uri") + "?state=" + query.Get("state") + "&" + redirectQuery)
+					Expect(err).ToNot(HaveOccurred())
+					response.Body.Close()
+				}()
+			})
+		})
+
+		It("exchanges the code and PKCE verifier for tokens", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeUAAClient.AuthorizationCodeURLCallCount()).To(Equal(1))
+			redirectURI, state, codeChallenge, origin := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+			Expect(state).ToNot(BeEmpty())
+			Expect(origin).To(Equal("some-origin"))
+
+			Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
+			credentials, passedOrigin, grantType := fakeUAAClient.AuthenticateArgsForCall(0)
+			Expect(grantType).To(Equal(constant.GrantTypeAuthorizationCode))
+			Expect(passedOrigin).To(Equal("some-origin"))
+			Expect(credentials["code"]).To(Equal("some-code"))
+			Expect(credentials["redirect_uri"]).To(Equal(redirectURI))
+
+			challenge := sha256.Sum256([]byte(credentials["code_verifier"]))
+			Expect(codeChallenge).To(Equal(base64.RawURLEncoding.EncodeToString(challenge[:])))
+
+			accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+			Expect(accessToken).To(Equal("bearer some-access-token"))
+			Expect(refreshToken).To(Equal("some-refresh-token"))
+		})
+
+		When("the login is denied in the browser", func() {
+			BeforeEach(func() {
+				redirectQuery = "error=access_denied"
+			})
+
+			It("returns the error without requesting a token", func() {
+				Expect(executeErr).To(MatchError(uaa.UAAErrorResponse{Type: "access_denied"}))
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("AuthenticateWithDeviceCode", func() {
+		var (
+			deviceAuthorization DeviceAuthorization
+			errs                chan error
+		)
+
+		BeforeEach(func() {
+			deviceAuthorization = DeviceAuthorization{
+				DeviceCode: "some-device-code",
+				ExpiresIn:  30,
+				Interval:   5,
+			}
+		})
+
+		JustBeforeEach(func() {
+			errs = make(chan error, 1)
+			go func() {
+				errs <- actor.AuthenticateWithDeviceCode(deviceAuthorization)
+			}()
+		})
+
+		When("the login is approved after a few polls", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturnsOnCall(0, "", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateReturnsOnCall(1, "", "", uaa.SlowDownError{})
+				fakeUAAClient.AuthenticateReturnsOnCall(2, "some-access-token", "some-refresh-token", nil)
+			})
+
+			It("polls at the given interval, slowing down when asked", func() {
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+				Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(1))
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+				Eventually(fakeUAAClient.AuthenticateCallCount).Should(Equal(2))
+
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+				Consistently(fakeUAAClient.AuthenticateCallCount).Should(Equal(2))
+				fakeClock.Increment(5 * time.Second)
+
+				Eventually(errs).Should(Receive(BeNil()))
+				credentials, _, grantType := fakeUAAClient.AuthenticateArgsForCall(2)
+				Expect(credentials).To(Equal(map[string]string{"device_code": "some-device-code"}))
+				Expect(grantType).To(Equal(constant.GrantTypeDeviceCode))
+			})
+		})
+
+		When("the device code expires", func() {
+			BeforeEach(func() {
+				deviceAuthorization.ExpiresIn = 10
+				fakeUAAClient.AuthenticateReturns("", "", uaa.AuthorizationPendingError{})
+			})
+
+			It("returns a DeviceAuthorizationExpiredError", func() {
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+				Eventually(errs).Should(Receive(MatchError(actionerror.DeviceAuthorizationExpiredError{})))
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(2))
+			})
+		})
+
+		When("polling fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateReturns("", "", errors.New("access denied"))
+			})
+
+			It("returns the error", func() {
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+				Eventually(errs).Should(Receive(MatchError("access denied")))
+			})
+		})
+	})
+})
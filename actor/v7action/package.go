@@ -1,6 +1,8 @@
 package v7action
 
 import (
+	"crypto/sha256"
+	"fmt"
 	"io"
 	"os"
 	"time"
@@ -47,44 +49,50 @@ func (actor Actor) CreateDockerPackageByApplicationNameAndSpace(appName string,
 	return pkg, append(getWarnings, warnings...), err
 }
 
-func (actor Actor) CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (resources.Package, Warnings, error) {
-	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+// WriteBitsPackageArchive builds the archive that create-package would upload
+// for the app bits at bitsPath, writes it to outputPath instead, and returns
+// its SHA256 checksum.
+func (actor Actor) WriteBitsPackageArchive(bitsPath string, outputPath string) (string, error) {
+	archivePath, err := actor.createBitsArchive(bitsPath)
 	if err != nil {
-		return resources.Package{}, allWarnings, err
+		return "", err
 	}
+	defer os.RemoveAll(archivePath)
 
-	if bitsPath == "" {
-		bitsPath, err = os.Getwd()
-		if err != nil {
-			return resources.Package{}, allWarnings, err
-		}
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return "", err
 	}
+	defer archive.Close()
 
-	info, err := os.Stat(bitsPath)
+	output, err := os.Create(outputPath)
 	if err != nil {
-		return resources.Package{}, allWarnings, err
+		return "", err
 	}
+	defer output.Close()
 
-	var fileResources []sharedaction.Resource
-	if info.IsDir() {
-		fileResources, err = actor.SharedActor.GatherDirectoryResources(bitsPath)
-	} else {
-		fileResources, err = actor.SharedActor.GatherArchiveResources(bitsPath)
+	sum := sha256.New()
+	_, err = io.Copy(io.MultiWriter(output, sum), archive)
+	if err != nil {
+		return "", err
 	}
+
+	err = output.Close()
 	if err != nil {
-		return resources.Package{}, allWarnings, err
+		return "", err
 	}
 
-	// potentially match resources here in the future
+	return fmt.Sprintf("%x", sum.Sum(nil)), nil
+}
 
-	var archivePath string
-	if info.IsDir() {
-		archivePath, err = actor.SharedActor.ZipDirectoryResources(bitsPath, fileResources)
-	} else {
-		archivePath, err = actor.SharedActor.ZipArchiveResources(bitsPath, fileResources)
+func (actor Actor) CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (resources.Package, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Package{}, allWarnings, err
 	}
+
+	archivePath, err := actor.createBitsArchive(bitsPath)
 	if err != nil {
-		os.RemoveAll(archivePath)
 		return resources.Package{}, allWarnings, err
 	}
 	defer os.RemoveAll(archivePath)
@@ -129,6 +137,49 @@ func (actor Actor) CreateAndUploadBitsPackageByApplicationNameAndSpace(appName s
 	return updatedPackage, append(allWarnings, updatedWarnings...), err
 }
 
+// createBitsArchive gathers the resources at bitsPath, which may be a
+// directory or a zip file and defaults to the working directory, and zips
+// them into a temporary archive.
+func (actor Actor) createBitsArchive(bitsPath string) (string, error) {
+	var err error
+	if bitsPath == "" {
+		bitsPath, err = os.Getwd()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	info, err := os.Stat(bitsPath)
+	if err != nil {
+		return "", err
+	}
+
+	var fileResources []sharedaction.Resource
+	if info.IsDir() {
+		fileResources, err = actor.SharedActor.GatherDirectoryResources(bitsPath)
+	} else {
+		fileResources, err = actor.SharedActor.GatherArchiveResources(bitsPath)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// potentially match resources here in the future
+
+	var archivePath string
+	if info.IsDir() {
+		archivePath, err = actor.SharedActor.ZipDirectoryResources(bitsPath, fileResources)
+	} else {
+		archivePath, err = actor.SharedActor.ZipArchiveResources(bitsPath, fileResources)
+	}
+	if err != nil {
+		os.RemoveAll(archivePath)
+		return "", err
+	}
+
+	return archivePath, nil
+}
+
 func (actor Actor) GetNewestReadyPackageForApplication(app resources.Application) (resources.Package, Warnings, error) {
 	ccv3Packages, warnings, err := actor.CloudControllerClient.GetPackages(
 		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
@@ -1,10 +1,13 @@
 package v7action_test
 
 import (
+	"crypto/sha256"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -362,6 +365,71 @@ var _ = Describe("Package Actions", func() {
 		})
 	})
 
+	Describe("WriteBitsPackageArchive", func() {
+		var (
+			bitsPath   string
+			outputDir  string
+			outputPath string
+			checksum   string
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			bitsPath, err = ioutil.TempDir("", "example")
+			Expect(err).ToNot(HaveOccurred())
+			outputDir, err = ioutil.TempDir("", "package-output")
+			Expect(err).ToNot(HaveOccurred())
+			outputPath = filepath.Join(outputDir, "package.zip")
+
+			fakeSharedActor.GatherDirectoryResourcesReturns([]sharedaction.Resource{{Filename: "file-1"}}, nil)
+			fakeSharedActor.ZipDirectoryResourcesStub = func(string, []sharedaction.Resource) (string, error) {
+				archive, err := ioutil.TempFile("", "zipped-archive")
+				Expect(err).ToNot(HaveOccurred())
+				defer archive.Close()
+				_, err = archive.WriteString("some-zip-contents")
+				Expect(err).ToNot(HaveOccurred())
+				return archive.Name(), nil
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(bitsPath)).To(Succeed())
+			Expect(os.RemoveAll(outputDir)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			checksum, executeErr = actor.WriteBitsPackageArchive(bitsPath, outputPath)
+		})
+
+		It("writes the archive to the output path and returns its checksum", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			sourceDir, resources := fakeSharedActor.ZipDirectoryResourcesArgsForCall(0)
+			Expect(sourceDir).To(Equal(bitsPath))
+			Expect(resources).To(Equal([]sharedaction.Resource{{Filename: "file-1"}}))
+
+			contents, err := ioutil.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-zip-contents"))
+			Expect(checksum).To(Equal(fmt.Sprintf("%x", sha256.Sum256([]byte("some-zip-contents")))))
+
+			Expect(fakeCloudControllerClient.CreatePackageCallCount()).To(Equal(0))
+			Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(0))
+		})
+
+		When("gathering resources fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.GatherDirectoryResourcesReturns(nil, errors.New("some-gather-error"))
+			})
+
+			It("returns the error without writing the output", func() {
+				Expect(executeErr).To(MatchError("some-gather-error"))
+				Expect(outputPath).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+
 	Describe("CreateAndUploadBitsPackageByApplicationNameAndSpace", func() {
 		var (
 			bitsPath   string
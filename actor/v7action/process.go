@@ -45,6 +45,14 @@ func (actor Actor) UpdateProcessByTypeAndApplication(processType string, appGUID
 		updatedProcess.HealthCheckEndpoint = ""
 	}
 
+	if updatedProcess.ReadinessHealthCheckType != constant.HTTP {
+		if updatedProcess.ReadinessHealthCheckEndpoint != constant.ProcessHealthCheckEndpointDefault && updatedProcess.ReadinessHealthCheckEndpoint != "" {
+			return nil, actionerror.HTTPHealthCheckInvalidError{}
+		}
+
+		updatedProcess.ReadinessHealthCheckEndpoint = ""
+	}
+
 	process, warnings, err := actor.GetProcessByTypeAndApplication(processType, appGUID)
 	allWarnings := warnings
 	if err != nil {
@@ -11,6 +11,11 @@ type ProcessHealthCheck struct {
 	HealthCheckType   constant.HealthCheckType
 	Endpoint          string
 	InvocationTimeout int64
+
+	ReadinessHealthCheckType   constant.HealthCheckType
+	ReadinessEndpoint          string
+	ReadinessInvocationTimeout int64
+	ReadinessInterval          int64
 }
 
 type ProcessHealthChecks []ProcessHealthCheck
@@ -60,6 +65,11 @@ func (actor Actor) GetApplicationProcessHealthChecksByNameAndSpace(appName strin
 			HealthCheckType:   ccv3Process.HealthCheckType,
 			Endpoint:          ccv3Process.HealthCheckEndpoint,
 			InvocationTimeout: ccv3Process.HealthCheckInvocationTimeout,
+
+			ReadinessHealthCheckType:   ccv3Process.ReadinessHealthCheckType,
+			ReadinessEndpoint:          ccv3Process.ReadinessHealthCheckEndpoint,
+			ReadinessInvocationTimeout: ccv3Process.ReadinessHealthCheckInvocationTimeout,
+			ReadinessInterval:          ccv3Process.ReadinessHealthCheckInterval,
 		}
 		processHealthChecks = append(processHealthChecks, processHealthCheck)
 	}
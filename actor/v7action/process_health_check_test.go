@@ -140,6 +140,11 @@ var _ = Describe("Process Health Check Actions", func() {
 								HealthCheckType:              "health-check-type-1",
 								HealthCheckEndpoint:          "health-check-endpoint-1",
 								HealthCheckInvocationTimeout: 42,
+
+								ReadinessHealthCheckType:              constant.HTTP,
+								ReadinessHealthCheckEndpoint:          "/ready",
+								ReadinessHealthCheckInvocationTimeout: 5,
+								ReadinessHealthCheckInterval:          10,
 							},
 							{
 								GUID:                         "process-guid-2",
@@ -162,6 +167,11 @@ var _ = Describe("Process Health Check Actions", func() {
 							HealthCheckType:   "health-check-type-1",
 							Endpoint:          "health-check-endpoint-1",
 							InvocationTimeout: 42,
+
+							ReadinessHealthCheckType:   constant.HTTP,
+							ReadinessEndpoint:          "/ready",
+							ReadinessInvocationTimeout: 5,
+							ReadinessInterval:          10,
 						},
 						{
 							ProcessType:       "process-type-2",
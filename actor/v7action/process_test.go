@@ -276,6 +276,18 @@ var _ = Describe("Process Actions", func() {
 			})
 		})
 
+		When("the user specifies an endpoint for a non-http readiness health check", func() {
+			BeforeEach(func() {
+				inputProcess.ReadinessHealthCheckType = constant.Process
+				inputProcess.ReadinessHealthCheckEndpoint = "some-http-endpoint"
+			})
+
+			It("returns an HTTPHealthCheckInvalidError", func() {
+				Expect(err).To(MatchError(actionerror.HTTPHealthCheckInvalidError{}))
+				Expect(warnings).To(BeNil())
+			})
+		})
+
 		When("getting application process by type returns an error", func() {
 			var expectedErr error
 
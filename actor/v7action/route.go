@@ -26,7 +26,7 @@ type RouteSummary struct {
 	ServiceInstanceName string
 }
 
-func (actor Actor) CreateRoute(spaceGUID, domainName, hostname, path string, port int) (resources.Route, Warnings, error) {
+func (actor Actor) CreateRoute(spaceGUID, domainName, hostname, path string, port int, options map[string]*string) (resources.Route, Warnings, error) {
 	allWarnings := Warnings{}
 	domain, warnings, err := actor.GetDomainByName(domainName)
 	allWarnings = append(allWarnings, warnings...)
@@ -41,6 +41,7 @@ func (actor Actor) CreateRoute(spaceGUID, domainName, hostname, path string, por
 		Host:       hostname,
 		Path:       path,
 		Port:       port,
+		Options:    options,
 	})
 
 	actorWarnings := Warnings(apiWarnings)
@@ -405,6 +406,14 @@ func (actor Actor) UpdateDestination(routeGUID string, destinationGUID string, p
 	warnings, err := actor.CloudControllerClient.UpdateDestination(routeGUID, destinationGUID, protocol)
 	return Warnings(warnings), err
 }
+
+// UpdateRoute sets the options of the route. An option with a nil value is
+// removed from the route.
+func (actor Actor) UpdateRoute(routeGUID string, options map[string]*string) (resources.Route, Warnings, error) {
+	route, warnings, err := actor.CloudControllerClient.UpdateRoute(routeGUID, options)
+	return route, Warnings(warnings), err
+}
+
 func (actor Actor) UnmapRoute(routeGUID string, destinationGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.UnmapRoute(routeGUID, destinationGUID)
 	return Warnings(warnings), err
@@ -33,16 +33,18 @@ var _ = Describe("Route Actions", func() {
 			hostname   string
 			path       string
 			port       int
+			options    map[string]*string
 		)
 
 		BeforeEach(func() {
 			hostname = ""
 			path = ""
 			port = 0
+			options = nil
 		})
 
 		JustBeforeEach(func() {
-			_, warnings, executeErr = actor.CreateRoute("space-guid", "domain-name", hostname, path, port)
+			_, warnings, executeErr = actor.CreateRoute("space-guid", "domain-name", hostname, path, port, options)
 		})
 
 		When("the API layer calls are successful", func() {
@@ -106,6 +108,20 @@ var _ = Describe("Route Actions", func() {
 					))
 				})
 			})
+
+			When("options are given", func() {
+				BeforeEach(func() {
+					roundRobin := "round-robin"
+					options = map[string]*string{"loadbalancing": &roundRobin}
+				})
+
+				It("creates the route with the options", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					passedRoute := fakeCloudControllerClient.CreateRouteArgsForCall(0)
+					Expect(passedRoute.Options).To(Equal(options))
+				})
+			})
 		})
 
 		When("the API call to get the domain returns an error", func() {
@@ -1701,6 +1717,53 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("UpdateRoute", func() {
+		var (
+			options map[string]*string
+
+			route      resources.Route
+			executeErr error
+			warnings   Warnings
+		)
+
+		BeforeEach(func() {
+			leastConnection := "least-connection"
+			options = map[string]*string{"loadbalancing": &leastConnection}
+		})
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.UpdateRoute("route-guid", options)
+		})
+
+		When("the cloud controller client errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateRouteReturns(resources.Route{}, ccv3.Warnings{"update-route-warning"}, errors.New("update-route-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(errors.New("update-route-error")))
+				Expect(warnings).To(ConsistOf("update-route-warning"))
+			})
+		})
+
+		When("the cloud controller client succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateRouteReturns(resources.Route{GUID: "route-guid", Options: options}, ccv3.Warnings{"update-route-warning"}, nil)
+			})
+
+			It("updates the options and returns the route and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("update-route-warning"))
+				Expect(route).To(Equal(resources.Route{GUID: "route-guid", Options: options}))
+
+				Expect(fakeCloudControllerClient.UpdateRouteCallCount()).To(Equal(1))
+				routeGUID, passedOptions := fakeCloudControllerClient.UpdateRouteArgsForCall(0)
+				Expect(routeGUID).To(Equal("route-guid"))
+				Expect(passedOptions).To(Equal(options))
+			})
+		})
+	})
+
 	Describe("UpdateDestination", func() {
 		var (
 			routeGUID       string
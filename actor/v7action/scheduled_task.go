@@ -0,0 +1,117 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/cron"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// ScheduledTask is a task from the manifest that is run on a cron schedule.
+type ScheduledTask struct {
+	SpaceGUID    string
+	AppName      string
+	Name         string
+	Command      string
+	MemoryInMB   uint64
+	DiskInMB     uint64
+	ScheduleSpec string
+	Schedule     cron.Schedule
+}
+
+// IsDue returns whether the schedule has fired since lastChecked, up to and
+// including now.
+func (task ScheduledTask) IsDue(lastChecked time.Time, now time.Time) bool {
+	next := task.Schedule.Next(lastChecked)
+	return !next.IsZero() && !next.After(now)
+}
+
+// GetScheduledTasks returns the tasks declared by the apps in the manifest,
+// in the order they appear in it.
+func (actor Actor) GetScheduledTasks(spaceGUID string, manifest manifestparser.Manifest) ([]ScheduledTask, error) {
+	var scheduledTasks []ScheduledTask
+	for _, app := range manifest.Applications {
+		names := map[string]bool{}
+		for _, task := range app.Tasks {
+			scheduledTask, err := newScheduledTask(spaceGUID, app.Name, task)
+			if err != nil {
+				return nil, err
+			}
+
+			if names[task.Name] {
+				return nil, actionerror.InvalidScheduledTaskError{AppName: app.Name, TaskName: task.Name, Reason: "the name is used by more than one task"}
+			}
+			names[task.Name] = true
+
+			scheduledTasks = append(scheduledTasks, scheduledTask)
+		}
+	}
+
+	return scheduledTasks, nil
+}
+
+// RunScheduledTask runs the task on its app.
+func (actor Actor) RunScheduledTask(task ScheduledTask) (resources.Task, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(task.AppName, task.SpaceGUID)
+	if err != nil {
+		return resources.Task{}, warnings, err
+	}
+
+	createdTask, runWarnings, err := actor.RunTask(app.GUID, resources.Task{
+		Name:       task.Name,
+		Command:    task.Command,
+		MemoryInMB: task.MemoryInMB,
+		DiskInMB:   task.DiskInMB,
+	})
+	warnings = append(warnings, runWarnings...)
+
+	return createdTask, warnings, err
+}
+
+func newScheduledTask(spaceGUID string, appName string, task manifestparser.Task) (ScheduledTask, error) {
+	invalid := func(reason string) error {
+		return actionerror.InvalidScheduledTaskError{AppName: appName, TaskName: task.Name, Reason: reason}
+	}
+
+	switch {
+	case task.Name == "":
+		return ScheduledTask{}, invalid("a name is required")
+	case task.Command == "":
+		return ScheduledTask{}, invalid("a command is required")
+	case task.Schedule == "":
+		return ScheduledTask{}, invalid("a schedule is required")
+	}
+
+	schedule, err := cron.Parse(task.Schedule)
+	if err != nil {
+		return ScheduledTask{}, invalid(err.Error())
+	}
+
+	scheduledTask := ScheduledTask{
+		SpaceGUID:    spaceGUID,
+		AppName:      appName,
+		Name:         task.Name,
+		Command:      task.Command,
+		ScheduleSpec: task.Schedule,
+		Schedule:     schedule,
+	}
+
+	if task.Memory != "" {
+		scheduledTask.MemoryInMB, err = bytefmt.ToMegabytes(task.Memory)
+		if err != nil {
+			return ScheduledTask{}, invalid("invalid memory '" + task.Memory + "'")
+		}
+	}
+
+	if task.DiskQuota != "" {
+		scheduledTask.DiskInMB, err = bytefmt.ToMegabytes(task.DiskQuota)
+		if err != nil {
+			return ScheduledTask{}, invalid("invalid disk quota '" + task.DiskQuota + "'")
+		}
+	}
+
+	return scheduledTask, nil
+}
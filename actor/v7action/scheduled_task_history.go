@@ -0,0 +1,127 @@
+package v7action
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ScheduledTaskHistoryLimit is the number of runs kept in the history of each
+// scheduled task.
+const ScheduledTaskHistoryLimit = 20
+
+// ScheduledTaskRun records a single run of a scheduled task.
+type ScheduledTaskRun struct {
+	Time       time.Time `json:"time"`
+	TaskGUID   string    `json:"task_guid,omitempty"`
+	SequenceID int64     `json:"sequence_id,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+type scheduledTaskHistoryEntry struct {
+	LastChecked time.Time          `json:"last_checked"`
+	Runs        []ScheduledTaskRun `json:"runs,omitempty"`
+}
+
+// ScheduledTaskHistory records when each scheduled task was last checked and
+// the runs it has made, so that a schedule can be evaluated across separate
+// invocations of the CLI.
+type ScheduledTaskHistory struct {
+	path    string
+	entries map[string]scheduledTaskHistoryEntry
+}
+
+// NewScheduledTaskHistory loads the history stored at path. A missing file
+// results in an empty history.
+func NewScheduledTaskHistory(path string) (*ScheduledTaskHistory, error) {
+	history := &ScheduledTaskHistory{
+		path:    path,
+		entries: map[string]scheduledTaskHistoryEntry{},
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return history, nil
+		}
+		return nil, err
+	}
+
+	err = json.Unmarshal(raw, &history.entries)
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// LastChecked returns when the task was last checked, and false if it never
+// has been.
+func (history *ScheduledTaskHistory) LastChecked(task ScheduledTask) (time.Time, bool) {
+	entry, ok := history.entries[scheduledTaskKey(task)]
+	return entry.LastChecked, ok
+}
+
+// RecordCheck records that the task was checked at the given time.
+func (history *ScheduledTaskHistory) RecordCheck(task ScheduledTask, checkedAt time.Time) {
+	key := scheduledTaskKey(task)
+	entry := history.entries[key]
+	entry.LastChecked = checkedAt
+	history.entries[key] = entry
+}
+
+// RecordRun adds a run to the history of the task, dropping the oldest runs
+// beyond ScheduledTaskHistoryLimit.
+func (history *ScheduledTaskHistory) RecordRun(task ScheduledTask, run ScheduledTaskRun) {
+	key := scheduledTaskKey(task)
+	entry := history.entries[key]
+	entry.Runs = append(entry.Runs, run)
+	if len(entry.Runs) > ScheduledTaskHistoryLimit {
+		entry.Runs = entry.Runs[len(entry.Runs)-ScheduledTaskHistoryLimit:]
+	}
+	history.entries[key] = entry
+}
+
+// Runs returns the recorded runs of the task, oldest first.
+func (history *ScheduledTaskHistory) Runs(task ScheduledTask) []ScheduledTaskRun {
+	return history.entries[scheduledTaskKey(task)].Runs
+}
+
+// Save writes the history back to disk.
+func (history *ScheduledTaskHistory) Save() error {
+	raw, err := json.MarshalIndent(history.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(history.path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(dir, "temp-scheduled-tasks")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(raw)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), history.path)
+}
+
+// scheduledTaskKey identifies the task across runs. The space is part of the
+// key so the same manifest can be scheduled in several spaces.
+func scheduledTaskKey(task ScheduledTask) string {
+	return strings.Join([]string{task.SpaceGUID, task.AppName, task.Name}, "/")
+}
@@ -0,0 +1,91 @@
+package v7action_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTaskHistory", func() {
+	var (
+		historyDir  string
+		historyPath string
+		history     *ScheduledTaskHistory
+		task        ScheduledTask
+	)
+
+	BeforeEach(func() {
+		var err error
+		historyDir, err = ioutil.TempDir("", "scheduled-task-history")
+		Expect(err).ToNot(HaveOccurred())
+		historyPath = filepath.Join(historyDir, ".cf", "scheduled_tasks.json")
+
+		history, err = NewScheduledTaskHistory(historyPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		task = ScheduledTask{SpaceGUID: "some-space-guid", AppName: "some-app", Name: "report"}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(historyDir)).To(Succeed())
+	})
+
+	It("has not checked tasks it has no record of", func() {
+		_, ok := history.LastChecked(task)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("persists checks and runs across saves", func() {
+		checkedAt := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+		history.RecordCheck(task, checkedAt)
+		history.RecordRun(task, ScheduledTaskRun{Time: checkedAt, TaskGUID: "some-task-guid", SequenceID: 3})
+		Expect(history.Save()).To(Succeed())
+
+		reloaded, err := NewScheduledTaskHistory(historyPath)
+		Expect(err).ToNot(HaveOccurred())
+
+		lastChecked, ok := reloaded.LastChecked(task)
+		Expect(ok).To(BeTrue())
+		Expect(lastChecked.Equal(checkedAt)).To(BeTrue())
+		Expect(reloaded.Runs(task)).To(HaveLen(1))
+		Expect(reloaded.Runs(task)[0].TaskGUID).To(Equal("some-task-guid"))
+	})
+
+	It("keeps tasks in other spaces apart", func() {
+		history.RecordCheck(task, time.Now())
+
+		otherSpaceTask := task
+		otherSpaceTask.SpaceGUID = "some-other-space-guid"
+		_, ok := history.LastChecked(otherSpaceTask)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("keeps only the most recent runs", func() {
+		for i := 0; i < ScheduledTaskHistoryLimit+5; i++ {
+			history.RecordRun(task, ScheduledTaskRun{TaskGUID: fmt.Sprintf("task-guid-%d", i)})
+		}
+
+		runs := history.Runs(task)
+		Expect(runs).To(HaveLen(ScheduledTaskHistoryLimit))
+		Expect(runs[0].TaskGUID).To(Equal("task-guid-5"))
+		Expect(runs[len(runs)-1].TaskGUID).To(Equal(fmt.Sprintf("task-guid-%d", ScheduledTaskHistoryLimit+4)))
+	})
+
+	When("the history file is corrupt", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(historyPath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(historyPath, []byte("{not json"), 0600)).To(Succeed())
+		})
+
+		It("returns an error rather than losing track of the schedules", func() {
+			_, err := NewScheduledTaskHistory(historyPath)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
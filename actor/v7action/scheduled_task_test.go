@@ -0,0 +1,195 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/cron"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduled Task Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetScheduledTasks", func() {
+		var (
+			manifest       manifestparser.Manifest
+			scheduledTasks []ScheduledTask
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			manifest = manifestparser.Manifest{
+				Applications: []manifestparser.Application{
+					{
+						Name: "some-app",
+						Tasks: []manifestparser.Task{
+							{Name: "report", Command: "bin/report", Memory: "256M", DiskQuota: "1G", Schedule: "0 2 * * *"},
+							{Name: "cleanup", Command: "bin/cleanup", Schedule: "@hourly"},
+						},
+					},
+					{Name: "some-other-app"},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			scheduledTasks, executeErr = actor.GetScheduledTasks("some-space-guid", manifest)
+		})
+
+		It("returns the tasks of every app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			nightly, err := cron.Parse("0 2 * * *")
+			Expect(err).ToNot(HaveOccurred())
+			hourly, err := cron.Parse("@hourly")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(scheduledTasks).To(Equal([]ScheduledTask{
+				{
+					SpaceGUID:    "some-space-guid",
+					AppName:      "some-app",
+					Name:         "report",
+					Command:      "bin/report",
+					MemoryInMB:   256,
+					DiskInMB:     1024,
+					ScheduleSpec: "0 2 * * *",
+					Schedule:     nightly,
+				},
+				{
+					SpaceGUID:    "some-space-guid",
+					AppName:      "some-app",
+					Name:         "cleanup",
+					Command:      "bin/cleanup",
+					ScheduleSpec: "@hourly",
+					Schedule:     hourly,
+				},
+			}))
+		})
+
+		When("a task is missing its schedule", func() {
+			BeforeEach(func() {
+				manifest.Applications[0].Tasks[1].Schedule = ""
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidScheduledTaskError{AppName: "some-app", TaskName: "cleanup", Reason: "a schedule is required"}))
+			})
+		})
+
+		When("a schedule is invalid", func() {
+			BeforeEach(func() {
+				manifest.Applications[0].Tasks[1].Schedule = "every hour"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidScheduledTaskError{
+					AppName:  "some-app",
+					TaskName: "cleanup",
+					Reason:   "invalid cron schedule 'every hour': expected 5 fields, got 2",
+				}))
+			})
+		})
+
+		When("the memory is invalid", func() {
+			BeforeEach(func() {
+				manifest.Applications[0].Tasks[0].Memory = "lots"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidScheduledTaskError{AppName: "some-app", TaskName: "report", Reason: "invalid memory 'lots'"}))
+			})
+		})
+
+		When("two tasks of an app have the same name", func() {
+			BeforeEach(func() {
+				manifest.Applications[0].Tasks[1].Name = "report"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidScheduledTaskError{AppName: "some-app", TaskName: "report", Reason: "the name is used by more than one task"}))
+			})
+		})
+	})
+
+	Describe("RunScheduledTask", func() {
+		var (
+			task       resources.Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			task, warnings, executeErr = actor.RunScheduledTask(ScheduledTask{
+				SpaceGUID:  "some-space-guid",
+				AppName:    "some-app",
+				Name:       "report",
+				Command:    "bin/report",
+				MemoryInMB: 256,
+				DiskInMB:   1024,
+			})
+		})
+
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "some-app", GUID: "some-app-guid"}}, ccv3.Warnings{"get-app-warning"}, nil)
+				fakeCloudControllerClient.CreateApplicationTaskReturns(resources.Task{GUID: "some-task-guid", SequenceID: 3}, ccv3.Warnings{"create-task-warning"}, nil)
+			})
+
+			It("runs the task on the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "create-task-warning"))
+				Expect(task).To(Equal(resources.Task{GUID: "some-task-guid", SequenceID: 3}))
+
+				Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(1))
+				appGUID, taskArg := fakeCloudControllerClient.CreateApplicationTaskArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(taskArg).To(Equal(resources.Task{Name: "report", Command: "bin/report", MemoryInMB: 256, DiskInMB: 1024}))
+			})
+		})
+
+		When("getting the app fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, errors.New("get-app-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-app-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.CreateApplicationTaskCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("ScheduledTask.IsDue", func() {
+		var task ScheduledTask
+
+		BeforeEach(func() {
+			schedule, err := cron.Parse("0 * * * *")
+			Expect(err).ToNot(HaveOccurred())
+			task = ScheduledTask{Schedule: schedule}
+		})
+
+		It("is due once the schedule has fired since it was last checked", func() {
+			lastChecked := time.Date(2024, time.January, 1, 10, 30, 0, 0, time.UTC)
+			Expect(task.IsDue(lastChecked, time.Date(2024, time.January, 1, 10, 59, 0, 0, time.UTC))).To(BeFalse())
+			Expect(task.IsDue(lastChecked, time.Date(2024, time.January, 1, 11, 0, 0, 0, time.UTC))).To(BeTrue())
+			Expect(task.IsDue(lastChecked, time.Date(2024, time.January, 1, 15, 0, 0, 0, time.UTC))).To(BeTrue())
+		})
+	})
+})
@@ -102,6 +102,40 @@ func (actor Actor) DeleteServiceAppBinding(params DeleteServiceAppBindingParams)
 	}
 }
 
+// GetServiceAppBindingByServiceInstanceAndApp returns the binding between the
+// app and the service instance.
+func (actor Actor) GetServiceAppBindingByServiceInstanceAndApp(serviceInstanceName, appName, spaceGUID string) (resources.ServiceCredentialBinding, Warnings, error) {
+	var (
+		serviceInstance resources.ServiceInstance
+		app             resources.Application
+		binding         resources.ServiceCredentialBinding
+	)
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			serviceInstance, _, warnings, err = actor.getServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			app, warnings, err = actor.CloudControllerClient.GetApplicationByNameAndSpace(appName, spaceGUID)
+			return
+		},
+		func() (warnings ccv3.Warnings, err error) {
+			binding, warnings, err = actor.getServiceAppBinding(serviceInstance.GUID, app.GUID)
+			return
+		},
+	)
+
+	switch err.(type) {
+	case nil:
+		return binding, Warnings(warnings), nil
+	case ccerror.ApplicationNotFoundError:
+		return resources.ServiceCredentialBinding{}, Warnings(warnings), actionerror.ApplicationNotFoundError{Name: appName}
+	default:
+		return resources.ServiceCredentialBinding{}, Warnings(warnings), err
+	}
+}
+
 func (actor Actor) createServiceAppBinding(serviceInstanceGUID, appGUID, bindingName string, parameters types.OptionalObject) (ccv3.JobURL, ccv3.Warnings, error) {
 	jobURL, warnings, err := actor.CloudControllerClient.CreateServiceCredentialBinding(resources.ServiceCredentialBinding{
 		Type:                resources.AppBinding,
@@ -493,4 +493,94 @@ var _ = Describe("Service App Binding Action", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceAppBindingByServiceInstanceAndApp", func() {
+		const (
+			serviceInstanceName = "fake-service-instance-name"
+			serviceInstanceGUID = "fake-service-instance-guid"
+			appName             = "fake-app-name"
+			appGUID             = "fake-app-guid"
+			spaceGUID           = "fake-space-guid"
+		)
+
+		var (
+			binding        resources.ServiceCredentialBinding
+			warnings       Warnings
+			executionError error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceInstanceByNameAndSpaceReturns(
+				resources.ServiceInstance{Name: serviceInstanceName, GUID: serviceInstanceGUID},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"get instance warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(
+				resources.Application{GUID: appGUID, Name: appName},
+				ccv3.Warnings{"get app warning"},
+				nil,
+			)
+
+			fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+				[]resources.ServiceCredentialBinding{
+					{GUID: "fake-binding-guid", Name: "fake-binding-name"},
+				},
+				ccv3.Warnings{"get bindings warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			binding, warnings, executionError = actor.GetServiceAppBindingByServiceInstanceAndApp(serviceInstanceName, appName, spaceGUID)
+		})
+
+		It("returns the binding and warnings", func() {
+			Expect(executionError).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get instance warning", "get app warning", "get bindings warning"))
+			Expect(binding).To(Equal(resources.ServiceCredentialBinding{GUID: "fake-binding-guid", Name: "fake-binding-name"}))
+
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"app"}},
+				ccv3.Query{Key: ccv3.ServiceInstanceGUIDFilter, Values: []string{serviceInstanceGUID}},
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+				ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+			))
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationByNameAndSpaceReturns(
+					resources.Application{},
+					ccv3.Warnings{"get app warning"},
+					ccerror.ApplicationNotFoundError{Name: appName},
+				)
+			})
+
+			It("returns an actionerror and warnings", func() {
+				Expect(warnings).To(ConsistOf("get instance warning", "get app warning"))
+				Expect(executionError).To(MatchError(actionerror.ApplicationNotFoundError{Name: appName}))
+			})
+		})
+
+		When("the app is not bound to the service instance", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingsReturns(
+					[]resources.ServiceCredentialBinding{},
+					ccv3.Warnings{"get bindings warning"},
+					nil,
+				)
+			})
+
+			It("returns a ServiceBindingNotFoundError", func() {
+				Expect(executionError).To(MatchError(actionerror.ServiceBindingNotFoundError{
+					AppGUID:             appGUID,
+					ServiceInstanceGUID: serviceInstanceGUID,
+				}))
+			})
+		})
+	})
 })
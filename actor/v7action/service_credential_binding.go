@@ -0,0 +1,23 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// GetServiceCredentialBindingParameters returns the parameters that an app
+// binding or a service key was created with.
+func (actor Actor) GetServiceCredentialBindingParameters(bindingGUID string) (types.OptionalObject, Warnings, error) {
+	params, warnings, err := actor.CloudControllerClient.GetServiceCredentialBindingParameters(bindingGUID)
+
+	switch err.(type) {
+	case nil:
+		return types.NewOptionalObject(params), Warnings(warnings), nil
+	case ccerror.ResourceNotFoundError,
+		ccerror.ServiceCredentialBindingParametersFetchNotSupportedError:
+		return types.OptionalObject{}, Warnings(warnings), actionerror.ServiceCredentialBindingParamsFetchingNotSupportedError{}
+	default:
+		return types.OptionalObject{}, Warnings(warnings), err
+	}
+}
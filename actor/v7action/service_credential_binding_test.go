@@ -0,0 +1,97 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Credential Binding Action", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetServiceCredentialBindingParameters", func() {
+		var (
+			params     types.OptionalObject
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceCredentialBindingParametersReturns(
+				types.JSONObject{"foo": "bar"},
+				ccv3.Warnings{"parameters warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			params, warnings, executeErr = actor.GetServiceCredentialBindingParameters("some-binding-guid")
+		})
+
+		It("returns the parameters of the binding", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("parameters warning"))
+			Expect(params).To(Equal(types.NewOptionalObject(map[string]interface{}{"foo": "bar"})))
+
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingParametersCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetServiceCredentialBindingParametersArgsForCall(0)).To(Equal("some-binding-guid"))
+		})
+
+		When("the binding has no parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingParametersReturns(nil, nil, nil)
+			})
+
+			It("returns an empty object", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(params).To(Equal(types.NewOptionalObject(nil)))
+			})
+		})
+
+		When("the broker does not support fetching the parameters", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingParametersReturns(
+					nil,
+					ccv3.Warnings{"parameters warning"},
+					ccerror.ServiceCredentialBindingParametersFetchNotSupportedError{Message: "not supported"},
+				)
+			})
+
+			It("returns a ServiceCredentialBindingParamsFetchingNotSupportedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceCredentialBindingParamsFetchingNotSupportedError{}))
+				Expect(warnings).To(ConsistOf("parameters warning"))
+				Expect(params.IsSet).To(BeFalse())
+			})
+		})
+
+		When("getting the parameters fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceCredentialBindingParametersReturns(
+					nil,
+					ccv3.Warnings{"parameters warning"},
+					errors.New("boom"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("parameters warning"))
+			})
+		})
+	})
+})
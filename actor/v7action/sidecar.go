@@ -0,0 +1,48 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// GetApplicationSidecars returns the sidecars of the named application.
+func (actor Actor) GetApplicationSidecars(appName string, spaceGUID string) ([]resources.Sidecar, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	sidecars, ccWarnings, err := actor.CloudControllerClient.GetApplicationSidecars(app.GUID)
+	return sidecars, append(warnings, ccWarnings...), err
+}
+
+// CreateApplicationSidecar adds the given sidecar to the named application.
+// The sidecar only starts running once the application is restarted.
+func (actor Actor) CreateApplicationSidecar(appName string, spaceGUID string, sidecar resources.Sidecar) (resources.Sidecar, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Sidecar{}, warnings, err
+	}
+
+	createdSidecar, ccWarnings, err := actor.CloudControllerClient.CreateApplicationSidecar(app.GUID, sidecar)
+	return createdSidecar, append(warnings, ccWarnings...), err
+}
+
+// DeleteApplicationSidecar removes the named sidecar from the named
+// application. It returns a SidecarNotFoundError if the application has no
+// sidecar with that name.
+func (actor Actor) DeleteApplicationSidecar(appName string, spaceGUID string, sidecarName string) (Warnings, error) {
+	sidecars, warnings, err := actor.GetApplicationSidecars(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	for _, sidecar := range sidecars {
+		if sidecar.Name == sidecarName {
+			ccWarnings, err := actor.CloudControllerClient.DeleteSidecar(sidecar.GUID)
+			return append(warnings, ccWarnings...), err
+		}
+	}
+
+	return warnings, actionerror.SidecarNotFoundError{Name: sidecarName, AppName: appName}
+}
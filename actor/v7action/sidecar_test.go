@@ -0,0 +1,169 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+
+		fakeCloudControllerClient.GetApplicationsReturns(
+			[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+			ccv3.Warnings{"get-app-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetApplicationSidecars", func() {
+		var sidecars []resources.Sidecar
+
+		JustBeforeEach(func() {
+			sidecars, warnings, executeErr = actor.GetApplicationSidecars("some-app", "some-space-guid")
+		})
+
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(
+					[]resources.Sidecar{{GUID: "sidecar-guid", Name: "some-sidecar"}},
+					ccv3.Warnings{"get-sidecars-warning"},
+					nil,
+				)
+			})
+
+			It("returns the sidecars of the app and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+				Expect(sidecars).To(Equal([]resources.Sidecar{{GUID: "sidecar-guid", Name: "some-sidecar"}}))
+
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app"}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an application not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		var (
+			sidecar        resources.Sidecar
+			createdSidecar resources.Sidecar
+		)
+
+		BeforeEach(func() {
+			sidecar = resources.Sidecar{
+				Name:         "some-sidecar",
+				Command:      types.FilteredString{IsSet: true, Value: "run-me"},
+				ProcessTypes: []string{"web"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			createdSidecar, warnings, executeErr = actor.CreateApplicationSidecar("some-app", "some-space-guid", sidecar)
+		})
+
+		When("creating the sidecar succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationSidecarReturns(
+					resources.Sidecar{GUID: "sidecar-guid", Name: "some-sidecar"},
+					ccv3.Warnings{"create-sidecar-warning"},
+					nil,
+				)
+			})
+
+			It("creates the sidecar on the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "create-sidecar-warning"))
+				Expect(createdSidecar.GUID).To(Equal("sidecar-guid"))
+
+				Expect(fakeCloudControllerClient.CreateApplicationSidecarCallCount()).To(Equal(1))
+				appGUID, givenSidecar := fakeCloudControllerClient.CreateApplicationSidecarArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(givenSidecar).To(Equal(sidecar))
+			})
+		})
+
+		When("creating the sidecar fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateApplicationSidecarReturns(
+					resources.Sidecar{},
+					ccv3.Warnings{"create-sidecar-warning"},
+					errors.New("create-error"),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("create-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "create-sidecar-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteApplicationSidecar", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationSidecarsReturns(
+				[]resources.Sidecar{
+					{GUID: "other-sidecar-guid", Name: "other-sidecar"},
+					{GUID: "sidecar-guid", Name: "some-sidecar"},
+				},
+				ccv3.Warnings{"get-sidecars-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.DeleteSidecarReturns(ccv3.Warnings{"delete-sidecar-warning"}, nil)
+		})
+
+		When("the app has a sidecar with the given name", func() {
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.DeleteApplicationSidecar("some-app", "some-space-guid", "some-sidecar")
+			})
+
+			It("deletes that sidecar", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning", "delete-sidecar-warning"))
+				Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteSidecarArgsForCall(0)).To(Equal("sidecar-guid"))
+			})
+		})
+
+		When("the app has no sidecar with the given name", func() {
+			JustBeforeEach(func() {
+				warnings, executeErr = actor.DeleteApplicationSidecar("some-app", "some-space-guid", "missing-sidecar")
+			})
+
+			It("returns a sidecar not found error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SidecarNotFoundError{Name: "missing-sidecar", AppName: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-sidecars-warning"))
+				Expect(fakeCloudControllerClient.DeleteSidecarCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -14,6 +14,13 @@ type SSHAuthentication struct {
 	Username           string
 }
 
+// InstanceSSHAuthentication is the SSH authentication information for one
+// instance of a process.
+type InstanceSSHAuthentication struct {
+	Index uint
+	SSHAuthentication
+}
+
 func (actor Actor) GetSSHPasscode() (string, error) {
 	return actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
 }
@@ -25,37 +32,23 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 ) (SSHAuthentication, Warnings, error) {
 	var allWarnings Warnings
 
-	rootInfo, warnings, err := actor.CloudControllerClient.GetInfo()
+	endpoint, fingerprint, warnings, err := actor.getSSHEndpoint()
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return SSHAuthentication{}, allWarnings, err
 	}
 
-	endpoint := rootInfo.AppSSHEndpoint()
-	if endpoint == "" {
-		return SSHAuthentication{}, nil, actionerror.SSHEndpointNotSetError{}
-	}
-
-	fingerprint := rootInfo.AppSSHHostKeyFingerprint()
-	if fingerprint == "" {
-		return SSHAuthentication{}, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
-	}
-
-	passcode, err := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+	passcode, err := actor.GetSSHPasscode()
 	if err != nil {
 		return SSHAuthentication{}, Warnings{}, err
 	}
 
-	application, appWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	application, appWarnings, err := actor.getStartedApplication(appName, spaceGUID)
 	allWarnings = append(allWarnings, appWarnings...)
 	if err != nil {
 		return SSHAuthentication{}, allWarnings, err
 	}
 
-	if !application.Started() {
-		return SSHAuthentication{}, allWarnings, actionerror.ApplicationNotStartedError{Name: appName}
-	}
-
 	username, processWarnings, err := actor.getUsername(application, processType, processIndex)
 	allWarnings = append(allWarnings, processWarnings...)
 	if err != nil {
@@ -70,24 +63,128 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 	}, allWarnings, err
 }
 
+// GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes
+// returns the SSH authentication information for each of the given instances
+// of the process, or for every running instance when no indexes are given.
+// Passcodes are one-time and short-lived, so they are not included; get one
+// with GetSSHPasscode right before connecting to each instance.
+func (actor Actor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes(
+	appName string, spaceGUID string, processType string, processIndexes []uint,
+) ([]InstanceSSHAuthentication, Warnings, error) {
+	var allWarnings Warnings
+
+	endpoint, fingerprint, warnings, err := actor.getSSHEndpoint()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	application, appWarnings, err := actor.getStartedApplication(appName, spaceGUID)
+	allWarnings = append(allWarnings, appWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	allWarnings = append(allWarnings, processWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(processIndexes) == 0 {
+		for _, instance := range processSummary.InstanceDetails {
+			if instance.Running() {
+				processIndexes = append(processIndexes, uint(instance.Index))
+			}
+		}
+	} else {
+		for _, processIndex := range processIndexes {
+			err = checkInstanceIsRunning(processSummary, processType, processIndex)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+		}
+	}
+
+	var authentications []InstanceSSHAuthentication
+	for _, processIndex := range processIndexes {
+		authentications = append(authentications, InstanceSSHAuthentication{
+			Index: processIndex,
+			SSHAuthentication: SSHAuthentication{
+				Endpoint:           endpoint,
+				HostKeyFingerprint: fingerprint,
+				Username:           fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex),
+			},
+		})
+	}
+
+	return authentications, allWarnings, nil
+}
+
+// getSSHEndpoint returns the SSH endpoint and its host key fingerprint, and
+// an error when either of them is not set.
+func (actor Actor) getSSHEndpoint() (string, string, Warnings, error) {
+	rootInfo, warnings, err := actor.CloudControllerClient.GetInfo()
+	if err != nil {
+		return "", "", Warnings(warnings), err
+	}
+
+	endpoint := rootInfo.AppSSHEndpoint()
+	if endpoint == "" {
+		return "", "", nil, actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := rootInfo.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return "", "", nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	return endpoint, fingerprint, Warnings(warnings), nil
+}
+
+func (actor Actor) getStartedApplication(appName string, spaceGUID string) (resources.Application, Warnings, error) {
+	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return resources.Application{}, warnings, err
+	}
+
+	if !application.Started() {
+		return resources.Application{}, warnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	return application, warnings, nil
+}
+
 func (actor Actor) getUsername(application resources.Application, processType string, processIndex uint) (string, Warnings, error) {
-	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
 	if err != nil {
 		return "", processWarnings, err
 	}
 
-	var processSummary ProcessSummary
+	err = checkInstanceIsRunning(processSummary, processType, processIndex)
+	if err != nil {
+		return "", processWarnings, err
+	}
+
+	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex), processWarnings, nil
+}
+
+func (actor Actor) getProcessSummaryByType(application resources.Application, processType string) (ProcessSummary, Warnings, error) {
+	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
+	if err != nil {
+		return ProcessSummary{}, processWarnings, err
+	}
+
 	for _, appProcessSummary := range processSummaries {
 		if appProcessSummary.Type == processType {
-			processSummary = appProcessSummary
-			break
+			return appProcessSummary, processWarnings, nil
 		}
 	}
 
-	if processSummary.GUID == "" {
-		return "", processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
-	}
+	return ProcessSummary{}, processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+}
 
+func checkInstanceIsRunning(processSummary ProcessSummary, processType string, processIndex uint) error {
 	var processInstance ProcessInstance
 	for _, instance := range processSummary.InstanceDetails {
 		if uint(instance.Index) == processIndex {
@@ -97,12 +194,12 @@ func (actor Actor) getUsername(application resources.Application, processType st
 	}
 
 	if processInstance == (ProcessInstance{}) {
-		return "", processWarnings, actionerror.ProcessInstanceNotFoundError{ProcessType: processType, InstanceIndex: processIndex}
+		return actionerror.ProcessInstanceNotFoundError{ProcessType: processType, InstanceIndex: processIndex}
 	}
 
 	if !processInstance.Running() {
-		return "", processWarnings, actionerror.ProcessInstanceNotRunningError{ProcessType: processType, InstanceIndex: processIndex}
+		return actionerror.ProcessInstanceNotRunningError{ProcessType: processType, InstanceIndex: processIndex}
 	}
 
-	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex), processWarnings, nil
+	return nil
 }
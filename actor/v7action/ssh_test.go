@@ -267,4 +267,118 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes", func() {
+		var (
+			sshAuths       []InstanceSSHAuthentication
+			processIndexes []uint
+		)
+
+		BeforeEach(func() {
+			processIndexes = nil
+
+			fakeCloudControllerClient.GetInfoReturns(ccv3.Info{
+				Links: ccv3.InfoLinks{
+					AppSSH: resources.APILink{
+						HREF: "some-app-ssh-endpoint",
+						Meta: resources.APILinkMeta{HostKeyFingerprint: "some-app-ssh-fingerprint"},
+					},
+				},
+			}, ccv3.Warnings{"some-info-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]resources.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+				{State: constant.ProcessInstanceRunning, Index: 0},
+				{State: constant.ProcessInstanceDown, Index: 1},
+				{State: constant.ProcessInstanceRunning, Index: 2},
+			}, ccv3.Warnings{"some-instance-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sshAuths, warnings, executeErr = actor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes("some-app", "some-space-guid", "some-process-type", processIndexes)
+		})
+
+		When("no indexes are given", func() {
+			It("returns a configuration without a passcode for every running instance", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+				Expect(sshAuths).To(Equal([]InstanceSSHAuthentication{
+					{
+						Index: 0,
+						SSHAuthentication: SSHAuthentication{
+							Endpoint:           "some-app-ssh-endpoint",
+							HostKeyFingerprint: "some-app-ssh-fingerprint",
+							Username:           "cf:some-process-guid/0",
+						},
+					},
+					{
+						Index: 2,
+						SSHAuthentication: SSHAuthentication{
+							Endpoint:           "some-app-ssh-endpoint",
+							HostKeyFingerprint: "some-app-ssh-fingerprint",
+							Username:           "cf:some-process-guid/2",
+						},
+					},
+				}))
+
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("indexes are given", func() {
+			BeforeEach(func() {
+				processIndexes = []uint{2}
+			})
+
+			It("returns configurations for those instances only", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(sshAuths).To(HaveLen(1))
+				Expect(sshAuths[0].Index).To(Equal(uint(2)))
+				Expect(sshAuths[0].Username).To(Equal("cf:some-process-guid/2"))
+			})
+		})
+
+		When("a given instance is not running", func() {
+			BeforeEach(func() {
+				processIndexes = []uint{0, 1}
+			})
+
+			It("returns a ProcessInstanceNotRunningError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 1}))
+			})
+		})
+
+		When("a given instance does not exist", func() {
+			BeforeEach(func() {
+				processIndexes = []uint{5}
+			})
+
+			It("returns a ProcessInstanceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 5}))
+			})
+		})
+
+		When("the app is not started", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "some-app", State: constant.ApplicationStopped}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns an ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-info-warnings", "some-app-warnings"))
+			})
+		})
+
+		When("the SSH endpoint is not set", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetInfoReturns(ccv3.Info{}, ccv3.Warnings{"some-info-warnings"}, nil)
+			})
+
+			It("returns an SSHEndpointNotSetError", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHEndpointNotSetError{}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -68,6 +68,27 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return resources.Task(tasks[0]), Warnings(warnings), nil
 }
 
+// GetTaskByNameAndApplication returns the most recently created task with the
+// given name. Task names are not unique, so earlier runs are ignored.
+func (actor Actor) GetTaskByNameAndApplication(name string, appGUID string) (resources.Task, Warnings, error) {
+	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(
+		appGUID,
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{name}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+		ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+		ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+	)
+	if err != nil {
+		return resources.Task{}, Warnings(warnings), err
+	}
+
+	if len(tasks) == 0 {
+		return resources.Task{}, Warnings(warnings), actionerror.TaskNotFoundError{Name: name}
+	}
+
+	return resources.Task(tasks[0]), Warnings(warnings), nil
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (resources.Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTaskCancel(taskGUID)
 	return resources.Task(task), Warnings(warnings), err
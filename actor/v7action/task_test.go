@@ -266,6 +266,46 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("GetTaskByNameAndApplication", func() {
+		When("a task with the name exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]resources.Task{{GUID: "task-3-guid", SequenceID: 3, Name: "migrate"}},
+					ccv3.Warnings{"get-task-warning-1"},
+					nil,
+				)
+			})
+
+			It("returns the most recently created task with that name", func() {
+				task, warnings, err := actor.GetTaskByNameAndApplication("migrate", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.GUID).To(Equal("task-3-guid"))
+				Expect(warnings).To(ConsistOf("get-task-warning-1"))
+
+				appGUID, queries := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(queries).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"migrate"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{"1"}},
+					ccv3.Query{Key: ccv3.Page, Values: []string{"1"}},
+				))
+			})
+		})
+
+		When("no task with the name exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(nil, ccv3.Warnings{"get-task-warning-1"}, nil)
+			})
+
+			It("returns a TaskNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetTaskByNameAndApplication("migrate", "some-app-guid")
+				Expect(err).To(MatchError(actionerror.TaskNotFoundError{Name: "migrate"}))
+				Expect(warnings).To(ConsistOf("get-task-warning-1"))
+			})
+		})
+	})
+
 	Describe("TerminateTask", func() {
 		When("the task exists", func() {
 			var returnedTask resources.Task
@@ -9,6 +9,7 @@ import (
 
 type UAAClient interface {
 	Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) (string, string, error)
+	AuthorizationCodeURL(redirectURI string, state string, codeChallenge string, origin string) (string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
 	DeleteUser(userGuid string) (uaa.User, error)
 	GetAPIVersion() (string, error)
@@ -16,6 +17,7 @@ type UAAClient interface {
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	ListUsers(userName, origin string) ([]uaa.User, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
+	StartDeviceAuthorization() (uaa.DeviceAuthorization, error)
 	UpdatePassword(userGUID string, oldPassword string, newPassword string) error
 	ValidateClientUser(clientID string) error
 	Revoke(token string) error
@@ -0,0 +1,31 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// GetAppUsageEvents returns all app usage events created after the event with
+// afterGUID, oldest first. All events are returned when afterGUID is empty.
+func (actor Actor) GetAppUsageEvents(afterGUID string) ([]resources.AppUsageEvent, Warnings, error) {
+	events, warnings, err := actor.CloudControllerClient.GetAppUsageEvents(usageEventQueries(afterGUID)...)
+	return events, Warnings(warnings), err
+}
+
+// GetServiceUsageEvents returns all service usage events created after the
+// event with afterGUID, oldest first. All events are returned when afterGUID
+// is empty.
+func (actor Actor) GetServiceUsageEvents(afterGUID string) ([]resources.ServiceUsageEvent, Warnings, error) {
+	events, warnings, err := actor.CloudControllerClient.GetServiceUsageEvents(usageEventQueries(afterGUID)...)
+	return events, Warnings(warnings), err
+}
+
+func usageEventQueries(afterGUID string) []ccv3.Query {
+	queries := []ccv3.Query{
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+	if afterGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{afterGUID}})
+	}
+	return queries
+}
@@ -0,0 +1,103 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		afterGUID                 string
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+		afterGUID = ""
+	})
+
+	Describe("GetAppUsageEvents", func() {
+		var events []resources.AppUsageEvent
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetAppUsageEventsReturns(
+				[]resources.AppUsageEvent{{GUID: "event-guid-1"}, {GUID: "event-guid-2"}},
+				ccv3.Warnings{"usage-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetAppUsageEvents(afterGUID)
+		})
+
+		It("returns every event and the warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("usage-warning"))
+			Expect(events).To(Equal([]resources.AppUsageEvent{{GUID: "event-guid-1"}, {GUID: "event-guid-2"}}))
+			Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		When("resuming after a checkpoint", func() {
+			BeforeEach(func() {
+				afterGUID = "checkpoint-guid"
+			})
+
+			It("only asks for events after the checkpoint", func() {
+				Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{"checkpoint-guid"}},
+				))
+			})
+		})
+
+		When("getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppUsageEventsReturns(nil, ccv3.Warnings{"usage-warning"}, errors.New("usage-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("usage-error"))
+				Expect(warnings).To(ConsistOf("usage-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceUsageEvents", func() {
+		var events []resources.ServiceUsageEvent
+
+		BeforeEach(func() {
+			afterGUID = "checkpoint-guid"
+			fakeCloudControllerClient.GetServiceUsageEventsReturns(
+				[]resources.ServiceUsageEvent{{GUID: "event-guid-1"}},
+				ccv3.Warnings{"usage-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetServiceUsageEvents(afterGUID)
+		})
+
+		It("returns the events after the checkpoint", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("usage-warning"))
+			Expect(events).To(Equal([]resources.ServiceUsageEvent{{GUID: "event-guid-1"}}))
+			Expect(fakeCloudControllerClient.GetServiceUsageEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{"checkpoint-guid"}},
+			))
+		})
+	})
+})
@@ -62,6 +62,7 @@ func NewActor(v3Actor V7Actor, sharedActor SharedActor) *Actor {
 		HandleDockerUsernameOverride,
 		HandleStackOverride,
 		HandleBuildpacksOverride,
+		HandleLifecycleOverride,
 		HandleStrategyOverride,
 		HandleAppPathOverride,
 		HandleDropletPathOverride,
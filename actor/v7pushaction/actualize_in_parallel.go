@@ -0,0 +1,194 @@
+package v7pushaction
+
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	log "github.com/sirupsen/logrus"
+)
+
+type planState int
+
+const (
+	planPending planState = iota
+	planRunning
+	planSucceeded
+	planFailed
+)
+
+// ActualizeInParallel actualizes several push plans at the same time, running
+// at most maxParallel of them at once. A plan is only started once every plan
+// it depends on has been actualized successfully; a plan that depends on one
+// that failed is not started and gets a single event with a
+// PushDependencyFailedError instead. Each plan that is actualized successfully
+// ends with an ActualizeComplete event. Upload progress is not displayed, as
+// several uploads may be running at once.
+func (actor Actor) ActualizeInParallel(plans []PushPlan, maxParallel int) <-chan *PushEvent {
+	log.WithField("number of plans", len(plans)).Debug("actualizing push plans in parallel")
+	eventStream := make(chan *PushEvent)
+
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+
+	go func() {
+		defer close(eventStream)
+
+		indexByName := make(map[string]int, len(plans))
+		for i, plan := range plans {
+			indexByName[plan.Application.Name] = i
+		}
+
+		type result struct {
+			index     int
+			succeeded bool
+		}
+		results := make(chan result)
+		states := make([]planState, len(plans))
+		running := 0
+		remaining := len(plans)
+
+		for remaining > 0 {
+			for i, plan := range plans {
+				if states[i] != planPending {
+					continue
+				}
+
+				ready, failedDependency := dependencyStatus(plan, states, indexByName)
+				if failedDependency != "" {
+					states[i] = planFailed
+					remaining--
+					eventStream <- &PushEvent{
+						Plan: plan,
+						Err:  actionerror.PushDependencyFailedError{AppName: plan.Application.Name, Dependency: failedDependency},
+					}
+					continue
+				}
+
+				if !ready || running >= maxParallel {
+					continue
+				}
+
+				states[i] = planRunning
+				running++
+				go func(index int, plan PushPlan) {
+					succeeded := true
+					for event := range actor.Actualize(plan, silentProgressBar{}) {
+						if event.Err != nil {
+							succeeded = false
+						}
+						plan = event.Plan
+						eventStream <- event
+					}
+					if succeeded {
+						eventStream <- &PushEvent{Plan: plan, Event: ActualizeComplete}
+					}
+					results <- result{index: index, succeeded: succeeded}
+				}(i, plan)
+			}
+
+			if running == 0 {
+				// Only reachable when the remaining plans depend on each other,
+				// which manifest validation should have already prevented.
+				for i, plan := range plans {
+					if states[i] == planPending {
+						states[i] = planFailed
+						eventStream <- &PushEvent{
+							Plan: plan,
+							Err:  actionerror.PushDependencyFailedError{AppName: plan.Application.Name, Dependency: firstDependency(plan, indexByName)},
+						}
+					}
+				}
+				return
+			}
+
+			finished := <-results
+			running--
+			remaining--
+			if finished.succeeded {
+				states[finished.index] = planSucceeded
+			} else {
+				states[finished.index] = planFailed
+			}
+		}
+
+		log.Debug("completed parallel apply")
+	}()
+
+	return eventStream
+}
+
+// OrderPushPlansByDependencies returns the push plans in an order where every
+// plan comes after the plans it depends on. Plans keep their original order
+// wherever their dependencies allow it.
+func OrderPushPlansByDependencies(plans []PushPlan) []PushPlan {
+	indexByName := make(map[string]int, len(plans))
+	for i, plan := range plans {
+		indexByName[plan.Application.Name] = i
+	}
+
+	states := make([]planState, len(plans))
+	ordered := make([]PushPlan, 0, len(plans))
+	for len(ordered) < len(plans) {
+		placed := false
+		for i, plan := range plans {
+			if states[i] != planPending {
+				continue
+			}
+			if ready, _ := dependencyStatus(plan, states, indexByName); ready {
+				states[i] = planSucceeded
+				ordered = append(ordered, plan)
+				placed = true
+				break
+			}
+		}
+
+		if !placed {
+			// The remaining plans depend on each other, keep their order.
+			for i, plan := range plans {
+				if states[i] == planPending {
+					ordered = append(ordered, plan)
+				}
+			}
+			break
+		}
+	}
+
+	return ordered
+}
+
+// dependencyStatus returns whether every dependency of the plan has
+// succeeded, or the name of a dependency that failed. Dependencies on apps
+// that are not being pushed are ignored.
+func dependencyStatus(plan PushPlan, states []planState, indexByName map[string]int) (bool, string) {
+	ready := true
+	for _, dependency := range plan.DependsOn {
+		index, ok := indexByName[dependency]
+		if !ok {
+			continue
+		}
+		switch states[index] {
+		case planFailed:
+			return false, dependency
+		case planPending, planRunning:
+			ready = false
+		}
+	}
+	return ready, ""
+}
+
+func firstDependency(plan PushPlan, indexByName map[string]int) string {
+	for _, dependency := range plan.DependsOn {
+		if _, ok := indexByName[dependency]; ok {
+			return dependency
+		}
+	}
+	return ""
+}
+
+// silentProgressBar does not display upload progress.
+type silentProgressBar struct{}
+
+func (silentProgressBar) NewProgressBarWrapper(reader io.Reader, _ int64) io.Reader {
+	return reader
+}
@@ -0,0 +1,306 @@
+package v7pushaction_test
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/util"
+	"code.cloudfoundry.org/cli/resources"
+	"github.com/SermoDigital/jose/crypto"
+	"github.com/SermoDigital/jose/jws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+func namedPlan(name string, dependsOn ...string) PushPlan {
+	return PushPlan{
+		Application: resources.Application{Name: name},
+		DependsOn:   dependsOn,
+	}
+}
+
+func planNames(plans []PushPlan) []string {
+	var names []string
+	for _, plan := range plans {
+		names = append(names, plan.Application.Name)
+	}
+	return names
+}
+
+var _ = Describe("ActualizeInParallel", func() {
+	var (
+		actor       *Actor
+		plans       []PushPlan
+		maxParallel int
+
+		lock          sync.Mutex
+		started       []string
+		running       int32
+		maxRunning    int32
+		failingApps   map[string]bool
+		events        []*PushEvent
+		releaseStarts chan struct{}
+	)
+
+	BeforeEach(func() {
+		actor, _, _ = getTestPushActor()
+		maxParallel = 2
+		started = nil
+		running = 0
+		maxRunning = 0
+		failingApps = map[string]bool{}
+		events = nil
+		releaseStarts = nil
+
+		actor.ChangeApplicationSequence = func(PushPlan) []ChangeApplicationFunc {
+			return []ChangeApplicationFunc{
+				func(plan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+					lock.Lock()
+					started = append(started, plan.Application.Name)
+					lock.Unlock()
+
+					current := atomic.AddInt32(&running, 1)
+					for {
+						seen := atomic.LoadInt32(&maxRunning)
+						if current <= seen || atomic.CompareAndSwapInt32(&maxRunning, seen, current) {
+							break
+						}
+					}
+					if releaseStarts != nil {
+						<-releaseStarts
+					}
+					defer atomic.AddInt32(&running, -1)
+
+					eventStream <- &PushEvent{Plan: plan, Event: StartingStaging}
+					if failingApps[plan.Application.Name] {
+						return plan, Warnings{"some-warning"}, errors.New("some-push-error")
+					}
+					plan.Application.GUID = plan.Application.Name + "-guid"
+					return plan, nil, nil
+				},
+			}
+		}
+	})
+
+	JustBeforeEach(func() {
+		for event := range actor.ActualizeInParallel(plans, maxParallel) {
+			events = append(events, event)
+		}
+	})
+
+	eventsFor := func(name string) []*PushEvent {
+		var appEvents []*PushEvent
+		for _, event := range events {
+			if event.Plan.Application.Name == name {
+				appEvents = append(appEvents, event)
+			}
+		}
+		return appEvents
+	}
+
+	When("the apps are independent", func() {
+		BeforeEach(func() {
+			plans = []PushPlan{namedPlan("app-1"), namedPlan("app-2"), namedPlan("app-3"), namedPlan("app-4")}
+			releaseStarts = make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				Eventually(func() int32 { return atomic.LoadInt32(&running) }).Should(Equal(int32(2)))
+				close(releaseStarts)
+			}()
+		})
+
+		It("actualizes up to the parallel limit at the same time", func() {
+			Expect(atomic.LoadInt32(&maxRunning)).To(Equal(int32(2)))
+			Expect(started).To(ConsistOf("app-1", "app-2", "app-3", "app-4"))
+		})
+
+		It("ends each app's events with ActualizeComplete carrying the final plan", func() {
+			for _, name := range []string{"app-1", "app-2", "app-3", "app-4"} {
+				appEvents := eventsFor(name)
+				Expect(appEvents).To(HaveLen(3))
+				Expect(appEvents[0].Event).To(Equal(StartingStaging))
+				Expect(appEvents[2].Event).To(Equal(ActualizeComplete))
+				Expect(appEvents[2].Plan.Application.GUID).To(Equal(name + "-guid"))
+			}
+		})
+	})
+
+	When("an app depends on another", func() {
+		BeforeEach(func() {
+			maxParallel = 3
+			plans = []PushPlan{namedPlan("frontend", "backend"), namedPlan("backend", "database"), namedPlan("database"), namedPlan("worker", "not-being-pushed")}
+		})
+
+		It("starts the app only after its dependencies complete", func() {
+			Expect(started).To(HaveLen(4))
+			Expect(started).To(ContainElement("worker"))
+
+			var ordered []string
+			for _, name := range started {
+				if name != "worker" {
+					ordered = append(ordered, name)
+				}
+			}
+			Expect(ordered).To(Equal([]string{"database", "backend", "frontend"}))
+		})
+	})
+
+	When("an app fails", func() {
+		BeforeEach(func() {
+			failingApps["backend"] = true
+			plans = []PushPlan{namedPlan("frontend", "backend"), namedPlan("backend"), namedPlan("worker")}
+		})
+
+		It("reports the error and skips the apps that depend on it", func() {
+			Expect(started).To(ConsistOf("backend", "worker"))
+
+			backendEvents := eventsFor("backend")
+			Expect(backendEvents[len(backendEvents)-1].Err).To(MatchError("some-push-error"))
+			Expect(backendEvents[len(backendEvents)-1].Warnings).To(ConsistOf("some-warning"))
+
+			Expect(eventsFor("frontend")).To(ConsistOf(&PushEvent{
+				Plan: plans[0],
+				Err:  actionerror.PushDependencyFailedError{AppName: "frontend", Dependency: "backend"},
+			}))
+
+			workerEvents := eventsFor("worker")
+			Expect(workerEvents[len(workerEvents)-1].Event).To(Equal(ActualizeComplete))
+		})
+	})
+})
+
+var _ = Describe("OrderPushPlansByDependencies", func() {
+	It("keeps the order of independent plans", func() {
+		plans := []PushPlan{namedPlan("app-1"), namedPlan("app-2"), namedPlan("app-3")}
+		Expect(planNames(OrderPushPlansByDependencies(plans))).To(Equal([]string{"app-1", "app-2", "app-3"}))
+	})
+
+	It("moves plans after the plans they depend on", func() {
+		plans := []PushPlan{namedPlan("frontend", "backend"), namedPlan("worker"), namedPlan("backend", "database"), namedPlan("database")}
+		Expect(planNames(OrderPushPlansByDependencies(plans))).To(Equal([]string{"worker", "database", "backend", "frontend"}))
+	})
+
+	It("ignores dependencies on apps that are not being pushed", func() {
+		plans := []PushPlan{namedPlan("frontend", "not-being-pushed"), namedPlan("backend")}
+		Expect(planNames(OrderPushPlansByDependencies(plans))).To(Equal([]string{"frontend", "backend"}))
+	})
+})
+
+var _ = Describe("ActualizeInParallel with a Cloud Controller client", func() {
+	var (
+		ccServer      *ghttp.Server
+		uaaServer     *ghttp.Server
+		tokenCache    *util.InMemoryCache
+		actor         *Actor
+		plans         []PushPlan
+		events        []*PushEvent
+		newTokenValue string
+	)
+
+	buildToken := func(expiration time.Time) string {
+		claims := jws.Claims{}
+		claims.SetExpiration(expiration)
+		token, err := jws.NewJWT(claims, crypto.Unsecured).Serialize(nil)
+		Expect(err).NotTo(HaveOccurred())
+		return string(token)
+	}
+
+	BeforeEach(func() {
+		ccServer = ghttp.NewServer()
+		uaaServer = ghttp.NewServer()
+
+		tokenCache = util.NewInMemoryTokenCache()
+		tokenCache.SetAccessToken("bearer " + buildToken(time.Now().Add(-time.Hour)))
+		tokenCache.SetRefreshToken("some-refresh-token")
+		newTokenValue = buildToken(time.Now().Add(time.Hour))
+
+		uaaServer.RouteToHandler(http.MethodPost, "/oauth/token", ghttp.CombineHandlers(
+			ghttp.VerifyForm(url.Values{"refresh_token": {"some-refresh-token"}}),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{
+				"access_token":  newTokenValue,
+				"refresh_token": "new-refresh-token",
+				"token_type":    "bearer",
+			}),
+		))
+		for _, name := range []string{"app-1", "app-2"} {
+			ccServer.RouteToHandler(http.MethodPatch, "/v3/apps/"+name+"-guid", ghttp.CombineHandlers(
+				ghttp.VerifyHeaderKV("Authorization", "bearer "+newTokenValue),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, map[string]string{"guid": name + "-guid", "name": name}),
+			))
+		}
+
+		uaaClient := uaa.NewClient(new(uaafakes.FakeConfig))
+		Expect(uaaClient.SetupResources(uaaServer.URL(), uaaServer.URL())).To(Succeed())
+
+		ccClient := ccv3.NewClient(ccv3.Config{
+			AppName:    "cf",
+			AppVersion: "0.0.0",
+			Wrappers: []ccv3.ConnectionWrapper{
+				ccWrapper.NewUAAAuthentication(uaaClient, tokenCache),
+				ccWrapper.NewRetryRequest(2),
+			},
+		})
+		ccClient.TargetCF(ccv3.TargetSettings{URL: ccServer.URL()})
+
+		v7Actor := v7action.NewActor(ccClient, new(v7actionfakes.FakeConfig), nil, uaaClient, nil, nil)
+		actor = NewActor(v7Actor, new(v7pushactionfakes.FakeSharedActor))
+		actor.ChangeApplicationSequence = func(PushPlan) []ChangeApplicationFunc {
+			return []ChangeApplicationFunc{
+				func(plan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+					app, warnings, err := actor.V7Actor.UpdateApplication(plan.Application)
+					plan.Application = app
+					return plan, Warnings(warnings), err
+				},
+			}
+		}
+
+		plans = []PushPlan{
+			{Application: resources.Application{Name: "app-1", GUID: "app-1-guid"}},
+			{Application: resources.Application{Name: "app-2", GUID: "app-2-guid"}},
+		}
+		events = nil
+	})
+
+	AfterEach(func() {
+		ccServer.Close()
+		uaaServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		for event := range actor.ActualizeInParallel(plans, 2) {
+			events = append(events, event)
+		}
+	})
+
+	When("the access token has expired", func() {
+		It("refreshes it once and pushes both apps with the new token", func() {
+			var completed []string
+			for _, event := range events {
+				Expect(event.Err).NotTo(HaveOccurred())
+				if event.Event == ActualizeComplete {
+					completed = append(completed, event.Plan.Application.Name)
+				}
+			}
+			Expect(completed).To(ConsistOf("app-1", "app-2"))
+
+			Expect(uaaServer.ReceivedRequests()).To(HaveLen(1))
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(2))
+			Expect(tokenCache.AccessToken()).To(Equal("bearer " + newTokenValue))
+			Expect(tokenCache.RefreshToken()).To(Equal("new-refresh-token"))
+		})
+	})
+})
@@ -0,0 +1,33 @@
+package v7pushaction
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+)
+
+// GetBitsPackageChecksum returns the SHA256 checksum of the archive holding
+// all the resources of the push plan, so that it can be compared to the
+// checksum of a package the app already has.
+func (actor Actor) GetBitsPackageChecksum(pushPlan PushPlan) (string, error) {
+	archivePath, err := actor.CreateAndReturnArchivePath(pushPlan, pushPlan.AllResources)
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(archivePath)
+
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer archive.Close()
+
+	sum := sha256.New()
+	_, err = io.Copy(sum, archive)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sum.Sum(nil)), nil
+}
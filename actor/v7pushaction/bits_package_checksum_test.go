@@ -0,0 +1,88 @@
+package v7pushaction_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetBitsPackageChecksum", func() {
+	var (
+		actor           *Actor
+		fakeSharedActor *v7pushactionfakes.FakeSharedActor
+
+		pushPlan    PushPlan
+		archivePath string
+
+		checksum   string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, _, fakeSharedActor = getTestPushActor()
+
+		tempDir, err := ioutil.TempDir("", "bits-package-checksum")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, tempDir)
+
+		archivePath = filepath.Join(tempDir, "archive.zip")
+		Expect(ioutil.WriteFile(archivePath, []byte("some-archive"), 0600)).To(Succeed())
+		fakeSharedActor.ZipDirectoryResourcesReturns(archivePath, nil)
+		fakeSharedActor.ZipArchiveResourcesReturns(archivePath, nil)
+
+		pushPlan = PushPlan{
+			BitsPath: "/some/bits/path",
+			AllResources: []sharedaction.V3Resource{
+				{FilePath: "some-file", Checksum: ccv3.Checksum{Value: "some-sha1"}, SizeInBytes: 6},
+				{FilePath: "some-other-file", Checksum: ccv3.Checksum{Value: "some-other-sha1"}, SizeInBytes: 8},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		checksum, executeErr = actor.GetBitsPackageChecksum(pushPlan)
+	})
+
+	It("zips all the resources and returns the SHA256 checksum of the archive", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(checksum).To(Equal("4bd51f0fb046e4b390a4f4e8a85880b287dd1265c8d5e4534399ae10258ccbc4"))
+
+		Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(1))
+		bitsPath, resources := fakeSharedActor.ZipDirectoryResourcesArgsForCall(0)
+		Expect(bitsPath).To(Equal("/some/bits/path"))
+		Expect(resources).To(HaveLen(2))
+
+		_, err := os.Stat(archivePath)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	When("the bits are an archive", func() {
+		BeforeEach(func() {
+			pushPlan.Archive = true
+		})
+
+		It("zips the resources from the archive", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeSharedActor.ZipArchiveResourcesCallCount()).To(Equal(1))
+			Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("zipping the resources fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.ZipDirectoryResourcesReturns("", errors.New("zip-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("zip-error"))
+		})
+	})
+})
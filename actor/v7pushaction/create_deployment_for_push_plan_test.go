@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -35,6 +36,8 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 			Application: resources.Application{
 				GUID: "some-app-guid",
 			},
+			DropletGUID: "some-droplet-guid",
+			Strategy:    constant.DeploymentStrategyCanary,
 		}
 	})
 
@@ -47,7 +50,7 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 	Describe("creating deployment", func() {
 		When("creating the deployment is successful", func() {
 			BeforeEach(func() {
-				fakeV7Actor.PollStartForRollingCalls(func(_ resources.Application, _ string, _ bool, handleInstanceDetails func(string)) (warnings v7action.Warnings, err error) {
+				fakeV7Actor.PollStartForDeploymentCalls(func(_ resources.Application, _ string, _ bool, handleInstanceDetails func(string)) (warnings v7action.Warnings, err error) {
 					handleInstanceDetails("Instances starting...")
 					return nil, nil
 				})
@@ -59,9 +62,17 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 				)
 			})
 
+			It("creates the deployment with the plan's strategy", func() {
+				Expect(fakeV7Actor.CreateDeploymentByApplicationAndDropletCallCount()).To(Equal(1))
+				givenAppGUID, givenDropletGUID, givenStrategy := fakeV7Actor.CreateDeploymentByApplicationAndDropletArgsForCall(0)
+				Expect(givenAppGUID).To(Equal("some-app-guid"))
+				Expect(givenDropletGUID).To(Equal("some-droplet-guid"))
+				Expect(givenStrategy).To(Equal(constant.DeploymentStrategyCanary))
+			})
+
 			It("waits for the app to start", func() {
-				Expect(fakeV7Actor.PollStartForRollingCallCount()).To(Equal(1))
-				givenApp, givenDeploymentGUID, noWait, _ := fakeV7Actor.PollStartForRollingArgsForCall(0)
+				Expect(fakeV7Actor.PollStartForDeploymentCallCount()).To(Equal(1))
+				givenApp, givenDeploymentGUID, noWait, _ := fakeV7Actor.PollStartForDeploymentArgsForCall(0)
 				Expect(givenApp).To(Equal(resources.Application{GUID: "some-app-guid"}))
 				Expect(givenDeploymentGUID).To(Equal("some-deployment-guid"))
 				Expect(noWait).To(Equal(false))
@@ -93,7 +104,7 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 			})
 
 			It("does not wait for the app to start", func() {
-				Expect(fakeV7Actor.PollStartForRollingCallCount()).To(Equal(0))
+				Expect(fakeV7Actor.PollStartForDeploymentCallCount()).To(Equal(0))
 			})
 
 			It("returns errors and warnings", func() {
@@ -111,7 +122,7 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 	Describe("waiting for app to start", func() {
 		When("the the polling is successful", func() {
 			BeforeEach(func() {
-				fakeV7Actor.PollStartForRollingReturns(v7action.Warnings{"some-poll-start-warning"}, nil)
+				fakeV7Actor.PollStartForDeploymentReturns(v7action.Warnings{"some-poll-start-warning"}, nil)
 			})
 
 			It("returns warnings and unchanged push plan", func() {
@@ -129,7 +140,7 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 
 			BeforeEach(func() {
 				someErr = errors.New("app failed to start")
-				fakeV7Actor.PollStartForRollingReturns(v7action.Warnings{"some-poll-start-warning"}, someErr)
+				fakeV7Actor.PollStartForDeploymentReturns(v7action.Warnings{"some-poll-start-warning"}, someErr)
 			})
 
 			It("returns errors and warnings", func() {
@@ -148,7 +159,7 @@ var _ = Describe("CreateDeploymentForApplication()", func() {
 			})
 
 			It("passes in the noWait flag", func() {
-				_, _, noWait, _ := fakeV7Actor.PollStartForRollingArgsForCall(0)
+				_, _, noWait, _ := fakeV7Actor.PollStartForDeploymentArgsForCall(0)
 				Expect(noWait).To(Equal(true))
 			})
 		})
@@ -28,6 +28,7 @@ func (actor Actor) CreatePushPlans(
 			OrgGUID:     orgGUID,
 			SpaceGUID:   spaceGUID,
 			Application: nameToApp[manifestApplication.Name],
+			DependsOn:   manifestApplication.DependsOn,
 			BitsPath:    manifestApplication.Path,
 		}
 
@@ -10,6 +10,7 @@ type PushEvent struct {
 type Event string
 
 const (
+	ActualizeComplete               Event = "actualize complete"
 	ApplyManifest                   Event = "Applying manifest"
 	ApplyManifestComplete           Event = "Applying manifest Complete"
 	CreatingArchive                 Event = "creating archive"
@@ -27,6 +27,14 @@ func HandleDockerImageOverride(manifest manifestparser.Manifest, overrides FlagO
 			}
 		}
 
+		if app.Lifecycle != "" {
+			return manifest, translatableerror.ArgumentManifestMismatchError{
+				Arg:              "--docker-image, -o",
+				ManifestProperty: "lifecycle",
+				ManifestValue:    string(app.Lifecycle),
+			}
+		}
+
 		if app.Docker == nil {
 			emptyDockerInfo := manifestparser.Docker{}
 			app.Docker = &emptyDockerInfo
@@ -1,6 +1,7 @@
 package v7pushaction_test
 
 import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 
@@ -112,6 +113,27 @@ var _ = Describe("HandleDockerImageOverride", func() {
 			})
 		})
 
+		When("a lifecycle is set in the manifest", func() {
+			BeforeEach(func() {
+				overrides.DockerImage = "some-docker-image"
+
+				originalManifest.Applications = []manifestparser.Application{
+					{
+						Name:      "some-app",
+						Lifecycle: constant.AppLifecycleTypeCNB,
+					},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentManifestMismatchError{
+					Arg:              "--docker-image, -o",
+					ManifestProperty: "lifecycle",
+					ManifestValue:    "cnb",
+				}))
+			})
+		})
+
 		When("there are multiple apps in the manifest", func() {
 			BeforeEach(func() {
 				overrides.DockerImage = "some-docker-image"
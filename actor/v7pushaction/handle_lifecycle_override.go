@@ -0,0 +1,47 @@
+package v7pushaction
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+func HandleLifecycleOverride(manifest manifestparser.Manifest, overrides FlagOverrides) (manifestparser.Manifest, error) {
+	if overrides.Lifecycle != "" {
+		if manifest.ContainsMultipleApps() {
+			return manifest, translatableerror.CommandLineArgsWithMultipleAppsError{}
+		}
+
+		app := manifest.GetFirstApp()
+
+		if app.Docker != nil {
+			return manifest, translatableerror.ArgumentManifestMismatchError{
+				Arg:              "--lifecycle",
+				ManifestProperty: "docker",
+			}
+		}
+
+		app.Lifecycle = overrides.Lifecycle
+	}
+
+	// Buildpacks given as OCI images (docker://...) can only be staged by the
+	// cnb lifecycle, so catch them before the manifest is applied
+	for _, app := range manifest.Applications {
+		if app.Lifecycle != constant.AppLifecycleTypeBuildpack {
+			continue
+		}
+
+		for _, buildpack := range app.Buildpacks() {
+			if strings.HasPrefix(buildpack, "docker://") {
+				return manifest, translatableerror.OCIBuildpackRequiresCNBLifecycleError{
+					AppName:   app.Name,
+					Buildpack: buildpack,
+				}
+			}
+		}
+	}
+
+	return manifest, nil
+}
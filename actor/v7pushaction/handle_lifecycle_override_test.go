@@ -0,0 +1,133 @@
+package v7pushaction_test
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HandleLifecycleOverride", func() {
+	var (
+		originalManifest    manifestparser.Manifest
+		transformedManifest manifestparser.Manifest
+		overrides           FlagOverrides
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		originalManifest = manifestparser.Manifest{}
+		overrides = FlagOverrides{}
+	})
+
+	JustBeforeEach(func() {
+		transformedManifest, executeErr = HandleLifecycleOverride(originalManifest, overrides)
+	})
+
+	When("lifecycle flag is not set", func() {
+		BeforeEach(func() {
+			originalManifest.Applications = []manifestparser.Application{
+				{Name: "app-1", Lifecycle: constant.AppLifecycleTypeCNB},
+			}
+		})
+
+		It("does not change the manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(transformedManifest).To(Equal(originalManifest))
+		})
+	})
+
+	When("lifecycle flag is set", func() {
+		BeforeEach(func() {
+			overrides.Lifecycle = constant.AppLifecycleTypeCNB
+		})
+
+		When("there is a single app in the manifest", func() {
+			BeforeEach(func() {
+				originalManifest.Applications = []manifestparser.Application{
+					{Name: "app-1", Lifecycle: constant.AppLifecycleTypeBuildpack},
+				}
+			})
+
+			It("overrides the lifecycle of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(transformedManifest.Applications).To(ConsistOf(
+					manifestparser.Application{Name: "app-1", Lifecycle: constant.AppLifecycleTypeCNB},
+				))
+			})
+		})
+
+		When("there are multiple apps in the manifest", func() {
+			BeforeEach(func() {
+				originalManifest.Applications = []manifestparser.Application{
+					{Name: "app-1"},
+					{Name: "app-2"},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.CommandLineArgsWithMultipleAppsError{}))
+			})
+		})
+
+		When("docker is set in the manifest", func() {
+			BeforeEach(func() {
+				originalManifest.Applications = []manifestparser.Application{
+					{
+						Name:   "app-1",
+						Docker: &manifestparser.Docker{Image: "some-image"},
+					},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentManifestMismatchError{
+					Arg:              "--lifecycle",
+					ManifestProperty: "docker",
+				}))
+			})
+		})
+	})
+
+	When("an app references a buildpack by OCI image", func() {
+		BeforeEach(func() {
+			app := manifestparser.Application{Name: "app-1"}
+			app.SetBuildpacks([]string{"docker://docker.io/paketobuildpacks/nodejs"})
+			originalManifest.Applications = []manifestparser.Application{app}
+		})
+
+		When("the app uses the cnb lifecycle", func() {
+			BeforeEach(func() {
+				overrides.Lifecycle = constant.AppLifecycleTypeCNB
+			})
+
+			It("allows the buildpack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(transformedManifest.Applications[0].Lifecycle).To(Equal(constant.AppLifecycleTypeCNB))
+			})
+		})
+
+		When("the app does not set a lifecycle", func() {
+			It("leaves it to the Cloud Controller", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+			})
+		})
+
+		When("the app uses the buildpack lifecycle", func() {
+			BeforeEach(func() {
+				originalManifest.Applications[0].Lifecycle = constant.AppLifecycleTypeBuildpack
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.OCIBuildpackRequiresCNBLifecycleError{
+					AppName:   "app-1",
+					Buildpack: "docker://docker.io/paketobuildpacks/nodejs",
+				}))
+			})
+		})
+	})
+})
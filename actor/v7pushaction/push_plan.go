@@ -16,6 +16,7 @@ type PushPlan struct {
 	OrgGUID   string
 
 	Application resources.Application
+	DependsOn   []string
 
 	NoStart             bool
 	NoWait              bool
@@ -46,6 +47,7 @@ type FlagOverrides struct {
 	HealthCheckTimeout  int64
 	HealthCheckType     constant.HealthCheckType
 	Instances           types.NullInt
+	Lifecycle           constant.AppLifecycleType
 	Memory              string
 	NoStart             bool
 	NoWait              bool
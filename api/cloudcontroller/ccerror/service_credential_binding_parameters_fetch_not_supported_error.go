@@ -0,0 +1,12 @@
+package ccerror
+
+// ServiceCredentialBindingParametersFetchNotSupportedError is returned when
+// the service broker does not support fetching the parameters of a binding or
+// a key.
+type ServiceCredentialBindingParametersFetchNotSupportedError struct {
+	Message string
+}
+
+func (e ServiceCredentialBindingParametersFetchNotSupportedError) Error() string {
+	return e.Message
+}
@@ -117,6 +117,39 @@ var _ = Describe("Application", func() {
 				})
 			})
 
+			When("lifecycle type cnb is provided", func() {
+				BeforeEach(func() {
+					app.LifecycleType = constant.AppLifecycleTypeCNB
+				})
+
+				When("no buildpacks are provided", func() {
+					It("sets lifecycle type to cnb with empty data", func() {
+						Expect(string(appBytes)).To(MatchJSON(`{"lifecycle":{"data":{},"type":"cnb"}}`))
+					})
+				})
+
+				When("default buildpack is provided", func() {
+					BeforeEach(func() {
+						app.LifecycleBuildpacks = []string{"default"}
+						app.StackName = "cflinuxfs4"
+					})
+
+					It("sets the lifecycle buildpacks to be empty in the JSON", func() {
+						Expect(string(appBytes)).To(MatchJSON(`{"lifecycle":{"data":{"buildpacks":null,"stack":"cflinuxfs4"},"type":"cnb"}}`))
+					})
+				})
+
+				When("buildpacks are provided", func() {
+					BeforeEach(func() {
+						app.LifecycleBuildpacks = []string{"docker://docker.io/paketobuildpacks/nodejs", "some-buildpack"}
+					})
+
+					It("sets them in the JSON", func() {
+						Expect(string(appBytes)).To(MatchJSON(`{"lifecycle":{"data":{"buildpacks":["docker://docker.io/paketobuildpacks/nodejs","some-buildpack"]},"type":"cnb"}}`))
+					})
+				})
+			})
+
 			When("metadata is provided", func() {
 				BeforeEach(func() {
 					app = resources.Application{
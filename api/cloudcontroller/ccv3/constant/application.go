@@ -9,6 +9,9 @@ const (
 	// AppLifecycleTypeDocker will pull a docker image from a registry to run an
 	// app.
 	AppLifecycleTypeDocker AppLifecycleType = "docker"
+	// AppLifecycleTypeCNB will use Cloud Native Buildpacks to build a droplet
+	// and run it on a rootfs.
+	AppLifecycleTypeCNB AppLifecycleType = "cnb"
 )
 
 // ApplicationAction represents the action being taken on an application
@@ -39,6 +39,14 @@ const (
 	// DeploymentStatusReasonSuperseded means the deployment's status.value is
 	// 'SUPERSEDED'
 	DeploymentStatusReasonSuperseded DeploymentStatusReason = "SUPERSEDED"
+
+	// DeploymentStatusReasonDeploying means the deployment's status.value is
+	// 'DEPLOYING'
+	DeploymentStatusReasonDeploying DeploymentStatusReason = "DEPLOYING"
+
+	// DeploymentStatusReasonPaused means the deployment's status.value is
+	// 'PAUSED'
+	DeploymentStatusReasonPaused DeploymentStatusReason = "PAUSED"
 )
 
 // DeploymentStatusValue describes the status values a deployment can have
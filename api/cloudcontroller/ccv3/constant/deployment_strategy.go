@@ -9,4 +9,7 @@ const (
 
 	// Rolling means a new web process will be created for the app and instances will roll from the old one to the new one.
 	DeploymentStrategyRolling DeploymentStrategy = "rolling"
+
+	// Canary means a single instance of the new web process will be created and the deployment will pause until it is continued or canceled.
+	DeploymentStrategyCanary DeploymentStrategy = "canary"
 )
@@ -15,9 +15,19 @@ func (client *Client) CancelDeployment(deploymentGUID string) (Warnings, error)
 	return warnings, err
 }
 
-func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID string) (string, Warnings, error) {
+func (client *Client) ContinueDeployment(deploymentGUID string) (Warnings, error) {
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.PostApplicationDeploymentActionContinueRequest,
+		URIParams:   internal.Params{"deployment_guid": deploymentGUID},
+	})
+
+	return warnings, err
+}
+
+func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID string, strategy constant.DeploymentStrategy) (string, Warnings, error) {
 	dep := resources.Deployment{
 		DropletGUID:   dropletGUID,
+		Strategy:      strategy,
 		Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: appGUID}},
 	}
 
@@ -32,9 +42,10 @@ func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID st
 	return responseBody.GUID, warnings, err
 }
 
-func (client *Client) CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (string, Warnings, error) {
+func (client *Client) CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string, strategy constant.DeploymentStrategy) (string, Warnings, error) {
 	dep := resources.Deployment{
 		RevisionGUID:  revisionGUID,
+		Strategy:      strategy,
 		Relationships: resources.Relationships{constant.RelationshipTypeApplication: resources.Relationship{GUID: appGUID}},
 	}
 
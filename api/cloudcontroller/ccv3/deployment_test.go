@@ -165,16 +165,75 @@ var _ = Describe("Deployment", func() {
 		})
 	})
 
+	Describe("ContinueDeployment", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.ContinueDeployment("some-deployment-guid")
+		})
+
+		Context("when continuing the deployment succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusOK, "", http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("continues the deployment with no errors and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		Context("when the deployment is not paused", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Cannot continue a deployment with status: FINALIZED and reason: DEPLOYED",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments/some-deployment-guid/actions/continue"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Cannot continue a deployment with status: FINALIZED and reason: DEPLOYED",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("CreateApplicationDeployment", func() {
 		var (
 			deploymentGUID string
 			warnings       Warnings
 			executeErr     error
 			dropletGUID    string
+			strategy       constant.DeploymentStrategy
 		)
 
+		BeforeEach(func() {
+			strategy = constant.DeploymentStrategyRolling
+		})
+
 		JustBeforeEach(func() {
-			deploymentGUID, warnings, executeErr = client.CreateApplicationDeployment("some-app-guid", dropletGUID)
+			deploymentGUID, warnings, executeErr = client.CreateApplicationDeployment("some-app-guid", dropletGUID, strategy)
 		})
 
 		Context("when the application exists", func() {
@@ -199,7 +258,7 @@ var _ = Describe("Deployment", func() {
 					server.AppendHandlers(
 						CombineHandlers(
 							VerifyRequest(http.MethodPost, "/v3/deployments"),
-							VerifyJSON(`{"droplet":{ "guid":"some-droplet-guid" }, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+							VerifyJSON(`{"droplet":{ "guid":"some-droplet-guid" }, "strategy":"rolling", "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
 							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
 						),
 					)
@@ -213,13 +272,32 @@ var _ = Describe("Deployment", func() {
 
 			})
 
+			Context("when the strategy is canary", func() {
+				BeforeEach(func() {
+					strategy = constant.DeploymentStrategyCanary
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v3/deployments"),
+							VerifyJSON(`{"droplet":{ "guid":"some-droplet-guid" }, "strategy":"canary", "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+						),
+					)
+				})
+
+				It("creates the deployment with the canary strategy", func() {
+					Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("warning"))
+				})
+			})
+
 			Context("when no droplet guid is provided", func() {
 				BeforeEach(func() {
 					dropletGUID = ""
 					server.AppendHandlers(
 						CombineHandlers(
 							VerifyRequest(http.MethodPost, "/v3/deployments"),
-							VerifyJSON(`{"relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+							VerifyJSON(`{"strategy":"rolling", "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
 							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
 						),
 					)
@@ -242,7 +320,7 @@ var _ = Describe("Deployment", func() {
 		)
 
 		JustBeforeEach(func() {
-			deploymentGUID, warnings, executeErr = client.CreateApplicationDeploymentByRevision("some-app-guid", revisionGUID)
+			deploymentGUID, warnings, executeErr = client.CreateApplicationDeploymentByRevision("some-app-guid", revisionGUID, constant.DeploymentStrategyRolling)
 		})
 
 		Context("when the application exists", func() {
@@ -267,7 +345,7 @@ var _ = Describe("Deployment", func() {
 					server.AppendHandlers(
 						CombineHandlers(
 							VerifyRequest(http.MethodPost, "/v3/deployments"),
-							VerifyJSON(`{"revision":{ "guid":"some-revision-guid" }, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+							VerifyJSON(`{"revision":{ "guid":"some-revision-guid" }, "strategy":"rolling", "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
 							RespondWith(http.StatusAccepted, response, http.Header{"X-Cf-Warnings": {"warning"}}),
 						),
 					)
@@ -117,6 +117,8 @@ func handleBadRequest(errorResponse ccerror.V3Error, _ *cloudcontroller.Request)
 		return ccerror.InvalidStateError{}
 	case "This service does not support fetching service instance parameters.":
 		return ccerror.ServiceInstanceParametersFetchNotSupportedError{Message: errorResponse.Detail}
+	case "This service does not support fetching service binding parameters.":
+		return ccerror.ServiceCredentialBindingParametersFetchNotSupportedError{Message: errorResponse.Detail}
 	default:
 		return ccerror.BadRequestError{Message: errorResponse.Detail}
 	}
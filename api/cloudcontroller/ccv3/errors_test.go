@@ -127,6 +127,26 @@ var _ = Describe("Error Wrapper", func() {
 								Message: "This service does not support fetching service instance parameters."}))
 						})
 					})
+
+					When("service binding fetch params not supported", func() {
+						BeforeEach(func() {
+							serverResponse = `
+{
+   "errors": [
+      {
+         "detail": "This service does not support fetching service binding parameters.",
+         "title": "CF-ServiceFetchBindingParametersNotSupported",
+         "code": 90011
+      }
+   ]
+}`
+						})
+
+						It("returns a ServiceCredentialBindingParametersFetchNotSupportedError", func() {
+							Expect(makeError).To(MatchError(ccerror.ServiceCredentialBindingParametersFetchNotSupportedError{
+								Message: "This service does not support fetching service binding parameters."}))
+						})
+					})
 				})
 
 				Context("(401) Unauthorized", func() {
@@ -8,11 +8,17 @@ import (
 )
 
 type Event struct {
-	GUID      string
-	CreatedAt time.Time
-	Type      string
-	ActorName string
-	Data      map[string]interface{}
+	GUID             string
+	CreatedAt        time.Time
+	Type             string
+	ActorName        string
+	ActorType        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Data             map[string]interface{}
 }
 
 func (e *Event) UnmarshalJSON(data []byte) error {
@@ -22,7 +28,19 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 		Type      string    `json:"type"`
 		Actor     struct {
 			Name string `json:"name"`
+			Type string `json:"type"`
 		} `json:"actor"`
+		Target struct {
+			GUID string `json:"guid"`
+			Type string `json:"type"`
+			Name string `json:"name"`
+		} `json:"target"`
+		Space struct {
+			GUID string `json:"guid"`
+		} `json:"space"`
+		Organization struct {
+			GUID string `json:"guid"`
+		} `json:"organization"`
 		Data map[string]interface{} `json:"data"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccEvent)
@@ -34,6 +52,12 @@ func (e *Event) UnmarshalJSON(data []byte) error {
 	e.CreatedAt = ccEvent.CreatedAt
 	e.Type = ccEvent.Type
 	e.ActorName = ccEvent.Actor.Name
+	e.ActorType = ccEvent.Actor.Type
+	e.TargetGUID = ccEvent.Target.GUID
+	e.TargetType = ccEvent.Target.Type
+	e.TargetName = ccEvent.Target.Name
+	e.SpaceGUID = ccEvent.Space.GUID
+	e.OrganizationGUID = ccEvent.Organization.GUID
 	e.Data = ccEvent.Data
 
 	return nil
@@ -31,6 +31,7 @@ const (
 	DeleteServiceOfferingRequest                                = "DeleteServiceOffering"
 	DeleteServicePlanVisibilityRequest                          = "DeleteServicePlanVisibility"
 	DeleteSharedOrgFromDomainRequest                            = "DeleteSharedOrgFromDomain"
+	DeleteSidecarRequest                                        = "DeleteSidecar"
 	DeleteSpaceQuotaRequest                                     = "DeleteSpaceQuota"
 	DeleteSpaceRequest                                          = "DeleteSpace"
 	DeleteSpaceQuotaFromSpaceRequest                            = "DeleteSpaceQuotaFromSpace"
@@ -44,8 +45,10 @@ const (
 	GetApplicationRevisionsRequest                              = "GetApplicationRevisions"
 	GetApplicationRevisionsDeployedRequest                      = "GetApplicationRevisionsDeployed"
 	GetApplicationRoutesRequest                                 = "GetApplicationRoutes"
+	GetApplicationSidecarsRequest                               = "GetApplicationSidecars"
 	GetApplicationTasksRequest                                  = "GetApplicationTasks"
 	GetApplicationsRequest                                      = "GetApplications"
+	GetAppUsageEventsRequest                                    = "GetAppUsageEvents"
 	GetBuildRequest                                             = "GetBuild"
 	GetBuildpacksRequest                                        = "GetBuildpacks"
 	GetDefaultDomainRequest                                     = "GetDefaultDomain"
@@ -85,6 +88,7 @@ const (
 	GetServiceBrokersRequest                                    = "GetServiceBrokers"
 	GetServiceCredentialBindingsRequest                         = "GetServiceCredentialBindings"
 	GetServiceCredentialBindingDetailsRequest                   = "GetServiceCredentialBindingDetails"
+	GetServiceCredentialBindingParametersRequest                = "GetServiceCredentialBindingParameters"
 	GetServiceInstanceParametersRequest                         = "GetServiceInstanceParameters"
 	GetServiceInstancesRequest                                  = "GetServiceInstances"
 	GetServiceInstanceRelationshipsSharedSpacesRequest          = "GetServiceInstanceRelationshipSharedSpacesRequest"
@@ -94,6 +98,7 @@ const (
 	GetServicePlanRequest                                       = "GetServicePlan"
 	GetServicePlansRequest                                      = "GetServicePlans"
 	GetServicePlanVisibilityRequest                             = "GetServicePlanVisibility"
+	GetServiceUsageEventsRequest                                = "GetServiceUsageEvents"
 	GetSpaceFeatureRequest                                      = "GetSpaceFeatureRequest"
 	GetSpaceRelationshipIsolationSegmentRequest                 = "GetSpaceRelationshipIsolationSegment"
 	GetSpaceRunningSecurityGroupsRequest                        = "GetSpaceRunningSecurityGroups"
@@ -137,9 +142,11 @@ const (
 	PostApplicationActionStartRequest                           = "PostApplicationActionStart"
 	PostApplicationActionStopRequest                            = "PostApplicationActionStop"
 	PostApplicationDeploymentActionCancelRequest                = "PostApplicationDeploymentActionCancel"
+	PostApplicationDeploymentActionContinueRequest              = "PostApplicationDeploymentActionContinue"
 	PostApplicationDeploymentRequest                            = "PostApplicationDeployment"
 	PostApplicationProcessActionScaleRequest                    = "PostApplicationProcessActionScale"
 	PostApplicationRequest                                      = "PostApplication"
+	PostApplicationSidecarRequest                               = "PostApplicationSidecar"
 	PostApplicationTasksRequest                                 = "PostApplicationTasks"
 	PostBuildRequest                                            = "PostBuild"
 	PostBuildpackBitsRequest                                    = "PostBuildpackBits"
@@ -182,6 +189,7 @@ const (
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = map[string]Route{
+	GetAppUsageEventsRequest:                                    {Path: "/v3/app_usage_events", Method: http.MethodGet},
 	GetApplicationsRequest:                                      {Path: "/v3/apps", Method: http.MethodGet},
 	PostApplicationRequest:                                      {Path: "/v3/apps", Method: http.MethodPost},
 	DeleteApplicationRequest:                                    {Path: "/v3/apps/:app_guid", Method: http.MethodDelete},
@@ -204,6 +212,8 @@ var APIRoutes = map[string]Route{
 	GetApplicationRevisionsRequest:                              {Path: "/v3/apps/:app_guid/revisions", Method: http.MethodGet},
 	GetApplicationRevisionsDeployedRequest:                      {Path: "/v3/apps/:app_guid/revisions/deployed", Method: http.MethodGet},
 	GetApplicationRoutesRequest:                                 {Path: "/v3/apps/:app_guid/routes", Method: http.MethodGet},
+	GetApplicationSidecarsRequest:                               {Path: "/v3/apps/:app_guid/sidecars", Method: http.MethodGet},
+	PostApplicationSidecarRequest:                               {Path: "/v3/apps/:app_guid/sidecars", Method: http.MethodPost},
 	GetSSHEnabled:                                               {Path: "/v3/apps/:app_guid/ssh_enabled", Method: http.MethodGet},
 	GetApplicationTasksRequest:                                  {Path: "/v3/apps/:app_guid/tasks", Method: http.MethodGet},
 	PostApplicationTasksRequest:                                 {Path: "/v3/apps/:app_guid/tasks", Method: http.MethodPost},
@@ -218,6 +228,7 @@ var APIRoutes = map[string]Route{
 	PostApplicationDeploymentRequest:                            {Path: "/v3/deployments", Method: http.MethodPost},
 	GetDeploymentRequest:                                        {Path: "/v3/deployments/:deployment_guid", Method: http.MethodGet},
 	PostApplicationDeploymentActionCancelRequest:                {Path: "/v3/deployments/:deployment_guid/actions/cancel", Method: http.MethodPost},
+	PostApplicationDeploymentActionContinueRequest:              {Path: "/v3/deployments/:deployment_guid/actions/continue", Method: http.MethodPost},
 	GetDomainsRequest:                                           {Path: "/v3/domains", Method: http.MethodGet},
 	PostDomainRequest:                                           {Path: "/v3/domains", Method: http.MethodPost},
 	DeleteDomainRequest:                                         {Path: "/v3/domains/:domain_guid", Method: http.MethodDelete},
@@ -300,6 +311,7 @@ var APIRoutes = map[string]Route{
 	GetServiceCredentialBindingsRequest:                         {Path: "/v3/service_credential_bindings", Method: http.MethodGet},
 	DeleteServiceCredentialBindingRequest:                       {Path: "/v3/service_credential_bindings/:service_credential_binding_guid", Method: http.MethodDelete},
 	GetServiceCredentialBindingDetailsRequest:                   {Path: "/v3/service_credential_bindings/:service_credential_binding_guid/details", Method: http.MethodGet},
+	GetServiceCredentialBindingParametersRequest:                {Path: "/v3/service_credential_bindings/:service_credential_binding_guid/parameters", Method: http.MethodGet},
 	GetServiceInstancesRequest:                                  {Path: "/v3/service_instances", Method: http.MethodGet},
 	PostServiceInstanceRequest:                                  {Path: "/v3/service_instances", Method: http.MethodPost},
 	GetServiceInstanceParametersRequest:                         {Path: "/v3/service_instances/:service_instance_guid/parameters", Method: http.MethodGet},
@@ -319,9 +331,11 @@ var APIRoutes = map[string]Route{
 	GetServicePlanVisibilityRequest:                             {Path: "/v3/service_plans/:service_plan_guid/visibility", Method: http.MethodGet},
 	PostServicePlanVisibilityRequest:                            {Path: "/v3/service_plans/:service_plan_guid/visibility", Method: http.MethodPost},
 	DeleteServicePlanVisibilityRequest:                          {Path: "/v3/service_plans/:service_plan_guid/visibility/:organization_guid", Method: http.MethodDelete},
+	GetServiceUsageEventsRequest:                                {Path: "/v3/service_usage_events", Method: http.MethodGet},
 	PostRouteBindingRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodPost},
 	GetRouteBindingsRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodGet},
 	DeleteRouteBindingRequest:                                   {Path: "/v3/service_route_bindings/:route_binding_guid", Method: http.MethodDelete},
+	DeleteSidecarRequest:                                        {Path: "/v3/sidecars/:sidecar_guid", Method: http.MethodDelete},
 	GetSpacesRequest:                                            {Path: "/v3/spaces", Method: http.MethodGet},
 	PostSpaceRequest:                                            {Path: "/v3/spaces", Method: http.MethodPost},
 	DeleteSpaceRequest:                                          {Path: "/v3/spaces/:space_guid", Method: http.MethodDelete},
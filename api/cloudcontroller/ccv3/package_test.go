@@ -343,6 +343,12 @@ var _ = Describe("Package", func() {
 							"type": "bits",
 						  "state": "READY",
 							"created_at": "2017-08-14T21:20:13Z",
+							"data": {
+								"checksum": {
+									"type": "sha256",
+									"value": "some-sha256"
+								}
+							},
 							"links": {
 								"upload": {
 									"href": "some-pkg-upload-url-2",
@@ -378,6 +384,7 @@ var _ = Describe("Package", func() {
 						Type:      constant.PackageTypeBits,
 						State:     constant.PackageReady,
 						CreatedAt: "2017-08-14T21:20:13Z",
+						Checksum:  "some-sha256",
 						Links: map[string]resources.APILink{
 							"upload": resources.APILink{HREF: "some-pkg-upload-url-2", Method: http.MethodPost},
 						},
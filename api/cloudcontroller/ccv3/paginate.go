@@ -2,47 +2,103 @@ package ccv3
 
 import (
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+// maxConcurrentPageRequests is the number of pages fetched at the same time
+// once the first page has reported the total number of pages.
+const maxConcurrentPageRequests = 4
+
+// fetchedPage is the result of fetching a single page of a list request.
+type fetchedPage struct {
+	url       string
+	wrapper   *PaginatedResources
+	resources []interface{}
+	warnings  Warnings
+	err       error
+}
+
 func (requester RealRequester) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error, specificPage bool) (IncludedResources, Warnings, error) {
 	fullWarningsList := Warnings{}
 	var includes IncludedResources
 
-	for {
-		wrapper, warnings, err := requester.wrapFirstPage(request, obj, appendToExternalList)
-		fullWarningsList = append(fullWarningsList, warnings...)
-		if err != nil {
-			return IncludedResources{}, fullWarningsList, err
-		}
+	wrapper, warnings, err := requester.wrapFirstPage(request, obj, appendToExternalList)
+	fullWarningsList = append(fullWarningsList, warnings...)
+	if err != nil {
+		return IncludedResources{}, fullWarningsList, err
+	}
+	appendIncludedResources(&includes, wrapper.IncludedResources)
 
-		includes.Apps = append(includes.Apps, wrapper.IncludedResources.Apps...)
-		includes.Users = append(includes.Users, wrapper.IncludedResources.Users...)
-		includes.Organizations = append(includes.Organizations, wrapper.IncludedResources.Organizations...)
-		includes.Spaces = append(includes.Spaces, wrapper.IncludedResources.Spaces...)
-		includes.ServiceBrokers = append(includes.ServiceBrokers, wrapper.IncludedResources.ServiceBrokers...)
-		includes.ServiceInstances = append(includes.ServiceInstances, wrapper.IncludedResources.ServiceInstances...)
-		includes.ServiceOfferings = append(includes.ServiceOfferings, wrapper.IncludedResources.ServiceOfferings...)
-		includes.ServicePlans = append(includes.ServicePlans, wrapper.IncludedResources.ServicePlans...)
-
-		if specificPage || wrapper.NextPage() == "" {
-			break
+	if specificPage {
+		return includes, fullWarningsList, nil
+	}
+
+	nextPage := wrapper.NextPage()
+	if pageURLs := remainingPageURLs(nextPage, wrapper.Pagination.TotalPages); len(pageURLs) > 1 {
+		for _, page := range requester.fetchPages(pageURLs, obj) {
+			fullWarningsList = append(fullWarningsList, page.warnings...)
+
+			// Any page that failed, and every page after it, is fetched again
+			// below by following next links one page at a time.
+			if page.err != nil {
+				nextPage = page.url
+				break
+			}
+
+			for _, item := range page.resources {
+				err = appendToExternalList(item)
+				if err != nil {
+					return IncludedResources{}, fullWarningsList, err
+				}
+			}
+			appendIncludedResources(&includes, page.wrapper.IncludedResources)
+			nextPage = page.wrapper.NextPage()
 		}
+	}
 
+	for nextPage != "" {
 		request, err = requester.newHTTPRequest(requestOptions{
-			URL:    wrapper.NextPage(),
+			URL:    nextPage,
 			Method: http.MethodGet,
 		})
 		if err != nil {
 			return IncludedResources{}, fullWarningsList, err
 		}
+
+		wrapper, warnings, err = requester.wrapFirstPage(request, obj, appendToExternalList)
+		fullWarningsList = append(fullWarningsList, warnings...)
+		if err != nil {
+			return IncludedResources{}, fullWarningsList, err
+		}
+		appendIncludedResources(&includes, wrapper.IncludedResources)
+
+		nextPage = wrapper.NextPage()
 	}
 
 	return includes, fullWarningsList, nil
 }
 
 func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (*PaginatedResources, Warnings, error) {
+	wrapper, list, warnings, err := requester.getPage(request, obj)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	for _, item := range list {
+		err = appendToExternalList(item)
+		if err != nil {
+			return nil, warnings, err
+		}
+	}
+
+	return wrapper, warnings, nil
+}
+
+func (requester RealRequester) getPage(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, []interface{}, Warnings, error) {
 	warnings := Warnings{}
 	wrapper := NewPaginatedResources(obj)
 	response := cloudcontroller.Response{
@@ -52,20 +108,97 @@ func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, o
 	err := requester.connection.Make(request, &response)
 	warnings = append(warnings, response.Warnings...)
 	if err != nil {
-		return nil, warnings, err
+		return nil, nil, warnings, err
 	}
 
 	list, err := wrapper.Resources()
 	if err != nil {
-		return nil, warnings, err
+		return nil, nil, warnings, err
 	}
 
-	for _, item := range list {
-		err = appendToExternalList(item)
-		if err != nil {
-			return nil, warnings, err
-		}
+	return wrapper, list, warnings, nil
+}
+
+// fetchPages gets the given page URLs using a bounded number of concurrent
+// requests. The returned pages are in the same order as the URLs.
+func (requester RealRequester) fetchPages(pageURLs []string, obj interface{}) []fetchedPage {
+	pages := make([]fetchedPage, len(pageURLs))
+	pageIndexes := make(chan int)
+
+	workers := min(maxConcurrentPageRequests, len(pageURLs))
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for index := range pageIndexes {
+				pages[index] = requester.fetchPage(pageURLs[index], obj)
+			}
+		}()
 	}
 
-	return wrapper, warnings, nil
+	for index := range pageURLs {
+		pageIndexes <- index
+	}
+	close(pageIndexes)
+	wg.Wait()
+
+	return pages
+}
+
+func (requester RealRequester) fetchPage(pageURL string, obj interface{}) fetchedPage {
+	page := fetchedPage{url: pageURL}
+
+	request, err := requester.newHTTPRequest(requestOptions{
+		URL:    pageURL,
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		page.err = err
+		return page
+	}
+
+	page.wrapper, page.resources, page.warnings, page.err = requester.getPage(request, obj)
+	return page
+}
+
+// remainingPageURLs returns the URLs of every page from nextPage up to
+// totalPages. It returns nil when the page number cannot be determined from
+// nextPage, in which case the pages have to be followed one at a time.
+func remainingPageURLs(nextPage string, totalPages int) []string {
+	if nextPage == "" {
+		return nil
+	}
+
+	nextURL, err := url.Parse(nextPage)
+	if err != nil {
+		return nil
+	}
+
+	query := nextURL.Query()
+	firstPage, err := strconv.Atoi(query.Get("page"))
+	if err != nil || firstPage > totalPages {
+		return nil
+	}
+
+	pageURLs := []string{nextPage}
+	for page := firstPage + 1; page <= totalPages; page++ {
+		query.Set("page", strconv.Itoa(page))
+		pageURL := *nextURL
+		pageURL.RawQuery = query.Encode()
+		pageURLs = append(pageURLs, pageURL.String())
+	}
+
+	return pageURLs
+}
+
+func appendIncludedResources(includes *IncludedResources, other IncludedResources) {
+	includes.Apps = append(includes.Apps, other.Apps...)
+	includes.Users = append(includes.Users, other.Users...)
+	includes.Organizations = append(includes.Organizations, other.Organizations...)
+	includes.Spaces = append(includes.Spaces, other.Spaces...)
+	includes.ServiceBrokers = append(includes.ServiceBrokers, other.ServiceBrokers...)
+	includes.ServiceInstances = append(includes.ServiceInstances, other.ServiceInstances...)
+	includes.ServiceOfferings = append(includes.ServiceOfferings, other.ServiceOfferings...)
+	includes.ServicePlans = append(includes.ServicePlans, other.ServicePlans...)
 }
@@ -18,6 +18,8 @@ func NewPaginatedResources(exampleResource interface{}) *PaginatedResources {
 type PaginatedResources struct {
 	// Pagination represents information about the paginated resource.
 	Pagination struct {
+		// TotalPages is the number of pages available for the request.
+		TotalPages int `json:"total_pages"`
 		// Next represents a link to the next page.
 		Next struct {
 			// HREF is the HREF of the next page.
@@ -134,6 +134,11 @@ func (client *Client) UpdateProcess(process resources.Process) (resources.Proces
 			HealthCheckEndpoint:          process.HealthCheckEndpoint,
 			HealthCheckTimeout:           process.HealthCheckTimeout,
 			HealthCheckInvocationTimeout: process.HealthCheckInvocationTimeout,
+
+			ReadinessHealthCheckType:              process.ReadinessHealthCheckType,
+			ReadinessHealthCheckEndpoint:          process.ReadinessHealthCheckEndpoint,
+			ReadinessHealthCheckInvocationTimeout: process.ReadinessHealthCheckInvocationTimeout,
+			ReadinessHealthCheckInterval:          process.ReadinessHealthCheckInterval,
 		},
 		ResponseBody: &responseBody,
 	})
@@ -56,6 +56,14 @@ var _ = Describe("Process", func() {
 							"endpoint": "/health",
 							"invocation_timeout": 42
 						}
+					},
+					"readiness_health_check": {
+						"type": "http",
+						"data": {
+							"endpoint": "/ready",
+							"invocation_timeout": 5,
+							"interval": 10
+						}
 					}
 				}`
 				server.AppendHandlers(
@@ -70,18 +78,22 @@ var _ = Describe("Process", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf("this is a warning"))
 				Expect(process).To(MatchAllFields(Fields{
-					"GUID":                         Equal("process-1-guid"),
-					"Type":                         Equal("some-type"),
-					"AppGUID":                      Equal("some-app-guid"),
-					"Command":                      Equal(types.FilteredString{IsSet: true, Value: "start-command-1"}),
-					"Instances":                    Equal(types.NullInt{Value: 22, IsSet: true}),
-					"MemoryInMB":                   Equal(types.NullUint64{Value: 32, IsSet: true}),
-					"DiskInMB":                     Equal(types.NullUint64{Value: 1024, IsSet: true}),
-					"LogRateLimitInBPS":            Equal(types.NullInt{Value: 512, IsSet: true}),
-					"HealthCheckType":              Equal(constant.HTTP),
-					"HealthCheckEndpoint":          Equal("/health"),
-					"HealthCheckInvocationTimeout": BeEquivalentTo(42),
-					"HealthCheckTimeout":           BeEquivalentTo(90),
+					"GUID":                                  Equal("process-1-guid"),
+					"Type":                                  Equal("some-type"),
+					"AppGUID":                               Equal("some-app-guid"),
+					"Command":                               Equal(types.FilteredString{IsSet: true, Value: "start-command-1"}),
+					"Instances":                             Equal(types.NullInt{Value: 22, IsSet: true}),
+					"MemoryInMB":                            Equal(types.NullUint64{Value: 32, IsSet: true}),
+					"DiskInMB":                              Equal(types.NullUint64{Value: 1024, IsSet: true}),
+					"LogRateLimitInBPS":                     Equal(types.NullInt{Value: 512, IsSet: true}),
+					"HealthCheckType":                       Equal(constant.HTTP),
+					"HealthCheckEndpoint":                   Equal("/health"),
+					"HealthCheckInvocationTimeout":          BeEquivalentTo(42),
+					"HealthCheckTimeout":                    BeEquivalentTo(90),
+					"ReadinessHealthCheckType":              Equal(constant.HTTP),
+					"ReadinessHealthCheckEndpoint":          Equal("/ready"),
+					"ReadinessHealthCheckInvocationTimeout": BeEquivalentTo(5),
+					"ReadinessHealthCheckInterval":          BeEquivalentTo(10),
 				}))
 			})
 		})
@@ -317,6 +329,14 @@ var _ = Describe("Process", func() {
 							"endpoint": "/health",
 							"invocation_timeout": 42
 						}
+					},
+					"readiness_health_check": {
+						"type": "http",
+						"data": {
+							"endpoint": "/ready",
+							"invocation_timeout": 5,
+							"interval": 10
+						}
 					}
 				}`
 				server.AppendHandlers(
@@ -331,18 +351,22 @@ var _ = Describe("Process", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(warnings).To(ConsistOf("this is a warning"))
 				Expect(process).To(MatchAllFields(Fields{
-					"GUID":                         Equal("process-1-guid"),
-					"Type":                         Equal("some-type"),
-					"AppGUID":                      Equal("some-app-guid"),
-					"Command":                      Equal(types.FilteredString{IsSet: true, Value: "start-command-1"}),
-					"Instances":                    Equal(types.NullInt{Value: 22, IsSet: true}),
-					"MemoryInMB":                   Equal(types.NullUint64{Value: 32, IsSet: true}),
-					"DiskInMB":                     Equal(types.NullUint64{Value: 1024, IsSet: true}),
-					"LogRateLimitInBPS":            Equal(types.NullInt{Value: 64, IsSet: true}),
-					"HealthCheckType":              Equal(constant.HTTP),
-					"HealthCheckEndpoint":          Equal("/health"),
-					"HealthCheckInvocationTimeout": BeEquivalentTo(42),
-					"HealthCheckTimeout":           BeEquivalentTo(90),
+					"GUID":                                  Equal("process-1-guid"),
+					"Type":                                  Equal("some-type"),
+					"AppGUID":                               Equal("some-app-guid"),
+					"Command":                               Equal(types.FilteredString{IsSet: true, Value: "start-command-1"}),
+					"Instances":                             Equal(types.NullInt{Value: 22, IsSet: true}),
+					"MemoryInMB":                            Equal(types.NullUint64{Value: 32, IsSet: true}),
+					"DiskInMB":                              Equal(types.NullUint64{Value: 1024, IsSet: true}),
+					"LogRateLimitInBPS":                     Equal(types.NullInt{Value: 64, IsSet: true}),
+					"HealthCheckType":                       Equal(constant.HTTP),
+					"HealthCheckEndpoint":                   Equal("/health"),
+					"HealthCheckInvocationTimeout":          BeEquivalentTo(42),
+					"HealthCheckTimeout":                    BeEquivalentTo(90),
+					"ReadinessHealthCheckType":              Equal(constant.HTTP),
+					"ReadinessHealthCheckEndpoint":          Equal("/ready"),
+					"ReadinessHealthCheckInvocationTimeout": BeEquivalentTo(5),
+					"ReadinessHealthCheckInterval":          BeEquivalentTo(10),
 				}))
 			})
 		})
@@ -937,6 +961,54 @@ var _ = Describe("Process", func() {
 				})
 			})
 
+			When("the readiness health check is set", func() {
+				BeforeEach(func() {
+					inputProcess.ReadinessHealthCheckType = constant.HTTP
+					inputProcess.ReadinessHealthCheckEndpoint = "/ready"
+					inputProcess.ReadinessHealthCheckInvocationTimeout = 5
+					inputProcess.ReadinessHealthCheckInterval = 10
+
+					expectedBody := `{
+					"readiness_health_check": {
+						"type": "http",
+						"data": {
+							"endpoint": "/ready",
+							"invocation_timeout": 5,
+							"interval": 10
+						}
+					}
+				}`
+					responseBody := `{
+					"readiness_health_check": {
+						"type": "http",
+						"data": {
+							"endpoint": "/ready",
+							"invocation_timeout": 5,
+							"interval": 10
+						}
+					}
+				}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPatch, "/v3/processes/some-process-guid"),
+							VerifyJSON(expectedBody),
+							RespondWith(http.StatusOK, responseBody, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+				})
+
+				It("patches this process's readiness health check", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("this is a warning"))
+					Expect(process).To(MatchFields(IgnoreExtras, Fields{
+						"ReadinessHealthCheckType":              Equal(constant.HTTP),
+						"ReadinessHealthCheckEndpoint":          Equal("/ready"),
+						"ReadinessHealthCheckInvocationTimeout": BeEquivalentTo(5),
+						"ReadinessHealthCheckInterval":          BeEquivalentTo(10),
+					}))
+				})
+			})
+
 			When("the endpoint and timeout are not set", func() {
 				BeforeEach(func() {
 					inputProcess.HealthCheckType = "some-type"
@@ -9,6 +9,8 @@ import (
 type QueryKey string
 
 const (
+	// AfterGUIDFilter is a query parameter for listing usage events created after the event with the given GUID.
+	AfterGUIDFilter QueryKey = "after_guid"
 	// AppGUIDFilter is a query parameter for listing objects by app GUID.
 	AppGUIDFilter QueryKey = "app_guids"
 	// AvailableFilter is a query parameter for listing available resources
@@ -55,6 +57,12 @@ const (
 	RoleTypesFilter QueryKey = "types"
 	// StackFilter is a query parameter for listing objects by stack name
 	StackFilter QueryKey = "stacks"
+	// EventTypesFilter is a query parameter for listing audit events by type
+	EventTypesFilter QueryKey = "types"
+	// CreatedAtsGreaterThanOrEqualFilter is a query parameter for listing objects created at or after a timestamp
+	CreatedAtsGreaterThanOrEqualFilter QueryKey = "created_ats[gte]"
+	// CreatedAtsLessThanOrEqualFilter is a query parameter for listing objects created at or before a timestamp
+	CreatedAtsLessThanOrEqualFilter QueryKey = "created_ats[lte]"
 	// TypeFiler is a query parameter for selecting binding type
 	TypeFilter QueryKey = "type"
 	// UnmappedFilter is a query parameter specifying unmapped routes
@@ -114,6 +122,10 @@ const (
 	// used in conjunction with the OrderBy QueryKey.
 	PositionOrder = "position"
 
+	// CreatedAtAscendingOrder is a query value for ordering by created_at
+	// timestamp, in ascending order.
+	CreatedAtAscendingOrder = "created_at"
+
 	// CreatedAtDescendingOrder is a query value for ordering by created_at timestamp,
 	// in descending order.
 	CreatedAtDescendingOrder = "-created_at"
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -1032,6 +1034,117 @@ var _ = Describe("shared request helpers", func() {
 				})
 			})
 		})
+
+		Context("when the first page reports the total number of pages", func() {
+			var (
+				resourceList   []resources.Stack
+				requestedPages []string
+				failuresByPage map[string]int
+				lock           sync.Mutex
+			)
+
+			BeforeEach(func() {
+				resourceList = []resources.Stack{}
+				requestedPages = []string{}
+				failuresByPage = map[string]int{}
+
+				requestParams = RequestParams{
+					RequestName:  internal.GetStacksRequest,
+					Query:        []Query{{Key: PerPage, Values: []string{"1"}}},
+					ResponseBody: resources.Stack{},
+					AppendToList: func(item interface{}) error {
+						resourceList = append(resourceList, item.(resources.Stack))
+						return nil
+					},
+				}
+
+				server.RouteToHandler(http.MethodGet, "/v3/stacks", func(w http.ResponseWriter, r *http.Request) {
+					page := r.URL.Query().Get("page")
+					if page == "" {
+						page = "1"
+					}
+
+					lock.Lock()
+					requestedPages = append(requestedPages, page)
+					fail := failuresByPage[page] > 0
+					if fail {
+						failuresByPage[page]--
+					}
+					lock.Unlock()
+
+					if fail {
+						w.Header().Set("X-Cf-Warnings", "failed-warning-"+page)
+						w.WriteHeader(http.StatusInternalServerError)
+						_, _ = w.Write([]byte(`{"errors": [{"code": 10001, "title": "CF-UnknownError"}]}`))
+						return
+					}
+
+					next := "null"
+					if page != "4" {
+						pageNumber, _ := strconv.Atoi(page)
+						next = fmt.Sprintf(`{"href": "%s/v3/stacks?page=%d&per_page=1"}`, server.URL(), pageNumber+1)
+					}
+
+					w.Header().Set("X-Cf-Warnings", "warning-"+page)
+					_, _ = fmt.Fprintf(w, `{
+						"pagination": {
+							"total_pages": 4,
+							"next": %s
+						},
+						"resources": [
+							{
+								"guid": "stack-guid-%s"
+							}
+						]
+					}`, next, page)
+				})
+			})
+
+			It("fetches the remaining pages and keeps them in order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+				Expect(resourceList).To(Equal([]resources.Stack{
+					{GUID: "stack-guid-1"},
+					{GUID: "stack-guid-2"},
+					{GUID: "stack-guid-3"},
+					{GUID: "stack-guid-4"},
+				}))
+				Expect(requestedPages).To(HaveLen(4))
+				Expect(requestedPages[0]).To(Equal("1"))
+				Expect(requestedPages).To(ConsistOf("1", "2", "3", "4"))
+			})
+
+			When("one of the remaining pages fails", func() {
+				BeforeEach(func() {
+					failuresByPage["3"] = 1
+				})
+
+				It("fetches that page and the following pages again one at a time", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "failed-warning-3", "warning-3", "warning-4"}))
+					Expect(resourceList).To(Equal([]resources.Stack{
+						{GUID: "stack-guid-1"},
+						{GUID: "stack-guid-2"},
+						{GUID: "stack-guid-3"},
+						{GUID: "stack-guid-4"},
+					}))
+					Expect(requestedPages).To(HaveLen(6))
+					Expect(requestedPages[4:]).To(Equal([]string{"3", "4"}))
+				})
+			})
+
+			When("a page keeps failing", func() {
+				BeforeEach(func() {
+					failuresByPage["2"] = 2
+				})
+
+				It("returns the error and the warnings of every request", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(ccerror.V3UnexpectedResponseError{}))
+					Expect(executeErr.(ccerror.V3UnexpectedResponseError).ResponseCode).To(Equal(http.StatusInternalServerError))
+					Expect(warnings).To(Equal(Warnings{"warning-1", "failed-warning-2", "failed-warning-2"}))
+				})
+			})
+		})
 	})
 
 	Describe("MakeRequestReceiveRaw", func() {
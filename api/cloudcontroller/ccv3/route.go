@@ -160,6 +160,21 @@ func (client Client) UpdateDestination(routeGUID string, destinationGUID string,
 	return warnings, err
 }
 
+// UpdateRoute sets the options of the route. An option with a nil value is
+// removed from the route.
+func (client Client) UpdateRoute(routeGUID string, options map[string]*string) (resources.Route, Warnings, error) {
+	var responseBody resources.Route
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.PatchRouteRequest,
+		URIParams:    internal.Params{"route_guid": routeGUID},
+		RequestBody:  resources.Route{Options: options},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody, warnings, err
+}
+
 func (client Client) ShareRoute(routeGUID string, spaceGUID string) (Warnings, error) {
 	type space struct {
 		GUID string `json:"guid"`
@@ -963,6 +963,83 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("UpdateRoute", func() {
+		var (
+			options    map[string]*string
+			route      resources.Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			leastConnection := "least-connection"
+			options = map[string]*string{
+				"loadbalancing": &leastConnection,
+				"removed":       nil,
+			}
+		})
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = client.UpdateRoute("route-guid", options)
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "route-guid",
+					"host": "some-host",
+					"options": {"loadbalancing": "least-connection"}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid"),
+						VerifyJSON(`{"options": {"loadbalancing": "least-connection", "removed": null}}`),
+						RespondWith(http.StatusOK, response, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+						}),
+					),
+				)
+			})
+
+			It("returns the updated route and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(route.GUID).To(Equal("route-guid"))
+				Expect(route.Host).To(Equal("some-host"))
+				Expect(route.FormattedOptions()).To(Equal("loadbalancing=least-connection"))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+	  "errors": [
+	    {
+	      "code": 10008,
+	      "detail": "Options Loadbalancing must be one of 'round-robin, least-connection'",
+	      "title": "CF-UnprocessableEntity"
+	    }
+	  ]
+	}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+						}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Options Loadbalancing must be one of 'round-robin, least-connection'",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("DeleteOrphanedRoutes", func() {
 		var (
 			spaceGUID  string
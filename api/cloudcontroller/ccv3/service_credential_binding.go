@@ -3,6 +3,7 @@ package ccv3
 import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/lookuptable"
 )
 
@@ -58,3 +59,13 @@ func (client *Client) GetServiceCredentialBindingDetails(guid string) (details r
 
 	return
 }
+
+func (client *Client) GetServiceCredentialBindingParameters(guid string) (parameters types.JSONObject, warnings Warnings, err error) {
+	_, warnings, err = client.MakeRequest(RequestParams{
+		RequestName:  internal.GetServiceCredentialBindingParametersRequest,
+		URIParams:    internal.Params{"service_credential_binding_guid": guid},
+		ResponseBody: &parameters,
+	})
+
+	return
+}
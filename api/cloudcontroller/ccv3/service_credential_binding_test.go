@@ -325,4 +325,44 @@ var _ = Describe("Service Credential Bindings", func() {
 			})
 		})
 	})
+
+	Describe("GetServiceCredentialBindingParameters", func() {
+		const guid = "fake-guid"
+
+		BeforeEach(func() {
+			requester.MakeRequestCalls(func(params RequestParams) (JobURL, Warnings, error) {
+				json.Unmarshal([]byte(`{"foo":"bar"}`), params.ResponseBody)
+				return "", Warnings{"one", "two"}, nil
+			})
+		})
+
+		It("makes the correct API request", func() {
+			client.GetServiceCredentialBindingParameters(guid)
+
+			Expect(requester.MakeRequestCallCount()).To(Equal(1))
+			actualRequest := requester.MakeRequestArgsForCall(0)
+			Expect(actualRequest.RequestName).To(Equal(internal.GetServiceCredentialBindingParametersRequest))
+			Expect(actualRequest.URIParams).To(Equal(internal.Params{"service_credential_binding_guid": guid}))
+		})
+
+		It("returns the parameters", func() {
+			params, warnings, err := client.GetServiceCredentialBindingParameters(guid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("one", "two"))
+			Expect(params).To(Equal(types.JSONObject{"foo": "bar"}))
+		})
+
+		When("there is an error getting the parameters", func() {
+			BeforeEach(func() {
+				requester.MakeRequestReturns("", Warnings{"one", "two"}, errors.New("boom"))
+			})
+
+			It("returns warnings and an error", func() {
+				params, warnings, err := client.GetServiceCredentialBindingParameters(guid)
+				Expect(err).To(MatchError("boom"))
+				Expect(warnings).To(ConsistOf("one", "two"))
+				Expect(params).To(BeEmpty())
+			})
+		})
+	})
 })
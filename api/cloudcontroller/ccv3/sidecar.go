@@ -5,6 +5,49 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 )
 
+// CreateApplicationSidecar creates a sidecar for the application with the
+// provided GUID.
+func (client *Client) CreateApplicationSidecar(appGUID string, sidecar resources.Sidecar) (resources.Sidecar, Warnings, error) {
+	var responseBody resources.Sidecar
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.PostApplicationSidecarRequest,
+		URIParams:    internal.Params{"app_guid": appGUID},
+		RequestBody:  sidecar,
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody, warnings, err
+}
+
+// DeleteSidecar deletes the sidecar with the provided GUID.
+func (client *Client) DeleteSidecar(sidecarGUID string) (Warnings, error) {
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.DeleteSidecarRequest,
+		URIParams:   internal.Params{"sidecar_guid": sidecarGUID},
+	})
+
+	return warnings, err
+}
+
+// GetApplicationSidecars returns the sidecars of the application with the
+// provided GUID.
+func (client *Client) GetApplicationSidecars(appGUID string) ([]resources.Sidecar, Warnings, error) {
+	var sidecars []resources.Sidecar
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetApplicationSidecarsRequest,
+		URIParams:    internal.Params{"app_guid": appGUID},
+		ResponseBody: resources.Sidecar{},
+		AppendToList: func(item interface{}) error {
+			sidecars = append(sidecars, item.(resources.Sidecar))
+			return nil
+		},
+	})
+
+	return sidecars, warnings, err
+}
+
 func (client *Client) GetProcessSidecars(processGuid string) ([]resources.Sidecar, Warnings, error) {
 	var sidecars []resources.Sidecar
 
@@ -84,14 +84,20 @@ var _ = Describe("Sidecar", func() {
 				Expect(warnings).To(ConsistOf("this is a warning"))
 				Expect(len(processSidecars)).To(Equal(2))
 				Expect(processSidecars[0]).To(MatchAllFields(Fields{
-					"GUID":    Equal("process-1-guid"),
-					"Name":    Equal("auth-sidecar"),
-					"Command": Equal(types.FilteredString{IsSet: true, Value: "bundle exec rackup"}),
+					"GUID":         Equal("process-1-guid"),
+					"Name":         Equal("auth-sidecar"),
+					"Command":      Equal(types.FilteredString{IsSet: true, Value: "bundle exec rackup"}),
+					"ProcessTypes": Equal([]string{"web", "worker"}),
+					"MemoryInMB":   Equal(types.NullUint64{IsSet: true, Value: 300}),
+					"Origin":       BeEmpty(),
 				}))
 				Expect(processSidecars[1]).To(MatchAllFields(Fields{
-					"GUID":    Equal("process-2-guid"),
-					"Name":    Equal("echo-sidecar"),
-					"Command": Equal(types.FilteredString{IsSet: true, Value: "start-echo-server"}),
+					"GUID":         Equal("process-2-guid"),
+					"Name":         Equal("echo-sidecar"),
+					"Command":      Equal(types.FilteredString{IsSet: true, Value: "start-echo-server"}),
+					"ProcessTypes": Equal([]string{"web"}),
+					"MemoryInMB":   Equal(types.NullUint64{IsSet: true, Value: 300}),
+					"Origin":       BeEmpty(),
 				}))
 			})
 		})
@@ -185,4 +191,223 @@ var _ = Describe("Sidecar", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationSidecars", func() {
+		var (
+			sidecars []resources.Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			sidecars, warnings, err = client.GetApplicationSidecars("some-app-guid")
+		})
+
+		When("the app has sidecars", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {"next": null},
+					"resources": [
+						{
+							"guid": "sidecar-guid",
+							"name": "auth-sidecar",
+							"command": "bundle exec rackup",
+							"process_types": ["web", "worker"],
+							"memory_in_mb": 300,
+							"origin": "user"
+						},
+						{
+							"guid": "other-sidecar-guid",
+							"name": "buildpack-sidecar",
+							"command": "start-agent",
+							"process_types": ["web"],
+							"memory_in_mb": null,
+							"origin": "buildpack"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the sidecars and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(sidecars).To(Equal([]resources.Sidecar{
+					{
+						GUID:         "sidecar-guid",
+						Name:         "auth-sidecar",
+						Command:      types.FilteredString{IsSet: true, Value: "bundle exec rackup"},
+						ProcessTypes: []string{"web", "worker"},
+						MemoryInMB:   types.NullUint64{IsSet: true, Value: 300},
+						Origin:       "user",
+					},
+					{
+						GUID:         "other-sidecar-guid",
+						Name:         "buildpack-sidecar",
+						Command:      types.FilteredString{IsSet: true, Value: "start-agent"},
+						ProcessTypes: []string{"web"},
+						Origin:       "buildpack",
+					},
+				}))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns an error and warnings", func() {
+				Expect(err).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("CreateApplicationSidecar", func() {
+		var (
+			sidecar  resources.Sidecar
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			sidecar, warnings, err = client.CreateApplicationSidecar("some-app-guid", resources.Sidecar{
+				Name:         "auth-sidecar",
+				Command:      types.FilteredString{IsSet: true, Value: "bundle exec rackup"},
+				ProcessTypes: []string{"web"},
+				MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+			})
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "sidecar-guid",
+					"name": "auth-sidecar",
+					"command": "bundle exec rackup",
+					"process_types": ["web"],
+					"memory_in_mb": 64,
+					"origin": "user"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/sidecars"),
+						VerifyJSON(`{
+							"name": "auth-sidecar",
+							"command": "bundle exec rackup",
+							"process_types": ["web"],
+							"memory_in_mb": 64
+						}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created sidecar and all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(sidecar.GUID).To(Equal("sidecar-guid"))
+				Expect(sidecar.Origin).To(Equal("user"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Sidecar with name 'auth-sidecar' already exists for given app",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Sidecar with name 'auth-sidecar' already exists for given app",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("DeleteSidecar", func() {
+		var (
+			warnings Warnings
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			warnings, err = client.DeleteSidecar("sidecar-guid")
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/sidecars/sidecar-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the sidecar does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"detail": "Sidecar not found",
+							"title": "CF-ResourceNotFound",
+							"code": 10010
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/sidecars/sidecar-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Sidecar not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
 })
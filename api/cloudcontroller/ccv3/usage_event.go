@@ -0,0 +1,42 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// GetAppUsageEvents lists app usage events with optional filters, oldest
+// first.
+func (client *Client) GetAppUsageEvents(query ...Query) ([]resources.AppUsageEvent, Warnings, error) {
+	var events []resources.AppUsageEvent
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetAppUsageEventsRequest,
+		Query:        query,
+		ResponseBody: resources.AppUsageEvent{},
+		AppendToList: func(item interface{}) error {
+			events = append(events, item.(resources.AppUsageEvent))
+			return nil
+		},
+	})
+
+	return events, warnings, err
+}
+
+// GetServiceUsageEvents lists service usage events with optional filters,
+// oldest first.
+func (client *Client) GetServiceUsageEvents(query ...Query) ([]resources.ServiceUsageEvent, Warnings, error) {
+	var events []resources.ServiceUsageEvent
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetServiceUsageEventsRequest,
+		Query:        query,
+		ResponseBody: resources.ServiceUsageEvent{},
+		AppendToList: func(item interface{}) error {
+			events = append(events, item.(resources.ServiceUsageEvent))
+			return nil
+		},
+	})
+
+	return events, warnings, err
+}
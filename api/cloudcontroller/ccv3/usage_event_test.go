@@ -0,0 +1,223 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Usage Events", func() {
+	var (
+		client    *Client
+		createdAt time.Time
+	)
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+		createdAt = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	Describe("GetAppUsageEvents", func() {
+		var (
+			events     []resources.AppUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetAppUsageEvents(
+				Query{Key: AfterGUIDFilter, Values: []string{"checkpoint-guid"}},
+			)
+		})
+
+		When("the events exist across several pages", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/app_usage_events?after_guid=checkpoint-guid&page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "event-guid-1",
+      "created_at": "2026-10-01T12:00:00Z",
+      "state": { "current": "STARTED", "previous": "STOPPED" },
+      "app": { "guid": "app-guid", "name": "dora" },
+      "process": { "guid": "process-guid", "type": "web" },
+      "space": { "guid": "space-guid", "name": "production" },
+      "organization": { "guid": "org-guid" },
+      "buildpack": { "guid": "buildpack-guid", "name": "ruby_buildpack" },
+      "task": { "guid": null, "name": null },
+      "memory_in_mb_per_instance": { "current": 512, "previous": 256 },
+      "instance_count": { "current": 10, "previous": 5 }
+    }
+  ]
+}`, server.URL())
+				response2 := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "event-guid-2",
+      "created_at": "2026-10-01T12:00:00Z",
+      "state": { "current": "TASK_STARTED", "previous": null },
+      "app": { "guid": "app-guid", "name": "dora" },
+      "task": { "guid": "task-guid", "name": "migrate" },
+      "memory_in_mb_per_instance": { "current": 256, "previous": null },
+      "instance_count": { "current": 1, "previous": null }
+    }
+  ]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events", "after_guid=checkpoint-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events", "after_guid=checkpoint-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the events from every page and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(events).To(Equal([]resources.AppUsageEvent{
+					{
+						GUID:                          "event-guid-1",
+						CreatedAt:                     createdAt,
+						State:                         "STARTED",
+						PreviousState:                 "STOPPED",
+						AppGUID:                       "app-guid",
+						AppName:                       "dora",
+						ProcessGUID:                   "process-guid",
+						ProcessType:                   "web",
+						SpaceGUID:                     "space-guid",
+						SpaceName:                     "production",
+						OrganizationGUID:              "org-guid",
+						BuildpackGUID:                 "buildpack-guid",
+						BuildpackName:                 "ruby_buildpack",
+						InstanceCount:                 10,
+						PreviousInstanceCount:         5,
+						MemoryInMBPerInstance:         512,
+						PreviousMemoryInMBPerInstance: 256,
+					},
+					{
+						GUID:                  "event-guid-2",
+						CreatedAt:             createdAt,
+						State:                 "TASK_STARTED",
+						AppGUID:               "app-guid",
+						AppName:               "dora",
+						TaskGUID:              "task-guid",
+						TaskName:              "migrate",
+						InstanceCount:         1,
+						MemoryInMBPerInstance: 256,
+					},
+				}))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10003,
+      "detail": "You are not authorized to perform the requested action",
+      "title": "CF-NotAuthorized"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ForbiddenError{Message: "You are not authorized to perform the requested action"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceUsageEvents", func() {
+		var (
+			events     []resources.ServiceUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetServiceUsageEvents(
+				Query{Key: AfterGUIDFilter, Values: []string{"checkpoint-guid"}},
+			)
+		})
+
+		When("the events exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "event-guid-1",
+      "created_at": "2026-10-01T12:00:00Z",
+      "state": "CREATED",
+      "space": { "guid": "space-guid", "name": "production" },
+      "organization": { "guid": "org-guid" },
+      "service_instance": { "guid": "instance-guid", "name": "my-db", "type": "managed_service_instance" },
+      "service_plan": { "guid": "plan-guid", "name": "small" },
+      "service_offering": { "guid": "offering-guid", "name": "postgres" },
+      "service_broker": { "guid": "broker-guid", "name": "db-broker" }
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_usage_events", "after_guid=checkpoint-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the events and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+				Expect(events).To(Equal([]resources.ServiceUsageEvent{
+					{
+						GUID:                "event-guid-1",
+						CreatedAt:           createdAt,
+						State:               "CREATED",
+						ServiceInstanceGUID: "instance-guid",
+						ServiceInstanceName: "my-db",
+						ServiceInstanceType: "managed_service_instance",
+						ServicePlanGUID:     "plan-guid",
+						ServicePlanName:     "small",
+						ServiceOfferingGUID: "offering-guid",
+						ServiceOfferingName: "postgres",
+						ServiceBrokerGUID:   "broker-guid",
+						ServiceBrokerName:   "db-broker",
+						SpaceGUID:           "space-guid",
+						SpaceName:           "production",
+						OrganizationGUID:    "org-guid",
+					},
+				}))
+			})
+		})
+	})
+})
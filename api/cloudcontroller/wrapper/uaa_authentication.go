@@ -2,6 +2,7 @@ package wrapper
 
 import (
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/SermoDigital/jose/jws"
@@ -35,6 +36,10 @@ type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// refreshLock serializes token refreshes between requests made
+	// concurrently, so that the refresh token is only used once.
+	refreshLock sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -50,14 +55,15 @@ func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication
 // wrapped connection's Make. If the client is not set on the wrapper, it will
 // not add any header or handle any authentication errors.
 func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
-	if request.Header.Get("Authorization") == "" && (t.cache.AccessToken() != "" || t.cache.RefreshToken() != "") {
-		// assert a valid access token for authenticated requests
-		err := t.refreshTokenIfNecessary(t.cache.AccessToken())
+	if request.Header.Get("Authorization") == "" {
+		accessToken, err := t.validAccessToken()
 		if nil != err {
 			return err
 		}
 
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		if accessToken != "" {
+			request.Header.Set("Authorization", accessToken)
+		}
 	}
 
 	err := t.connection.Make(request, passedResponse)
@@ -75,6 +81,24 @@ func (t *UAAAuthentication) Wrap(innerconnection cloudcontroller.Connection) clo
 	return t
 }
 
+// validAccessToken returns the cached access token, refreshing it first if
+// necessary. It returns an empty token when the user is not logged in.
+func (t *UAAAuthentication) validAccessToken() (string, error) {
+	t.refreshLock.Lock()
+	defer t.refreshLock.Unlock()
+
+	if t.cache.AccessToken() == "" && t.cache.RefreshToken() == "" {
+		return "", nil
+	}
+
+	// assert a valid access token for authenticated requests
+	err := t.refreshTokenIfNecessary(t.cache.AccessToken())
+	if err != nil {
+		return "", err
+	}
+	return t.cache.AccessToken(), nil
+}
+
 // refreshToken refreshes the JWT access token if it is expired or about to expire.
 // If the access token is not yet expired, no action is performed.
 func (t *UAAAuthentication) refreshTokenIfNecessary(accessToken string) error {
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -221,6 +222,47 @@ var _ = Describe("UAA Authentication", func() {
 			})
 
 		})
+
+		When("requests are made concurrently with an expired access token", func() {
+			BeforeEach(func() {
+				expiredAccessToken, err := buildTokenString(time.Time{})
+				Expect(err).ToNot(HaveOccurred())
+				newAccessToken, err := buildTokenString(time.Now().AddDate(0, 1, 1))
+				Expect(err).ToNot(HaveOccurred())
+
+				inMemoryCache.SetAccessToken(expiredAccessToken)
+				inMemoryCache.SetRefreshToken("some-refresh-token")
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  newAccessToken,
+						RefreshToken: "new-refresh-token",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token once and uses it for every request", func() {
+				var wg sync.WaitGroup
+				requests := make([]*cloudcontroller.Request, 5)
+				for i := range requests {
+					requests[i] = &cloudcontroller.Request{Request: &http.Request{Header: http.Header{}}}
+					wg.Add(1)
+					go func(request *cloudcontroller.Request) {
+						defer GinkgoRecover()
+						defer wg.Done()
+						Expect(wrapper.Make(request, nil)).To(Succeed())
+					}(requests[i])
+				}
+				wg.Wait()
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenArgsForCall(0)).To(Equal("some-refresh-token"))
+				for _, request := range requests {
+					Expect(request.Header.Get("Authorization")).To(Equal(inMemoryCache.AccessToken()))
+				}
+			})
+		})
 	})
 })
 
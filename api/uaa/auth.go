@@ -60,7 +60,8 @@ func (client Client) Authenticate(creds map[string]string, origin string, grantT
 		return "", "", err
 	}
 
-	if grantType == constant.GrantTypePassword {
+	switch grantType {
+	case constant.GrantTypePassword, constant.GrantTypeAuthorizationCode, constant.GrantTypeDeviceCode:
 		request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
 	}
 
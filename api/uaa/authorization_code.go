@@ -0,0 +1,141 @@
+package uaa
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// AuthorizationCodeURL returns the URL the user has to visit in a browser to
+// start an authorization code login. The code challenge is expected to be the
+// S256 PKCE challenge of the verifier later sent to Authenticate.
+func (client Client) AuthorizationCodeURL(redirectURI string, state string, codeChallenge string, origin string) (string, error) {
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {client.config.UAAOAuthClient()},
+		"redirect_uri":          {redirectURI},
+		"state":                 {state},
+		"code_challenge":        {codeChallenge},
+		"code_challenge_method": {"S256"},
+	}
+
+	if origin != "" {
+		originParam, err := json.Marshal(struct {
+			Origin string `json:"origin"`
+		}{origin})
+		if err != nil {
+			return "", err
+		}
+		query.Set("login_hint", string(originParam))
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetAuthorizationCodeRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
+}
+
+// LoopbackRedirectListener receives the redirect at the end of a browser
+// login on a local port and hands over the authorization code.
+type LoopbackRedirectListener struct {
+	listener net.Listener
+	server   *http.Server
+	state    string
+	result   chan loopbackResult
+}
+
+type loopbackResult struct {
+	code string
+	err  error
+}
+
+// NewLoopbackRedirectListener starts listening on a random loopback port for
+// a redirect carrying the given state.
+func NewLoopbackRedirectListener(state string) (*LoopbackRedirectListener, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	redirectListener := &LoopbackRedirectListener{
+		listener: listener,
+		state:    state,
+		result:   make(chan loopbackResult, 1),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", redirectListener.handleRedirect)
+	redirectListener.server = &http.Server{Handler: mux}
+
+	go func() {
+		_ = redirectListener.server.Serve(listener)
+	}()
+
+	return redirectListener, nil
+}
+
+// RedirectURI is the redirect_uri to send with the authorization request.
+func (l *LoopbackRedirectListener) RedirectURI() string {
+	return fmt.Sprintf("http://%s/callback", l.listener.Addr().String())
+}
+
+// WaitForCode blocks until the browser has been redirected back with an
+// authorization code or an error, or until timeout has passed.
+func (l *LoopbackRedirectListener) WaitForCode(timeout time.Duration) (string, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-l.result:
+		return result.code, result.err
+	case <-timer.C:
+		return "", AuthorizationCodeTimeoutError{Timeout: timeout}
+	}
+}
+
+// Close stops listening for redirects.
+func (l *LoopbackRedirectListener) Close() error {
+	return l.server.Close()
+}
+
+func (l *LoopbackRedirectListener) handleRedirect(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Anything not carrying our state did not come from this login; keep
+	// waiting for the real redirect.
+	if query.Get("state") != l.state {
+		http.Error(w, "Invalid state.", http.StatusBadRequest)
+		return
+	}
+
+	var result loopbackResult
+	if errorType := query.Get("error"); errorType != "" {
+		result.err = UAAErrorResponse{Type: errorType, Description: query.Get("error_description")}
+	} else if code := query.Get("code"); code != "" {
+		result.code = code
+	} else {
+		result.err = MissingAuthorizationCodeError{}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if result.err != nil {
+		fmt.Fprint(w, "<html><body>Login failed. You can close this window and return to the CLI.</body></html>")
+	} else {
+		fmt.Fprint(w, "<html><body>Login successful. You can close this window and return to the CLI.</body></html>")
+	}
+
+	select {
+	case l.result <- result:
+	default:
+	}
+}
@@ -0,0 +1,139 @@
+package uaa_test
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorization Code", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore(NewTestConfig())
+	})
+
+	Describe("AuthorizationCodeURL", func() {
+		var (
+			origin      string
+			authURL     string
+			executeErr  error
+			parsedQuery url.Values
+		)
+
+		JustBeforeEach(func() {
+			authURL, executeErr = client.AuthorizationCodeURL("http://127.0.0.1:1234/callback", "some-state", "some-challenge", origin)
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			parsedURL, err := url.Parse(authURL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsedURL.Host).To(Equal(TestAuthorizationResource))
+			Expect(parsedURL.Path).To(Equal("/oauth/authorize"))
+			parsedQuery = parsedURL.Query()
+		})
+
+		It("returns the authorize URL with a PKCE challenge", func() {
+			Expect(parsedQuery).To(Equal(url.Values{
+				"response_type":         {"code"},
+				"client_id":             {"client-id"},
+				"redirect_uri":          {"http://127.0.0.1:1234/callback"},
+				"state":                 {"some-state"},
+				"code_challenge":        {"some-challenge"},
+				"code_challenge_method": {"S256"},
+			}))
+		})
+
+		When("an origin is given", func() {
+			BeforeEach(func() {
+				origin = "some-origin"
+			})
+
+			It("adds a login hint", func() {
+				Expect(parsedQuery.Get("login_hint")).To(Equal(`{"origin":"some-origin"}`))
+			})
+		})
+	})
+
+	Describe("LoopbackRedirectListener", func() {
+		var listener *LoopbackRedirectListener
+
+		BeforeEach(func() {
+			var err error
+			listener, err = NewLoopbackRedirectListener("some-state")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(listener.Close()).To(Succeed())
+		})
+
+		redirect := func(query string) (int, string) {
+			response, err := http.Get(listener.RedirectURI() + "?" + query)
+			Expect(err).ToNot(HaveOccurred())
+			defer response.Body.Close()
+			body, err := io.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			return response.StatusCode, string(body)
+		}
+
+		It("listens on the loopback interface", func() {
+			Expect(listener.RedirectURI()).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+		})
+
+		When("the browser is redirected back with a code", func() {
+			It("returns the code", func() {
+				status, body := redirect("code=some-code&state=some-state")
+				Expect(status).To(Equal(http.StatusOK))
+				Expect(body).To(ContainSubstring("Login successful"))
+
+				code, err := listener.WaitForCode(time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(code).To(Equal("some-code"))
+			})
+		})
+
+		When("the state does not match", func() {
+			It("rejects the request and keeps waiting", func() {
+				status, _ := redirect("code=other-code&state=other-state")
+				Expect(status).To(Equal(http.StatusBadRequest))
+
+				redirect("code=some-code&state=some-state")
+				code, err := listener.WaitForCode(time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(code).To(Equal("some-code"))
+			})
+		})
+
+		When("the browser is redirected back with an error", func() {
+			It("returns the error", func() {
+				_, body := redirect("error=access_denied&error_description=denied&state=some-state")
+				Expect(body).To(ContainSubstring("Login failed"))
+
+				_, err := listener.WaitForCode(time.Minute)
+				Expect(err).To(MatchError(UAAErrorResponse{Type: "access_denied", Description: "denied"}))
+			})
+		})
+
+		When("the browser is redirected back without a code or an error", func() {
+			It("returns a MissingAuthorizationCodeError", func() {
+				_, body := redirect("state=some-state")
+				Expect(body).To(ContainSubstring("Login failed"))
+
+				_, err := listener.WaitForCode(time.Minute)
+				Expect(err).To(MatchError(MissingAuthorizationCodeError{}))
+			})
+		})
+
+		When("the browser is not redirected back in time", func() {
+			It("returns an AuthorizationCodeTimeoutError", func() {
+				_, err := listener.WaitForCode(10 * time.Millisecond)
+				Expect(err).To(MatchError(AuthorizationCodeTimeoutError{Timeout: 10 * time.Millisecond}))
+			})
+		})
+	})
+})
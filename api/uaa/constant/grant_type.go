@@ -10,4 +10,10 @@ const (
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
+	// GrantTypeAuthorizationCode is used for exchanging the code received at
+	// the end of a browser login for a token.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
+	// GrantTypeDeviceCode is used for polling for a token while the user
+	// approves the login on another device.
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 )
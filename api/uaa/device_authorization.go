@@ -0,0 +1,50 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// DeviceAuthorization is the response to a device authorization request. The
+// user approves the login at VerificationURI with UserCode while the CLI
+// polls for a token with DeviceCode.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	// ExpiresIn is the number of seconds the device code is valid for.
+	ExpiresIn int `json:"expires_in"`
+	// Interval is the minimum number of seconds to wait between polls.
+	Interval int `json:"interval"`
+}
+
+// StartDeviceAuthorization requests a device code and user code for the
+// configured OAuth client.
+func (client Client) StartDeviceAuthorization() (DeviceAuthorization, error) {
+	requestBody := url.Values{
+		"client_id": {client.config.UAAOAuthClient()},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostDeviceAuthorizationRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	var deviceAuthorization DeviceAuthorization
+	response := Response{
+		Result: &deviceAuthorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	return deviceAuthorization, err
+}
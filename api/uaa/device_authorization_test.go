@@ -0,0 +1,77 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Device Authorization", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore(NewTestConfig())
+	})
+
+	Describe("StartDeviceAuthorization", func() {
+		var (
+			deviceAuthorization DeviceAuthorization
+			executeErr          error
+		)
+
+		JustBeforeEach(func() {
+			deviceAuthorization, executeErr = client.StartDeviceAuthorization()
+		})
+
+		When("no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+					"device_code": "some-device-code",
+					"user_code": "ABCD-EFGH",
+					"verification_uri": "https://uaa.example.com/device",
+					"verification_uri_complete": "https://uaa.example.com/device?user_code=ABCD-EFGH",
+					"expires_in": 300,
+					"interval": 5
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodPost, "/oauth/device_authorize"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyBody([]byte("client_id=client-id")),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the device authorization", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(deviceAuthorization).To(Equal(DeviceAuthorization{
+					DeviceCode:              "some-device-code",
+					UserCode:                "ABCD-EFGH",
+					VerificationURI:         "https://uaa.example.com/device",
+					VerificationURIComplete: "https://uaa.example.com/device?user_code=ABCD-EFGH",
+					ExpiresIn:               300,
+					Interval:                5,
+				}))
+			})
+		})
+
+		When("UAA returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestAuthorizationResource),
+						VerifyRequest(http.MethodPost, "/oauth/device_authorize"),
+						RespondWith(http.StatusUnauthorized, `{"error": "unauthorized", "error_description": "Bad credentials"}`),
+					))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(UnauthorizedError{Message: "Bad credentials"}))
+			})
+		})
+	})
+})
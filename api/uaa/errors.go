@@ -1,6 +1,9 @@
 package uaa
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // RawHTTPStatusError represents any response with a 4xx or 5xx status code.
 type RawHTTPStatusError struct {
@@ -22,6 +25,24 @@ func (e UAAErrorResponse) Error() string {
 	return fmt.Sprintf("Error Type: %s\nDescription: %s", e.Type, e.Description)
 }
 
+// AuthorizationCodeTimeoutError is returned when the browser is not redirected
+// back to the CLI before an authorization code login times out.
+type AuthorizationCodeTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e AuthorizationCodeTimeoutError) Error() string {
+	return fmt.Sprintf("Timed out after %s waiting for the browser login to complete.", e.Timeout)
+}
+
+// MissingAuthorizationCodeError is returned when the browser is redirected
+// back with neither an authorization code nor an error.
+type MissingAuthorizationCodeError struct{}
+
+func (MissingAuthorizationCodeError) Error() string {
+	return "The login redirect did not include an authorization code."
+}
+
 // ConflictError is returned when the response status code is 409. It
 // represents when there is a conflict in the state of the requested resource.
 type ConflictError struct {
@@ -104,3 +125,19 @@ type InvalidPasswordError struct {
 func (e InvalidPasswordError) Error() string {
 	return e.Message
 }
+
+// AuthorizationPendingError is returned while polling for a device code token
+// before the user has approved the login.
+type AuthorizationPendingError struct{}
+
+func (AuthorizationPendingError) Error() string {
+	return "authorization pending"
+}
+
+// SlowDownError is returned when a device code token is polled for more
+// often than the server allows.
+type SlowDownError struct{}
+
+func (SlowDownError) Error() string {
+	return "slow down"
+}
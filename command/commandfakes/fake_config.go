@@ -101,6 +101,31 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ContextsStub        func() []configv3.NamedContext
+	contextsMutex       sync.RWMutex
+	contextsArgsForCall []struct {
+	}
+	contextsReturns struct {
+		result1 []configv3.NamedContext
+	}
+	contextsReturnsOnCall map[int]struct {
+		result1 []configv3.NamedContext
+	}
+	CreateContextStub        func(string)
+	createContextMutex       sync.RWMutex
+	createContextArgsForCall []struct {
+		arg1 string
+	}
+	CurrentContextStub        func() string
+	currentContextMutex       sync.RWMutex
+	currentContextArgsForCall []struct {
+	}
+	currentContextReturns struct {
+		result1 string
+	}
+	currentContextReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -271,6 +296,16 @@ type FakeConfig struct {
 	networkPolicyV1EndpointReturnsOnCall map[int]struct {
 		result1 string
 	}
+	OutputFormatStub        func() configv3.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct {
+	}
+	outputFormatReturns struct {
+		result1 configv3.OutputFormat
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 configv3.OutputFormat
+	}
 	OverallPollingTimeoutStub        func() time.Duration
 	overallPollingTimeoutMutex       sync.RWMutex
 	overallPollingTimeoutArgsForCall []struct {
@@ -336,6 +371,16 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	ReproducibleArchivesStub        func() bool
+	reproducibleArchivesMutex       sync.RWMutex
+	reproducibleArchivesArgsForCall []struct {
+	}
+	reproducibleArchivesReturns struct {
+		result1 bool
+	}
+	reproducibleArchivesReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct {
@@ -477,6 +522,17 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	SwitchContextStub        func(string) error
+	switchContextMutex       sync.RWMutex
+	switchContextArgsForCall []struct {
+		arg1 string
+	}
+	switchContextReturns struct {
+		result1 error
+	}
+	switchContextReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct {
@@ -636,15 +692,16 @@ func (fake *FakeConfig) APIVersion() string {
 	ret, specificReturn := fake.aPIVersionReturnsOnCall[len(fake.aPIVersionArgsForCall)]
 	fake.aPIVersionArgsForCall = append(fake.aPIVersionArgsForCall, struct {
 	}{})
+	stub := fake.APIVersionStub
+	fakeReturns := fake.aPIVersionReturns
 	fake.recordInvocation("APIVersion", []interface{}{})
 	fake.aPIVersionMutex.Unlock()
-	if fake.APIVersionStub != nil {
-		return fake.APIVersionStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.aPIVersionReturns
 	return fakeReturns.result1
 }
 
@@ -688,15 +745,16 @@ func (fake *FakeConfig) AccessToken() string {
 	ret, specificReturn := fake.accessTokenReturnsOnCall[len(fake.accessTokenArgsForCall)]
 	fake.accessTokenArgsForCall = append(fake.accessTokenArgsForCall, struct {
 	}{})
+	stub := fake.AccessTokenStub
+	fakeReturns := fake.accessTokenReturns
 	fake.recordInvocation("AccessToken", []interface{}{})
 	fake.accessTokenMutex.Unlock()
-	if fake.AccessTokenStub != nil {
-		return fake.AccessTokenStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.accessTokenReturns
 	return fakeReturns.result1
 }
 
@@ -740,9 +798,10 @@ func (fake *FakeConfig) AddPlugin(arg1 configv3.Plugin) {
 	fake.addPluginArgsForCall = append(fake.addPluginArgsForCall, struct {
 		arg1 configv3.Plugin
 	}{arg1})
+	stub := fake.AddPluginStub
 	fake.recordInvocation("AddPlugin", []interface{}{arg1})
 	fake.addPluginMutex.Unlock()
-	if fake.AddPluginStub != nil {
+	if stub != nil {
 		fake.AddPluginStub(arg1)
 	}
 }
@@ -772,9 +831,10 @@ func (fake *FakeConfig) AddPluginRepository(arg1 string, arg2 string) {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.AddPluginRepositoryStub
 	fake.recordInvocation("AddPluginRepository", []interface{}{arg1, arg2})
 	fake.addPluginRepositoryMutex.Unlock()
-	if fake.AddPluginRepositoryStub != nil {
+	if stub != nil {
 		fake.AddPluginRepositoryStub(arg1, arg2)
 	}
 }
@@ -803,15 +863,16 @@ func (fake *FakeConfig) AuthorizationEndpoint() string {
 	ret, specificReturn := fake.authorizationEndpointReturnsOnCall[len(fake.authorizationEndpointArgsForCall)]
 	fake.authorizationEndpointArgsForCall = append(fake.authorizationEndpointArgsForCall, struct {
 	}{})
+	stub := fake.AuthorizationEndpointStub
+	fakeReturns := fake.authorizationEndpointReturns
 	fake.recordInvocation("AuthorizationEndpoint", []interface{}{})
 	fake.authorizationEndpointMutex.Unlock()
-	if fake.AuthorizationEndpointStub != nil {
-		return fake.AuthorizationEndpointStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.authorizationEndpointReturns
 	return fakeReturns.result1
 }
 
@@ -855,15 +916,16 @@ func (fake *FakeConfig) BinaryName() string {
 	ret, specificReturn := fake.binaryNameReturnsOnCall[len(fake.binaryNameArgsForCall)]
 	fake.binaryNameArgsForCall = append(fake.binaryNameArgsForCall, struct {
 	}{})
+	stub := fake.BinaryNameStub
+	fakeReturns := fake.binaryNameReturns
 	fake.recordInvocation("BinaryName", []interface{}{})
 	fake.binaryNameMutex.Unlock()
-	if fake.BinaryNameStub != nil {
-		return fake.BinaryNameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.binaryNameReturns
 	return fakeReturns.result1
 }
 
@@ -907,15 +969,16 @@ func (fake *FakeConfig) BinaryVersion() string {
 	ret, specificReturn := fake.binaryVersionReturnsOnCall[len(fake.binaryVersionArgsForCall)]
 	fake.binaryVersionArgsForCall = append(fake.binaryVersionArgsForCall, struct {
 	}{})
+	stub := fake.BinaryVersionStub
+	fakeReturns := fake.binaryVersionReturns
 	fake.recordInvocation("BinaryVersion", []interface{}{})
 	fake.binaryVersionMutex.Unlock()
-	if fake.BinaryVersionStub != nil {
-		return fake.BinaryVersionStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.binaryVersionReturns
 	return fakeReturns.result1
 }
 
@@ -959,15 +1022,16 @@ func (fake *FakeConfig) CFPassword() string {
 	ret, specificReturn := fake.cFPasswordReturnsOnCall[len(fake.cFPasswordArgsForCall)]
 	fake.cFPasswordArgsForCall = append(fake.cFPasswordArgsForCall, struct {
 	}{})
+	stub := fake.CFPasswordStub
+	fakeReturns := fake.cFPasswordReturns
 	fake.recordInvocation("CFPassword", []interface{}{})
 	fake.cFPasswordMutex.Unlock()
-	if fake.CFPasswordStub != nil {
-		return fake.CFPasswordStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.cFPasswordReturns
 	return fakeReturns.result1
 }
 
@@ -1011,15 +1075,16 @@ func (fake *FakeConfig) CFUsername() string {
 	ret, specificReturn := fake.cFUsernameReturnsOnCall[len(fake.cFUsernameArgsForCall)]
 	fake.cFUsernameArgsForCall = append(fake.cFUsernameArgsForCall, struct {
 	}{})
+	stub := fake.CFUsernameStub
+	fakeReturns := fake.cFUsernameReturns
 	fake.recordInvocation("CFUsername", []interface{}{})
 	fake.cFUsernameMutex.Unlock()
-	if fake.CFUsernameStub != nil {
-		return fake.CFUsernameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.cFUsernameReturns
 	return fakeReturns.result1
 }
 
@@ -1063,15 +1128,16 @@ func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
 	fake.colorEnabledArgsForCall = append(fake.colorEnabledArgsForCall, struct {
 	}{})
+	stub := fake.ColorEnabledStub
+	fakeReturns := fake.colorEnabledReturns
 	fake.recordInvocation("ColorEnabled", []interface{}{})
 	fake.colorEnabledMutex.Unlock()
-	if fake.ColorEnabledStub != nil {
-		return fake.ColorEnabledStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.colorEnabledReturns
 	return fakeReturns.result1
 }
 
@@ -1110,20 +1176,159 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) Contexts() []configv3.NamedContext {
+	fake.contextsMutex.Lock()
+	ret, specificReturn := fake.contextsReturnsOnCall[len(fake.contextsArgsForCall)]
+	fake.contextsArgsForCall = append(fake.contextsArgsForCall, struct {
+	}{})
+	stub := fake.ContextsStub
+	fakeReturns := fake.contextsReturns
+	fake.recordInvocation("Contexts", []interface{}{})
+	fake.contextsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ContextsCallCount() int {
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	return len(fake.contextsArgsForCall)
+}
+
+func (fake *FakeConfig) ContextsCalls(stub func() []configv3.NamedContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = stub
+}
+
+func (fake *FakeConfig) ContextsReturns(result1 []configv3.NamedContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = nil
+	fake.contextsReturns = struct {
+		result1 []configv3.NamedContext
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextsReturnsOnCall(i int, result1 []configv3.NamedContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = nil
+	if fake.contextsReturnsOnCall == nil {
+		fake.contextsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.NamedContext
+		})
+	}
+	fake.contextsReturnsOnCall[i] = struct {
+		result1 []configv3.NamedContext
+	}{result1}
+}
+
+func (fake *FakeConfig) CreateContext(arg1 string) {
+	fake.createContextMutex.Lock()
+	fake.createContextArgsForCall = append(fake.createContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CreateContextStub
+	fake.recordInvocation("CreateContext", []interface{}{arg1})
+	fake.createContextMutex.Unlock()
+	if stub != nil {
+		fake.CreateContextStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) CreateContextCallCount() int {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	return len(fake.createContextArgsForCall)
+}
+
+func (fake *FakeConfig) CreateContextCalls(stub func(string)) {
+	fake.createContextMutex.Lock()
+	defer fake.createContextMutex.Unlock()
+	fake.CreateContextStub = stub
+}
+
+func (fake *FakeConfig) CreateContextArgsForCall(i int) string {
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	argsForCall := fake.createContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) CurrentContext() string {
+	fake.currentContextMutex.Lock()
+	ret, specificReturn := fake.currentContextReturnsOnCall[len(fake.currentContextArgsForCall)]
+	fake.currentContextArgsForCall = append(fake.currentContextArgsForCall, struct {
+	}{})
+	stub := fake.CurrentContextStub
+	fakeReturns := fake.currentContextReturns
+	fake.recordInvocation("CurrentContext", []interface{}{})
+	fake.currentContextMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CurrentContextCallCount() int {
+	fake.currentContextMutex.RLock()
+	defer fake.currentContextMutex.RUnlock()
+	return len(fake.currentContextArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentContextCalls(stub func() string) {
+	fake.currentContextMutex.Lock()
+	defer fake.currentContextMutex.Unlock()
+	fake.CurrentContextStub = stub
+}
+
+func (fake *FakeConfig) CurrentContextReturns(result1 string) {
+	fake.currentContextMutex.Lock()
+	defer fake.currentContextMutex.Unlock()
+	fake.CurrentContextStub = nil
+	fake.currentContextReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContextReturnsOnCall(i int, result1 string) {
+	fake.currentContextMutex.Lock()
+	defer fake.currentContextMutex.Unlock()
+	fake.CurrentContextStub = nil
+	if fake.currentContextReturnsOnCall == nil {
+		fake.currentContextReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentContextReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
 	fake.currentUserArgsForCall = append(fake.currentUserArgsForCall, struct {
 	}{})
+	stub := fake.CurrentUserStub
+	fakeReturns := fake.currentUserReturns
 	fake.recordInvocation("CurrentUser", []interface{}{})
 	fake.currentUserMutex.Unlock()
-	if fake.CurrentUserStub != nil {
-		return fake.CurrentUserStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.currentUserReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1170,15 +1375,16 @@ func (fake *FakeConfig) CurrentUserName() (string, error) {
 	ret, specificReturn := fake.currentUserNameReturnsOnCall[len(fake.currentUserNameArgsForCall)]
 	fake.currentUserNameArgsForCall = append(fake.currentUserNameArgsForCall, struct {
 	}{})
+	stub := fake.CurrentUserNameStub
+	fakeReturns := fake.currentUserNameReturns
 	fake.recordInvocation("CurrentUserName", []interface{}{})
 	fake.currentUserNameMutex.Unlock()
-	if fake.CurrentUserNameStub != nil {
-		return fake.CurrentUserNameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.currentUserNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1225,15 +1431,16 @@ func (fake *FakeConfig) DialTimeout() time.Duration {
 	ret, specificReturn := fake.dialTimeoutReturnsOnCall[len(fake.dialTimeoutArgsForCall)]
 	fake.dialTimeoutArgsForCall = append(fake.dialTimeoutArgsForCall, struct {
 	}{})
+	stub := fake.DialTimeoutStub
+	fakeReturns := fake.dialTimeoutReturns
 	fake.recordInvocation("DialTimeout", []interface{}{})
 	fake.dialTimeoutMutex.Unlock()
-	if fake.DialTimeoutStub != nil {
-		return fake.DialTimeoutStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.dialTimeoutReturns
 	return fakeReturns.result1
 }
 
@@ -1277,15 +1484,16 @@ func (fake *FakeConfig) DockerPassword() string {
 	ret, specificReturn := fake.dockerPasswordReturnsOnCall[len(fake.dockerPasswordArgsForCall)]
 	fake.dockerPasswordArgsForCall = append(fake.dockerPasswordArgsForCall, struct {
 	}{})
+	stub := fake.DockerPasswordStub
+	fakeReturns := fake.dockerPasswordReturns
 	fake.recordInvocation("DockerPassword", []interface{}{})
 	fake.dockerPasswordMutex.Unlock()
-	if fake.DockerPasswordStub != nil {
-		return fake.DockerPasswordStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.dockerPasswordReturns
 	return fakeReturns.result1
 }
 
@@ -1329,15 +1537,16 @@ func (fake *FakeConfig) Experimental() bool {
 	ret, specificReturn := fake.experimentalReturnsOnCall[len(fake.experimentalArgsForCall)]
 	fake.experimentalArgsForCall = append(fake.experimentalArgsForCall, struct {
 	}{})
+	stub := fake.ExperimentalStub
+	fakeReturns := fake.experimentalReturns
 	fake.recordInvocation("Experimental", []interface{}{})
 	fake.experimentalMutex.Unlock()
-	if fake.ExperimentalStub != nil {
-		return fake.ExperimentalStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.experimentalReturns
 	return fakeReturns.result1
 }
 
@@ -1382,15 +1591,16 @@ func (fake *FakeConfig) GetPlugin(arg1 string) (configv3.Plugin, bool) {
 	fake.getPluginArgsForCall = append(fake.getPluginArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.GetPluginStub
+	fakeReturns := fake.getPluginReturns
 	fake.recordInvocation("GetPlugin", []interface{}{arg1})
 	fake.getPluginMutex.Unlock()
-	if fake.GetPluginStub != nil {
-		return fake.GetPluginStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getPluginReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1445,15 +1655,16 @@ func (fake *FakeConfig) GetPluginCaseInsensitive(arg1 string) (configv3.Plugin,
 	fake.getPluginCaseInsensitiveArgsForCall = append(fake.getPluginCaseInsensitiveArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.GetPluginCaseInsensitiveStub
+	fakeReturns := fake.getPluginCaseInsensitiveReturns
 	fake.recordInvocation("GetPluginCaseInsensitive", []interface{}{arg1})
 	fake.getPluginCaseInsensitiveMutex.Unlock()
-	if fake.GetPluginCaseInsensitiveStub != nil {
-		return fake.GetPluginCaseInsensitiveStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getPluginCaseInsensitiveReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1507,15 +1718,16 @@ func (fake *FakeConfig) HasTargetedOrganization() bool {
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
 	fake.hasTargetedOrganizationArgsForCall = append(fake.hasTargetedOrganizationArgsForCall, struct {
 	}{})
+	stub := fake.HasTargetedOrganizationStub
+	fakeReturns := fake.hasTargetedOrganizationReturns
 	fake.recordInvocation("HasTargetedOrganization", []interface{}{})
 	fake.hasTargetedOrganizationMutex.Unlock()
-	if fake.HasTargetedOrganizationStub != nil {
-		return fake.HasTargetedOrganizationStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.hasTargetedOrganizationReturns
 	return fakeReturns.result1
 }
 
@@ -1559,15 +1771,16 @@ func (fake *FakeConfig) HasTargetedSpace() bool {
 	ret, specificReturn := fake.hasTargetedSpaceReturnsOnCall[len(fake.hasTargetedSpaceArgsForCall)]
 	fake.hasTargetedSpaceArgsForCall = append(fake.hasTargetedSpaceArgsForCall, struct {
 	}{})
+	stub := fake.HasTargetedSpaceStub
+	fakeReturns := fake.hasTargetedSpaceReturns
 	fake.recordInvocation("HasTargetedSpace", []interface{}{})
 	fake.hasTargetedSpaceMutex.Unlock()
-	if fake.HasTargetedSpaceStub != nil {
-		return fake.HasTargetedSpaceStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.hasTargetedSpaceReturns
 	return fakeReturns.result1
 }
 
@@ -1611,15 +1824,16 @@ func (fake *FakeConfig) IsCFOnK8s() bool {
 	ret, specificReturn := fake.isCFOnK8sReturnsOnCall[len(fake.isCFOnK8sArgsForCall)]
 	fake.isCFOnK8sArgsForCall = append(fake.isCFOnK8sArgsForCall, struct {
 	}{})
+	stub := fake.IsCFOnK8sStub
+	fakeReturns := fake.isCFOnK8sReturns
 	fake.recordInvocation("IsCFOnK8s", []interface{}{})
 	fake.isCFOnK8sMutex.Unlock()
-	if fake.IsCFOnK8sStub != nil {
-		return fake.IsCFOnK8sStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.isCFOnK8sReturns
 	return fakeReturns.result1
 }
 
@@ -1663,15 +1877,16 @@ func (fake *FakeConfig) IsTTY() bool {
 	ret, specificReturn := fake.isTTYReturnsOnCall[len(fake.isTTYArgsForCall)]
 	fake.isTTYArgsForCall = append(fake.isTTYArgsForCall, struct {
 	}{})
+	stub := fake.IsTTYStub
+	fakeReturns := fake.isTTYReturns
 	fake.recordInvocation("IsTTY", []interface{}{})
 	fake.isTTYMutex.Unlock()
-	if fake.IsTTYStub != nil {
-		return fake.IsTTYStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.isTTYReturns
 	return fakeReturns.result1
 }
 
@@ -1715,15 +1930,16 @@ func (fake *FakeConfig) Locale() string {
 	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
 	fake.localeArgsForCall = append(fake.localeArgsForCall, struct {
 	}{})
+	stub := fake.LocaleStub
+	fakeReturns := fake.localeReturns
 	fake.recordInvocation("Locale", []interface{}{})
 	fake.localeMutex.Unlock()
-	if fake.LocaleStub != nil {
-		return fake.LocaleStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.localeReturns
 	return fakeReturns.result1
 }
 
@@ -1767,15 +1983,16 @@ func (fake *FakeConfig) LogCacheEndpoint() string {
 	ret, specificReturn := fake.logCacheEndpointReturnsOnCall[len(fake.logCacheEndpointArgsForCall)]
 	fake.logCacheEndpointArgsForCall = append(fake.logCacheEndpointArgsForCall, struct {
 	}{})
+	stub := fake.LogCacheEndpointStub
+	fakeReturns := fake.logCacheEndpointReturns
 	fake.recordInvocation("LogCacheEndpoint", []interface{}{})
 	fake.logCacheEndpointMutex.Unlock()
-	if fake.LogCacheEndpointStub != nil {
-		return fake.LogCacheEndpointStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.logCacheEndpointReturns
 	return fakeReturns.result1
 }
 
@@ -1819,15 +2036,16 @@ func (fake *FakeConfig) MinCLIVersion() string {
 	ret, specificReturn := fake.minCLIVersionReturnsOnCall[len(fake.minCLIVersionArgsForCall)]
 	fake.minCLIVersionArgsForCall = append(fake.minCLIVersionArgsForCall, struct {
 	}{})
+	stub := fake.MinCLIVersionStub
+	fakeReturns := fake.minCLIVersionReturns
 	fake.recordInvocation("MinCLIVersion", []interface{}{})
 	fake.minCLIVersionMutex.Unlock()
-	if fake.MinCLIVersionStub != nil {
-		return fake.MinCLIVersionStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.minCLIVersionReturns
 	return fakeReturns.result1
 }
 
@@ -1871,15 +2089,16 @@ func (fake *FakeConfig) NOAARequestRetryCount() int {
 	ret, specificReturn := fake.nOAARequestRetryCountReturnsOnCall[len(fake.nOAARequestRetryCountArgsForCall)]
 	fake.nOAARequestRetryCountArgsForCall = append(fake.nOAARequestRetryCountArgsForCall, struct {
 	}{})
+	stub := fake.NOAARequestRetryCountStub
+	fakeReturns := fake.nOAARequestRetryCountReturns
 	fake.recordInvocation("NOAARequestRetryCount", []interface{}{})
 	fake.nOAARequestRetryCountMutex.Unlock()
-	if fake.NOAARequestRetryCountStub != nil {
-		return fake.NOAARequestRetryCountStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.nOAARequestRetryCountReturns
 	return fakeReturns.result1
 }
 
@@ -1923,15 +2142,16 @@ func (fake *FakeConfig) NetworkPolicyV1Endpoint() string {
 	ret, specificReturn := fake.networkPolicyV1EndpointReturnsOnCall[len(fake.networkPolicyV1EndpointArgsForCall)]
 	fake.networkPolicyV1EndpointArgsForCall = append(fake.networkPolicyV1EndpointArgsForCall, struct {
 	}{})
+	stub := fake.NetworkPolicyV1EndpointStub
+	fakeReturns := fake.networkPolicyV1EndpointReturns
 	fake.recordInvocation("NetworkPolicyV1Endpoint", []interface{}{})
 	fake.networkPolicyV1EndpointMutex.Unlock()
-	if fake.NetworkPolicyV1EndpointStub != nil {
-		return fake.NetworkPolicyV1EndpointStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.networkPolicyV1EndpointReturns
 	return fakeReturns.result1
 }
 
@@ -1970,20 +2190,74 @@ func (fake *FakeConfig) NetworkPolicyV1EndpointReturnsOnCall(i int, result1 stri
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() configv3.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct {
+	}{})
+	stub := fake.OutputFormatStub
+	fakeReturns := fake.outputFormatReturns
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatCalls(stub func() configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = stub
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.OutputFormat
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
 func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
 	fake.overallPollingTimeoutMutex.Lock()
 	ret, specificReturn := fake.overallPollingTimeoutReturnsOnCall[len(fake.overallPollingTimeoutArgsForCall)]
 	fake.overallPollingTimeoutArgsForCall = append(fake.overallPollingTimeoutArgsForCall, struct {
 	}{})
+	stub := fake.OverallPollingTimeoutStub
+	fakeReturns := fake.overallPollingTimeoutReturns
 	fake.recordInvocation("OverallPollingTimeout", []interface{}{})
 	fake.overallPollingTimeoutMutex.Unlock()
-	if fake.OverallPollingTimeoutStub != nil {
-		return fake.OverallPollingTimeoutStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.overallPollingTimeoutReturns
 	return fakeReturns.result1
 }
 
@@ -2027,15 +2301,16 @@ func (fake *FakeConfig) PluginHome() string {
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
 	fake.pluginHomeArgsForCall = append(fake.pluginHomeArgsForCall, struct {
 	}{})
+	stub := fake.PluginHomeStub
+	fakeReturns := fake.pluginHomeReturns
 	fake.recordInvocation("PluginHome", []interface{}{})
 	fake.pluginHomeMutex.Unlock()
-	if fake.PluginHomeStub != nil {
-		return fake.PluginHomeStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.pluginHomeReturns
 	return fakeReturns.result1
 }
 
@@ -2079,15 +2354,16 @@ func (fake *FakeConfig) PluginRepositories() []configv3.PluginRepository {
 	ret, specificReturn := fake.pluginRepositoriesReturnsOnCall[len(fake.pluginRepositoriesArgsForCall)]
 	fake.pluginRepositoriesArgsForCall = append(fake.pluginRepositoriesArgsForCall, struct {
 	}{})
+	stub := fake.PluginRepositoriesStub
+	fakeReturns := fake.pluginRepositoriesReturns
 	fake.recordInvocation("PluginRepositories", []interface{}{})
 	fake.pluginRepositoriesMutex.Unlock()
-	if fake.PluginRepositoriesStub != nil {
-		return fake.PluginRepositoriesStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.pluginRepositoriesReturns
 	return fakeReturns.result1
 }
 
@@ -2131,15 +2407,16 @@ func (fake *FakeConfig) Plugins() []configv3.Plugin {
 	ret, specificReturn := fake.pluginsReturnsOnCall[len(fake.pluginsArgsForCall)]
 	fake.pluginsArgsForCall = append(fake.pluginsArgsForCall, struct {
 	}{})
+	stub := fake.PluginsStub
+	fakeReturns := fake.pluginsReturns
 	fake.recordInvocation("Plugins", []interface{}{})
 	fake.pluginsMutex.Unlock()
-	if fake.PluginsStub != nil {
-		return fake.PluginsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.pluginsReturns
 	return fakeReturns.result1
 }
 
@@ -2183,15 +2460,16 @@ func (fake *FakeConfig) PollingInterval() time.Duration {
 	ret, specificReturn := fake.pollingIntervalReturnsOnCall[len(fake.pollingIntervalArgsForCall)]
 	fake.pollingIntervalArgsForCall = append(fake.pollingIntervalArgsForCall, struct {
 	}{})
+	stub := fake.PollingIntervalStub
+	fakeReturns := fake.pollingIntervalReturns
 	fake.recordInvocation("PollingInterval", []interface{}{})
 	fake.pollingIntervalMutex.Unlock()
-	if fake.PollingIntervalStub != nil {
-		return fake.PollingIntervalStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.pollingIntervalReturns
 	return fakeReturns.result1
 }
 
@@ -2235,15 +2513,16 @@ func (fake *FakeConfig) RefreshToken() string {
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
 	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct {
 	}{})
+	stub := fake.RefreshTokenStub
+	fakeReturns := fake.refreshTokenReturns
 	fake.recordInvocation("RefreshToken", []interface{}{})
 	fake.refreshTokenMutex.Unlock()
-	if fake.RefreshTokenStub != nil {
-		return fake.RefreshTokenStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.refreshTokenReturns
 	return fakeReturns.result1
 }
 
@@ -2287,9 +2566,10 @@ func (fake *FakeConfig) RemovePlugin(arg1 string) {
 	fake.removePluginArgsForCall = append(fake.removePluginArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.RemovePluginStub
 	fake.recordInvocation("RemovePlugin", []interface{}{arg1})
 	fake.removePluginMutex.Unlock()
-	if fake.RemovePluginStub != nil {
+	if stub != nil {
 		fake.RemovePluginStub(arg1)
 	}
 }
@@ -2313,20 +2593,74 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) ReproducibleArchives() bool {
+	fake.reproducibleArchivesMutex.Lock()
+	ret, specificReturn := fake.reproducibleArchivesReturnsOnCall[len(fake.reproducibleArchivesArgsForCall)]
+	fake.reproducibleArchivesArgsForCall = append(fake.reproducibleArchivesArgsForCall, struct {
+	}{})
+	stub := fake.ReproducibleArchivesStub
+	fakeReturns := fake.reproducibleArchivesReturns
+	fake.recordInvocation("ReproducibleArchives", []interface{}{})
+	fake.reproducibleArchivesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ReproducibleArchivesCallCount() int {
+	fake.reproducibleArchivesMutex.RLock()
+	defer fake.reproducibleArchivesMutex.RUnlock()
+	return len(fake.reproducibleArchivesArgsForCall)
+}
+
+func (fake *FakeConfig) ReproducibleArchivesCalls(stub func() bool) {
+	fake.reproducibleArchivesMutex.Lock()
+	defer fake.reproducibleArchivesMutex.Unlock()
+	fake.ReproducibleArchivesStub = stub
+}
+
+func (fake *FakeConfig) ReproducibleArchivesReturns(result1 bool) {
+	fake.reproducibleArchivesMutex.Lock()
+	defer fake.reproducibleArchivesMutex.Unlock()
+	fake.ReproducibleArchivesStub = nil
+	fake.reproducibleArchivesReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) ReproducibleArchivesReturnsOnCall(i int, result1 bool) {
+	fake.reproducibleArchivesMutex.Lock()
+	defer fake.reproducibleArchivesMutex.Unlock()
+	fake.ReproducibleArchivesStub = nil
+	if fake.reproducibleArchivesReturnsOnCall == nil {
+		fake.reproducibleArchivesReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.reproducibleArchivesReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
 	fake.requestRetryCountArgsForCall = append(fake.requestRetryCountArgsForCall, struct {
 	}{})
+	stub := fake.RequestRetryCountStub
+	fakeReturns := fake.requestRetryCountReturns
 	fake.recordInvocation("RequestRetryCount", []interface{}{})
 	fake.requestRetryCountMutex.Unlock()
-	if fake.RequestRetryCountStub != nil {
-		return fake.RequestRetryCountStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.requestRetryCountReturns
 	return fakeReturns.result1
 }
 
@@ -2370,15 +2704,16 @@ func (fake *FakeConfig) RoutingEndpoint() string {
 	ret, specificReturn := fake.routingEndpointReturnsOnCall[len(fake.routingEndpointArgsForCall)]
 	fake.routingEndpointArgsForCall = append(fake.routingEndpointArgsForCall, struct {
 	}{})
+	stub := fake.RoutingEndpointStub
+	fakeReturns := fake.routingEndpointReturns
 	fake.recordInvocation("RoutingEndpoint", []interface{}{})
 	fake.routingEndpointMutex.Unlock()
-	if fake.RoutingEndpointStub != nil {
-		return fake.RoutingEndpointStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.routingEndpointReturns
 	return fakeReturns.result1
 }
 
@@ -2422,15 +2757,16 @@ func (fake *FakeConfig) SSHOAuthClient() string {
 	ret, specificReturn := fake.sSHOAuthClientReturnsOnCall[len(fake.sSHOAuthClientArgsForCall)]
 	fake.sSHOAuthClientArgsForCall = append(fake.sSHOAuthClientArgsForCall, struct {
 	}{})
+	stub := fake.SSHOAuthClientStub
+	fakeReturns := fake.sSHOAuthClientReturns
 	fake.recordInvocation("SSHOAuthClient", []interface{}{})
 	fake.sSHOAuthClientMutex.Unlock()
-	if fake.SSHOAuthClientStub != nil {
-		return fake.SSHOAuthClientStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.sSHOAuthClientReturns
 	return fakeReturns.result1
 }
 
@@ -2474,9 +2810,10 @@ func (fake *FakeConfig) SetAccessToken(arg1 string) {
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetAccessTokenStub
 	fake.recordInvocation("SetAccessToken", []interface{}{arg1})
 	fake.setAccessTokenMutex.Unlock()
-	if fake.SetAccessTokenStub != nil {
+	if stub != nil {
 		fake.SetAccessTokenStub(arg1)
 	}
 }
@@ -2505,9 +2842,10 @@ func (fake *FakeConfig) SetAsyncTimeout(arg1 int) {
 	fake.setAsyncTimeoutArgsForCall = append(fake.setAsyncTimeoutArgsForCall, struct {
 		arg1 int
 	}{arg1})
+	stub := fake.SetAsyncTimeoutStub
 	fake.recordInvocation("SetAsyncTimeout", []interface{}{arg1})
 	fake.setAsyncTimeoutMutex.Unlock()
-	if fake.SetAsyncTimeoutStub != nil {
+	if stub != nil {
 		fake.SetAsyncTimeoutStub(arg1)
 	}
 }
@@ -2536,9 +2874,10 @@ func (fake *FakeConfig) SetColorEnabled(arg1 string) {
 	fake.setColorEnabledArgsForCall = append(fake.setColorEnabledArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetColorEnabledStub
 	fake.recordInvocation("SetColorEnabled", []interface{}{arg1})
 	fake.setColorEnabledMutex.Unlock()
-	if fake.SetColorEnabledStub != nil {
+	if stub != nil {
 		fake.SetColorEnabledStub(arg1)
 	}
 }
@@ -2567,9 +2906,10 @@ func (fake *FakeConfig) SetKubernetesAuthInfo(arg1 string) {
 	fake.setKubernetesAuthInfoArgsForCall = append(fake.setKubernetesAuthInfoArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetKubernetesAuthInfoStub
 	fake.recordInvocation("SetKubernetesAuthInfo", []interface{}{arg1})
 	fake.setKubernetesAuthInfoMutex.Unlock()
-	if fake.SetKubernetesAuthInfoStub != nil {
+	if stub != nil {
 		fake.SetKubernetesAuthInfoStub(arg1)
 	}
 }
@@ -2598,9 +2938,10 @@ func (fake *FakeConfig) SetLocale(arg1 string) {
 	fake.setLocaleArgsForCall = append(fake.setLocaleArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetLocaleStub
 	fake.recordInvocation("SetLocale", []interface{}{arg1})
 	fake.setLocaleMutex.Unlock()
-	if fake.SetLocaleStub != nil {
+	if stub != nil {
 		fake.SetLocaleStub(arg1)
 	}
 }
@@ -2629,9 +2970,10 @@ func (fake *FakeConfig) SetMinCLIVersion(arg1 string) {
 	fake.setMinCLIVersionArgsForCall = append(fake.setMinCLIVersionArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetMinCLIVersionStub
 	fake.recordInvocation("SetMinCLIVersion", []interface{}{arg1})
 	fake.setMinCLIVersionMutex.Unlock()
-	if fake.SetMinCLIVersionStub != nil {
+	if stub != nil {
 		fake.SetMinCLIVersionStub(arg1)
 	}
 }
@@ -2661,9 +3003,10 @@ func (fake *FakeConfig) SetOrganizationInformation(arg1 string, arg2 string) {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.SetOrganizationInformationStub
 	fake.recordInvocation("SetOrganizationInformation", []interface{}{arg1, arg2})
 	fake.setOrganizationInformationMutex.Unlock()
-	if fake.SetOrganizationInformationStub != nil {
+	if stub != nil {
 		fake.SetOrganizationInformationStub(arg1, arg2)
 	}
 }
@@ -2692,9 +3035,10 @@ func (fake *FakeConfig) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetRefreshTokenStub
 	fake.recordInvocation("SetRefreshToken", []interface{}{arg1})
 	fake.setRefreshTokenMutex.Unlock()
-	if fake.SetRefreshTokenStub != nil {
+	if stub != nil {
 		fake.SetRefreshTokenStub(arg1)
 	}
 }
@@ -2725,9 +3069,10 @@ func (fake *FakeConfig) SetSpaceInformation(arg1 string, arg2 string, arg3 bool)
 		arg2 string
 		arg3 bool
 	}{arg1, arg2, arg3})
+	stub := fake.SetSpaceInformationStub
 	fake.recordInvocation("SetSpaceInformation", []interface{}{arg1, arg2, arg3})
 	fake.setSpaceInformationMutex.Unlock()
-	if fake.SetSpaceInformationStub != nil {
+	if stub != nil {
 		fake.SetSpaceInformationStub(arg1, arg2, arg3)
 	}
 }
@@ -2756,9 +3101,10 @@ func (fake *FakeConfig) SetTargetInformation(arg1 configv3.TargetInformationArgs
 	fake.setTargetInformationArgsForCall = append(fake.setTargetInformationArgsForCall, struct {
 		arg1 configv3.TargetInformationArgs
 	}{arg1})
+	stub := fake.SetTargetInformationStub
 	fake.recordInvocation("SetTargetInformation", []interface{}{arg1})
 	fake.setTargetInformationMutex.Unlock()
-	if fake.SetTargetInformationStub != nil {
+	if stub != nil {
 		fake.SetTargetInformationStub(arg1)
 	}
 }
@@ -2789,9 +3135,10 @@ func (fake *FakeConfig) SetTokenInformation(arg1 string, arg2 string, arg3 strin
 		arg2 string
 		arg3 string
 	}{arg1, arg2, arg3})
+	stub := fake.SetTokenInformationStub
 	fake.recordInvocation("SetTokenInformation", []interface{}{arg1, arg2, arg3})
 	fake.setTokenInformationMutex.Unlock()
-	if fake.SetTokenInformationStub != nil {
+	if stub != nil {
 		fake.SetTokenInformationStub(arg1, arg2, arg3)
 	}
 }
@@ -2820,9 +3167,10 @@ func (fake *FakeConfig) SetTrace(arg1 string) {
 	fake.setTraceArgsForCall = append(fake.setTraceArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetTraceStub
 	fake.recordInvocation("SetTrace", []interface{}{arg1})
 	fake.setTraceMutex.Unlock()
-	if fake.SetTraceStub != nil {
+	if stub != nil {
 		fake.SetTraceStub(arg1)
 	}
 }
@@ -2852,9 +3200,10 @@ func (fake *FakeConfig) SetUAAClientCredentials(arg1 string, arg2 string) {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.SetUAAClientCredentialsStub
 	fake.recordInvocation("SetUAAClientCredentials", []interface{}{arg1, arg2})
 	fake.setUAAClientCredentialsMutex.Unlock()
-	if fake.SetUAAClientCredentialsStub != nil {
+	if stub != nil {
 		fake.SetUAAClientCredentialsStub(arg1, arg2)
 	}
 }
@@ -2883,9 +3232,10 @@ func (fake *FakeConfig) SetUAAEndpoint(arg1 string) {
 	fake.setUAAEndpointArgsForCall = append(fake.setUAAEndpointArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetUAAEndpointStub
 	fake.recordInvocation("SetUAAEndpoint", []interface{}{arg1})
 	fake.setUAAEndpointMutex.Unlock()
-	if fake.SetUAAEndpointStub != nil {
+	if stub != nil {
 		fake.SetUAAEndpointStub(arg1)
 	}
 }
@@ -2914,9 +3264,10 @@ func (fake *FakeConfig) SetUAAGrantType(arg1 string) {
 	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
 		arg1 string
 	}{arg1})
+	stub := fake.SetUAAGrantTypeStub
 	fake.recordInvocation("SetUAAGrantType", []interface{}{arg1})
 	fake.setUAAGrantTypeMutex.Unlock()
-	if fake.SetUAAGrantTypeStub != nil {
+	if stub != nil {
 		fake.SetUAAGrantTypeStub(arg1)
 	}
 }
@@ -2945,15 +3296,16 @@ func (fake *FakeConfig) SkipSSLValidation() bool {
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
 	fake.skipSSLValidationArgsForCall = append(fake.skipSSLValidationArgsForCall, struct {
 	}{})
+	stub := fake.SkipSSLValidationStub
+	fakeReturns := fake.skipSSLValidationReturns
 	fake.recordInvocation("SkipSSLValidation", []interface{}{})
 	fake.skipSSLValidationMutex.Unlock()
-	if fake.SkipSSLValidationStub != nil {
-		return fake.SkipSSLValidationStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.skipSSLValidationReturns
 	return fakeReturns.result1
 }
 
@@ -2997,15 +3349,16 @@ func (fake *FakeConfig) StagingTimeout() time.Duration {
 	ret, specificReturn := fake.stagingTimeoutReturnsOnCall[len(fake.stagingTimeoutArgsForCall)]
 	fake.stagingTimeoutArgsForCall = append(fake.stagingTimeoutArgsForCall, struct {
 	}{})
+	stub := fake.StagingTimeoutStub
+	fakeReturns := fake.stagingTimeoutReturns
 	fake.recordInvocation("StagingTimeout", []interface{}{})
 	fake.stagingTimeoutMutex.Unlock()
-	if fake.StagingTimeoutStub != nil {
-		return fake.StagingTimeoutStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.stagingTimeoutReturns
 	return fakeReturns.result1
 }
 
@@ -3049,15 +3402,16 @@ func (fake *FakeConfig) StartupTimeout() time.Duration {
 	ret, specificReturn := fake.startupTimeoutReturnsOnCall[len(fake.startupTimeoutArgsForCall)]
 	fake.startupTimeoutArgsForCall = append(fake.startupTimeoutArgsForCall, struct {
 	}{})
+	stub := fake.StartupTimeoutStub
+	fakeReturns := fake.startupTimeoutReturns
 	fake.recordInvocation("StartupTimeout", []interface{}{})
 	fake.startupTimeoutMutex.Unlock()
-	if fake.StartupTimeoutStub != nil {
-		return fake.StartupTimeoutStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.startupTimeoutReturns
 	return fakeReturns.result1
 }
 
@@ -3096,20 +3450,82 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) SwitchContext(arg1 string) error {
+	fake.switchContextMutex.Lock()
+	ret, specificReturn := fake.switchContextReturnsOnCall[len(fake.switchContextArgsForCall)]
+	fake.switchContextArgsForCall = append(fake.switchContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SwitchContextStub
+	fakeReturns := fake.switchContextReturns
+	fake.recordInvocation("SwitchContext", []interface{}{arg1})
+	fake.switchContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SwitchContextCallCount() int {
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
+	return len(fake.switchContextArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchContextCalls(stub func(string) error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = stub
+}
+
+func (fake *FakeConfig) SwitchContextArgsForCall(i int) string {
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
+	argsForCall := fake.switchContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SwitchContextReturns(result1 error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = nil
+	fake.switchContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchContextReturnsOnCall(i int, result1 error) {
+	fake.switchContextMutex.Lock()
+	defer fake.switchContextMutex.Unlock()
+	fake.SwitchContextStub = nil
+	if fake.switchContextReturnsOnCall == nil {
+		fake.switchContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.switchContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
 	fake.targetArgsForCall = append(fake.targetArgsForCall, struct {
 	}{})
+	stub := fake.TargetStub
+	fakeReturns := fake.targetReturns
 	fake.recordInvocation("Target", []interface{}{})
 	fake.targetMutex.Unlock()
-	if fake.TargetStub != nil {
-		return fake.TargetStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.targetReturns
 	return fakeReturns.result1
 }
 
@@ -3153,15 +3569,16 @@ func (fake *FakeConfig) TargetedOrganization() configv3.Organization {
 	ret, specificReturn := fake.targetedOrganizationReturnsOnCall[len(fake.targetedOrganizationArgsForCall)]
 	fake.targetedOrganizationArgsForCall = append(fake.targetedOrganizationArgsForCall, struct {
 	}{})
+	stub := fake.TargetedOrganizationStub
+	fakeReturns := fake.targetedOrganizationReturns
 	fake.recordInvocation("TargetedOrganization", []interface{}{})
 	fake.targetedOrganizationMutex.Unlock()
-	if fake.TargetedOrganizationStub != nil {
-		return fake.TargetedOrganizationStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.targetedOrganizationReturns
 	return fakeReturns.result1
 }
 
@@ -3205,15 +3622,16 @@ func (fake *FakeConfig) TargetedOrganizationName() string {
 	ret, specificReturn := fake.targetedOrganizationNameReturnsOnCall[len(fake.targetedOrganizationNameArgsForCall)]
 	fake.targetedOrganizationNameArgsForCall = append(fake.targetedOrganizationNameArgsForCall, struct {
 	}{})
+	stub := fake.TargetedOrganizationNameStub
+	fakeReturns := fake.targetedOrganizationNameReturns
 	fake.recordInvocation("TargetedOrganizationName", []interface{}{})
 	fake.targetedOrganizationNameMutex.Unlock()
-	if fake.TargetedOrganizationNameStub != nil {
-		return fake.TargetedOrganizationNameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.targetedOrganizationNameReturns
 	return fakeReturns.result1
 }
 
@@ -3257,15 +3675,16 @@ func (fake *FakeConfig) TargetedSpace() configv3.Space {
 	ret, specificReturn := fake.targetedSpaceReturnsOnCall[len(fake.targetedSpaceArgsForCall)]
 	fake.targetedSpaceArgsForCall = append(fake.targetedSpaceArgsForCall, struct {
 	}{})
+	stub := fake.TargetedSpaceStub
+	fakeReturns := fake.targetedSpaceReturns
 	fake.recordInvocation("TargetedSpace", []interface{}{})
 	fake.targetedSpaceMutex.Unlock()
-	if fake.TargetedSpaceStub != nil {
-		return fake.TargetedSpaceStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.targetedSpaceReturns
 	return fakeReturns.result1
 }
 
@@ -3309,15 +3728,16 @@ func (fake *FakeConfig) TerminalWidth() int {
 	ret, specificReturn := fake.terminalWidthReturnsOnCall[len(fake.terminalWidthArgsForCall)]
 	fake.terminalWidthArgsForCall = append(fake.terminalWidthArgsForCall, struct {
 	}{})
+	stub := fake.TerminalWidthStub
+	fakeReturns := fake.terminalWidthReturns
 	fake.recordInvocation("TerminalWidth", []interface{}{})
 	fake.terminalWidthMutex.Unlock()
-	if fake.TerminalWidthStub != nil {
-		return fake.TerminalWidthStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.terminalWidthReturns
 	return fakeReturns.result1
 }
 
@@ -3361,15 +3781,16 @@ func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
 	fake.uAADisableKeepAlivesArgsForCall = append(fake.uAADisableKeepAlivesArgsForCall, struct {
 	}{})
+	stub := fake.UAADisableKeepAlivesStub
+	fakeReturns := fake.uAADisableKeepAlivesReturns
 	fake.recordInvocation("UAADisableKeepAlives", []interface{}{})
 	fake.uAADisableKeepAlivesMutex.Unlock()
-	if fake.UAADisableKeepAlivesStub != nil {
-		return fake.UAADisableKeepAlivesStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.uAADisableKeepAlivesReturns
 	return fakeReturns.result1
 }
 
@@ -3413,15 +3834,16 @@ func (fake *FakeConfig) UAAEndpoint() string {
 	ret, specificReturn := fake.uAAEndpointReturnsOnCall[len(fake.uAAEndpointArgsForCall)]
 	fake.uAAEndpointArgsForCall = append(fake.uAAEndpointArgsForCall, struct {
 	}{})
+	stub := fake.UAAEndpointStub
+	fakeReturns := fake.uAAEndpointReturns
 	fake.recordInvocation("UAAEndpoint", []interface{}{})
 	fake.uAAEndpointMutex.Unlock()
-	if fake.UAAEndpointStub != nil {
-		return fake.UAAEndpointStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.uAAEndpointReturns
 	return fakeReturns.result1
 }
 
@@ -3465,15 +3887,16 @@ func (fake *FakeConfig) UAAGrantType() string {
 	ret, specificReturn := fake.uAAGrantTypeReturnsOnCall[len(fake.uAAGrantTypeArgsForCall)]
 	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct {
 	}{})
+	stub := fake.UAAGrantTypeStub
+	fakeReturns := fake.uAAGrantTypeReturns
 	fake.recordInvocation("UAAGrantType", []interface{}{})
 	fake.uAAGrantTypeMutex.Unlock()
-	if fake.UAAGrantTypeStub != nil {
-		return fake.UAAGrantTypeStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.uAAGrantTypeReturns
 	return fakeReturns.result1
 }
 
@@ -3517,15 +3940,16 @@ func (fake *FakeConfig) UAAOAuthClient() string {
 	ret, specificReturn := fake.uAAOAuthClientReturnsOnCall[len(fake.uAAOAuthClientArgsForCall)]
 	fake.uAAOAuthClientArgsForCall = append(fake.uAAOAuthClientArgsForCall, struct {
 	}{})
+	stub := fake.UAAOAuthClientStub
+	fakeReturns := fake.uAAOAuthClientReturns
 	fake.recordInvocation("UAAOAuthClient", []interface{}{})
 	fake.uAAOAuthClientMutex.Unlock()
-	if fake.UAAOAuthClientStub != nil {
-		return fake.UAAOAuthClientStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.uAAOAuthClientReturns
 	return fakeReturns.result1
 }
 
@@ -3569,15 +3993,16 @@ func (fake *FakeConfig) UAAOAuthClientSecret() string {
 	ret, specificReturn := fake.uAAOAuthClientSecretReturnsOnCall[len(fake.uAAOAuthClientSecretArgsForCall)]
 	fake.uAAOAuthClientSecretArgsForCall = append(fake.uAAOAuthClientSecretArgsForCall, struct {
 	}{})
+	stub := fake.UAAOAuthClientSecretStub
+	fakeReturns := fake.uAAOAuthClientSecretReturns
 	fake.recordInvocation("UAAOAuthClientSecret", []interface{}{})
 	fake.uAAOAuthClientSecretMutex.Unlock()
-	if fake.UAAOAuthClientSecretStub != nil {
-		return fake.UAAOAuthClientSecretStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.uAAOAuthClientSecretReturns
 	return fakeReturns.result1
 }
 
@@ -3620,9 +4045,10 @@ func (fake *FakeConfig) UnsetOrganizationAndSpaceInformation() {
 	fake.unsetOrganizationAndSpaceInformationMutex.Lock()
 	fake.unsetOrganizationAndSpaceInformationArgsForCall = append(fake.unsetOrganizationAndSpaceInformationArgsForCall, struct {
 	}{})
+	stub := fake.UnsetOrganizationAndSpaceInformationStub
 	fake.recordInvocation("UnsetOrganizationAndSpaceInformation", []interface{}{})
 	fake.unsetOrganizationAndSpaceInformationMutex.Unlock()
-	if fake.UnsetOrganizationAndSpaceInformationStub != nil {
+	if stub != nil {
 		fake.UnsetOrganizationAndSpaceInformationStub()
 	}
 }
@@ -3643,9 +4069,10 @@ func (fake *FakeConfig) UnsetSpaceInformation() {
 	fake.unsetSpaceInformationMutex.Lock()
 	fake.unsetSpaceInformationArgsForCall = append(fake.unsetSpaceInformationArgsForCall, struct {
 	}{})
+	stub := fake.UnsetSpaceInformationStub
 	fake.recordInvocation("UnsetSpaceInformation", []interface{}{})
 	fake.unsetSpaceInformationMutex.Unlock()
-	if fake.UnsetSpaceInformationStub != nil {
+	if stub != nil {
 		fake.UnsetSpaceInformationStub()
 	}
 }
@@ -3666,9 +4093,10 @@ func (fake *FakeConfig) UnsetUserInformation() {
 	fake.unsetUserInformationMutex.Lock()
 	fake.unsetUserInformationArgsForCall = append(fake.unsetUserInformationArgsForCall, struct {
 	}{})
+	stub := fake.UnsetUserInformationStub
 	fake.recordInvocation("UnsetUserInformation", []interface{}{})
 	fake.unsetUserInformationMutex.Unlock()
-	if fake.UnsetUserInformationStub != nil {
+	if stub != nil {
 		fake.UnsetUserInformationStub()
 	}
 }
@@ -3691,9 +4119,10 @@ func (fake *FakeConfig) V7SetSpaceInformation(arg1 string, arg2 string) {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.V7SetSpaceInformationStub
 	fake.recordInvocation("V7SetSpaceInformation", []interface{}{arg1, arg2})
 	fake.v7SetSpaceInformationMutex.Unlock()
-	if fake.V7SetSpaceInformationStub != nil {
+	if stub != nil {
 		fake.V7SetSpaceInformationStub(arg1, arg2)
 	}
 }
@@ -3722,15 +4151,16 @@ func (fake *FakeConfig) Verbose() (bool, []string) {
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
 	fake.verboseArgsForCall = append(fake.verboseArgsForCall, struct {
 	}{})
+	stub := fake.VerboseStub
+	fakeReturns := fake.verboseReturns
 	fake.recordInvocation("Verbose", []interface{}{})
 	fake.verboseMutex.Unlock()
-	if fake.VerboseStub != nil {
-		return fake.VerboseStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.verboseReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -3777,15 +4207,16 @@ func (fake *FakeConfig) WriteConfig() error {
 	ret, specificReturn := fake.writeConfigReturnsOnCall[len(fake.writeConfigArgsForCall)]
 	fake.writeConfigArgsForCall = append(fake.writeConfigArgsForCall, struct {
 	}{})
+	stub := fake.WriteConfigStub
+	fakeReturns := fake.writeConfigReturns
 	fake.recordInvocation("WriteConfig", []interface{}{})
 	fake.writeConfigMutex.Unlock()
-	if fake.WriteConfigStub != nil {
-		return fake.WriteConfigStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.writeConfigReturns
 	return fakeReturns.result1
 }
 
@@ -3829,15 +4260,16 @@ func (fake *FakeConfig) WritePluginConfig() error {
 	ret, specificReturn := fake.writePluginConfigReturnsOnCall[len(fake.writePluginConfigArgsForCall)]
 	fake.writePluginConfigArgsForCall = append(fake.writePluginConfigArgsForCall, struct {
 	}{})
+	stub := fake.WritePluginConfigStub
+	fakeReturns := fake.writePluginConfigReturns
 	fake.recordInvocation("WritePluginConfig", []interface{}{})
 	fake.writePluginConfigMutex.Unlock()
-	if fake.WritePluginConfigStub != nil {
-		return fake.WritePluginConfigStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.writePluginConfigReturns
 	return fakeReturns.result1
 }
 
@@ -3899,6 +4331,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.cFUsernameMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	fake.createContextMutex.RLock()
+	defer fake.createContextMutex.RUnlock()
+	fake.currentContextMutex.RLock()
+	defer fake.currentContextMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
@@ -3931,6 +4369,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.nOAARequestRetryCountMutex.RUnlock()
 	fake.networkPolicyV1EndpointMutex.RLock()
 	defer fake.networkPolicyV1EndpointMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
@@ -3945,6 +4385,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.reproducibleArchivesMutex.RLock()
+	defer fake.reproducibleArchivesMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
 	fake.routingEndpointMutex.RLock()
@@ -3987,6 +4429,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
 	defer fake.startupTimeoutMutex.RUnlock()
+	fake.switchContextMutex.RLock()
+	defer fake.switchContextMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
 	fake.targetedOrganizationMutex.RLock()
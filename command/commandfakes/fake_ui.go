@@ -226,6 +226,17 @@ type FakeUI struct {
 	displayWarningsArgsForCall []struct {
 		arg1 []string
 	}
+	DisplayYAMLStub        func(interface{}) error
+	displayYAMLMutex       sync.RWMutex
+	displayYAMLArgsForCall []struct {
+		arg1 interface{}
+	}
+	displayYAMLReturns struct {
+		result1 error
+	}
+	displayYAMLReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetErrStub        func() io.Writer
 	getErrMutex       sync.RWMutex
 	getErrArgsForCall []struct {
@@ -737,15 +748,16 @@ func (fake *FakeUI) DisplayJSON(arg1 string, arg2 interface{}) error {
 		arg1 string
 		arg2 interface{}
 	}{arg1, arg2})
+	stub := fake.DisplayJSONStub
+	fakeReturns := fake.displayJSONReturns
 	fake.recordInvocation("DisplayJSON", []interface{}{arg1, arg2})
 	fake.displayJSONMutex.Unlock()
-	if fake.DisplayJSONStub != nil {
-		return fake.DisplayJSONStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.displayJSONReturns
 	return fakeReturns.result1
 }
 
@@ -1462,6 +1474,67 @@ func (fake *FakeUI) DisplayWarningsArgsForCall(i int) []string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeUI) DisplayYAML(arg1 interface{}) error {
+	fake.displayYAMLMutex.Lock()
+	ret, specificReturn := fake.displayYAMLReturnsOnCall[len(fake.displayYAMLArgsForCall)]
+	fake.displayYAMLArgsForCall = append(fake.displayYAMLArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	stub := fake.DisplayYAMLStub
+	fakeReturns := fake.displayYAMLReturns
+	fake.recordInvocation("DisplayYAML", []interface{}{arg1})
+	fake.displayYAMLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayYAMLCallCount() int {
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
+	return len(fake.displayYAMLArgsForCall)
+}
+
+func (fake *FakeUI) DisplayYAMLCalls(stub func(interface{}) error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = stub
+}
+
+func (fake *FakeUI) DisplayYAMLArgsForCall(i int) interface{} {
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
+	argsForCall := fake.displayYAMLArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayYAMLReturns(result1 error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = nil
+	fake.displayYAMLReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayYAMLReturnsOnCall(i int, result1 error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = nil
+	if fake.displayYAMLReturnsOnCall == nil {
+		fake.displayYAMLReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayYAMLReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) GetErr() io.Writer {
 	fake.getErrMutex.Lock()
 	ret, specificReturn := fake.getErrReturnsOnCall[len(fake.getErrArgsForCall)]
@@ -1975,6 +2048,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayWarningMutex.RUnlock()
 	fake.displayWarningsMutex.RLock()
 	defer fake.displayWarningsMutex.RUnlock()
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
 	fake.getErrMutex.RLock()
 	defer fake.getErrMutex.RUnlock()
 	fake.getInMutex.RLock()
@@ -3,6 +3,7 @@ package common
 import (
 	"reflect"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/plugin"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 )
@@ -11,7 +12,9 @@ var Commands commandList
 var ShouldFallbackToLegacy = false
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool              `short:"v" long:"version" description:"verbose and version flag"`
+	Output           flag.OutputFormat `long:"output" description:"Print list and detail commands as json or yaml"`
+	Context          string            `long:"context" description:"Run the command against the named context"`
 
 	V3Push v7.PushCommand `command:"v3-push" description:"Push a new app or sync changes to an existing app" hidden:"true"`
 
@@ -19,9 +22,13 @@ type commandList struct {
 	AddNetworkPolicy                   v7.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AllowSpaceSSH                      v7.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
+	Annotations                        v7.AnnotationsCommand                        `command:"annotations" description:"List all annotations (key-value pairs) for an API resource"`
 	App                                v7.AppCommand                                `command:"app" description:"Display health and status for an app"`
+	AppMetrics                         v7.AppMetricsCommand                         `command:"app-metrics" description:"Display live metrics for an app"`
 	ApplyManifest                      v7.ApplyManifestCommand                      `command:"apply-manifest" description:"Apply manifest properties to a space"`
+	ApplyNetworkPolicies               v7.ApplyNetworkPoliciesCommand               `command:"apply-network-policies" description:"Add network policies from a file to the target space, optionally removing the ones not in it"`
 	Apps                               v7.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
+	AuditEvents                        v7.AuditEventsCommand                        `command:"audit-events" description:"List audit events across orgs and spaces"`
 	Auth                               v7.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v7.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
 	BindRunningSecurityGroup           v7.BindRunningSecurityGroupCommand           `command:"bind-running-security-group" description:"Bind a security group to the list of security groups to be used for running applications"`
@@ -32,6 +39,8 @@ type commandList struct {
 	CancelDeployment                   v7.CancelDeploymentCommand                   `command:"cancel-deployment" description:"Cancel the most recent deployment for an app. Resets the current droplet to the previous deployment's droplet."`
 	CheckRoute                         v7.CheckRouteCommand                         `command:"check-route" description:"Perform a check to determine whether a route currently exists or not"`
 	Config                             v7.ConfigCommand                             `command:"config" description:"Write default values to the config"`
+	Contexts                           v7.ContextsCommand                           `command:"contexts" description:"List the saved contexts"`
+	ContinueDeployment                 v7.ContinueDeploymentCommand                 `command:"continue-deployment" description:"Continue the most recent deployment for an app."`
 	CopySource                         v7.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application and restages that application"`
 	CreateApp                          v7.CreateAppCommand                          `command:"create-app" description:"Create an Application in the target space"`
 	CreateAppManifest                  v7.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
@@ -47,6 +56,7 @@ type commandList struct {
 	CreateServiceBroker                v7.CreateServiceBrokerCommand                `command:"create-service-broker" alias:"csb" description:"Create a service broker"`
 	CreateServiceKey                   v7.CreateServiceKeyCommand                   `command:"create-service-key" alias:"csk" description:"Create key for a service instance"`
 	CreateSharedDomain                 v7.CreateSharedDomainCommand                 `command:"create-shared-domain" description:"Create a domain that can be used by all orgs (admin-only)"`
+	CreateSidecar                      v7.CreateSidecarCommand                      `command:"create-sidecar" description:"Create a sidecar process for an app"`
 	CreateSpace                        v7.CreateSpaceCommand                        `command:"create-space" alias:"csp" description:"Create a space"`
 	CreateSpaceQuota                   v7.CreateSpaceQuotaCommand                   `command:"create-space-quota" description:"Define a new quota for a space"`
 	CreateUser                         v7.CreateUserCommand                         `command:"create-user" description:"Create a new user"`
@@ -65,6 +75,7 @@ type commandList struct {
 	DeleteServiceBroker                v7.DeleteServiceBrokerCommand                `command:"delete-service-broker" description:"Delete a service broker"`
 	DeleteServiceKey                   v7.DeleteServiceKeyCommand                   `command:"delete-service-key" alias:"dsk" description:"Delete a service key"`
 	DeleteSharedDomain                 v7.DeleteSharedDomainCommand                 `command:"delete-shared-domain" description:"Delete a shared domain"`
+	DeleteSidecar                      v7.DeleteSidecarCommand                      `command:"delete-sidecar" description:"Delete a sidecar process from an app"`
 	DeleteSpace                        v7.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteSpaceQuota                   v7.DeleteSpaceQuotaCommand                   `command:"delete-space-quota" description:"Delete a space quota"`
 	DeleteUser                         v7.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
@@ -82,6 +93,7 @@ type commandList struct {
 	EnableServiceAccess                v7.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service offering or service plan for one or all orgs"`
 	Env                                v7.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v7.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportNetworkPolicies              v7.ExportNetworkPoliciesCommand              `command:"export-network-policies" description:"Export the network policies of the target space to a file"`
 	FeatureFlag                        v7.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	FeatureFlags                       v7.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	GetHealthCheck                     v7.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
@@ -108,6 +120,7 @@ type commandList struct {
 	PurgeServiceInstance               v7.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v7.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service offering and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v7.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
+	RebindService                      v7.RebindServiceCommand                      `command:"rebind-service" description:"Replace the binding between an app and a service instance with a new one"`
 	RemoveNetworkPolicy                v7.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	Rename                             v7.RenameCommand                             `command:"rename" description:"Rename an app"`
@@ -125,12 +138,15 @@ type commandList struct {
 	StagePackage                       v7.StagePackageCommand                       `command:"stage-package" alias:"stage" description:"Stage a package into a droplet"`
 	Restart                            v7.RestartCommand                            `command:"restart" alias:"rs" description:"Stop all instances of the app, then start them again."`
 	RestartAppInstance                 v7.RestartAppInstanceCommand                 `command:"restart-app-instance" description:"Terminate, then instantiate an app instance"`
+	RotateServiceKey                   v7.RotateServiceKeyCommand                   `command:"rotate-service-key" description:"Replace a service key with a new one of the same name"`
 	RouterGroups                       v7.RouterGroupsCommand                       `command:"router-groups" description:"List router groups"`
 	Route                              v7.RouteCommand                              `command:"route" alias:"ro" description:"Display route details and mapped destinations"`
 	Routes                             v7.RoutesCommand                             `command:"routes" alias:"r" description:"List all routes in the current space or the current organization"`
+	RunScheduledTasks                  v7.RunScheduledTasksCommand                  `command:"run-scheduled-tasks" description:"Run the tasks from a manifest whose schedule is due"`
 	RunTask                            v7.RunTaskCommand                            `command:"run-task" alias:"rt" description:"Run a one-off task on an app"`
 	RunningEnvironmentVariableGroup    v7.RunningEnvironmentVariableGroupCommand    `command:"running-environment-variable-group" alias:"revg" description:"Retrieve the contents of the running environment variable group"`
 	RunningSecurityGroups              v7.RunningSecurityGroupsCommand              `command:"running-security-groups" description:"List security groups globally configured for running applications"`
+	SCP                                v7.SCPCommand                                `command:"scp" description:"Copy files to or from an application container instance"`
 	SSH                                v7.SSHCommand                                `command:"ssh" description:"SSH to an application container instance"`
 	SSHCode                            v7.SSHCodeCommand                            `command:"ssh-code" description:"Get a one time password for ssh clients"`
 	SSHEnabled                         v7.SSHEnabledCommand                         `command:"ssh-enabled" description:"Reports whether SSH is enabled on an application container instance"`
@@ -143,6 +159,7 @@ type commandList struct {
 	ServiceKey                         v7.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
 	ServiceKeys                        v7.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
 	Services                           v7.ServicesCommand                           `command:"services" alias:"s" description:"List all service instances in the target space"`
+	SetAnnotation                      v7.SetAnnotationCommand                      `command:"set-annotation" description:"Set an annotation (key-value pairs) for an API resource"`
 	SetDroplet                         v7.SetDropletCommand                         `command:"set-droplet" description:"Set the droplet used to run an app"`
 	SetEnv                             v7.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
 	SetHealthCheck                     v7.SetHealthCheckCommand                     `command:"set-health-check" description:"Change type of health check performed on an app's process"`
@@ -158,6 +175,7 @@ type commandList struct {
 	SharePrivateDomain                 v7.SharePrivateDomainCommand                 `command:"share-private-domain" description:"Share a private domain with a specific org"`
 	ShareService                       v7.ShareServiceCommand                       `command:"share-service" description:"Share a service instance with another space"`
 	ShareRoute                         v7.ShareRouteCommand                         `command:"share-route" description:"Share a route in between spaces"`
+	Sidecars                           v7.SidecarsCommand                           `command:"sidecars" description:"List the sidecar processes of an app"`
 	Space                              v7.SpaceCommand                              `command:"space" description:"Show space info"`
 	SpaceQuota                         v7.SpaceQuotaCommand                         `command:"space-quota" description:"Show space quota info"`
 	SpaceQuotas                        v7.SpaceQuotasCommand                        `command:"space-quotas" description:"List available space quotas"`
@@ -171,6 +189,7 @@ type commandList struct {
 	Start                              v7.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v7.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
 	Target                             v7.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
+	TaskLogs                           v7.TaskLogsCommand                           `command:"task-logs" description:"Show the logs of a task, following them until the task completes"`
 	Tasks                              v7.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v7.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
 	MoveRoute                          v7.MoveRouteCommand                          `command:"move-route" description:"Assign a route to a different space"`
@@ -181,6 +200,7 @@ type commandList struct {
 	UnbindStagingSecurityGroup         v7.UnbindStagingSecurityGroupCommand         `command:"unbind-staging-security-group" description:"Unbind a security group from the set of security groups for staging applications globally"`
 	UninstallPlugin                    plugin.UninstallPluginCommand                `command:"uninstall-plugin" description:"Uninstall CLI plugin"`
 	UnmapRoute                         v7.UnmapRouteCommand                         `command:"unmap-route" description:"Remove a route from an app"`
+	UnsetAnnotation                    v7.UnsetAnnotationCommand                    `command:"unset-annotation" description:"Unset an annotation (key-value pairs) for an API resource"`
 	UnsetEnv                           v7.UnsetEnvCommand                           `command:"unset-env" alias:"ue" description:"Remove an env variable from an app"`
 	UnsetLabel                         v7.UnsetLabelCommand                         `command:"unset-label" description:"Unset a label (key-value pairs) for an API resource"`
 	UnsetOrgRole                       v7.UnsetOrgRoleCommand                       `command:"unset-org-role" description:"Remove an org role from a user"`
@@ -191,6 +211,7 @@ type commandList struct {
 	UnshareService                     v7.UnshareServiceCommand                     `command:"unshare-service" description:"Unshare a shared service instance from a space"`
 	UpdateBuildpack                    v7.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
 	UpdateDestination                  v7.UpdateDestinationCommand                  `command:"update-destination" description:"Updates the destination protocol for a route"`
+	UpdateRoute                        v7.UpdateRouteCommand                        `command:"update-route" description:"Change or remove the per-route options of a route"`
 	UpdateOrgQuota                     v7.UpdateOrgQuotaCommand                     `command:"update-org-quota" alias:"update-quota" description:"Update an existing organization quota"`
 	UpdateSecurityGroup                v7.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateService                      v7.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
@@ -198,6 +219,8 @@ type commandList struct {
 	UpdateServiceBroker                v7.UpdateServiceBrokerCommand                `command:"update-service-broker" description:"Update a service broker"`
 	UpdateSpaceQuota                   v7.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v7.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UsageEvents                        v7.UsageEventsCommand                        `command:"usage-events" description:"Export app or service usage events"`
+	UseContext                         v7.UseContextCommand                         `command:"use-context" description:"Switch to a saved context, or create a new one"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
@@ -321,6 +321,7 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_DIAL_TIMEOUT=6", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_REPRODUCIBLE_ARCHIVES=true", cmd.UI.TranslateText("Build app bits archives with sorted entries and normalized timestamps and permissions")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
@@ -332,6 +333,8 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--output FORMAT", cmd.UI.TranslateText("Print list and detail commands as json or yaml")},
+		{"--context NAME", cmd.UI.TranslateText("Run the command against the named context")},
 	}
 }
 
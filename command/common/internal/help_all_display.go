@@ -6,6 +6,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"contexts", "use-context"},
 		},
 	},
 	{
@@ -13,16 +14,17 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"apps", "app", "create-app"},
 			{"push", "scale", "delete", "rename"},
-			{"cancel-deployment"},
+			{"cancel-deployment", "continue-deployment"},
 			{"start", "stop", "restart", "stage-package", "restage", "restart-app-instance"},
-			{"run-task", "tasks", "terminate-task"},
+			{"run-task", "tasks", "task-logs", "terminate-task", "run-scheduled-tasks"},
+			{"sidecars", "create-sidecar", "delete-sidecar"},
 			{"packages", "create-package"},
 			{"droplets", "set-droplet", "download-droplet"},
-			{"events", "logs"},
+			{"events", "logs", "app-metrics"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp"},
 		},
 	},
 	{
@@ -30,8 +32,8 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"marketplace", "services", "service"},
 			{"create-service", "update-service", "upgrade-service", "delete-service", "rename-service"},
-			{"create-service-key", "service-keys", "service-key", "delete-service-key"},
-			{"bind-service", "unbind-service"},
+			{"create-service-key", "service-keys", "service-key", "delete-service-key", "rotate-service-key"},
+			{"bind-service", "unbind-service", "rebind-service"},
 			{"bind-route-service", "unbind-route-service"},
 			{"create-user-provided-service", "update-user-provided-service"},
 			{"share-service", "unshare-service"},
@@ -65,7 +67,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
 			{"routes", "route"},
-			{"create-route", "check-route", "map-route", "unmap-route", "delete-route"},
+			{"create-route", "check-route", "map-route", "unmap-route", "update-route", "delete-route"},
 			{"delete-orphaned-routes"},
 			{"update-destination"},
 			{"share-route", "unshare-route"},
@@ -76,6 +78,7 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "NETWORK POLICIES:",
 		CommandList: [][]string{
 			{"network-policies", "add-network-policy", "remove-network-policy"},
+			{"export-network-policies", "apply-network-policies"},
 		},
 	},
 	{
@@ -146,12 +149,14 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "METADATA:",
 		CommandList: [][]string{
 			{"labels", "set-label", "unset-label"},
+			{"annotations", "set-annotation", "unset-annotation"},
 		},
 	},
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
 			{"curl", "config", "oauth-token", "ssh-code"},
+			{"audit-events", "usage-events"},
 		},
 	},
 	{
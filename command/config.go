@@ -20,6 +20,9 @@ type Config interface {
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	Contexts() []configv3.NamedContext
+	CreateContext(name string)
+	CurrentContext() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
 	DialTimeout() time.Duration
@@ -35,6 +38,7 @@ type Config interface {
 	MinCLIVersion() string
 	NOAARequestRetryCount() int
 	NetworkPolicyV1Endpoint() string
+	OutputFormat() configv3.OutputFormat
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
@@ -42,6 +46,7 @@ type Config interface {
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	ReproducibleArchives() bool
 	RequestRetryCount() int
 	RoutingEndpoint() string
 	SetAsyncTimeout(timeout int)
@@ -63,6 +68,7 @@ type Config interface {
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
+	SwitchContext(name string) error
 	// TODO: Rename to APITarget()
 	Target() string
 	TargetedOrganization() configv3.Organization
@@ -0,0 +1,9 @@
+package flag
+
+import flags "github.com/jessevdk/go-flags"
+
+type AppLifecycle string
+
+func (AppLifecycle) Complete(prefix string) []flags.Completion {
+	return completions([]string{"buildpack", "cnb"}, prefix, false)
+}
@@ -0,0 +1,32 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AppLifecycle", func() {
+	var lifecycle AppLifecycle
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := lifecycle.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'buildpack' when passed 'b'", "b",
+				[]flags.Completion{{Item: "buildpack"}}),
+			Entry("completes to 'cnb' when passed 'c'", "c",
+				[]flags.Completion{{Item: "cnb"}}),
+			Entry("completes to 'cnb' when passed 'cN'", "cN",
+				[]flags.Completion{{Item: "cnb"}}),
+			Entry("returns 'buildpack' and 'cnb' when passed nothing", "",
+				[]flags.Completion{{Item: "buildpack"}, {Item: "cnb"}}),
+			Entry("completes to nothing when passed 'docker'", "docker",
+				[]flags.Completion{}),
+		)
+	})
+})
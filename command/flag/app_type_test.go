@@ -25,8 +25,10 @@ var _ = Describe("AppType", func() {
 				[]flags.Completion{{Item: "buildpack"}}),
 			Entry("completes to 'docker' when passed 'Do'", "Do",
 				[]flags.Completion{{Item: "docker"}}),
-			Entry("returns 'buildpack' and 'docker' when passed nothing", "",
-				[]flags.Completion{{Item: "buildpack"}, {Item: "docker"}}),
+			Entry("completes to 'cnb' when passed 'c'", "c",
+				[]flags.Completion{{Item: "cnb"}}),
+			Entry("returns 'buildpack', 'docker' and 'cnb' when passed nothing", "",
+				[]flags.Completion{{Item: "buildpack"}, {Item: "docker"}, {Item: "cnb"}}),
 			Entry("completes to nothing when passed 'wut'", "wut",
 				[]flags.Completion{}),
 		)
@@ -288,6 +288,24 @@ type UnsetLabelArgs struct {
 	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
 	LabelKeys    []string `positional-arg-name:"KEY" required:"true" description:"A label to unset on the resource"`
 }
+
+type AnnotationsArgs struct {
+	ResourceType string `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource to annotate"`
+	ResourceName string `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+}
+
+type SetAnnotationArgs struct {
+	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource to annotate"`
+	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	Annotations  []string `positional-arg-name:"KEY=VALUE" description:"A space-separated list of annotations to set on the resource"`
+}
+
+type UnsetAnnotationArgs struct {
+	ResourceType   string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource"`
+	ResourceName   string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	AnnotationKeys []string `positional-arg-name:"KEY" required:"true" description:"An annotation to unset on the resource"`
+}
+
 type OrgRoleArgs struct {
 	Username     string  `positional-arg-name:"USERNAME" required:"true" description:"The user"`
 	Organization string  `positional-arg-name:"ORG" required:"true" description:"The organization"`
@@ -364,11 +382,30 @@ type RunTaskArgsV7 struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+type ContextArgs struct {
+	Name string `positional-arg-name:"CONTEXT_NAME" required:"true" description:"The context name"`
+}
+
+type SCPArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The local path, or APP_NAME:PATH for a path in the app instance"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"The local path, or APP_NAME:PATH for a path in the app instance"`
+}
+
+type TaskLogsArgs struct {
+	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	Task    string `positional-arg-name:"TASK" required:"true" description:"The task's sequence ID or name"`
+}
+
 type TerminateTaskArgs struct {
 	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	SequenceID string `positional-arg-name:"TASK_ID" required:"true" description:"The task's unique sequence ID"`
 }
 
+type SidecarArgs struct {
+	AppName     string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	SidecarName string `positional-arg-name:"SIDECAR_NAME" required:"true" description:"The sidecar name"`
+}
+
 type IsolationSegmentName struct {
 	IsolationSegmentName string `positional-arg-name:"SEGMENT_NAME" required:"true" description:"The isolation segment name"`
 }
@@ -400,6 +437,10 @@ type AddNetworkPolicyArgsV7 struct {
 	DestApp   string `positional-arg-name:"DESTINATION_APP" required:"true" description:"The destination app"`
 }
 
+type ApplyNetworkPoliciesArgs struct {
+	Path PathWithExistenceCheck `positional-arg-name:"PATH" required:"true" description:"Path to the network policies file"`
+}
+
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
@@ -408,3 +449,7 @@ type RemoveNetworkPolicyArgsV7 struct {
 	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
 	DestApp   string `positional-arg-name:"DESTINATION_APP" required:"true" description:"The destination app"`
 }
+
+type UsageEventsArgs struct {
+	EventType string `positional-arg-name:"TYPE" required:"true" description:"The type of usage events to export: app or service"`
+}
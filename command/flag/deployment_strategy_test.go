@@ -19,6 +19,10 @@ var _ = Describe("DeploymentStrategy", func() {
 			},
 			Entry("returns 'rolling' when passed 'r'", "r",
 				[]flags.Completion{{Item: "rolling"}}),
+			Entry("returns 'canary' when passed 'c'", "c",
+				[]flags.Completion{{Item: "canary"}}),
+			Entry("returns all strategies when passed ''", "",
+				[]flags.Completion{{Item: "rolling"}, {Item: "canary"}}),
 		)
 	})
 
@@ -36,6 +40,8 @@ var _ = Describe("DeploymentStrategy", func() {
 			Entry("sets 'rolling' when passed 'rolling'", "rolling", constant.DeploymentStrategyRolling),
 			Entry("sets 'rolling' when passed 'rOlliNg'", "rOlliNg", constant.DeploymentStrategyRolling),
 			Entry("sets 'rolling' when passed 'ROLLING'", "ROLLING", constant.DeploymentStrategyRolling),
+			Entry("sets 'canary' when passed 'canary'", "canary", constant.DeploymentStrategyCanary),
+			Entry("sets 'canary' when passed 'CaNaRy'", "CaNaRy", constant.DeploymentStrategyCanary),
 		)
 
 		When("passed anything else", func() {
@@ -43,7 +49,7 @@ var _ = Describe("DeploymentStrategy", func() {
 				err := strategy.UnmarshalFlag("banana")
 				Expect(err).To(MatchError(&flags.Error{
 					Type:    flags.ErrInvalidChoice,
-					Message: `STRATEGY must be "rolling" or not set`,
+					Message: `STRATEGY must be "rolling", "canary" or not set`,
 				}))
 				Expect(strategy.Name).To(BeEmpty())
 			})
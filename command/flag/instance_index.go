@@ -0,0 +1,28 @@
+package flag
+
+import (
+	"strconv"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// InstanceIndex is a single app instance index. The index defaults to 0; use
+// IsSet to tell whether it was given on the command line.
+type InstanceIndex struct {
+	IsSet bool
+	Value uint
+}
+
+func (i *InstanceIndex) UnmarshalFlag(val string) error {
+	index, err := strconv.ParseUint(val, 10, 32)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "invalid instance index (expected int >= 0)",
+		}
+	}
+
+	i.IsSet = true
+	i.Value = uint(index)
+	return nil
+}
@@ -0,0 +1,48 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("InstanceIndex", func() {
+	var index InstanceIndex
+
+	BeforeEach(func() {
+		index = InstanceIndex{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("passed a non-negative integer", func() {
+			It("sets the value and marks it as set", func() {
+				err := index.UnmarshalFlag("3")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(index).To(Equal(InstanceIndex{IsSet: true, Value: 3}))
+			})
+		})
+
+		When("passed zero", func() {
+			It("marks it as set", func() {
+				err := index.UnmarshalFlag("0")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(index).To(Equal(InstanceIndex{IsSet: true, Value: 0}))
+			})
+		})
+
+		DescribeTable("passed an invalid index",
+			func(val string) {
+				err := index.UnmarshalFlag(val)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "invalid instance index (expected int >= 0)",
+				}))
+				Expect(index.IsSet).To(BeFalse())
+			},
+			Entry("negative", "-1"),
+			Entry("not a number", "a"),
+		)
+	})
+})
@@ -0,0 +1,60 @@
+package flag
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// InstanceIndexes is a comma-separated list of app instance indexes and
+// ranges of indexes, such as 0,2-4.
+type InstanceIndexes []uint
+
+func (i *InstanceIndexes) UnmarshalFlag(val string) error {
+	seen := map[uint]bool{}
+	for _, part := range strings.Split(val, ",") {
+		first, last, err := parseInstanceIndexRange(strings.TrimSpace(part))
+		if err != nil {
+			return &flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: fmt.Sprintf("Invalid instance indexes '%s'. Expected a comma-separated list of indexes or ranges, such as 0,2-4.", val),
+			}
+		}
+		for index := first; index <= last; index++ {
+			seen[index] = true
+		}
+	}
+
+	indexes := InstanceIndexes{}
+	for index := range seen {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(a, b int) bool { return indexes[a] < indexes[b] })
+
+	*i = indexes
+	return nil
+}
+
+func parseInstanceIndexRange(part string) (uint, uint, error) {
+	firstString, lastString, isRange := strings.Cut(part, "-")
+	if !isRange {
+		lastString = firstString
+	}
+
+	first, err := strconv.ParseUint(firstString, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	last, err := strconv.ParseUint(lastString, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	if last < first {
+		return 0, 0, fmt.Errorf("range %s is reversed", part)
+	}
+
+	return uint(first), uint(last), nil
+}
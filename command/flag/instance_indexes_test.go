@@ -0,0 +1,43 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("InstanceIndexes", func() {
+	var indexes InstanceIndexes
+
+	BeforeEach(func() {
+		indexes = nil
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("parses a single index", func() {
+			Expect(indexes.UnmarshalFlag("3")).To(Succeed())
+			Expect(indexes).To(Equal(InstanceIndexes{3}))
+		})
+
+		It("parses lists and ranges into sorted unique indexes", func() {
+			Expect(indexes.UnmarshalFlag("5, 0,2-4,3")).To(Succeed())
+			Expect(indexes).To(Equal(InstanceIndexes{0, 2, 3, 4, 5}))
+		})
+
+		DescribeTable("rejects invalid lists",
+			func(val string) {
+				Expect(indexes.UnmarshalFlag(val)).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "Invalid instance indexes '" + val + "'. Expected a comma-separated list of indexes or ranges, such as 0,2-4.",
+				}))
+			},
+			Entry("empty", ""),
+			Entry("negative", "-1"),
+			Entry("not a number", "one"),
+			Entry("empty element", "0,,1"),
+			Entry("reversed range", "4-2"),
+		)
+	})
+})
@@ -0,0 +1,32 @@
+package flag
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type OutputFormat struct {
+	Format configv3.OutputFormat
+}
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{string(configv3.OutputFormatJSON), string(configv3.OutputFormatYAML)}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+
+	switch valLower {
+	case string(configv3.OutputFormatJSON), string(configv3.OutputFormatYAML):
+		o.Format = configv3.OutputFormat(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrInvalidChoice,
+			Message: `FORMAT must be "json" or "yaml"`,
+		}
+	}
+
+	return nil
+}
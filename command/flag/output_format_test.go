@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/configv3"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var outputFormat OutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := outputFormat.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("returns 'yaml' when passed 'Y'", "Y",
+				[]flags.Completion{{Item: "yaml"}}),
+			Entry("returns all formats when passed ''", "",
+				[]flags.Completion{{Item: "json"}, {Item: "yaml"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			outputFormat = OutputFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(val string, expectedFormat configv3.OutputFormat) {
+				err := outputFormat.UnmarshalFlag(val)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outputFormat.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'json' when passed 'json'", "json", configv3.OutputFormatJSON),
+			Entry("sets 'json' when passed 'JSON'", "JSON", configv3.OutputFormatJSON),
+			Entry("sets 'yaml' when passed 'yaml'", "yaml", configv3.OutputFormatYAML),
+			Entry("sets 'yaml' when passed 'YaMl'", "YaMl", configv3.OutputFormatYAML),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := outputFormat.UnmarshalFlag("table")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrInvalidChoice,
+					Message: `FORMAT must be "json" or "yaml"`,
+				}))
+				Expect(outputFormat.Format).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// RouteOption is a per-route option given as KEY=VALUE, such as
+// loadbalancing=least-connection.
+type RouteOption struct {
+	Key   string
+	Value string
+}
+
+func (o *RouteOption) UnmarshalFlag(val string) error {
+	key, value, found := strings.Cut(val, "=")
+	key = strings.TrimSpace(key)
+	value = strings.TrimSpace(value)
+	if !found || key == "" || value == "" {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "invalid argument for flag '--option' (expected KEY=VALUE, e.g. loadbalancing=least-connection)",
+		}
+	}
+
+	o.Key = key
+	o.Value = value
+	return nil
+}
@@ -0,0 +1,40 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RouteOption", func() {
+	var option RouteOption
+
+	BeforeEach(func() {
+		option = RouteOption{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("splits the option into its key and value", func() {
+			Expect(option.UnmarshalFlag("loadbalancing=least-connection")).To(Succeed())
+			Expect(option).To(Equal(RouteOption{Key: "loadbalancing", Value: "least-connection"}))
+		})
+
+		It("keeps any '=' in the value", func() {
+			Expect(option.UnmarshalFlag("some-key=a=b")).To(Succeed())
+			Expect(option).To(Equal(RouteOption{Key: "some-key", Value: "a=b"}))
+		})
+
+		DescribeTable("returns an error for malformed options",
+			func(val string) {
+				Expect(option.UnmarshalFlag(val)).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "invalid argument for flag '--option' (expected KEY=VALUE, e.g. loadbalancing=least-connection)",
+				}))
+			},
+			Entry("no '='", "loadbalancing"),
+			Entry("no key", "=round-robin"),
+			Entry("no value", "loadbalancing="),
+		)
+	})
+})
@@ -16,30 +16,80 @@ type SSHPortForwarding struct {
 }
 
 func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
-	splitHosts := strings.Split(val, ":")
-	for _, piece := range splitHosts {
-		if len(piece) == 0 {
-			return &flags.Error{
-				Type:    flags.ErrRequired,
-				Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
-			}
-		}
+	listenAddress, connectAddress, err := parseForwardingSpecification(val, "local")
+	if err != nil {
+		return err
 	}
 
+	s.LocalAddress = listenAddress
+	s.RemoteAddress = connectAddress
+	return nil
+}
+
+// SSHRemotePortForwarding listens on RemoteAddress in the app container and
+// connects to LocalAddress from this machine.
+type SSHRemotePortForwarding struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	listenAddress, connectAddress, err := parseForwardingSpecification(val, "remote")
+	if err != nil {
+		return err
+	}
+
+	s.RemoteAddress = listenAddress
+	s.LocalAddress = connectAddress
+	return nil
+}
+
+// SSHDynamicPortForwarding is the local address of a SOCKS proxy that
+// connects from the app container.
+type SSHDynamicPortForwarding struct {
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	splitHosts := strings.Split(val, ":")
 	re := regexp.MustCompile(`^\d+$`)
 	switch {
-	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+	case len(splitHosts) == 1 && re.MatchString(splitHosts[0]):
 		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2])
-	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
-		s.LocalAddress = fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3])
+	case len(splitHosts) == 2 && len(splitHosts[0]) > 0 && re.MatchString(splitHosts[1]):
+		s.LocalAddress = val
 	default:
 		return &flags.Error{
 			Type:    flags.ErrRequired,
-			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
+			Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", val),
 		}
 	}
 
 	return nil
 }
+
+// parseForwardingSpecification parses [BIND_ADDRESS:]PORT:HOST:HOST_PORT into
+// the address to listen on and the address to connect to.
+func parseForwardingSpecification(val string, kind string) (string, string, error) {
+	badSpecificationErr := &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("Bad %s forwarding specification '%s'", kind, val),
+	}
+
+	splitHosts := strings.Split(val, ":")
+	for _, piece := range splitHosts {
+		if len(piece) == 0 {
+			return "", "", badSpecificationErr
+		}
+	}
+
+	re := regexp.MustCompile(`^\d+$`)
+	switch {
+	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+		return fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0]), fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2]), nil
+	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
+		return fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1]), fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3]), nil
+	default:
+		return "", "", badSpecificationErr
+	}
+}
@@ -60,3 +60,89 @@ var _ = Describe("SpaceRole", func() {
 		)
 	})
 })
+
+var _ = Describe("SSHRemotePortForwarding", func() {
+	var forward SSHRemotePortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHRemotePortForwarding{}
+		})
+
+		When("passed remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("5005:localhost:5006")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "localhost:5005",
+					LocalAddress:  "localhost:5006",
+				}))
+			})
+		})
+
+		When("passed remote:remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:5005:debugger:5006")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "0.0.0.0:5005",
+					LocalAddress:  "debugger:5006",
+				}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad remote forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("1 colon", "5005:localhost"),
+			Entry("empty values in between colons", "5005::5006"),
+			Entry("incorrect port number", "5005:localhost:potato"),
+		)
+	})
+})
+
+var _ = Describe("SSHDynamicPortForwarding", func() {
+	var forward SSHDynamicPortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHDynamicPortForwarding{}
+		})
+
+		When("passed a port", func() {
+			It("binds to localhost", func() {
+				err := forward.UnmarshalFlag("1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHDynamicPortForwarding{LocalAddress: "localhost:1080"}))
+			})
+		})
+
+		When("passed a bind address and port", func() {
+			It("binds to the address", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHDynamicPortForwarding{LocalAddress: "0.0.0.0:1080"}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("not a port", "socks"),
+			Entry("empty bind address", ":1080"),
+			Entry("too many colons", "a:b:1080"),
+		)
+	})
+})
@@ -0,0 +1,44 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp is a point in time given either as an RFC3339 timestamp or as a
+// duration before now, e.g. 24h.
+type Timestamp struct {
+	Time  time.Time
+	Ago   time.Duration
+	IsSet bool
+}
+
+func (t *Timestamp) UnmarshalFlag(rawValue string) error {
+	if parsed, err := time.Parse(time.RFC3339, rawValue); err == nil {
+		*t = Timestamp{Time: parsed, IsSet: true}
+		return nil
+	}
+
+	if ago, err := time.ParseDuration(rawValue); err == nil && ago >= 0 {
+		*t = Timestamp{Ago: ago, IsSet: true}
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: "Time must be an RFC3339 timestamp (e.g. 2006-01-02T15:04:05Z) or a duration before now (e.g. 24h)",
+	}
+}
+
+// Resolve returns the point in time, relative to now if it was given as a
+// duration.
+func (t Timestamp) Resolve(now time.Time) time.Time {
+	if !t.IsSet {
+		return time.Time{}
+	}
+	if t.Time.IsZero() {
+		return now.Add(-t.Ago)
+	}
+	return t.Time
+}
@@ -0,0 +1,60 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Timestamp", func() {
+	var (
+		timestamp Timestamp
+		now       time.Time
+	)
+
+	BeforeEach(func() {
+		timestamp = Timestamp{}
+		now = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		When("passed an RFC3339 timestamp", func() {
+			It("resolves to that time", func() {
+				err := timestamp.UnmarshalFlag("2026-09-30T08:30:00Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.IsSet).To(BeTrue())
+				Expect(timestamp.Resolve(now)).To(Equal(time.Date(2026, 9, 30, 8, 30, 0, 0, time.UTC)))
+			})
+		})
+
+		When("passed a duration", func() {
+			It("resolves to that long before now", func() {
+				err := timestamp.UnmarshalFlag("90m")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Resolve(now)).To(Equal(time.Date(2026, 10, 1, 10, 30, 0, 0, time.UTC)))
+			})
+		})
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := timestamp.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Time must be an RFC3339 timestamp (e.g. 2006-01-02T15:04:05Z) or a duration before now (e.g. 24h)",
+				}))
+			})
+		})
+	})
+
+	Describe("Resolve", func() {
+		When("the flag was not given", func() {
+			It("returns the zero time", func() {
+				Expect(timestamp.Resolve(now).IsZero()).To(BeTrue())
+			})
+		})
+	})
+})
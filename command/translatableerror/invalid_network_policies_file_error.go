@@ -0,0 +1,17 @@
+package translatableerror
+
+type InvalidNetworkPoliciesFileError struct {
+	Path string
+	Err  error
+}
+
+func (e InvalidNetworkPoliciesFileError) Error() string {
+	return "The network policies file {{.Path}} is invalid: {{.ErrorMessage}}"
+}
+
+func (e InvalidNetworkPoliciesFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":         e.Path,
+		"ErrorMessage": e.Err.Error(),
+	})
+}
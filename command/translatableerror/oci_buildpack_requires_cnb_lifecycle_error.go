@@ -0,0 +1,20 @@
+package translatableerror
+
+// OCIBuildpackRequiresCNBLifecycleError is returned when an app using the
+// buildpack lifecycle references a buildpack by OCI image (docker://...),
+// which only the cnb lifecycle can stage with.
+type OCIBuildpackRequiresCNBLifecycleError struct {
+	AppName   string
+	Buildpack string
+}
+
+func (OCIBuildpackRequiresCNBLifecycleError) Error() string {
+	return "Buildpack {{.Buildpack}} for app {{.AppName}} is an OCI image, which requires the cnb lifecycle. Use '--lifecycle cnb' or set 'lifecycle: cnb' in the manifest."
+}
+
+func (e OCIBuildpackRequiresCNBLifecycleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":   e.AppName,
+		"Buildpack": e.Buildpack,
+	})
+}
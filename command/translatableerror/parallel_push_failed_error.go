@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ParallelPushFailedError is returned when pushing several apps at once fails
+// for at least one of them.
+type ParallelPushFailedError struct {
+	AppNames []string
+}
+
+func (ParallelPushFailedError) Error() string {
+	return "Failed to push apps: {{.AppNames}}"
+}
+
+func (e ParallelPushFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, ", "),
+	})
+}
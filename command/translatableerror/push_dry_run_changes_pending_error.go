@@ -0,0 +1,14 @@
+package translatableerror
+
+// PushDryRunChangesPendingError is returned by push --dry-run when pushing
+// would change the targeted space. It makes the CLI exit with status 2
+// without displaying an error.
+type PushDryRunChangesPendingError struct{}
+
+func (PushDryRunChangesPendingError) Error() string {
+	return "Changes are pending."
+}
+
+func (e PushDryRunChangesPendingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
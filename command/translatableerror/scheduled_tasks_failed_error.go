@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// ScheduledTasksFailedError is returned when at least one scheduled task that
+// was due could not be run.
+type ScheduledTasksFailedError struct {
+	TaskNames []string
+}
+
+func (ScheduledTasksFailedError) Error() string {
+	return "Failed to run scheduled tasks: {{.TaskNames}}"
+}
+
+func (e ScheduledTasksFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"TaskNames": strings.Join(e.TaskNames, ", "),
+	})
+}
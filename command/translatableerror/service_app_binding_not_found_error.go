@@ -0,0 +1,19 @@
+package translatableerror
+
+// ServiceAppBindingNotFoundError is returned when an app is not bound to a
+// service instance.
+type ServiceAppBindingNotFoundError struct {
+	AppName             string
+	ServiceInstanceName string
+}
+
+func (ServiceAppBindingNotFoundError) Error() string {
+	return "App {{.AppName}} is not bound to service instance {{.ServiceInstanceName}}."
+}
+
+func (e ServiceAppBindingNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":             e.AppName,
+		"ServiceInstanceName": e.ServiceInstanceName,
+	})
+}
@@ -38,6 +38,7 @@ type UI interface {
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
+	DisplayYAML(yamlData interface{}) error
 	GetErr() io.Writer
 	GetIn() io.Reader
 	GetOut() io.Writer
@@ -15,6 +15,7 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"github.com/SermoDigital/jose/jwt"
 )
 
@@ -25,18 +26,22 @@ type Actor interface {
 	ApplySpaceQuotaByName(quotaName string, spaceGUID string, orgGUID string) (v7action.Warnings, error)
 	AssignIsolationSegmentToSpaceByNameAndSpace(isolationSegmentName string, spaceGUID string) (v7action.Warnings, error)
 	Authenticate(credentials map[string]string, origin string, grantType uaa.GrantType) error
+	AuthenticateWithAuthorizationCode(origin string, openURL func(authorizationURL string)) error
+	AuthenticateWithDeviceCode(deviceAuthorization v7action.DeviceAuthorization) error
 	BindSecurityGroupToSpaces(securityGroupGUID string, spaces []resources.Space, lifecycle constant.SecurityGroupLifecycle) (v7action.Warnings, error)
 	CancelDeployment(deploymentGUID string) (v7action.Warnings, error)
 	CheckRoute(domainName string, hostname string, path string, port int) (bool, v7action.Warnings, error)
 	ClearTarget()
+	ContinueDeployment(deploymentGUID string) (v7action.Warnings, error)
 	CopyPackage(sourceApp resources.Application, targetApp resources.Application) (resources.Package, v7action.Warnings, error)
 	CreateAndUploadBitsPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (resources.Package, v7action.Warnings, error)
 	CreateApplicationDroplet(appGUID string) (resources.Droplet, v7action.Warnings, error)
 	CreateApplicationInSpace(app resources.Application, spaceGUID string) (resources.Application, v7action.Warnings, error)
+	CreateApplicationSidecar(appName string, spaceGUID string, sidecar resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
 	CreateBitsPackageByApplication(appGUID string) (resources.Package, v7action.Warnings, error)
 	CreateBuildpack(buildpack resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
-	CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string) (string, v7action.Warnings, error)
-	CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, v7action.Warnings, error)
+	CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string, strategy constant.DeploymentStrategy) (string, v7action.Warnings, error)
+	CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string, strategy constant.DeploymentStrategy) (string, v7action.Warnings, error)
 	CreateDockerPackageByApplication(appGUID string, dockerImageCredentials v7action.DockerImageCredentials) (resources.Package, v7action.Warnings, error)
 	CreateDockerPackageByApplicationNameAndSpace(appName string, spaceGUID string, dockerImageCredentials v7action.DockerImageCredentials) (resources.Package, v7action.Warnings, error)
 	CreateIsolationSegmentByName(isolationSegment resources.IsolationSegment) (v7action.Warnings, error)
@@ -45,7 +50,7 @@ type Actor interface {
 	CreateOrganization(orgName string) (resources.Organization, v7action.Warnings, error)
 	CreateOrganizationQuota(name string, limits v7action.QuotaLimits) (v7action.Warnings, error)
 	CreatePrivateDomain(domainName string, orgName string) (v7action.Warnings, error)
-	CreateRoute(spaceGUID, domainName, hostname, path string, port int) (resources.Route, v7action.Warnings, error)
+	CreateRoute(spaceGUID, domainName, hostname, path string, port int, options map[string]*string) (resources.Route, v7action.Warnings, error)
 	CreateRouteBinding(params v7action.CreateRouteBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	CreateSecurityGroup(name, filePath string) (v7action.Warnings, error)
 	CreateServiceAppBinding(params v7action.CreateServiceAppBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
@@ -58,6 +63,7 @@ type Actor interface {
 	CreateUser(username string, password string, origin string) (resources.User, v7action.Warnings, error)
 	CreateUserProvidedServiceInstance(instance resources.ServiceInstance) (v7action.Warnings, error)
 	DeleteApplicationByNameAndSpace(name, spaceGUID string, deleteRoutes bool) (v7action.Warnings, error)
+	DeleteApplicationSidecar(appName string, spaceGUID string, sidecarName string) (v7action.Warnings, error)
 	DeleteBuildpackByNameAndStack(buildpackName string, buildpackStack string) (v7action.Warnings, error)
 	DeleteDomain(domain resources.Domain) (v7action.Warnings, error)
 	DeleteInstanceByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, instanceIndex int) (v7action.Warnings, error)
@@ -91,13 +97,18 @@ type Actor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	GetApplicationMapForRoute(route resources.Route) (map[string]resources.Application, v7action.Warnings, error)
 	GetApplicationDroplets(appName string, spaceGUID string) ([]resources.Droplet, v7action.Warnings, error)
+	GetApplicationAnnotations(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetApplicationPackages(appName string, spaceGUID string) ([]resources.Package, v7action.Warnings, error)
 	GetApplicationProcessHealthChecksByNameAndSpace(appName string, spaceGUID string) ([]v7action.ProcessHealthCheck, v7action.Warnings, error)
 	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, v7action.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, v7action.Warnings, error)
+	GetApplicationSidecars(appName string, spaceGUID string) ([]resources.Sidecar, v7action.Warnings, error)
 	GetApplicationTasks(appName string, sortOrder v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
+	GetAppUsageEvents(afterGUID string) ([]resources.AppUsageEvent, v7action.Warnings, error)
+	GetAuditEvents(filter v7action.AuditEventFilter) ([]v7action.AuditEvent, v7action.Warnings, error)
+	GetBuildpackAnnotations(buildpackName string, buildpackStack string) (map[string]types.NullString, v7action.Warnings, error)
 	GetBuildpackLabels(buildpackName string, buildpackStack string) (map[string]types.NullString, v7action.Warnings, error)
 	GetBuildpacks(labelSelector string) ([]resources.Buildpack, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
@@ -105,12 +116,15 @@ type Actor interface {
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	GetDomain(domainGUID string) (resources.Domain, v7action.Warnings, error)
 	GetDomainByName(domainName string) (resources.Domain, v7action.Warnings, error)
+	GetDomainAnnotations(domainName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetDomainLabels(domainName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (resources.IsolationSegment, v7action.Warnings, error)
 	GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (v7action.EnvironmentVariableGroup, v7action.Warnings, error)
 	GetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string) (v7action.EnvironmentVariableGroups, v7action.Warnings, error)
 	GetFeatureFlagByName(featureFlagName string) (resources.FeatureFlag, v7action.Warnings, error)
 	GetFeatureFlags() ([]resources.FeatureFlag, v7action.Warnings, error)
+	GetFilteredRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	GetFilteredStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error)
 	GetGlobalStagingSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error)
 	GetIsolationSegmentsByOrganization(orgName string) ([]resources.IsolationSegment, v7action.Warnings, error)
@@ -122,6 +136,7 @@ type Actor interface {
 	GetOrgUsersByRoleType(orgGUID string) (map[constant.RoleType][]resources.User, v7action.Warnings, error)
 	GetOrganizationByName(orgName string) (resources.Organization, v7action.Warnings, error)
 	GetOrganizationDomains(string, string) ([]resources.Domain, v7action.Warnings, error)
+	GetOrganizationAnnotations(orgName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetOrganizationLabels(orgName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetOrganizationQuotaByName(orgQuotaName string) (resources.OrganizationQuota, v7action.Warnings, error)
 	GetOrganizationQuotas() ([]resources.OrganizationQuota, v7action.Warnings, error)
@@ -133,49 +148,65 @@ type Actor interface {
 	GetRawApplicationManifestByNameAndSpace(appName string, spaceGUID string) ([]byte, v7action.Warnings, error)
 	GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]v7action.Event, v7action.Warnings, error)
 	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	GetRecentLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error)
 	GetRootResponse() (v7action.Info, v7action.Warnings, error)
 	GetRevisionByApplicationAndVersion(appGUID string, revisionVersion int) (resources.Revision, v7action.Warnings, error)
 	GetRevisionsByApplicationNameAndSpace(appName string, spaceGUID string) ([]resources.Revision, v7action.Warnings, error)
 	GetRouteByAttributes(domain resources.Domain, hostname string, path string, port int) (resources.Route, v7action.Warnings, error)
 	GetRouteDestinationByAppGUID(route resources.Route, appGUID string) (resources.RouteDestination, error)
+	GetRouteAnnotations(routeName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetRouteLabels(routeName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetRouterGroups() ([]v7action.RouterGroup, error)
 	GetRouteSummaries([]resources.Route) ([]v7action.RouteSummary, v7action.Warnings, error)
 	GetRoutesByOrg(orgGUID string, labels string) ([]resources.Route, v7action.Warnings, error)
 	GetRoutesBySpace(spaceGUID string, labels string) ([]resources.Route, v7action.Warnings, error)
+	GetScheduledTasks(spaceGUID string, manifest manifestparser.Manifest) ([]v7action.ScheduledTask, error)
 	GetSSHEnabled(appGUID string) (ccv3.SSHEnabled, v7action.Warnings, error)
 	GetSSHEnabledByAppName(appName string, spaceGUID string) (ccv3.SSHEnabled, v7action.Warnings, error)
 	GetSSHPasscode() (string, error)
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
+	GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes(appName string, spaceGUID string, processType string, processIndexes []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)
 	GetSecurityGroup(securityGroupName string) (resources.SecurityGroup, v7action.Warnings, error)
 	GetSecurityGroupSummary(securityGroupName string) (v7action.SecurityGroupSummary, v7action.Warnings, error)
 	GetSecurityGroups() ([]v7action.SecurityGroupSummary, v7action.Warnings, error)
 	GetServiceAccess(offeringName, brokerName, orgName string) ([]v7action.ServicePlanAccess, v7action.Warnings, error)
 	GetServiceBrokerByName(serviceBrokerName string) (resources.ServiceBroker, v7action.Warnings, error)
+	GetServiceBrokerAnnotations(serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServiceBrokerLabels(serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServiceBrokers() ([]resources.ServiceBroker, v7action.Warnings, error)
+	GetServiceAppBindingByServiceInstanceAndApp(serviceInstanceName, appName, spaceGUID string) (resources.ServiceCredentialBinding, v7action.Warnings, error)
+	GetServiceCredentialBindingParameters(bindingGUID string) (types.OptionalObject, v7action.Warnings, error)
 	GetServiceKeyByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (resources.ServiceCredentialBinding, v7action.Warnings, error)
 	GetServiceKeyDetailsByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (resources.ServiceCredentialBindingDetails, v7action.Warnings, error)
 	GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID string) (resources.ServiceInstance, v7action.Warnings, error)
 	GetServiceInstanceDetails(serviceInstanceName, spaceGUID string, omitApps bool) (v7action.ServiceInstanceDetails, v7action.Warnings, error)
 	GetServiceInstanceParameters(serviceInstanceName, spaceGUID string) (v7action.ServiceInstanceParameters, v7action.Warnings, error)
+	GetServiceInstanceAnnotations(serviceInstanceName, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServiceInstanceLabels(serviceInstanceName, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServiceInstancesForSpace(spaceGUID string, omitApps bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
 	GetServiceKeysByServiceInstance(serviceInstanceName, spaceGUID string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
+	GetServiceOfferingAnnotations(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetServicePlanAnnotations(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanLabels(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName string) (resources.ServicePlan, v7action.Warnings, error)
+	GetServiceUsageEvents(afterGUID string) ([]resources.ServiceUsageEvent, v7action.Warnings, error)
 	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (resources.Space, v7action.Warnings, error)
 	GetSpaceFeature(spaceName string, orgGUID string, feature string) (bool, v7action.Warnings, error)
+	GetSpaceAnnotations(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetSpaceQuotaByName(spaceQuotaName string, orgGUID string) (resources.SpaceQuota, v7action.Warnings, error)
 	GetSpaceQuotasByOrgGUID(orgGUID string) ([]resources.SpaceQuota, v7action.Warnings, error)
 	GetSpaceSummaryByNameAndOrganization(spaceName string, orgGUID string) (v7action.SpaceSummary, v7action.Warnings, error)
 	GetSpaceUsersByRoleType(spaceGuid string) (map[constant.RoleType][]resources.User, v7action.Warnings, error)
 	GetStackByName(stackName string) (resources.Stack, v7action.Warnings, error)
+	GetStackAnnotations(stackName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetStackLabels(stackName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetStacks(string) ([]resources.Stack, v7action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	GetStreamingLogsForTask(appGUID string, task resources.Task, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc)
+	GetStreamingMetricsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient, interval time.Duration) (<-chan []sharedaction.InstanceMetrics, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	GetTaskByNameAndApplication(name string, appGUID string) (resources.Task, v7action.Warnings, error)
 	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (resources.Task, v7action.Warnings, error)
 	GetUAAAPIVersion() (string, error)
 	GetUnstagedNewestPackageGUID(appGuid string) (string, v7action.Warnings, error)
@@ -188,12 +219,13 @@ type Actor interface {
 	PollBuild(buildGUID string, appName string) (resources.Droplet, v7action.Warnings, error)
 	PollPackage(pkg resources.Package) (resources.Package, v7action.Warnings, error)
 	PollStart(app resources.Application, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
-	PollStartForRolling(app resources.Application, deploymentGUID string, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
+	PollStartForDeployment(app resources.Application, deploymentGUID string, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
 	PollTask(task resources.Task) (resources.Task, v7action.Warnings, error)
 	PollUploadBuildpackJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
 	PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader v7action.Downloader) (string, error)
 	PurgeServiceInstance(serviceInstanceName, spaceGUID string) (v7action.Warnings, error)
 	PurgeServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName string) (v7action.Warnings, error)
+	QueryMetricsForApplicationByNameAndSpace(appName string, spaceGUID string, query string, client sharedaction.LogCacheClient) ([]sharedaction.MetricSample, v7action.Warnings, error)
 	RefreshAccessToken() (string, error)
 	RenameApplicationByNameAndSpaceGUID(oldAppName, newAppName, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	RenameOrganization(oldOrgName, newOrgName string) (resources.Organization, v7action.Warnings, error)
@@ -204,6 +236,7 @@ type Actor interface {
 	ResourceMatch(resources []sharedaction.V3Resource) ([]sharedaction.V3Resource, v7action.Warnings, error)
 	RestartApplication(appGUID string, noWait bool) (v7action.Warnings, error)
 	RevokeAccessAndRefreshTokens() error
+	RunScheduledTask(task v7action.ScheduledTask) (resources.Task, v7action.Warnings, error)
 	RunTask(appGUID string, task resources.Task) (resources.Task, v7action.Warnings, error)
 	ScaleProcessByApplication(appGUID string, process resources.Process) (v7action.Warnings, error)
 	ScheduleTokenRefresh(func(time.Duration) <-chan time.Time, chan struct{}, chan struct{}) (<-chan error, error)
@@ -211,6 +244,7 @@ type Actor interface {
 	SetApplicationDropletByApplicationNameAndSpace(appName string, spaceGUID string, dropletGUID string) (v7action.Warnings, error)
 	SetApplicationManifest(appGUID string, rawManifest []byte) (v7action.Warnings, error)
 	SetApplicationProcessHealthCheckTypeByNameAndSpace(appName string, spaceGUID string, healthCheckType constant.HealthCheckType, httpEndpoint string, processType string, invocationTimeout int64) (resources.Application, v7action.Warnings, error)
+	SetApplicationProcessReadinessHealthCheckByNameAndSpace(appName string, spaceGUID string, healthCheckType constant.HealthCheckType, httpEndpoint string, processType string, invocationTimeout int64, interval int64) (resources.Application, v7action.Warnings, error)
 	SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v7action.EnvironmentVariablePair) (v7action.Warnings, error)
 	SetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName, envVars resources.EnvironmentVariables) (v7action.Warnings, error)
 	SetOrganizationDefaultIsolationSegment(orgGUID string, isoSegGUID string) (v7action.Warnings, error)
@@ -222,7 +256,9 @@ type Actor interface {
 	StageApplicationPackage(pkgGUID string) (resources.Build, v7action.Warnings, error)
 	StagePackage(packageGUID, appName, spaceGUID string) (<-chan resources.Droplet, <-chan v7action.Warnings, <-chan error)
 	StartApplication(appGUID string) (v7action.Warnings, error)
+	StartDeviceAuthorization() (v7action.DeviceAuthorization, error)
 	StopApplication(appGUID string) (v7action.Warnings, error)
+	StreamAuditEvents(filter v7action.AuditEventFilter, pollInterval time.Duration) (<-chan v7action.AuditEvent, <-chan v7action.Warnings, <-chan error, context.CancelFunc)
 	TerminateTask(taskGUID string) (resources.Task, v7action.Warnings, error)
 	UnbindSecurityGroup(securityGroupName string, orgGUID string, spaceGUID string, lifecycle constant.SecurityGroupLifecycle) (v7action.Warnings, error)
 	UnmapRoute(routeGUID string, destinationGUID string) (v7action.Warnings, error)
@@ -233,31 +269,44 @@ type Actor interface {
 	UnshareServiceInstanceFromSpaceAndOrg(serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string, unshareFromDetails v7action.ServiceInstanceSharingParams) (v7action.Warnings, error)
 	UpdateAppFeature(app resources.Application, enabled bool, featureName string) (v7action.Warnings, error)
 	UpdateApplication(app resources.Application) (resources.Application, v7action.Warnings, error)
+	UpdateApplicationAnnotationsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateApplicationLabelsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateBuildpackByNameAndStack(buildpackName string, buildpackStack string, buildpack resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
+	UpdateBuildpackAnnotationsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateBuildpackLabelsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateDestination(string, string, string) (v7action.Warnings, error)
+	UpdateDomainAnnotationsByDomainName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateDomainLabelsByDomainName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateManagedServiceInstance(params v7action.UpdateManagedServiceInstanceParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	UpgradeManagedServiceInstance(serviceInstanceName, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	UpdateOrganizationAnnotationsByOrganizationName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateOrganizationLabelsByOrganizationName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateOrganizationQuota(quotaName string, newName string, limits v7action.QuotaLimits) (v7action.Warnings, error)
 	UpdateProcessByTypeAndApplication(processType string, appGUID string, updatedProcess resources.Process) (v7action.Warnings, error)
+	UpdateRoute(routeGUID string, options map[string]*string) (resources.Route, v7action.Warnings, error)
+	UpdateRouteAnnotations(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateRouteLabels(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateSecurityGroup(name, filePath string) (v7action.Warnings, error)
 	UpdateSecurityGroupGloballyEnabled(securityGroupName string, lifecycle constant.SecurityGroupLifecycle, enabled bool) (v7action.Warnings, error)
 	UpdateServiceBroker(serviceBrokerGUID string, model resources.ServiceBroker) (v7action.Warnings, error)
+	UpdateServiceBrokerAnnotationsByServiceBrokerName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateServiceBrokerLabelsByServiceBrokerName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceInstanceAnnotations(serviceInstanceName, spaceGUID string, annotations map[string]types.NullString) (v7action.Warnings, error)
 	UpdateServiceInstanceLabels(serviceInstanceName, spaceGUID string, labels map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceOfferingAnnotations(serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (v7action.Warnings, error)
 	UpdateServiceOfferingLabels(serviceOfferingName string, serviceBrokerName string, labels map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServicePlanAnnotations(servicePlanName string, serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (v7action.Warnings, error)
 	UpdateServicePlanLabels(servicePlanName string, serviceOfferingName string, serviceBrokerName string, labels map[string]types.NullString) (v7action.Warnings, error)
 	UpdateSpaceFeature(spaceName string, orgGUID string, enableds bool, feature string) (v7action.Warnings, error)
+	UpdateSpaceAnnotationsBySpaceName(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateSpaceLabelsBySpaceName(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateSpaceQuota(quotaName, orgGUID, newName string, limits v7action.QuotaLimits) (v7action.Warnings, error)
+	UpdateStackAnnotationsByStackName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateStackLabelsByStackName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateUserPassword(userGUID string, oldPassword string, newPassword string) error
 	UpdateUserProvidedServiceInstance(serviceInstanceName, spaceGUID string, serviceInstanceUpdates resources.ServiceInstance) (v7action.Warnings, error)
 	UploadBitsPackage(pkg resources.Package, matchedResources []sharedaction.V3Resource, newResources io.Reader, newResourcesLength int64) (resources.Package, v7action.Warnings, error)
 	UploadBuildpack(guid string, pathToBuildpackBits string, progressBar v7action.SimpleProgressBar) (ccv3.JobURL, v7action.Warnings, error)
 	UploadDroplet(dropletGUID string, dropletPath string, progressReader io.Reader, fileSize int64) (v7action.Warnings, error)
+	WriteBitsPackageArchive(bitsPath string, outputPath string) (string, error)
 }
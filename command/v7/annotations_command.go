@@ -0,0 +1,56 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type AnnotationsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AnnotationsArgs `positional-args:"yes"`
+	BuildpackStack  string               `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	relatedCommands interface{}          `related_commands:"set-annotation, unset-annotation"`
+	ServiceBroker   string               `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
+	ServiceOffering string               `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+}
+
+func (cmd AnnotationsCommand) Execute(args []string) error {
+	lister := metadataLister{
+		BaseCommand: cmd.BaseCommand,
+		field:       AnnotationsField,
+		targetResource: TargetResource{
+			ResourceType:    cmd.RequiredArgs.ResourceType,
+			ResourceName:    cmd.RequiredArgs.ResourceName,
+			BuildpackStack:  cmd.BuildpackStack,
+			ServiceBroker:   cmd.ServiceBroker,
+			ServiceOffering: cmd.ServiceOffering,
+		},
+	}
+	return lister.execute()
+}
+
+func (cmd AnnotationsCommand) Usage() string {
+	return `CF_NAME annotations RESOURCE RESOURCE_NAME`
+}
+
+func (cmd AnnotationsCommand) Examples() string {
+	return `
+cf annotations app dora
+cf annotations org business
+cf annotations buildpack go_buildpack --stack cflinuxfs3`
+}
+
+func (cmd AnnotationsCommand) Resources() string {
+	return `
+app
+buildpack
+domain
+org
+route
+service-broker
+service-instance
+service-offering
+service-plan
+space
+stack`
+}
@@ -0,0 +1,158 @@
+package v7_test
+
+import (
+	"errors"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("annotations command", func() {
+	var (
+		cmd             AnnotationsCommand
+		fakeActor       *v7fakes.FakeActor
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		testUI          *ui.UI
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v7fakes.FakeActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		cmd = AnnotationsCommand{
+			BaseCommand: BaseCommand{
+				Actor:       fakeActor,
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+			},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "fake-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("an unrecognized resource type is specified", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "unrecognized-resource"
+		})
+
+		It("errors", func() {
+			Expect(executeErr).To(MatchError("Unsupported resource type of 'unrecognized-resource'"))
+		})
+	})
+
+	When("--stack is combined with anything other than 'buildpack'", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.ResourceType = "app"
+			cmd.BuildpackStack = "cflinuxfs4"
+		})
+
+		It("errors", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"app", "--stack, -s"},
+			}))
+		})
+	})
+
+	Describe("for apps", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{
+				ResourceType: "app",
+				ResourceName: "dora",
+			}
+			fakeActor.GetApplicationAnnotationsReturns(
+				map[string]types.NullString{
+					"runbook": types.NewNullString("# Runbook\nRestart it.\r\nThen page someone."),
+					"contact": types.NewNullString("team@example.com"),
+				},
+				v7action.Warnings{"some-warning-1", "some-warning-2"},
+				nil,
+			)
+		})
+
+		It("retrieves the annotations of the app in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Getting annotations for app dora in org fake-org / space fake-space as some-user...")))
+
+			Expect(fakeActor.GetApplicationAnnotationsCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetApplicationAnnotationsArgsForCall(0)
+			Expect(appName).To(Equal("dora"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Err).To(Say("some-warning-1"))
+			Expect(testUI.Err).To(Say("some-warning-2"))
+		})
+
+		It("displays the annotations alphabetically, one row per line of the value", func() {
+			Expect(testUI.Out).To(Say(`key\s+value`))
+			Expect(testUI.Out).To(Say(`contact\s+team@example.com\n`))
+			Expect(testUI.Out).To(Say(`runbook\s+# Runbook\n`))
+			Expect(testUI.Out).To(Say(`\s+Restart it.\n`))
+			Expect(testUI.Out).To(Say(`\s+Then page someone.\n`))
+		})
+
+		When("the app has no annotations", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationAnnotationsReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No annotations found."))
+			})
+		})
+
+		When("there is an error retrieving the annotations", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationAnnotationsReturns(nil, v7action.Warnings{"some-warning-1"}, errors.New("boom"))
+			})
+
+			It("returns the error and still prints warnings", func() {
+				Expect(executeErr).To(MatchError("boom"))
+				Expect(testUI.Err).To(Say("some-warning-1"))
+			})
+		})
+	})
+
+	Describe("for service offerings", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{
+				ResourceType: "service-offering",
+				ResourceName: "some-offering",
+			}
+			cmd.ServiceBroker = "some-broker"
+		})
+
+		It("retrieves the annotations of the offering from the given broker", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Getting annotations for service-offering some-offering from service broker some-broker as some-user...")))
+
+			Expect(fakeActor.GetServiceOfferingAnnotationsCallCount()).To(Equal(1))
+			offeringName, brokerName := fakeActor.GetServiceOfferingAnnotationsArgsForCall(0)
+			Expect(offeringName).To(Equal("some-offering"))
+			Expect(brokerName).To(Equal("some-broker"))
+		})
+	})
+})
@@ -29,13 +29,15 @@ func (cmd AppCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   cmd.RequiredArgs.AppName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
 	summary, warnings, err := cmd.Actor.GetDetailedAppSummary(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
@@ -44,6 +46,10 @@ func (cmd AppCommand) Execute(args []string) error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.AppKind, shared.NewDetailedAppOutput(summary))
+	}
+
 	appSummaryDisplayer.AppDisplay(summary, false)
 	return nil
 }
@@ -238,4 +238,37 @@ var _ = Describe("app Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			fakeActor.GetDetailedAppSummaryReturns(
+				v7action.DetailedApplicationSummary{
+					ApplicationSummary: v7action.ApplicationSummary{
+						Application: resources.Application{
+							Name:  "some-app",
+							GUID:  "some-app-guid",
+							State: constant.ApplicationStopped,
+						},
+					},
+					CurrentDroplet: resources.Droplet{GUID: "some-droplet-guid", Stack: "cflinuxfs4"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the detailed summary as a versioned document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Showing health and status"))
+			Expect(testUI.Out).To(Say("schema_version: \"1\""))
+			Expect(testUI.Out).To(Say("kind: app\n"))
+			Expect(testUI.Out).To(Say("  name: some-app\n"))
+			Expect(testUI.Out).To(Say("  requested_state: stopped\n"))
+			Expect(testUI.Out).To(Say("  current_droplet:\n"))
+			Expect(testUI.Out).To(Say("    guid: some-droplet-guid\n"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -0,0 +1,313 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// The gauges shown in the fixed columns of the metrics table. Their quotas
+// are shown alongside them rather than in columns of their own.
+const (
+	cpuGauge         = "cpu"
+	memoryGauge      = "memory"
+	memoryQuotaGauge = "memory_quota"
+	diskGauge        = "disk"
+	diskQuotaGauge   = "disk_quota"
+)
+
+type AppMetricsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	Query           string       `long:"query" description:"Run a PromQL query against Log Cache instead of streaming metrics. $APP_GUID in the query is replaced with the GUID of the app"`
+	usage           interface{}  `usage:"CF_NAME app-metrics APP_NAME [--query QUERY]\n\n   Without --query, the latest gauge and counter values of each app instance are\n   displayed in a new table every polling interval until interrupted.\n\nEXAMPLES:\n   CF_NAME app-metrics my-app\n   CF_NAME app-metrics my-app --query 'avg(cpu{source_id=\"$APP_GUID\"})'"`
+	relatedCommands interface{}  `related_commands:"app, logs"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *AppMetricsCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd AppMetricsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	templateValues := map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	}
+
+	if cmd.Query != "" {
+		cmd.UI.DisplayTextWithFlavor("Querying metrics for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+		cmd.UI.DisplayNewline()
+		return cmd.displayQuery()
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Streaming metrics for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	cmd.UI.DisplayNewline()
+	return cmd.streamMetrics()
+}
+
+func (cmd AppMetricsCommand) displayQuery() error {
+	samples, warnings, err := cmd.Actor.QueryMetricsForApplicationByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.Query,
+		cmd.LogCacheClient,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(samples) == 0 {
+		cmd.UI.DisplayText("No metrics found.")
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("metric"),
+		cmd.UI.TranslateText("value"),
+		cmd.UI.TranslateText("time"),
+	}}
+	for _, sample := range samples {
+		table = append(table, []string{
+			formatMetricLabels(sample.Labels),
+			strconv.FormatFloat(sample.Value, 'f', -1, 64),
+			cmd.UI.UserFriendlyDate(sample.Timestamp),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
+
+func (cmd AppMetricsCommand) streamMetrics() error {
+	stop := make(chan struct{})
+	stoppedRefreshing := make(chan struct{})
+	tokenRefreshErrors, err := cmd.Actor.ScheduleTokenRefresh(time.After, stop, stoppedRefreshing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		close(stop)
+		<-stoppedRefreshing
+	}()
+
+	metrics, metricErrs, stopStreaming, warnings, err := cmd.Actor.GetStreamingMetricsForApplicationByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.LogCacheClient,
+		cmd.Config.PollingInterval(),
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	defer stopStreaming()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	for metrics != nil || metricErrs != nil {
+		select {
+		case instances, ok := <-metrics:
+			if !ok {
+				metrics = nil
+				continue
+			}
+			cmd.displayInstanceMetrics(instances)
+		case metricErr, ok := <-metricErrs:
+			if !ok {
+				metricErrs = nil
+				continue
+			}
+			cmd.UI.DisplayWarning("{{.Error}}", map[string]interface{}{"Error": metricErr})
+		case err := <-tokenRefreshErrors:
+			cmd.UI.DisplayError(err)
+		case <-interrupt:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (cmd AppMetricsCommand) displayInstanceMetrics(instances []sharedaction.InstanceMetrics) {
+	if len(instances) == 0 {
+		cmd.UI.DisplayText("No metrics found.")
+		cmd.UI.DisplayNewline()
+		return
+	}
+
+	var latest time.Time
+	gaugeNames := map[string]bool{}
+	counterNames := map[string]bool{}
+	for _, instance := range instances {
+		if instance.Timestamp.After(latest) {
+			latest = instance.Timestamp
+		}
+		for name := range instance.Gauges {
+			switch name {
+			case cpuGauge, memoryGauge, memoryQuotaGauge, diskGauge, diskQuotaGauge:
+			default:
+				gaugeNames[name] = true
+			}
+		}
+		for name := range instance.Counters {
+			counterNames[name] = true
+		}
+	}
+	extraGauges := sortedKeys(gaugeNames)
+	counters := sortedKeys(counterNames)
+
+	header := []string{
+		"",
+		cmd.UI.TranslateText("cpu"),
+		cmd.UI.TranslateText("memory"),
+		cmd.UI.TranslateText("disk"),
+	}
+	header = append(header, extraGauges...)
+	header = append(header, counters...)
+	table := [][]string{header}
+
+	for _, instance := range instances {
+		row := []string{
+			instanceLabel(instance),
+			formatCPUGauge(instance.Gauges),
+			cmd.formatUsageGauge(instance.Gauges, memoryGauge, memoryQuotaGauge),
+			cmd.formatUsageGauge(instance.Gauges, diskGauge, diskQuotaGauge),
+		}
+		for _, name := range extraGauges {
+			row = append(row, formatGauge(instance.Gauges, name))
+		}
+		for _, name := range counters {
+			value := ""
+			if total, ok := instance.Counters[name]; ok {
+				value = strconv.FormatUint(total, 10)
+			}
+			row = append(row, value)
+		}
+		table = append(table, row)
+	}
+
+	cmd.UI.DisplayText("Metrics as of {{.Time}}:", map[string]interface{}{
+		"Time": cmd.UI.UserFriendlyDate(latest),
+	})
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+}
+
+// instanceLabel names an instance the way cf app does, prefixed with its
+// process type when log cache reports one.
+func instanceLabel(instance sharedaction.InstanceMetrics) string {
+	if instance.ProcessType == "" {
+		return "#" + instance.Instance
+	}
+	return instance.ProcessType + " #" + instance.Instance
+}
+
+func (cmd AppMetricsCommand) formatUsageGauge(gauges map[string]sharedaction.GaugeValue, usageName string, quotaName string) string {
+	usage, ok := gauges[usageName]
+	if !ok {
+		return ""
+	}
+
+	quota, ok := gauges[quotaName]
+	if !ok {
+		return bytefmt.ByteSize(uint64(usage.Value))
+	}
+
+	return cmd.UI.TranslateText("{{.Usage}} of {{.Quota}}", map[string]interface{}{
+		"Usage": bytefmt.ByteSize(uint64(usage.Value)),
+		"Quota": bytefmt.ByteSize(uint64(quota.Value)),
+	})
+}
+
+func formatCPUGauge(gauges map[string]sharedaction.GaugeValue) string {
+	cpu, ok := gauges[cpuGauge]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%.1f%%", cpu.Value)
+}
+
+func formatGauge(gauges map[string]sharedaction.GaugeValue, name string) string {
+	gauge, ok := gauges[name]
+	if !ok {
+		return ""
+	}
+
+	switch gauge.Unit {
+	case "bytes":
+		return bytefmt.ByteSize(uint64(gauge.Value))
+	case "":
+		return strconv.FormatFloat(gauge.Value, 'f', -1, 64)
+	default:
+		return strconv.FormatFloat(gauge.Value, 'f', -1, 64) + " " + gauge.Unit
+	}
+}
+
+// formatMetricLabels formats labels the way PromQL selects them, e.g.
+// cpu{instance_id="0",source_id="..."}.
+func formatMetricLabels(labels map[string]string) string {
+	name := labels["__name__"]
+
+	var keys []string
+	for key := range labels {
+		if key != "__name__" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", key, labels[key]))
+	}
+
+	if len(pairs) == 0 {
+		return name
+	}
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+func sortedKeys(set map[string]bool) []string {
+	var keys []string
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,229 @@
+package v7_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-metrics command", func() {
+	var (
+		cmd             AppMetricsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		logCacheClient  *sharedactionfakes.FakeLogCacheClient
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		logCacheClient = new(sharedactionfakes.FakeLogCacheClient)
+
+		cmd = AppMetricsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			LogCacheClient: logCacheClient,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		cmd.RequiredArgs.AppName = "some-app"
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space-name", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org-name"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("a query is given", func() {
+		BeforeEach(func() {
+			cmd.Query = `avg(cpu{source_id="$APP_GUID"})`
+		})
+
+		When("the query returns samples", func() {
+			BeforeEach(func() {
+				fakeActor.QueryMetricsForApplicationByNameAndSpaceReturns(
+					[]sharedaction.MetricSample{
+						{
+							Labels:    map[string]string{"__name__": "cpu", "source_id": "some-app-guid", "instance_id": "0"},
+							Timestamp: time.Unix(100, 0),
+							Value:     12.5,
+						},
+					},
+					v7action.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("displays the samples and warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				appName, spaceGUID, query, client := fakeActor.QueryMetricsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(query).To(Equal(`avg(cpu{source_id="$APP_GUID"})`))
+				Expect(client).To(Equal(logCacheClient))
+
+				Expect(testUI.Out).To(Say(`Querying metrics for app some-app in org some-org-name / space some-space-name as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`metric\s+value\s+time`))
+				Expect(testUI.Out).To(Say(`cpu\{instance_id="0",source_id="some-app-guid"\}\s+12\.5`))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(fakeActor.GetStreamingMetricsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the query returns no samples", func() {
+			It("says so", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("No metrics found."))
+			})
+		})
+
+		When("the query fails", func() {
+			BeforeEach(func() {
+				fakeActor.QueryMetricsForApplicationByNameAndSpaceReturns(nil, v7action.Warnings{"some-warning"}, errors.New("bad query"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("bad query"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+	})
+
+	When("no query is given", func() {
+		BeforeEach(func() {
+			fakeConfig.PollingIntervalReturns(3 * time.Second)
+			fakeActor.ScheduleTokenRefreshStub = func(
+				after func(time.Duration) <-chan time.Time,
+				stop chan struct{}, stoppedRefreshing chan struct{}) (<-chan error, error) {
+				go func() {
+					<-stop
+					close(stoppedRefreshing)
+				}()
+				return make(chan error, 1), nil
+			}
+		})
+
+		When("the metrics stream returns metrics and errors", func() {
+			var cancelled bool
+
+			BeforeEach(func() {
+				cancelled = false
+				fakeActor.GetStreamingMetricsForApplicationByNameAndSpaceStub = func(string, string, sharedaction.LogCacheClient, time.Duration) (<-chan []sharedaction.InstanceMetrics, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+					metrics := make(chan []sharedaction.InstanceMetrics, 1)
+					metricErrs := make(chan error, 1)
+					metrics <- []sharedaction.InstanceMetrics{
+						{
+							Instance:  "0",
+							Timestamp: time.Unix(100, 0),
+							Gauges: map[string]sharedaction.GaugeValue{
+								"cpu":          {Value: 12.5, Unit: "percentage"},
+								"memory":       {Value: 32 * 1024 * 1024, Unit: "bytes"},
+								"memory_quota": {Value: 1024 * 1024 * 1024, Unit: "bytes"},
+								"disk":         {Value: 64 * 1024 * 1024, Unit: "bytes"},
+							},
+							Counters: map[string]uint64{"requests": 42},
+						},
+						{
+							ProcessType: "worker",
+							Instance:    "0",
+							Timestamp:   time.Unix(90, 0),
+							Gauges: map[string]sharedaction.GaugeValue{
+								"cpu": {Value: 3, Unit: "percentage"},
+							},
+							Counters: map[string]uint64{},
+						},
+					}
+					metricErrs <- errors.New("Failed to retrieve metrics from Log Cache: flaky")
+					close(metrics)
+					close(metricErrs)
+					return metrics, metricErrs, func() { cancelled = true }, v7action.Warnings{"some-warning"}, nil
+				}
+			})
+
+			It("displays a table of the latest metrics of each instance", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				appName, spaceGUID, client, interval := fakeActor.GetStreamingMetricsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(client).To(Equal(logCacheClient))
+				Expect(interval).To(Equal(3 * time.Second))
+
+				Expect(testUI.Out).To(Say(`Streaming metrics for app some-app in org some-org-name / space some-space-name as some-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`Metrics as of`))
+				Expect(testUI.Out).To(Say(`\s+cpu\s+memory\s+disk\s+requests`))
+				Expect(testUI.Out).To(Say(`#0\s+12\.5%\s+32M of 1G\s+64M\s+42`))
+				Expect(testUI.Out).To(Say(`worker #0\s+3\.0%`))
+			})
+
+			It("displays errors as warnings and stops streaming", func() {
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Err).To(Say("Failed to retrieve metrics from Log Cache: flaky"))
+				Expect(cancelled).To(BeTrue())
+			})
+		})
+
+		When("finding the app fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetStreamingMetricsForApplicationByNameAndSpaceReturns(nil, nil, nil, v7action.Warnings{"some-warning"}, errors.New("app not found"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("app not found"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		When("scheduling the token refresh fails", func() {
+			BeforeEach(func() {
+				fakeActor.ScheduleTokenRefreshReturns(nil, errors.New("refresh failed"))
+			})
+
+			It("returns the error without streaming", func() {
+				Expect(executeErr).To(MatchError("refresh failed"))
+				Expect(fakeActor.GetStreamingMetricsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
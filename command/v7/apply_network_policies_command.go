@@ -0,0 +1,140 @@
+package v7
+
+import (
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ApplyNetworkPoliciesActor
+
+type ApplyNetworkPoliciesActor interface {
+	ApplyNetworkPolicies(spaceGUID string, policies []cfnetworkingaction.Policy, prune bool) (cfnetworkingaction.NetworkPolicyChanges, cfnetworkingaction.Warnings, error)
+}
+
+type ApplyNetworkPoliciesCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ApplyNetworkPoliciesArgs `positional-args:"yes"`
+	Prune           bool                          `long:"prune" description:"Remove the policies of apps in the targeted space that are not in the file"`
+	usage           interface{}                   `usage:"CF_NAME apply-network-policies PATH [--prune]\n\n   Adds the policies in the file that are missing from the targeted space. The source apps\n   of all policies are in the targeted space. Destination apps are in the targeted space\n   unless the policy has a destination_space, and in the targeted org unless it has a\n   destination_org.\n\n   Valid file example:\n   policies:\n   - source: frontend\n     destination: backend\n     protocol: tcp\n     ports: 8080-8090\n   - source: frontend\n     destination: auth\n     destination_space: shared\n     destination_org: platform\n     protocol: tcp\n     ports: 443\n\nEXAMPLES:\n   CF_NAME export-network-policies -p policies.yml\n   CF_NAME apply-network-policies policies.yml --prune"`
+	relatedCommands interface{}                   `related_commands:"add-network-policy, export-network-policies, network-policies, remove-network-policy"`
+
+	NetworkingActor ApplyNetworkPoliciesActor
+}
+
+func (cmd *ApplyNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	path := string(cmd.RequiredArgs.Path)
+	spaceName := cmd.Config.TargetedSpace().Name
+	orgName := cmd.Config.TargetedOrganization().Name
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	document, err := shared.ParseNetworkPoliciesDocument(raw)
+	if err != nil {
+		return translatableerror.InvalidNetworkPoliciesFileError{Path: path, Err: err}
+	}
+
+	policies, err := document.ToPolicies(spaceName, orgName)
+	if err != nil {
+		return translatableerror.InvalidNetworkPoliciesFileError{Path: path, Err: err}
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying network policies from {{.Path}} to org {{.Org}} / space {{.Space}} as {{.User}}...", map[string]interface{}{
+		"Path":  path,
+		"Org":   orgName,
+		"Space": spaceName,
+		"User":  user.Name,
+	})
+
+	changes, warnings, err := cmd.NetworkingActor.ApplyNetworkPolicies(cmd.Config.TargetedSpace().GUID, policies, cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	cmd.displayChanges(changes)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ApplyNetworkPoliciesCommand) displayChanges(changes cfnetworkingaction.NetworkPolicyChanges) {
+	cmd.UI.DisplayNewline()
+
+	if len(changes.Added) == 0 && len(changes.Removed) == 0 {
+		cmd.UI.DisplayText("No network policies were changed.")
+		cmd.UI.DisplayNewline()
+		return
+	}
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("source"),
+			cmd.UI.TranslateText("destination"),
+			cmd.UI.TranslateText("protocol"),
+			cmd.UI.TranslateText("ports"),
+			cmd.UI.TranslateText("destination space"),
+			cmd.UI.TranslateText("destination org"),
+		},
+	}
+
+	for _, change := range []struct {
+		marker   string
+		policies []cfnetworkingaction.Policy
+	}{
+		{"+", changes.Added},
+		{"-", changes.Removed},
+	} {
+		for _, policy := range change.policies {
+			table = append(table, []string{
+				change.marker,
+				policy.SourceName,
+				policy.DestinationName,
+				policy.Protocol,
+				shared.FormatPortRange(policy.StartPort, policy.EndPort),
+				policy.DestinationSpaceName,
+				policy.DestinationOrgName,
+			})
+		}
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+}
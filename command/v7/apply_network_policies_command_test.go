@@ -0,0 +1,204 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-network-policies Command", func() {
+	var (
+		cmd                           ApplyNetworkPoliciesCommand
+		testUI                        *ui.UI
+		fakeConfig                    *commandfakes.FakeConfig
+		fakeSharedActor               *commandfakes.FakeSharedActor
+		fakeActor                     *v7fakes.FakeActor
+		fakeApplyNetworkPoliciesActor *v7fakes.FakeApplyNetworkPoliciesActor
+		binaryName                    string
+		executeErr                    error
+		dir                           string
+		path                          string
+		contents                      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeApplyNetworkPoliciesActor = new(v7fakes.FakeApplyNetworkPoliciesActor)
+
+		var err error
+		dir, err = ioutil.TempDir("", "apply-network-policies")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "policies.yml")
+
+		cmd = ApplyNetworkPoliciesCommand{
+			BaseCommand: BaseCommand{
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeApplyNetworkPoliciesActor,
+			RequiredArgs:    flag.ApplyNetworkPoliciesArgs{Path: flag.PathWithExistenceCheck(path)},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+
+		contents = `
+policies:
+- source: app1
+  destination: app2
+  protocol: tcp
+  ports: 8080-8090
+- source: app1
+  destination: app3
+  destination_space: other-space
+  destination_org: other-org
+  protocol: udp
+  ports: 53
+`
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the policies are applied", func() {
+		BeforeEach(func() {
+			fakeApplyNetworkPoliciesActor.ApplyNetworkPoliciesReturns(cfnetworkingaction.NetworkPolicyChanges{
+				Added: []cfnetworkingaction.Policy{
+					{SourceName: "app1", DestinationName: "app3", Protocol: "udp", StartPort: 53, EndPort: 53, DestinationSpaceName: "other-space", DestinationOrgName: "other-org"},
+				},
+				Removed: []cfnetworkingaction.Policy{
+					{SourceName: "app1", DestinationName: "app4", Protocol: "tcp", StartPort: 8080, EndPort: 8080, DestinationSpaceName: "some-space", DestinationOrgName: "some-org"},
+				},
+			}, cfnetworkingaction.Warnings{"some-warning"}, nil)
+		})
+
+		It("applies the policies in the file to the targeted space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeApplyNetworkPoliciesActor.ApplyNetworkPoliciesCallCount()).To(Equal(1))
+			spaceGUID, policies, prune := fakeApplyNetworkPoliciesActor.ApplyNetworkPoliciesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(policies).To(Equal([]cfnetworkingaction.Policy{
+				{SourceName: "app1", DestinationName: "app2", Protocol: "tcp", StartPort: 8080, EndPort: 8090, DestinationSpaceName: "some-space", DestinationOrgName: "some-org"},
+				{SourceName: "app1", DestinationName: "app3", Protocol: "udp", StartPort: 53, EndPort: 53, DestinationSpaceName: "other-space", DestinationOrgName: "other-org"},
+			}))
+			Expect(prune).To(BeFalse())
+		})
+
+		It("displays the changes", func() {
+			Expect(testUI.Out).To(Say(`Applying network policies from .*policies\.yml to org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`\n\n\s+source\s+destination\s+protocol\s+ports\s+destination space\s+destination org`))
+			Expect(testUI.Out).To(Say(`\+\s+app1\s+app3\s+udp\s+53\s+other-space\s+other-org`))
+			Expect(testUI.Out).To(Say(`-\s+app1\s+app4\s+tcp\s+8080\s+some-space\s+some-org`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+
+		When("--prune is given", func() {
+			BeforeEach(func() {
+				cmd.Prune = true
+			})
+
+			It("asks the actor to remove the other policies", func() {
+				_, _, prune := fakeApplyNetworkPoliciesActor.ApplyNetworkPoliciesArgsForCall(0)
+				Expect(prune).To(BeTrue())
+			})
+		})
+	})
+
+	When("nothing changes", func() {
+		It("says so", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No network policies were changed\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the file is not valid YAML or JSON", func() {
+		BeforeEach(func() {
+			contents = "policies: [\n"
+		})
+
+		It("returns an InvalidNetworkPoliciesFileError without applying anything", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.InvalidNetworkPoliciesFileError{}))
+			Expect(executeErr.(translatableerror.InvalidNetworkPoliciesFileError).Path).To(Equal(path))
+			Expect(fakeApplyNetworkPoliciesActor.ApplyNetworkPoliciesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a policy in the file is invalid", func() {
+		BeforeEach(func() {
+			contents = `
+policies:
+- source: app1
+  destination: app2
+  protocol: icmp
+  ports: 8080
+`
+		})
+
+		It("returns an InvalidNetworkPoliciesFileError without applying anything", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidNetworkPoliciesFileError{
+				Path: path,
+				Err:  errors.New(`policy 1: PROTOCOL must be "tcp" or "udp"`),
+			}))
+			Expect(fakeApplyNetworkPoliciesActor.ApplyNetworkPoliciesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("applying the policies fails", func() {
+		BeforeEach(func() {
+			fakeApplyNetworkPoliciesActor.ApplyNetworkPoliciesReturns(cfnetworkingaction.NetworkPolicyChanges{}, cfnetworkingaction.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v7
 import (
 	"strings"
 
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -28,12 +29,14 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, cmd.Labels, cmd.OmitStats)
 	cmd.UI.DisplayWarnings(warnings)
@@ -41,6 +44,10 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.AppListKind, shared.NewAppListOutput(summaries))
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
@@ -345,4 +345,51 @@ var _ = Describe("apps Command", func() {
 		})
 	})
 
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetAppSummariesForSpaceReturns(
+				[]v7action.ApplicationSummary{
+					{
+						Application: resources.Application{
+							Name:  "some-app",
+							GUID:  "some-app-guid",
+							State: constant.ApplicationStarted,
+						},
+						ProcessSummaries: []v7action.ProcessSummary{
+							{Process: resources.Process{Type: "web"}},
+						},
+						Routes: []resources.Route{{URL: "some-app.example.com"}},
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the app summaries as a versioned document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting apps"))
+			Expect(testUI.Out).To(Say(`"schema_version": "1"`))
+			Expect(testUI.Out).To(Say(`"kind": "app_list"`))
+			Expect(testUI.Out).To(Say(`"name": "some-app"`))
+			Expect(testUI.Out).To(Say(`"requested_state": "started"`))
+			Expect(testUI.Out).To(Say(`"some-app.example.com"`))
+			Expect(testUI.Out).To(Say(`"type": "web"`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("there are no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetAppSummariesForSpaceReturns(nil, nil, nil)
+			})
+
+			It("renders an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("No apps found"))
+				Expect(testUI.Out).To(Say(`"data": \[\]`))
+			})
+		})
+	})
 })
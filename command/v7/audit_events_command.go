@@ -0,0 +1,210 @@
+package v7
+
+import (
+	"os"
+	"os/signal"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type AuditEventsCommand struct {
+	BaseCommand
+
+	Organization    string         `long:"org" short:"o" description:"Only show events in this org"`
+	Space           string         `long:"space" short:"s" description:"Only show events in this space of the org given with --org, or of the targeted org"`
+	TargetGUIDs     []string       `long:"target-guid" description:"Only show events for the resource with this GUID. Can be specified multiple times."`
+	Types           []string       `long:"type" description:"Only show events of this type, e.g. audit.app.update. Can be specified multiple times."`
+	Since           flag.Timestamp `long:"since" description:"Only show events created at or after this time, given as an RFC3339 timestamp or a duration before now (e.g. 24h)"`
+	Until           flag.Timestamp `long:"until" description:"Only show events created at or before this time, given as an RFC3339 timestamp or a duration before now (e.g. 24h)"`
+	Follow          bool           `long:"follow" short:"f" description:"Keep showing new events as they happen"`
+	usage           interface{}    `usage:"CF_NAME audit-events [-o ORG] [-s SPACE] [--target-guid GUID]... [--type TYPE]... [--since TIME] [--until TIME] [-f]\n\nEXAMPLES:\n   CF_NAME audit-events -o my-org --since 24h\n   CF_NAME audit-events -o my-org -s production --type audit.app.ssh-authorized --type audit.app.update\n   CF_NAME audit-events --target-guid 2e3151ba-9a63-4345-9c5b-6d8c238f4e55 --since 2024-01-01T00:00:00Z --until 2024-02-01T00:00:00Z\n   CF_NAME audit-events -o my-org -f"`
+	relatedCommands interface{}    `related_commands:"events"`
+}
+
+func (cmd AuditEventsCommand) Execute(args []string) error {
+	if cmd.Follow && cmd.Until.IsSet {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--follow", "--until"},
+		}
+	}
+
+	if cmd.Follow && shared.IsStructuredOutput(cmd.Config) {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--follow", "--output"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Space != "" && cmd.Organization == "", false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	filter, err := cmd.buildFilter()
+	if err != nil {
+		return err
+	}
+
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.displayGettingMessage(user.Name)
+		cmd.UI.DisplayNewline()
+	}
+
+	if cmd.Follow {
+		return cmd.followEvents(filter)
+	}
+
+	events, warnings, err := cmd.Actor.GetAuditEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.AuditEventListKind, shared.NewAuditEventListOutput(events))
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No events found.")
+		return nil
+	}
+
+	table := [][]string{cmd.tableHeader()}
+	for _, event := range events {
+		table = append(table, auditEventRow(event))
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd AuditEventsCommand) buildFilter() (v7action.AuditEventFilter, error) {
+	now := time.Now()
+	filter := v7action.AuditEventFilter{
+		TargetGUIDs: cmd.TargetGUIDs,
+		Types:       cmd.Types,
+		Since:       cmd.Since.Resolve(now),
+		Until:       cmd.Until.Resolve(now),
+	}
+
+	orgGUID := ""
+	if cmd.Organization != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v7action.AuditEventFilter{}, err
+		}
+		orgGUID = org.GUID
+		filter.OrganizationGUIDs = []string{org.GUID}
+	}
+
+	if cmd.Space != "" {
+		if orgGUID == "" {
+			orgGUID = cmd.Config.TargetedOrganization().GUID
+		}
+		space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, orgGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return v7action.AuditEventFilter{}, err
+		}
+		filter.SpaceGUIDs = []string{space.GUID}
+	}
+
+	return filter, nil
+}
+
+func (cmd AuditEventsCommand) displayGettingMessage(username string) {
+	orgName := cmd.Organization
+	if orgName == "" && cmd.Space != "" {
+		orgName = cmd.Config.TargetedOrganizationName()
+	}
+
+	switch {
+	case cmd.Space != "":
+		cmd.UI.DisplayTextWithFlavor("Getting audit events in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   orgName,
+			"SpaceName": cmd.Space,
+			"Username":  username,
+		})
+	case orgName != "":
+		cmd.UI.DisplayTextWithFlavor("Getting audit events in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgName,
+			"Username": username,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting audit events as {{.Username}}...", map[string]interface{}{
+			"Username": username,
+		})
+	}
+}
+
+func (cmd AuditEventsCommand) followEvents(filter v7action.AuditEventFilter) error {
+	events, warnings, errs, stopStreaming := cmd.Actor.StreamAuditEvents(filter, cmd.Config.PollingInterval())
+	defer stopStreaming()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	// Rows are printed as events arrive, so the columns are not aligned
+	// across rows the way a single table would be.
+	cmd.UI.DisplayNonWrappingTable("", [][]string{cmd.tableHeader()}, ui.DefaultTableSpacePadding)
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			cmd.UI.DisplayNonWrappingTable("", [][]string{auditEventRow(event)}, ui.DefaultTableSpacePadding)
+		case streamWarnings, ok := <-warnings:
+			if !ok {
+				warnings = nil
+				continue
+			}
+			cmd.UI.DisplayWarnings(streamWarnings)
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			return err
+		case <-interrupt:
+			return nil
+		}
+	}
+}
+
+func (cmd AuditEventsCommand) tableHeader() []string {
+	return []string{
+		cmd.UI.TranslateText("time"),
+		cmd.UI.TranslateText("event"),
+		cmd.UI.TranslateText("actor"),
+		cmd.UI.TranslateText("target"),
+		cmd.UI.TranslateText("description"),
+	}
+}
+
+func auditEventRow(event v7action.AuditEvent) []string {
+	target := event.TargetGUID
+	if event.TargetName != "" {
+		target = event.TargetType + " " + event.TargetName
+	}
+
+	return []string{
+		event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
+		event.Type,
+		event.ActorName,
+		target,
+		event.Description,
+	}
+}
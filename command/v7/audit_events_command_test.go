@@ -0,0 +1,280 @@
+package v7_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("audit-events Command", func() {
+	var (
+		cmd             AuditEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		eventTime       time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = AuditEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org", GUID: "targeted-org-guid"})
+		fakeConfig.TargetedOrganizationNameReturns("targeted-org")
+		fakeActor.GetOrganizationByNameReturns(resources.Organization{Name: "some-org", GUID: "some-org-guid"}, v7action.Warnings{"get-org-warning"}, nil)
+		fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{Name: "some-space", GUID: "some-space-guid"}, v7action.Warnings{"get-space-warning"}, nil)
+
+		eventTime = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		fakeActor.GetAuditEventsReturns(
+			[]v7action.AuditEvent{
+				{
+					GUID:        "event-guid-1",
+					Time:        eventTime,
+					Type:        "audit.app.update",
+					ActorName:   "admin",
+					TargetGUID:  "app-guid",
+					TargetType:  "app",
+					TargetName:  "dora",
+					Description: "instances: 2",
+				},
+				{
+					GUID:       "event-guid-2",
+					Time:       eventTime.Add(time.Minute),
+					Type:       "audit.user.space_developer_add",
+					ActorName:  "admin",
+					TargetGUID: "user-guid",
+				},
+			},
+			v7action.Warnings{"get-events-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks that the user is logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	When("no filters are given", func() {
+		It("lists all events visible to the user", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Getting audit events as some-user...")))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{}))
+
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+			Expect(testUI.Out).To(Say(`audit.app.update\s+admin\s+app dora\s+instances: 2`))
+			Expect(testUI.Out).To(Say(`audit.user.space_developer_add\s+admin\s+user-guid`))
+		})
+	})
+
+	When("filters are given", func() {
+		BeforeEach(func() {
+			cmd.Organization = "some-org"
+			cmd.Space = "some-space"
+			cmd.TargetGUIDs = []string{"app-guid"}
+			cmd.Types = []string{"audit.app.update", "audit.app.ssh-authorized"}
+			cmd.Since = flag.Timestamp{Time: eventTime.Add(-time.Hour), IsSet: true}
+			cmd.Until = flag.Timestamp{Time: eventTime.Add(time.Hour), IsSet: true}
+		})
+
+		It("resolves the org and space and filters by all of them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Getting audit events in org some-org / space some-space as some-user...")))
+			Expect(testUI.Err).To(Say("get-org-warning"))
+			Expect(testUI.Err).To(Say("get-space-warning"))
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{
+				OrganizationGUIDs: []string{"some-org-guid"},
+				SpaceGUIDs:        []string{"some-space-guid"},
+				TargetGUIDs:       []string{"app-guid"},
+				Types:             []string{"audit.app.update", "audit.app.ssh-authorized"},
+				Since:             eventTime.Add(-time.Hour),
+				Until:             eventTime.Add(time.Hour),
+			}))
+		})
+	})
+
+	When("only a space is given", func() {
+		BeforeEach(func() {
+			cmd.Space = "some-space"
+		})
+
+		It("looks for the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			checkOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Getting audit events in org targeted-org / space some-space as some-user...")))
+			_, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(orgGUID).To(Equal("targeted-org-guid"))
+			Expect(fakeActor.GetAuditEventsArgsForCall(0).OrganizationGUIDs).To(BeEmpty())
+		})
+	})
+
+	When("the org does not exist", func() {
+		BeforeEach(func() {
+			cmd.Organization = "missing-org"
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{}, nil, actionerror.OrganizationNotFoundError{Name: "missing-org"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "missing-org"}))
+			Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no events", func() {
+		BeforeEach(func() {
+			fakeActor.GetAuditEventsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No events found."))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAuditEventsReturns(nil, v7action.Warnings{"get-events-warning"}, errors.New("events-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("events-error"))
+			Expect(testUI.Err).To(Say("get-events-warning"))
+		})
+	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		})
+
+		It("renders the events as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting audit events"))
+			Expect(testUI.Out).To(Say(`"kind": "audit_event_list"`))
+			Expect(testUI.Out).To(Say(`"guid": "event-guid-1"`))
+			Expect(testUI.Out).To(Say(`"created_at": "2026-10-01T12:00:00Z"`))
+		})
+	})
+
+	When("following new events", func() {
+		var (
+			eventStream    chan v7action.AuditEvent
+			warningsStream chan v7action.Warnings
+			errorStream    chan error
+			cancelled      bool
+		)
+
+		BeforeEach(func() {
+			cmd.Follow = true
+			cancelled = false
+			fakeConfig.PollingIntervalReturns(3 * time.Second)
+
+			eventStream = make(chan v7action.AuditEvent)
+			warningsStream = make(chan v7action.Warnings)
+			errorStream = make(chan error)
+			fakeActor.StreamAuditEventsStub = func(v7action.AuditEventFilter, time.Duration) (<-chan v7action.AuditEvent, <-chan v7action.Warnings, <-chan error, context.CancelFunc) {
+				go func() {
+					eventStream <- v7action.AuditEvent{Time: eventTime, Type: "audit.app.start", ActorName: "admin", TargetType: "app", TargetName: "dora"}
+					warningsStream <- v7action.Warnings{"stream-warning"}
+					close(eventStream)
+					close(warningsStream)
+					close(errorStream)
+				}()
+				return eventStream, warningsStream, errorStream, func() { cancelled = true }
+			}
+		})
+
+		It("prints events as they arrive until the stream ends", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+
+			_, pollInterval := fakeActor.StreamAuditEventsArgsForCall(0)
+			Expect(pollInterval).To(Equal(3 * time.Second))
+
+			Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+			Expect(testUI.Out).To(Say(`audit.app.start\s+admin\s+app dora`))
+			Expect(testUI.Err).To(Say("stream-warning"))
+			Expect(cancelled).To(BeTrue())
+		})
+
+		When("the stream fails", func() {
+			BeforeEach(func() {
+				fakeActor.StreamAuditEventsStub = func(v7action.AuditEventFilter, time.Duration) (<-chan v7action.AuditEvent, <-chan v7action.Warnings, <-chan error, context.CancelFunc) {
+					go func() {
+						errorStream <- errors.New("stream-error")
+					}()
+					return eventStream, warningsStream, errorStream, func() {}
+				}
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("stream-error"))
+			})
+		})
+
+		When("--until is also given", func() {
+			BeforeEach(func() {
+				cmd.Until = flag.Timestamp{Time: eventTime, IsSet: true}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--follow", "--until"}}))
+			})
+		})
+
+		When("structured output is requested", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--follow", "--output"}}))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v7
 import (
 	"strconv"
 
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -26,10 +27,12 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	buildpacks, warnings, err := cmd.Actor.GetBuildpacks(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -37,6 +40,10 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.BuildpackListKind, shared.NewBuildpackListOutput(buildpacks))
+	}
+
 	if len(buildpacks) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No buildpacks found")
 	} else {
@@ -155,4 +155,34 @@ var _ = Describe("buildpacks Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetBuildpacksReturns(
+				[]resources.Buildpack{
+					{
+						Name:     "some-buildpack",
+						Position: types.NullInt{Value: 1, IsSet: true},
+						Enabled:  types.NullBool{Value: true, IsSet: true},
+						Stack:    "cflinuxfs4",
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the buildpacks as a versioned document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting buildpacks"))
+			Expect(testUI.Out).To(Say(`"kind": "buildpack_list"`))
+			Expect(testUI.Out).To(Say(`"position": 1`))
+			Expect(testUI.Out).To(Say(`"name": "some-buildpack"`))
+			Expect(testUI.Out).To(Say(`"stack": "cflinuxfs4"`))
+			Expect(testUI.Out).To(Say(`"enabled": true`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
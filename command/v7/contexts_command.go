@@ -0,0 +1,52 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ContextsCommand struct {
+	UI              command.UI
+	Config          command.Config
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"api, login, target, use-context"`
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting contexts...")
+	cmd.UI.DisplayNewline()
+
+	current := cmd.Config.CurrentContext()
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+	for _, context := range cmd.Config.Contexts() {
+		marker := ""
+		if context.Name == current {
+			marker = "*"
+		}
+		table = append(table, []string{
+			marker,
+			context.Name,
+			context.Target,
+			context.TargetedOrganization.Name,
+			context.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,58 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts Command", func() {
+	var (
+		cmd        ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+
+		fakeConfig.CurrentContextReturns("staging")
+		fakeConfig.ContextsReturns([]configv3.NamedContext{
+			{
+				Name: "default",
+				TargetContext: configv3.TargetContext{
+					Target:               "https://api.prod.example.com",
+					TargetedOrganization: configv3.Organization{Name: "prod-org"},
+					TargetedSpace:        configv3.Space{Name: "prod-space"},
+				},
+			},
+			{
+				Name:          "staging",
+				TargetContext: configv3.TargetContext{Target: "https://api.staging.example.com"},
+			},
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("lists the contexts and marks the current one", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Getting contexts\.\.\.`))
+		Expect(testUI.Out).To(Say(`\s+name\s+api endpoint\s+org\s+space`))
+		Expect(testUI.Out).To(Say(`\s+default\s+https://api.prod.example.com\s+prod-org\s+prod-space`))
+		Expect(testUI.Out).To(Say(`\*\s+staging\s+https://api.staging.example.com`))
+	})
+})
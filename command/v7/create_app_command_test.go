@@ -124,6 +124,22 @@ var _ = Describe("create-app Command", func() {
 					Expect(createSpaceGUID).To(Equal("some-space-guid"))
 				})
 			})
+
+			When("the cnb app type is specified", func() {
+				BeforeEach(func() {
+					cmd.AppType = "cnb"
+				})
+
+				It("creates an app with the cnb lifecycle", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					createApp, _ := fakeActor.CreateApplicationInSpaceArgsForCall(0)
+					Expect(createApp).To(Equal(resources.Application{
+						Name:          app,
+						LifecycleType: constant.AppLifecycleTypeCNB,
+					}))
+				})
+			})
 		})
 
 		When("the create is unsuccessful", func() {
@@ -1,21 +1,24 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/clock"
 )
 
 type CreatePackageCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName                `positional-args:"yes"`
+	OptionalArgs    flag.OptionalAppName        `positional-args:"yes"`
 	DockerImage     flag.DockerImage            `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
+	OutputFile      flag.Path                   `long:"output" description:"Write the package to FILE instead of uploading it"`
 	AppPath         flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
-	usage           interface{}                 `usage:"CF_NAME create-package APP_NAME [-p APP_PATH | --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG]]"`
+	usage           interface{}                 `usage:"CF_NAME create-package APP_NAME [-p APP_PATH | --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG]]\n   CF_NAME create-package [APP_NAME] [-p APP_PATH] --output FILE\n\n   Set CF_REPRODUCIBLE_ARCHIVES=true to build the same package for the same files on every machine."`
 	relatedCommands interface{}                 `related_commands:"app, droplets, packages, push"`
 
 	PackageDisplayer shared.PackageDisplayer
@@ -23,6 +26,21 @@ type CreatePackageCommand struct {
 
 func (cmd *CreatePackageCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.PackageDisplayer = shared.NewPackageDisplayer(ui, config)
+
+	// Writing the package locally does not need an API endpoint
+	if cmd.OutputFile != "" {
+		cmd.UI = ui
+		cmd.Config = config
+		sharedActor := sharedaction.NewActor(config)
+		cmd.SharedActor = sharedActor
+		cmd.Actor = v7action.NewActor(nil, config, sharedActor, nil, nil, clock.NewClock())
+		return nil
+	}
+
+	if cmd.OptionalArgs.AppName == "" {
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+
 	return cmd.BaseCommand.Setup(config, ui)
 }
 
@@ -33,6 +51,15 @@ func (cmd CreatePackageCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.OutputFile != "" {
+		if cmd.DockerImage.Path != "" {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"--output", "--docker-image, -o"},
+			}
+		}
+		return cmd.writePackage()
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -43,7 +70,7 @@ func (cmd CreatePackageCommand) Execute(args []string) error {
 	if err != nil {
 		return err
 	}
-	err = cmd.PackageDisplayer.DisplaySetupMessage(cmd.RequiredArgs.AppName, user.Name, isDockerImage)
+	err = cmd.PackageDisplayer.DisplaySetupMessage(cmd.OptionalArgs.AppName, user.Name, isDockerImage)
 	if err != nil {
 		return err
 	}
@@ -53,9 +80,9 @@ func (cmd CreatePackageCommand) Execute(args []string) error {
 		warnings v7action.Warnings
 	)
 	if isDockerImage {
-		pkg, warnings, err = cmd.Actor.CreateDockerPackageByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, v7action.DockerImageCredentials{Path: cmd.DockerImage.Path})
+		pkg, warnings, err = cmd.Actor.CreateDockerPackageByApplicationNameAndSpace(cmd.OptionalArgs.AppName, cmd.Config.TargetedSpace().GUID, v7action.DockerImageCredentials{Path: cmd.DockerImage.Path})
 	} else {
-		pkg, warnings, err = cmd.Actor.CreateAndUploadBitsPackageByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, string(cmd.AppPath))
+		pkg, warnings, err = cmd.Actor.CreateAndUploadBitsPackageByApplicationNameAndSpace(cmd.OptionalArgs.AppName, cmd.Config.TargetedSpace().GUID, string(cmd.AppPath))
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -70,3 +97,32 @@ func (cmd CreatePackageCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// writePackage builds the package locally and writes it to the output file
+// without uploading it, so it can be inspected or compared. No app is looked
+// up, so the app name is optional and only shown in the progress message.
+func (cmd CreatePackageCommand) writePackage() error {
+	if cmd.OptionalArgs.AppName == "" {
+		cmd.UI.DisplayTextWithFlavor("Writing package to {{.OutputFile}}...", map[string]interface{}{
+			"OutputFile": cmd.OutputFile,
+		})
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Writing package for app {{.AppName}} to {{.OutputFile}}...", map[string]interface{}{
+			"AppName":    cmd.OptionalArgs.AppName,
+			"OutputFile": cmd.OutputFile,
+		})
+	}
+
+	checksum, err := cmd.Actor.WriteBitsPackageArchive(string(cmd.AppPath), string(cmd.OutputFile))
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Package with sha256 checksum '{{.Checksum}}' has been written to {{.OutputFile}}.", map[string]interface{}{
+		"Checksum":   checksum,
+		"OutputFile": cmd.OutputFile,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
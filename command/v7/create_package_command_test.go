@@ -53,7 +53,7 @@ var _ = Describe("create-package Command", func() {
 				SharedActor: fakeSharedActor,
 				Actor:       fakeActor,
 			},
-			RequiredArgs:     flag.AppName{AppName: app},
+			OptionalArgs:     flag.OptionalAppName{AppName: app},
 			PackageDisplayer: packageDisplayer,
 		}
 	})
@@ -81,6 +81,84 @@ var _ = Describe("create-package Command", func() {
 		})
 	})
 
+	When("an output file is provided", func() {
+		BeforeEach(func() {
+			cmd.OutputFile = "some-package.zip"
+			cmd.AppPath = "some-app-path"
+			fakeActor.WriteBitsPackageArchiveReturns("some-checksum", nil)
+		})
+
+		It("writes the package locally without targeting or uploading", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Writing package for app some-app to some-package\.zip\.\.\.`))
+			Expect(testUI.Out).To(Say(`Package with sha256 checksum 'some-checksum' has been written to some-package\.zip\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.CreateAndUploadBitsPackageByApplicationNameAndSpaceCallCount()).To(Equal(0))
+
+			Expect(fakeActor.WriteBitsPackageArchiveCallCount()).To(Equal(1))
+			appPath, outputPath := fakeActor.WriteBitsPackageArchiveArgsForCall(0)
+			Expect(appPath).To(Equal("some-app-path"))
+			Expect(outputPath).To(Equal("some-package.zip"))
+		})
+
+		When("no app name is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppName = ""
+			})
+
+			It("writes the package without naming an app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Writing package to some-package\.zip\.\.\.`))
+				Expect(fakeActor.WriteBitsPackageArchiveCallCount()).To(Equal(1))
+			})
+		})
+
+		When("writing the package fails", func() {
+			BeforeEach(func() {
+				fakeActor.WriteBitsPackageArchiveReturns("", errors.New("some-write-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-write-error"))
+				Expect(testUI.Out).NotTo(Say("OK"))
+			})
+		})
+
+		When("a docker image is also provided", func() {
+			BeforeEach(func() {
+				cmd.AppPath = ""
+				cmd.DockerImage.Path = "some-docker-image"
+			})
+
+			It("displays an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--output", "--docker-image, -o"},
+				}))
+			})
+		})
+	})
+
+	Describe("Setup", func() {
+		When("no app name is provided without an output file", func() {
+			It("returns a RequiredArgumentError", func() {
+				cmd.OptionalArgs.AppName = ""
+				err := cmd.Setup(fakeConfig, testUI)
+				Expect(err).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			})
+		})
+
+		When("no app name is provided with an output file", func() {
+			It("does not require one", func() {
+				cmd.OptionalArgs.AppName = ""
+				cmd.OutputFile = "some-package.zip"
+				Expect(cmd.Setup(fakeConfig, testUI)).To(Succeed())
+			})
+		})
+	})
+
 	When("the user is logged in", func() {
 		BeforeEach(func() {
 			fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
@@ -10,12 +10,13 @@ import (
 type CreateRouteCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.Domain      `positional-args:"yes"`
-	usage           interface{}      `usage:"Create an HTTP route:\n      CF_NAME create-route DOMAIN [--hostname HOSTNAME] [--path PATH]\n\n   Create a TCP route:\n      CF_NAME create-route DOMAIN [--port PORT]\n\nEXAMPLES:\n   CF_NAME create-route example.com                             # example.com\n   CF_NAME create-route example.com --hostname myapp            # myapp.example.com\n   CF_NAME create-route example.com --hostname myapp --path foo # myapp.example.com/foo\n   CF_NAME create-route example.com --port 5000                 # example.com:5000"`
-	Hostname        string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
-	Path            flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
-	Port            int              `long:"port" description:"Port for the TCP route (default: random port)"`
-	relatedCommands interface{}      `related_commands:"check-route, domains, map-route, routes, unmap-route"`
+	RequiredArgs    flag.Domain        `positional-args:"yes"`
+	usage           interface{}        `usage:"Create an HTTP route:\n      CF_NAME create-route DOMAIN [--hostname HOSTNAME] [--path PATH] [--option OPTION=VALUE]...\n\n   Create a TCP route:\n      CF_NAME create-route DOMAIN [--port PORT]\n\nEXAMPLES:\n   CF_NAME create-route example.com                             # example.com\n   CF_NAME create-route example.com --hostname myapp            # myapp.example.com\n   CF_NAME create-route example.com --hostname myapp --path foo # myapp.example.com/foo\n   CF_NAME create-route example.com --hostname myapp --option loadbalancing=least-connection # myapp.example.com\n   CF_NAME create-route example.com --port 5000                 # example.com:5000"`
+	Hostname        string             `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path            flag.V7RoutePath   `long:"path" description:"Path for the HTTP route"`
+	Port            int                `long:"port" description:"Port for the TCP route (default: random port)"`
+	Options         []flag.RouteOption `long:"option" short:"o" description:"Set the value of a per-route option, e.g. loadbalancing=least-connection; can specify multiple times"`
+	relatedCommands interface{}        `related_commands:"check-route, domains, map-route, routes, unmap-route, update-route"`
 }
 
 func (cmd CreateRouteCommand) Execute(args []string) error {
@@ -46,7 +47,7 @@ func (cmd CreateRouteCommand) Execute(args []string) error {
 			"Organization": orgName,
 		})
 
-	route, warnings, err := cmd.Actor.CreateRoute(spaceGUID, domain, hostname, pathName, port)
+	route, warnings, err := cmd.Actor.CreateRoute(spaceGUID, domain, hostname, pathName, port, routeOptions(cmd.Options))
 
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -67,6 +68,20 @@ func (cmd CreateRouteCommand) Execute(args []string) error {
 	return nil
 }
 
+// routeOptions converts the --option flags into the options of a route.
+func routeOptions(options []flag.RouteOption) map[string]*string {
+	if len(options) == 0 {
+		return nil
+	}
+
+	routeOptions := map[string]*string{}
+	for _, option := range options {
+		value := option.Value
+		routeOptions[option.Key] = &value
+	}
+	return routeOptions
+}
+
 func desiredURL(domain, hostname, path string, port int) string {
 	url := ""
 
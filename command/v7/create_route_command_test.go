@@ -37,6 +37,7 @@ var _ = Describe("create-route Command", func() {
 		hostname   string
 		path       string
 		port       int
+		options    []flag.RouteOption
 	)
 
 	BeforeEach(func() {
@@ -52,6 +53,7 @@ var _ = Describe("create-route Command", func() {
 		hostname = ""
 		path = ""
 		port = 0
+		options = nil
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -65,6 +67,7 @@ var _ = Describe("create-route Command", func() {
 			Hostname: hostname,
 			Path:     flag.V7RoutePath{Path: path},
 			Port:     port,
+			Options:  options,
 			BaseCommand: BaseCommand{
 				UI:          testUI,
 				Config:      fakeConfig,
@@ -169,7 +172,7 @@ var _ = Describe("create-route Command", func() {
 
 			It("creates the route", func() {
 				Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
-				expectedSpaceGUID, expectedDomainName, expectedHostname, _, _ := fakeActor.CreateRouteArgsForCall(0)
+				expectedSpaceGUID, expectedDomainName, expectedHostname, _, _, _ := fakeActor.CreateRouteArgsForCall(0)
 				Expect(expectedSpaceGUID).To(Equal(spaceGUID))
 				Expect(expectedDomainName).To(Equal(domainName))
 				Expect(expectedHostname).To(Equal(hostname))
@@ -194,7 +197,7 @@ var _ = Describe("create-route Command", func() {
 
 				It("creates the route", func() {
 					Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
-					expectedSpaceGUID, expectedDomainName, expectedHostname, _, _ := fakeActor.CreateRouteArgsForCall(0)
+					expectedSpaceGUID, expectedDomainName, expectedHostname, _, _, _ := fakeActor.CreateRouteArgsForCall(0)
 					Expect(expectedSpaceGUID).To(Equal(spaceGUID))
 					Expect(expectedDomainName).To(Equal(domainName))
 					Expect(expectedHostname).To(Equal(hostname))
@@ -220,13 +223,30 @@ var _ = Describe("create-route Command", func() {
 
 				It("calls the actor with the correct arguments", func() {
 					Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
-					expectedSpaceGUID, expectedDomainName, expectedHostname, _, expectedPort := fakeActor.CreateRouteArgsForCall(0)
+					expectedSpaceGUID, expectedDomainName, expectedHostname, _, expectedPort, _ := fakeActor.CreateRouteArgsForCall(0)
 					Expect(expectedSpaceGUID).To(Equal(spaceGUID))
 					Expect(expectedDomainName).To(Equal(domainName))
 					Expect(expectedHostname).To(Equal(hostname))
 					Expect(expectedPort).To(Equal(port))
 				})
 			})
+
+			When("passing in options", func() {
+				BeforeEach(func() {
+					options = []flag.RouteOption{
+						{Key: "loadbalancing", Value: "least-connection"},
+					}
+				})
+
+				It("creates the route with the options", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
+					_, _, _, _, _, options := fakeActor.CreateRouteArgsForCall(0)
+					Expect(options).To(HaveLen(1))
+					Expect(options).To(HaveKey("loadbalancing"))
+					Expect(*options["loadbalancing"]).To(Equal("least-connection"))
+				})
+			})
 		})
 
 		When("the route already exists", func() {
@@ -0,0 +1,70 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type CreateSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SidecarArgs `positional-args:"yes"`
+	Command         string           `long:"command" short:"c" required:"true" description:"The command used to start the sidecar"`
+	ProcessTypes    string           `long:"process-types" default:"web" description:"Comma-separated list of process types the sidecar runs alongside"`
+	Memory          flag.Megabytes   `long:"memory" short:"m" description:"Memory reserved for the sidecar out of each process's memory limit, with a unit of measurement in M or G (e.g. 64M, 1G)"`
+	usage           interface{}      `usage:"CF_NAME create-sidecar APP_NAME SIDECAR_NAME -c COMMAND [--process-types TYPES] [-m MEMORY]\n\nEXAMPLES:\n   CF_NAME create-sidecar my-app config-agent -c './config-agent --port 8081'\n   CF_NAME create-sidecar my-app log-shipper -c 'bin/ship' --process-types web,worker -m 64M"`
+	relatedCommands interface{}      `related_commands:"delete-sidecar, restart, sidecars"`
+}
+
+func (cmd CreateSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating sidecar {{.SidecarName}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	sidecar := resources.Sidecar{
+		Name:         cmd.RequiredArgs.SidecarName,
+		Command:      types.FilteredString{IsSet: true, Value: cmd.Command},
+		ProcessTypes: cmd.processTypes(),
+		MemoryInMB:   cmd.Memory.NullUint64,
+	}
+
+	_, warnings, err := cmd.Actor.CreateApplicationSidecar(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, sidecar)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Restart the app for the sidecar to start running: '{{.Command}}'", map[string]interface{}{
+		"Command": cmd.Config.BinaryName() + " restart " + cmd.RequiredArgs.AppName,
+	})
+
+	return nil
+}
+
+func (cmd CreateSidecarCommand) processTypes() []string {
+	var processTypes []string
+	for _, processType := range strings.Split(cmd.ProcessTypes, ",") {
+		if processType = strings.TrimSpace(processType); processType != "" {
+			processTypes = append(processTypes, processType)
+		}
+	}
+	return processTypes
+}
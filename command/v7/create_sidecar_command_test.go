@@ -0,0 +1,125 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-sidecar Command", func() {
+	var (
+		cmd             CreateSidecarCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = CreateSidecarCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.SidecarArgs{AppName: "some-app", SidecarName: "some-sidecar"},
+			Command:      "./agent --port 8081",
+			ProcessTypes: "web",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+			Expect(fakeActor.CreateApplicationSidecarCallCount()).To(Equal(0))
+		})
+	})
+
+	When("creating the sidecar succeeds", func() {
+		BeforeEach(func() {
+			cmd.ProcessTypes = "web, worker,"
+			cmd.Memory = flag.Megabytes{NullUint64: types.NullUint64{IsSet: true, Value: 64}}
+			fakeActor.CreateApplicationSidecarReturns(resources.Sidecar{GUID: "sidecar-guid"}, v7action.Warnings{"some-warning"}, nil)
+		})
+
+		It("creates the sidecar with the given settings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateApplicationSidecarCallCount()).To(Equal(1))
+			appName, spaceGUID, sidecar := fakeActor.CreateApplicationSidecarArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(sidecar).To(Equal(resources.Sidecar{
+				Name:         "some-sidecar",
+				Command:      types.FilteredString{IsSet: true, Value: "./agent --port 8081"},
+				ProcessTypes: []string{"web", "worker"},
+				MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+			}))
+		})
+
+		It("displays the header, warnings, ok and a restart tip", func() {
+			Expect(testUI.Out).To(Say("Creating sidecar some-sidecar for app some-app in org some-org / space some-space as banana..."))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("TIP: Restart the app for the sidecar to start running: 'faceman restart some-app'"))
+		})
+	})
+
+	When("no memory is given", func() {
+		It("leaves the memory unset so the sidecar shares the process memory", func() {
+			_, _, sidecar := fakeActor.CreateApplicationSidecarArgsForCall(0)
+			Expect(sidecar.MemoryInMB.IsSet).To(BeFalse())
+			Expect(sidecar.ProcessTypes).To(Equal([]string{"web"}))
+		})
+	})
+
+	When("creating the sidecar fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationSidecarReturns(resources.Sidecar{}, v7action.Warnings{"some-warning"}, errors.New("create-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("create-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type DeleteSidecarCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SidecarArgs `positional-args:"yes"`
+	Force           bool             `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}      `usage:"CF_NAME delete-sidecar APP_NAME SIDECAR_NAME [-f]"`
+	relatedCommands interface{}      `related_commands:"create-sidecar, restart, sidecars"`
+}
+
+func (cmd DeleteSidecarCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		deleteSidecar, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the sidecar {{.SidecarName}} of app {{.AppName}}?", map[string]interface{}{
+			"SidecarName": cmd.RequiredArgs.SidecarName,
+			"AppName":     cmd.RequiredArgs.AppName,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !deleteSidecar {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting sidecar {{.SidecarName}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"SidecarName": cmd.RequiredArgs.SidecarName,
+		"AppName":     cmd.RequiredArgs.AppName,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteApplicationSidecar(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.SidecarName)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.SidecarNotFoundError); ok {
+		cmd.UI.DisplayWarning("Sidecar {{.SidecarName}} does not exist.", map[string]interface{}{
+			"SidecarName": cmd.RequiredArgs.SidecarName,
+		})
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
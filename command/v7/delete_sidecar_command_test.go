@@ -0,0 +1,149 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-sidecar Command", func() {
+	var (
+		cmd             DeleteSidecarCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = DeleteSidecarCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.SidecarName = "some-sidecar"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		When("the delete is successful", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteApplicationSidecarReturns(v7action.Warnings{"some-warning"}, nil)
+			})
+
+			It("deletes the sidecar and displays the header and ok", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Deleting sidecar some-sidecar of app some-app in org some-org / space some-space as banana..."))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				Expect(fakeActor.DeleteApplicationSidecarCallCount()).To(Equal(1))
+				appName, spaceGUID, sidecarName := fakeActor.DeleteApplicationSidecarArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(sidecarName).To(Equal("some-sidecar"))
+			})
+		})
+
+		When("the sidecar does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteApplicationSidecarReturns(v7action.Warnings{"some-warning"}, actionerror.SidecarNotFoundError{Name: "some-sidecar", AppName: "some-app"})
+			})
+
+			It("warns that it does not exist and succeeds", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Sidecar some-sidecar does not exist."))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+
+		When("the delete fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteApplicationSidecarReturns(v7action.Warnings{"some-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(testUI.Err).To(Say("some-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	When("the -f flag is not provided", func() {
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the sidecar", func() {
+				Expect(testUI.Out).To(Say(`Really delete the sidecar some-sidecar of app some-app\?`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(fakeActor.DeleteApplicationSidecarCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the user chooses the default", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("cancels the deletion", func() {
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.DeleteApplicationSidecarCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
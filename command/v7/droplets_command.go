@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
@@ -29,13 +30,15 @@ func (cmd DropletsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting droplets of app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
-		"AppName":      cmd.RequiredArgs.AppName,
-		"CurrentSpace": cmd.Config.TargetedSpace().Name,
-		"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
-		"CurrentUser":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.UI.DisplayTextWithFlavor("Getting droplets of app {{.AppName}} in org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...", map[string]interface{}{
+			"AppName":      cmd.RequiredArgs.AppName,
+			"CurrentSpace": cmd.Config.TargetedSpace().Name,
+			"CurrentOrg":   cmd.Config.TargetedOrganization().Name,
+			"CurrentUser":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	droplets, warnings, err := cmd.Actor.GetApplicationDroplets(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
 	cmd.UI.DisplayWarnings(warnings)
@@ -43,6 +46,10 @@ func (cmd DropletsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.DropletListKind, shared.NewDropletListOutput(droplets))
+	}
+
 	if len(droplets) == 0 {
 		cmd.UI.DisplayText("No droplets found")
 		return nil
@@ -175,4 +175,34 @@ var _ = Describe("droplets Command", func() {
 			Expect(testUI.Err).To(Say("warning-2"))
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetApplicationDropletsReturns(
+				[]resources.Droplet{
+					{
+						GUID:      "some-droplet-guid",
+						State:     constant.DropletStaged,
+						CreatedAt: "2017-08-14T21:16:42Z",
+						IsCurrent: true,
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the droplets as a versioned document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting droplets"))
+			Expect(testUI.Out).To(Say(`"kind": "droplet_list"`))
+			Expect(testUI.Out).To(Say(`"guid": "some-droplet-guid"`))
+			Expect(testUI.Out).To(Say(`"state": "staged"`))
+			Expect(testUI.Out).To(Say(`"created_at": "2017-08-14T21:16:42Z"`))
+			Expect(testUI.Out).To(Say(`"current": true`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -0,0 +1,107 @@
+package v7
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"gopkg.in/yaml.v2"
+)
+
+const networkPoliciesFormatJSON = "json"
+
+type ExportNetworkPoliciesCommand struct {
+	BaseCommand
+
+	SourceApp       string      `long:"source" description:"Only export the policies of this source app"`
+	Format          string      `long:"format" choice:"yaml" choice:"json" default:"yaml" description:"Format of the exported file"`
+	Path            string      `long:"path" short:"p" description:"Write the policies to this file instead of standard output"`
+	usage           interface{} `usage:"CF_NAME export-network-policies [--source SOURCE_APP] [--format yaml|json] [-p PATH]\n\nEXAMPLES:\n   CF_NAME export-network-policies -p policies.yml\n   CF_NAME export-network-policies --source frontend --format json > frontend-policies.json"`
+	relatedCommands interface{} `related_commands:"apply-network-policies, network-policies"`
+
+	NetworkingActor NetworkPoliciesActor
+}
+
+func (cmd *ExportNetworkPoliciesCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	ccClient, uaaClient := cmd.BaseCommand.GetClients()
+
+	networkingClient, err := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+
+	return nil
+}
+
+func (cmd ExportNetworkPoliciesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Path != "" {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Exporting network policies in org {{.Org}} / space {{.Space}} to {{.Path}} as {{.User}}...", map[string]interface{}{
+			"Org":   cmd.Config.TargetedOrganization().Name,
+			"Space": cmd.Config.TargetedSpace().Name,
+			"Path":  cmd.Path,
+			"User":  user.Name,
+		})
+	}
+
+	var policies []cfnetworkingaction.Policy
+	var warnings cfnetworkingaction.Warnings
+	if cmd.SourceApp != "" {
+		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesBySpaceAndAppName(cmd.Config.TargetedSpace().GUID, cmd.SourceApp)
+	} else {
+		policies, warnings, err = cmd.NetworkingActor.NetworkPoliciesBySpace(cmd.Config.TargetedSpace().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	document := shared.NewNetworkPoliciesDocument(policies, cmd.Config.TargetedSpace().Name, cmd.Config.TargetedOrganization().Name)
+	raw, err := cmd.marshal(document)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Path == "" {
+		_, err = cmd.UI.GetOut().Write(raw)
+		return err
+	}
+
+	err = ioutil.WriteFile(cmd.Path, raw, 0666)
+	if err != nil {
+		return translatableerror.FileCreationError{Err: err}
+	}
+
+	cmd.UI.DisplayText("Exported {{.Count}} network policies.", map[string]interface{}{
+		"Count": len(document.Policies),
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+func (cmd ExportNetworkPoliciesCommand) marshal(document shared.NetworkPoliciesDocument) ([]byte, error) {
+	if cmd.Format == networkPoliciesFormatJSON {
+		raw, err := json.MarshalIndent(document, "", "  ")
+		return append(raw, '\n'), err
+	}
+	return yaml.Marshal(document)
+}
@@ -0,0 +1,192 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-network-policies Command", func() {
+	var (
+		cmd                      ExportNetworkPoliciesCommand
+		testUI                   *ui.UI
+		fakeConfig               *commandfakes.FakeConfig
+		fakeSharedActor          *commandfakes.FakeSharedActor
+		fakeActor                *v7fakes.FakeActor
+		fakeNetworkPoliciesActor *v7fakes.FakeNetworkPoliciesActor
+		binaryName               string
+		executeErr               error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeNetworkPoliciesActor = new(v7fakes.FakeNetworkPoliciesActor)
+
+		cmd = ExportNetworkPoliciesCommand{
+			BaseCommand: BaseCommand{
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				UI:          testUI,
+				Actor:       fakeActor,
+			},
+			NetworkingActor: fakeNetworkPoliciesActor,
+			Format:          "yaml",
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+
+		policies := []cfnetworkingaction.Policy{
+			{
+				SourceName:           "app1",
+				DestinationName:      "app2",
+				Protocol:             "tcp",
+				StartPort:            8080,
+				EndPort:              8090,
+				DestinationSpaceName: "some-space",
+				DestinationOrgName:   "some-org",
+			},
+			{
+				SourceName:           "app1",
+				DestinationName:      "app3",
+				Protocol:             "udp",
+				StartPort:            53,
+				EndPort:              53,
+				DestinationSpaceName: "other-space",
+				DestinationOrgName:   "other-org",
+			},
+		}
+		fakeNetworkPoliciesActor.NetworkPoliciesBySpaceReturns(policies, cfnetworkingaction.Warnings{"some-warning"}, nil)
+		fakeNetworkPoliciesActor.NetworkPoliciesBySpaceAndAppNameReturns(policies[:1], cfnetworkingaction.Warnings{"some-app-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("writes the policies of the targeted space as YAML to standard output", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeNetworkPoliciesActor.NetworkPoliciesBySpaceCallCount()).To(Equal(1))
+		Expect(fakeNetworkPoliciesActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+		Expect(testUI.Out).NotTo(Say("Exporting"))
+		Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(`policies:
+- source: app1
+  destination: app2
+  protocol: tcp
+  ports: 8080-8090
+- source: app1
+  destination: app3
+  destination_space: other-space
+  destination_org: other-org
+  protocol: udp
+  ports: "53"
+`))
+		Expect(testUI.Err).To(Say("some-warning"))
+	})
+
+	When("--format json is given", func() {
+		BeforeEach(func() {
+			cmd.Format = "json"
+		})
+
+		It("writes the policies as JSON", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`(?s)\{\n  "policies": \[\n    \{\n      "source": "app1",\n      "destination": "app2",\n      "protocol": "tcp",\n      "ports": "8080-8090"\n    \},`))
+		})
+	})
+
+	When("--source is given", func() {
+		BeforeEach(func() {
+			cmd.SourceApp = "app1"
+		})
+
+		It("only exports the policies of the source app", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeNetworkPoliciesActor.NetworkPoliciesBySpaceCallCount()).To(Equal(0))
+			Expect(fakeNetworkPoliciesActor.NetworkPoliciesBySpaceAndAppNameCallCount()).To(Equal(1))
+			spaceGUID, appName := fakeNetworkPoliciesActor.NetworkPoliciesBySpaceAndAppNameArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(appName).To(Equal("app1"))
+
+			Expect(testUI.Out).To(Say("destination: app2"))
+			Expect(testUI.Out).NotTo(Say("app3"))
+			Expect(testUI.Err).To(Say("some-app-warning"))
+		})
+	})
+
+	When("-p is given", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "export-network-policies")
+			Expect(err).NotTo(HaveOccurred())
+			cmd.Path = filepath.Join(dir, "policies.yml")
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("writes the policies to the file", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Exporting network policies in org some-org / space some-space to %s as some-user\.\.\.`, regexp.QuoteMeta(cmd.Path)))
+			Expect(testUI.Out).To(Say(`Exported 2 network policies\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			raw, err := ioutil.ReadFile(cmd.Path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw)).To(HavePrefix("policies:\n- source: app1\n  destination: app2\n"))
+		})
+	})
+
+	When("getting the policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkPoliciesActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -75,5 +75,53 @@ func (cmd GetHealthCheckCommand) DisplayProcessTable(processHealthChecks []v7act
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 
+	cmd.displayReadinessTable(processHealthChecks)
+
 	return nil
 }
+
+// displayReadinessTable displays the readiness health checks of the
+// processes. Cloud controllers that predate readiness health checks do not
+// report them, in which case nothing is displayed.
+func (cmd GetHealthCheckCommand) displayReadinessTable(processHealthChecks []v7action.ProcessHealthCheck) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("readiness health check"),
+			cmd.UI.TranslateText("endpoint (for http)"),
+			cmd.UI.TranslateText("invocation timeout"),
+			cmd.UI.TranslateText("interval"),
+		},
+	}
+
+	for _, healthCheck := range processHealthChecks {
+		if healthCheck.ReadinessHealthCheckType == "" {
+			continue
+		}
+
+		invocationTimeout := healthCheck.ReadinessInvocationTimeout
+		if invocationTimeout == 0 {
+			invocationTimeout = 1
+		}
+
+		interval := ""
+		if healthCheck.ReadinessInterval != 0 {
+			interval = fmt.Sprint(healthCheck.ReadinessInterval)
+		}
+
+		table = append(table, []string{
+			healthCheck.ProcessType,
+			string(healthCheck.ReadinessHealthCheckType),
+			healthCheck.ReadinessEndpoint,
+			fmt.Sprint(invocationTimeout),
+			interval,
+		})
+	}
+
+	if len(table) == 1 {
+		return
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
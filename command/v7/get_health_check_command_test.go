@@ -151,6 +151,7 @@ var _ = Describe("get-health-check Command", func() {
 			Expect(testUI.Out).To(Say(`web\s+http\s+/foo\s+10\n`))
 			Expect(testUI.Out).To(Say(`queue\s+port\s+1\n`))
 			Expect(testUI.Out).To(Say(`timer\s+process\s+5\n`))
+			Expect(testUI.Out).ToNot(Say(`readiness health check`))
 
 			Expect(fakeActor.GetApplicationProcessHealthChecksByNameAndSpaceCallCount()).To(Equal(1))
 			appName, spaceGUID := fakeActor.GetApplicationProcessHealthChecksByNameAndSpaceArgsForCall(0)
@@ -158,4 +159,31 @@ var _ = Describe("get-health-check Command", func() {
 			Expect(spaceGUID).To(Equal("some-space-guid"))
 		})
 	})
+
+	When("the processes have readiness health checks", func() {
+		BeforeEach(func() {
+			appProcessHealthChecks := []v7action.ProcessHealthCheck{
+				{
+					ProcessType: constant.ProcessTypeWeb, HealthCheckType: constant.Port,
+					ReadinessHealthCheckType: constant.HTTP, ReadinessEndpoint: "/ready", ReadinessInvocationTimeout: 3, ReadinessInterval: 10,
+				},
+				{
+					ProcessType: "worker", HealthCheckType: constant.Process,
+					ReadinessHealthCheckType: constant.Process,
+				},
+			}
+			fakeActor.GetApplicationProcessHealthChecksByNameAndSpaceReturns(appProcessHealthChecks, nil, nil)
+		})
+
+		It("prints the readiness health check of each process after the health checks", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`process\s+health check\s+endpoint\s+\(for http\)\s+invocation timeout\n`))
+			Expect(testUI.Out).To(Say(`web\s+port\s+1\n`))
+			Expect(testUI.Out).To(Say(`worker\s+process\s+1\n`))
+			Expect(testUI.Out).To(Say(`process\s+readiness health check\s+endpoint\s+\(for http\)\s+invocation timeout\s+interval\n`))
+			Expect(testUI.Out).To(Say(`web\s+http\s+/ready\s+3\s+10\n`))
+			Expect(testUI.Out).To(Say(`worker\s+process\s+1\s*\n`))
+		})
+	})
 })
@@ -27,6 +27,17 @@ type SetLabelActor interface {
 	UpdateServiceBrokerLabelsByServiceBrokerName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateServiceOfferingLabels(serviceOfferingName string, serviceBrokerName string, labels map[string]types.NullString) (v7action.Warnings, error)
 	UpdateServicePlanLabels(servicePlanName string, serviceOfferingName string, serviceBrokerName string, labels map[string]types.NullString) (v7action.Warnings, error)
+	UpdateApplicationAnnotationsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateBuildpackAnnotationsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateDomainAnnotationsByDomainName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateOrganizationAnnotationsByOrganizationName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateRouteAnnotations(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateSpaceAnnotationsBySpaceName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateStackAnnotationsByStackName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceInstanceAnnotations(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceBrokerAnnotationsByServiceBrokerName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceOfferingAnnotations(serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServicePlanAnnotations(servicePlanName string, serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (v7action.Warnings, error)
 }
 
 type ActionType string
@@ -36,6 +47,24 @@ const (
 	Set   ActionType = "Setting"
 )
 
+// MetadataField is the part of a resource's metadata that the label and
+// annotation commands work on.
+type MetadataField string
+
+const (
+	LabelsField      MetadataField = "label"
+	AnnotationsField MetadataField = "annotation"
+)
+
+// singular returns the name of a single entry of the field, defaulting to
+// labels when the field is unset.
+func (field MetadataField) singular() string {
+	if field == "" {
+		return string(LabelsField)
+	}
+	return string(field)
+}
+
 type TargetResource struct {
 	ResourceType    string
 	ResourceName    string
@@ -44,9 +73,11 @@ type TargetResource struct {
 	ServiceOffering string
 }
 
+// LabelUpdater sets or removes the labels, or the annotations when Field is
+// AnnotationsField, of a resource.
 type LabelUpdater struct {
 	targetResource TargetResource
-	labels         map[string]types.NullString
+	values         map[string]types.NullString
 
 	UI          command.UI
 	Config      command.Config
@@ -55,11 +86,60 @@ type LabelUpdater struct {
 
 	Username string
 	Action   ActionType
+	Field    MetadataField
+}
+
+// metadataUpdateFuncs are the actor methods that update one metadata field of
+// each resource type.
+type metadataUpdateFuncs struct {
+	app             func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	buildpack       func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	domain          func(string, map[string]types.NullString) (v7action.Warnings, error)
+	org             func(string, map[string]types.NullString) (v7action.Warnings, error)
+	route           func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	serviceBroker   func(string, map[string]types.NullString) (v7action.Warnings, error)
+	serviceInstance func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	serviceOffering func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	servicePlan     func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
+	space           func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	stack           func(string, map[string]types.NullString) (v7action.Warnings, error)
+}
+
+func (cmd *LabelUpdater) updateFuncs() metadataUpdateFuncs {
+	if cmd.Field == AnnotationsField {
+		return metadataUpdateFuncs{
+			app:             cmd.Actor.UpdateApplicationAnnotationsByApplicationName,
+			buildpack:       cmd.Actor.UpdateBuildpackAnnotationsByBuildpackNameAndStack,
+			domain:          cmd.Actor.UpdateDomainAnnotationsByDomainName,
+			org:             cmd.Actor.UpdateOrganizationAnnotationsByOrganizationName,
+			route:           cmd.Actor.UpdateRouteAnnotations,
+			serviceBroker:   cmd.Actor.UpdateServiceBrokerAnnotationsByServiceBrokerName,
+			serviceInstance: cmd.Actor.UpdateServiceInstanceAnnotations,
+			serviceOffering: cmd.Actor.UpdateServiceOfferingAnnotations,
+			servicePlan:     cmd.Actor.UpdateServicePlanAnnotations,
+			space:           cmd.Actor.UpdateSpaceAnnotationsBySpaceName,
+			stack:           cmd.Actor.UpdateStackAnnotationsByStackName,
+		}
+	}
+
+	return metadataUpdateFuncs{
+		app:             cmd.Actor.UpdateApplicationLabelsByApplicationName,
+		buildpack:       cmd.Actor.UpdateBuildpackLabelsByBuildpackNameAndStack,
+		domain:          cmd.Actor.UpdateDomainLabelsByDomainName,
+		org:             cmd.Actor.UpdateOrganizationLabelsByOrganizationName,
+		route:           cmd.Actor.UpdateRouteLabels,
+		serviceBroker:   cmd.Actor.UpdateServiceBrokerLabelsByServiceBrokerName,
+		serviceInstance: cmd.Actor.UpdateServiceInstanceLabels,
+		serviceOffering: cmd.Actor.UpdateServiceOfferingLabels,
+		servicePlan:     cmd.Actor.UpdateServicePlanLabels,
+		space:           cmd.Actor.UpdateSpaceLabelsBySpaceName,
+		stack:           cmd.Actor.UpdateStackLabelsByStackName,
+	}
 }
 
-func (cmd *LabelUpdater) Execute(targetResource TargetResource, labels map[string]types.NullString) error {
+func (cmd *LabelUpdater) Execute(targetResource TargetResource, values map[string]types.NullString) error {
 	cmd.targetResource = targetResource
-	cmd.labels = labels
+	cmd.values = values
 	cmd.targetResource.ResourceType = strings.ToLower(cmd.targetResource.ResourceType)
 
 	user, err := cmd.Actor.GetCurrentUser()
@@ -78,40 +158,41 @@ func (cmd *LabelUpdater) Execute(targetResource TargetResource, labels map[strin
 	}
 
 	var warnings v7action.Warnings
+	update := cmd.updateFuncs()
 	switch ResourceType(cmd.targetResource.ResourceType) {
 	case App:
 		cmd.displayMessageWithOrgAndSpace()
-		warnings, err = cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.labels)
+		warnings, err = update.app(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.values)
 	case Buildpack:
 		cmd.displayMessageWithStack()
-		warnings, err = cmd.Actor.UpdateBuildpackLabelsByBuildpackNameAndStack(cmd.targetResource.ResourceName, cmd.targetResource.BuildpackStack, cmd.labels)
+		warnings, err = update.buildpack(cmd.targetResource.ResourceName, cmd.targetResource.BuildpackStack, cmd.values)
 	case Domain:
 		cmd.displayMessageDefault()
-		warnings, err = cmd.Actor.UpdateDomainLabelsByDomainName(cmd.targetResource.ResourceName, cmd.labels)
+		warnings, err = update.domain(cmd.targetResource.ResourceName, cmd.values)
 	case Org:
 		cmd.displayMessageDefault()
-		warnings, err = cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.targetResource.ResourceName, cmd.labels)
+		warnings, err = update.org(cmd.targetResource.ResourceName, cmd.values)
 	case Route:
 		cmd.displayMessageWithOrgAndSpace()
-		warnings, err = cmd.Actor.UpdateRouteLabels(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.labels)
+		warnings, err = update.route(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.values)
 	case ServiceBroker:
 		cmd.displayMessageDefault()
-		warnings, err = cmd.Actor.UpdateServiceBrokerLabelsByServiceBrokerName(cmd.targetResource.ResourceName, cmd.labels)
+		warnings, err = update.serviceBroker(cmd.targetResource.ResourceName, cmd.values)
 	case ServiceInstance:
 		cmd.displayMessageWithOrgAndSpace()
-		warnings, err = cmd.Actor.UpdateServiceInstanceLabels(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.labels)
+		warnings, err = update.serviceInstance(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.values)
 	case ServiceOffering:
 		cmd.displayMessageForServiceCommands()
-		warnings, err = cmd.Actor.UpdateServiceOfferingLabels(cmd.targetResource.ResourceName, cmd.targetResource.ServiceBroker, cmd.labels)
+		warnings, err = update.serviceOffering(cmd.targetResource.ResourceName, cmd.targetResource.ServiceBroker, cmd.values)
 	case ServicePlan:
 		cmd.displayMessageForServiceCommands()
-		warnings, err = cmd.Actor.UpdateServicePlanLabels(cmd.targetResource.ResourceName, cmd.targetResource.ServiceOffering, cmd.targetResource.ServiceBroker, cmd.labels)
+		warnings, err = update.servicePlan(cmd.targetResource.ResourceName, cmd.targetResource.ServiceOffering, cmd.targetResource.ServiceBroker, cmd.values)
 	case Space:
 		cmd.displayMessageWithOrg()
-		warnings, err = cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.targetResource.ResourceName, cmd.Config.TargetedOrganization().GUID, cmd.labels)
+		warnings, err = update.space(cmd.targetResource.ResourceName, cmd.Config.TargetedOrganization().GUID, cmd.values)
 	case Stack:
 		cmd.displayMessageDefault()
-		warnings, err = cmd.Actor.UpdateStackLabelsByStackName(cmd.targetResource.ResourceName, cmd.labels)
+		warnings, err = update.stack(cmd.targetResource.ResourceName, cmd.values)
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -161,7 +242,7 @@ func (cmd *LabelUpdater) validateFlags() error {
 	if cmd.targetResource.ServiceOffering != "" && resourceType != ServicePlan {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
-				cmd.targetResource.ResourceType, "--offering, -o",
+				cmd.targetResource.ResourceType, "--offering, -e",
 			},
 		}
 	}
@@ -169,19 +250,19 @@ func (cmd *LabelUpdater) validateFlags() error {
 	return nil
 }
 
-func actionForResourceString(action string, resourceType string) string {
-	return fmt.Sprintf("%s label(s) for %s", action, resourceType)
+func (cmd *LabelUpdater) actionForResourceString() string {
+	return fmt.Sprintf("%s %s(s) for %s", cmd.Action, cmd.Field.singular(), cmd.targetResource.ResourceType)
 }
 
 func (cmd *LabelUpdater) displayMessageDefault() {
-	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType)+" {{.ResourceName}} as {{.User}}...", map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(cmd.actionForResourceString()+" {{.ResourceName}} as {{.User}}...", map[string]interface{}{
 		"ResourceName": cmd.targetResource.ResourceName,
 		"User":         cmd.Username,
 	})
 }
 
 func (cmd *LabelUpdater) displayMessageWithOrgAndSpace() {
-	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType)+" {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(cmd.actionForResourceString()+" {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
 		"ResourceName": cmd.targetResource.ResourceName,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"SpaceName":    cmd.Config.TargetedSpace().Name,
@@ -192,9 +273,9 @@ func (cmd *LabelUpdater) displayMessageWithOrgAndSpace() {
 func (cmd *LabelUpdater) displayMessageWithStack() {
 	var template string
 	if cmd.targetResource.BuildpackStack == "" {
-		template = actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType) + " {{.ResourceName}} as {{.User}}..."
+		template = cmd.actionForResourceString() + " {{.ResourceName}} as {{.User}}..."
 	} else {
-		template = actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType) + " {{.ResourceName}} with stack {{.StackName}} as {{.User}}..."
+		template = cmd.actionForResourceString() + " {{.ResourceName}} with stack {{.StackName}} as {{.User}}..."
 	}
 
 	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
@@ -205,7 +286,7 @@ func (cmd *LabelUpdater) displayMessageWithStack() {
 }
 
 func (cmd *LabelUpdater) displayMessageForServiceCommands() {
-	template := actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType) + " {{.ResourceName}}"
+	template := cmd.actionForResourceString() + " {{.ResourceName}}"
 
 	if cmd.targetResource.ServiceOffering != "" || cmd.targetResource.ServiceBroker != "" {
 		template += " from"
@@ -232,7 +313,7 @@ func (cmd *LabelUpdater) displayMessageForServiceCommands() {
 }
 
 func (cmd *LabelUpdater) displayMessageWithOrg() {
-	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType)+" {{.ResourceName}} in org {{.OrgName}} as {{.User}}...", map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(cmd.actionForResourceString()+" {{.ResourceName}} in org {{.OrgName}} as {{.User}}...", map[string]interface{}{
 		"ResourceName": cmd.targetResource.ResourceName,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"User":         cmd.Username,
@@ -173,7 +173,7 @@ var _ = Describe("LabelUpdater", func() {
 				err := cmd.Execute(targetResource, nil)
 
 				argumentCombinationError := translatableerror.ArgumentCombinationError{
-					Args: []string{strings.ToLower(resourceType), "--offering, -o"},
+					Args: []string{strings.ToLower(resourceType), "--offering, -e"},
 				}
 				Expect(err).To(MatchError(argumentCombinationError))
 			},
@@ -1541,3 +1541,153 @@ var _ = Describe("LabelUpdater", func() {
 		})
 	})
 })
+
+var _ = Describe("LabelUpdater for annotations", func() {
+	var (
+		cmd             LabelUpdater
+		fakeActor       *v7fakes.FakeActor
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		testUI          *ui.UI
+		targetResource  TargetResource
+		annotations     map[string]types.NullString
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		cmd = LabelUpdater{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Action:      Set,
+			Field:       AnnotationsField,
+		}
+		annotations = map[string]types.NullString{
+			"contact": types.NewNullString("team@example.com"),
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "fake-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(targetResource, annotations)
+	})
+
+	When("updating annotations on apps", func() {
+		BeforeEach(func() {
+			targetResource = TargetResource{ResourceType: "App", ResourceName: "dora"}
+			fakeActor.UpdateApplicationAnnotationsByApplicationNameReturns(
+				v7action.Warnings{"some-warning-1", "some-warning-2"},
+				nil,
+			)
+		})
+
+		It("checks that the user is targeting an org and space", func() {
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeTrue())
+		})
+
+		It("sets the annotations on the app in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.UpdateApplicationAnnotationsByApplicationNameCallCount()).To(Equal(1))
+			appName, spaceGUID, givenAnnotations := fakeActor.UpdateApplicationAnnotationsByApplicationNameArgsForCall(0)
+			Expect(appName).To(Equal("dora"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(givenAnnotations).To(Equal(annotations))
+		})
+
+		It("displays a message, the warnings and OK", func() {
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Setting annotation(s) for app dora in org fake-org / space fake-space as some-user...")))
+			Expect(testUI.Err).To(Say("some-warning-1"))
+			Expect(testUI.Err).To(Say("some-warning-2"))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("unsetting annotations", func() {
+			BeforeEach(func() {
+				cmd.Action = Unset
+			})
+
+			It("says it is removing them", func() {
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta("Removing annotation(s) for app dora in org fake-org / space fake-space as some-user...")))
+			})
+		})
+
+		When("the update fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationAnnotationsByApplicationNameReturns(
+					v7action.Warnings{"some-warning-1"},
+					errors.New("update-error"),
+				)
+			})
+
+			It("returns the error, prints warnings and does not say OK", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(testUI.Err).To(Say("some-warning-1"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	When("updating annotations on buildpacks", func() {
+		BeforeEach(func() {
+			targetResource = TargetResource{ResourceType: "buildpack", ResourceName: "go_buildpack", BuildpackStack: "cflinuxfs4"}
+		})
+
+		It("sets the annotations on the buildpack with the given stack", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Setting annotation(s) for buildpack go_buildpack with stack cflinuxfs4 as some-user...")))
+			Expect(fakeActor.UpdateBuildpackAnnotationsByBuildpackNameAndStackCallCount()).To(Equal(1))
+			name, stack, givenAnnotations := fakeActor.UpdateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall(0)
+			Expect(name).To(Equal("go_buildpack"))
+			Expect(stack).To(Equal("cflinuxfs4"))
+			Expect(givenAnnotations).To(Equal(annotations))
+		})
+	})
+
+	When("updating annotations on service plans", func() {
+		BeforeEach(func() {
+			targetResource = TargetResource{
+				ResourceType:    "service-plan",
+				ResourceName:    "some-plan",
+				ServiceOffering: "some-offering",
+				ServiceBroker:   "some-broker",
+			}
+		})
+
+		It("sets the annotations on the disambiguated service plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Setting annotation(s) for service-plan some-plan from service offering some-offering / service broker some-broker as some-user...")))
+			Expect(fakeActor.UpdateServicePlanAnnotationsCallCount()).To(Equal(1))
+			plan, offering, broker, givenAnnotations := fakeActor.UpdateServicePlanAnnotationsArgsForCall(0)
+			Expect(plan).To(Equal("some-plan"))
+			Expect(offering).To(Equal("some-offering"))
+			Expect(broker).To(Equal("some-broker"))
+			Expect(givenAnnotations).To(Equal(annotations))
+		})
+	})
+
+	When("updating annotations on spaces", func() {
+		BeforeEach(func() {
+			targetResource = TargetResource{ResourceType: "space", ResourceName: "some-space"}
+		})
+
+		It("sets the annotations on the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Setting annotation(s) for space some-space in org fake-org as some-user...")))
+			Expect(fakeActor.UpdateSpaceAnnotationsBySpaceNameCallCount()).To(Equal(1))
+			spaceName, orgGUID, _ := fakeActor.UpdateSpaceAnnotationsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+		})
+	})
+})
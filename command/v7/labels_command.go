@@ -36,13 +36,110 @@ type LabelsCommand struct {
 	relatedCommands interface{}     `related_commands:"set-label, unset-label"`
 	ServiceBroker   string          `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
 	ServiceOffering string          `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
-
-	username string
 }
 
 func (cmd LabelsCommand) Execute(args []string) error {
+	lister := metadataLister{
+		BaseCommand: cmd.BaseCommand,
+		field:       LabelsField,
+		targetResource: TargetResource{
+			ResourceType:    cmd.RequiredArgs.ResourceType,
+			ResourceName:    cmd.RequiredArgs.ResourceName,
+			BuildpackStack:  cmd.BuildpackStack,
+			ServiceBroker:   cmd.ServiceBroker,
+			ServiceOffering: cmd.ServiceOffering,
+		},
+	}
+	return lister.execute()
+}
+
+func (cmd LabelsCommand) Usage() string {
+	return `CF_NAME labels RESOURCE RESOURCE_NAME`
+}
+
+func (cmd LabelsCommand) Examples() string {
+	return `
+cf labels app dora
+cf labels org business
+cf labels buildpack go_buildpack --stack cflinuxfs3`
+}
+
+func (cmd LabelsCommand) Resources() string {
+	return `
+app
+buildpack
+domain
+org
+route
+service-broker
+service-instance
+service-offering
+service-plan
+space
+stack`
+}
+
+// metadataLister displays the labels, or the annotations, of a resource for
+// the labels and annotations commands.
+type metadataLister struct {
+	BaseCommand
+
+	field          MetadataField
+	targetResource TargetResource
+	username       string
+}
+
+// metadataGetFuncs are the actor methods that read one metadata field of each
+// resource type.
+type metadataGetFuncs struct {
+	app             func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	buildpack       func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	domain          func(string) (map[string]types.NullString, v7action.Warnings, error)
+	org             func(string) (map[string]types.NullString, v7action.Warnings, error)
+	route           func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	serviceBroker   func(string) (map[string]types.NullString, v7action.Warnings, error)
+	serviceInstance func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	serviceOffering func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	servicePlan     func(string, string, string) (map[string]types.NullString, v7action.Warnings, error)
+	space           func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	stack           func(string) (map[string]types.NullString, v7action.Warnings, error)
+}
+
+func (cmd metadataLister) getFuncs() metadataGetFuncs {
+	if cmd.field == AnnotationsField {
+		return metadataGetFuncs{
+			app:             cmd.Actor.GetApplicationAnnotations,
+			buildpack:       cmd.Actor.GetBuildpackAnnotations,
+			domain:          cmd.Actor.GetDomainAnnotations,
+			org:             cmd.Actor.GetOrganizationAnnotations,
+			route:           cmd.Actor.GetRouteAnnotations,
+			serviceBroker:   cmd.Actor.GetServiceBrokerAnnotations,
+			serviceInstance: cmd.Actor.GetServiceInstanceAnnotations,
+			serviceOffering: cmd.Actor.GetServiceOfferingAnnotations,
+			servicePlan:     cmd.Actor.GetServicePlanAnnotations,
+			space:           cmd.Actor.GetSpaceAnnotations,
+			stack:           cmd.Actor.GetStackAnnotations,
+		}
+	}
+
+	return metadataGetFuncs{
+		app:             cmd.Actor.GetApplicationLabels,
+		buildpack:       cmd.Actor.GetBuildpackLabels,
+		domain:          cmd.Actor.GetDomainLabels,
+		org:             cmd.Actor.GetOrganizationLabels,
+		route:           cmd.Actor.GetRouteLabels,
+		serviceBroker:   cmd.Actor.GetServiceBrokerLabels,
+		serviceInstance: cmd.Actor.GetServiceInstanceLabels,
+		serviceOffering: cmd.Actor.GetServiceOfferingLabels,
+		servicePlan:     cmd.Actor.GetServicePlanLabels,
+		space:           cmd.Actor.GetSpaceLabels,
+		stack:           cmd.Actor.GetStackLabels,
+	}
+}
+
+func (cmd metadataLister) execute() error {
 	var (
-		labels   map[string]types.NullString
+		values   map[string]types.NullString
 		warnings v7action.Warnings
 		err      error
 	)
@@ -62,92 +159,67 @@ func (cmd LabelsCommand) Execute(args []string) error {
 		return err
 	}
 
+	get := cmd.getFuncs()
 	switch cmd.canonicalResourceTypeForName() {
 	case App:
 		cmd.displayMessageWithOrgAndSpace()
-		labels, warnings, err = cmd.Actor.GetApplicationLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+		values, warnings, err = get.app(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID)
 	case Buildpack:
 		cmd.displayMessageWithStack()
-		labels, warnings, err = cmd.Actor.GetBuildpackLabels(cmd.RequiredArgs.ResourceName, cmd.BuildpackStack)
+		values, warnings, err = get.buildpack(cmd.targetResource.ResourceName, cmd.targetResource.BuildpackStack)
 	case Domain:
 		cmd.displayMessageDefault()
-		labels, warnings, err = cmd.Actor.GetDomainLabels(cmd.RequiredArgs.ResourceName)
+		values, warnings, err = get.domain(cmd.targetResource.ResourceName)
 	case Org:
 		cmd.displayMessageDefault()
-		labels, warnings, err = cmd.Actor.GetOrganizationLabels(cmd.RequiredArgs.ResourceName)
+		values, warnings, err = get.org(cmd.targetResource.ResourceName)
 	case Route:
 		cmd.displayMessageWithOrgAndSpace()
-		labels, warnings, err = cmd.Actor.GetRouteLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+		values, warnings, err = get.route(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID)
 	case ServiceBroker:
 		cmd.displayMessageDefault()
-		labels, warnings, err = cmd.Actor.GetServiceBrokerLabels(cmd.RequiredArgs.ResourceName)
+		values, warnings, err = get.serviceBroker(cmd.targetResource.ResourceName)
 	case ServiceInstance:
 		cmd.displayMessageWithOrgAndSpace()
-		labels, warnings, err = cmd.Actor.GetServiceInstanceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+		values, warnings, err = get.serviceInstance(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID)
 	case ServiceOffering:
 		cmd.displayMessageForServiceCommands()
-		labels, warnings, err = cmd.Actor.GetServiceOfferingLabels(cmd.RequiredArgs.ResourceName, cmd.ServiceBroker)
+		values, warnings, err = get.serviceOffering(cmd.targetResource.ResourceName, cmd.targetResource.ServiceBroker)
 	case ServicePlan:
 		cmd.displayMessageForServiceCommands()
-		labels, warnings, err = cmd.Actor.GetServicePlanLabels(cmd.RequiredArgs.ResourceName, cmd.ServiceOffering, cmd.ServiceBroker)
+		values, warnings, err = get.servicePlan(cmd.targetResource.ResourceName, cmd.targetResource.ServiceOffering, cmd.targetResource.ServiceBroker)
 	case Space:
 		cmd.displayMessageWithOrg()
-		labels, warnings, err = cmd.Actor.GetSpaceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID)
+		values, warnings, err = get.space(cmd.targetResource.ResourceName, cmd.Config.TargetedOrganization().GUID)
 	case Stack:
 		cmd.displayMessageDefault()
-		labels, warnings, err = cmd.Actor.GetStackLabels(cmd.RequiredArgs.ResourceName)
+		values, warnings, err = get.stack(cmd.targetResource.ResourceName)
 	default:
-		err = fmt.Errorf("Unsupported resource type of '%s'", cmd.RequiredArgs.ResourceType)
+		err = fmt.Errorf("Unsupported resource type of '%s'", cmd.targetResource.ResourceType)
 	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
-	cmd.printLabels(labels)
+	cmd.printValues(values)
 	return nil
 }
 
-func (cmd LabelsCommand) Usage() string {
-	return `CF_NAME labels RESOURCE RESOURCE_NAME`
-}
-
-func (cmd LabelsCommand) Examples() string {
-	return `
-cf labels app dora
-cf labels org business
-cf labels buildpack go_buildpack --stack cflinuxfs3`
+func (cmd metadataLister) canonicalResourceTypeForName() ResourceType {
+	return ResourceType(strings.ToLower(cmd.targetResource.ResourceType))
 }
 
-func (cmd LabelsCommand) Resources() string {
-	return `
-app
-buildpack
-domain
-org
-route
-service-broker
-service-instance
-service-offering
-service-plan
-space
-stack`
-}
-
-func (cmd LabelsCommand) canonicalResourceTypeForName() ResourceType {
-	return ResourceType(strings.ToLower(cmd.RequiredArgs.ResourceType))
-}
-
-func (cmd LabelsCommand) printLabels(labels map[string]types.NullString) {
+func (cmd metadataLister) printValues(values map[string]types.NullString) {
 	cmd.UI.DisplayNewline()
 
-	if len(labels) == 0 {
-		cmd.UI.DisplayText("No labels found.")
+	if len(values) == 0 {
+		cmd.UI.DisplayText(fmt.Sprintf("No %ss found.", cmd.field))
 		return
 	}
 
-	keys := make([]string, 0, len(labels))
-	for key := range labels {
+	keys := make([]string, 0, len(values))
+	for key := range values {
 		keys = append(keys, key)
 	}
 	sort.Strings(keys)
@@ -159,35 +231,41 @@ func (cmd LabelsCommand) printLabels(labels map[string]types.NullString) {
 		},
 	}
 
+	// Annotation values are free-form and often span several lines, so each
+	// line gets its own row to keep the value column aligned.
 	for _, key := range keys {
-		table = append(table, []string{key, labels[key].Value})
+		lines := strings.Split(strings.ReplaceAll(values[key].Value, "\r\n", "\n"), "\n")
+		table = append(table, []string{key, lines[0]})
+		for _, line := range lines[1:] {
+			table = append(table, []string{"", line})
+		}
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
 
-func (cmd LabelsCommand) validateFlags() error {
+func (cmd metadataLister) validateFlags() error {
 	resourceType := cmd.canonicalResourceTypeForName()
-	if cmd.BuildpackStack != "" && resourceType != Buildpack {
+	if cmd.targetResource.BuildpackStack != "" && resourceType != Buildpack {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
-				cmd.RequiredArgs.ResourceType, "--stack, -s",
+				cmd.targetResource.ResourceType, "--stack, -s",
 			},
 		}
 	}
 
-	if cmd.ServiceBroker != "" && !(resourceType == ServiceOffering || resourceType == ServicePlan) {
+	if cmd.targetResource.ServiceBroker != "" && !(resourceType == ServiceOffering || resourceType == ServicePlan) {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
-				cmd.RequiredArgs.ResourceType, "--broker, -b",
+				cmd.targetResource.ResourceType, "--broker, -b",
 			},
 		}
 	}
 
-	if cmd.ServiceOffering != "" && resourceType != ServicePlan {
+	if cmd.targetResource.ServiceOffering != "" && resourceType != ServicePlan {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
-				cmd.RequiredArgs.ResourceType, "--offering, -o",
+				cmd.targetResource.ResourceType, "--offering, -e",
 			},
 		}
 	}
@@ -195,8 +273,8 @@ func (cmd LabelsCommand) validateFlags() error {
 	return nil
 }
 
-func (cmd LabelsCommand) checkTarget() error {
-	switch ResourceType(cmd.RequiredArgs.ResourceType) {
+func (cmd metadataLister) checkTarget() error {
+	switch ResourceType(cmd.targetResource.ResourceType) {
 	case App, Route, ServiceInstance:
 		return cmd.SharedActor.CheckTarget(true, true)
 	case Space:
@@ -206,71 +284,71 @@ func (cmd LabelsCommand) checkTarget() error {
 	}
 }
 
-func (cmd LabelsCommand) displayMessageDefault() {
-	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting labels for %s {{.ResourceName}} as {{.User}}...", cmd.RequiredArgs.ResourceType), map[string]interface{}{
-		"ResourceName": cmd.RequiredArgs.ResourceName,
+func (cmd metadataLister) displayMessageDefault() {
+	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting %ss for %s {{.ResourceName}} as {{.User}}...", cmd.field, cmd.targetResource.ResourceType), map[string]interface{}{
+		"ResourceName": cmd.targetResource.ResourceName,
 		"User":         cmd.username,
 	})
 
 	cmd.UI.DisplayNewline()
 }
 
-func (cmd LabelsCommand) displayMessageWithOrgAndSpace() {
-	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting labels for %s {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", cmd.RequiredArgs.ResourceType), map[string]interface{}{
-		"ResourceName": cmd.RequiredArgs.ResourceName,
+func (cmd metadataLister) displayMessageWithOrgAndSpace() {
+	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting %ss for %s {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", cmd.field, cmd.targetResource.ResourceType), map[string]interface{}{
+		"ResourceName": cmd.targetResource.ResourceName,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"SpaceName":    cmd.Config.TargetedSpace().Name,
 		"User":         cmd.username,
 	})
 }
 
-func (cmd LabelsCommand) displayMessageWithOrg() {
-	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting labels for %s {{.ResourceName}} in org {{.OrgName}} as {{.User}}...", cmd.RequiredArgs.ResourceType), map[string]interface{}{
-		"ResourceName": cmd.RequiredArgs.ResourceName,
+func (cmd metadataLister) displayMessageWithOrg() {
+	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting %ss for %s {{.ResourceName}} in org {{.OrgName}} as {{.User}}...", cmd.field, cmd.targetResource.ResourceType), map[string]interface{}{
+		"ResourceName": cmd.targetResource.ResourceName,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"User":         cmd.username,
 	})
 }
 
-func (cmd LabelsCommand) displayMessageWithStack() {
+func (cmd metadataLister) displayMessageWithStack() {
 	var template string
-	if cmd.BuildpackStack == "" {
-		template = fmt.Sprintf("Getting labels for %s {{.ResourceName}} as {{.User}}...", cmd.RequiredArgs.ResourceType)
+	if cmd.targetResource.BuildpackStack == "" {
+		template = fmt.Sprintf("Getting %ss for %s {{.ResourceName}} as {{.User}}...", cmd.field, cmd.targetResource.ResourceType)
 	} else {
-		template = fmt.Sprintf("Getting labels for %s {{.ResourceName}} with stack {{.StackName}} as {{.User}}...", cmd.RequiredArgs.ResourceType)
+		template = fmt.Sprintf("Getting %ss for %s {{.ResourceName}} with stack {{.StackName}} as {{.User}}...", cmd.field, cmd.targetResource.ResourceType)
 	}
 
 	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
-		"ResourceName": cmd.RequiredArgs.ResourceName,
-		"StackName":    cmd.BuildpackStack,
+		"ResourceName": cmd.targetResource.ResourceName,
+		"StackName":    cmd.targetResource.BuildpackStack,
 		"User":         cmd.username,
 	})
 }
 
-func (cmd LabelsCommand) displayMessageForServiceCommands() {
+func (cmd metadataLister) displayMessageForServiceCommands() {
 	var template string
-	template = fmt.Sprintf("Getting labels for %s {{.ResourceName}}", cmd.RequiredArgs.ResourceType)
+	template = fmt.Sprintf("Getting %ss for %s {{.ResourceName}}", cmd.field, cmd.targetResource.ResourceType)
 
-	if cmd.ServiceOffering != "" || cmd.ServiceBroker != "" {
+	if cmd.targetResource.ServiceOffering != "" || cmd.targetResource.ServiceBroker != "" {
 		template += " from"
 	}
-	if cmd.ServiceOffering != "" {
+	if cmd.targetResource.ServiceOffering != "" {
 		template += " service offering {{.ServiceOffering}}"
-		if cmd.ServiceBroker != "" {
+		if cmd.targetResource.ServiceBroker != "" {
 			template += " /"
 		}
 	}
 
-	if cmd.ServiceBroker != "" {
+	if cmd.targetResource.ServiceBroker != "" {
 		template += " service broker {{.ServiceBroker}}"
 	}
 
 	template += " as {{.User}}..."
 
 	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
-		"ResourceName":    cmd.RequiredArgs.ResourceName,
-		"ServiceBroker":   cmd.ServiceBroker,
-		"ServiceOffering": cmd.ServiceOffering,
+		"ResourceName":    cmd.targetResource.ResourceName,
+		"ServiceBroker":   cmd.targetResource.ServiceBroker,
+		"ServiceOffering": cmd.targetResource.ServiceOffering,
 		"User":            cmd.username,
 	})
 }
@@ -110,7 +110,7 @@ var _ = Describe("labels command", func() {
 				err := cmd.Execute(nil)
 
 				argumentCombinationError := translatableerror.ArgumentCombinationError{
-					Args: []string{strings.ToLower(resourceType), "--offering, -o"},
+					Args: []string{strings.ToLower(resourceType), "--offering, -e"},
 				}
 				Expect(err).To(MatchError(argumentCombinationError))
 			},
@@ -8,6 +8,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/browser"
 	"code.cloudfoundry.org/cli/util/ui"
 	"code.cloudfoundry.org/clock"
 
@@ -38,6 +39,12 @@ func (a ActualActorReloader) Reload(config command.Config, ui command.UI) (Actor
 	return v7action.NewActor(ccClient, config, nil, uaaClient, routingClient, clock.NewClock()), nil
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . BrowserOpener
+
+type BrowserOpener interface {
+	Open(url string) error
+}
+
 const maxLoginTries = 3
 
 type LoginCommand struct {
@@ -45,8 +52,11 @@ type LoginCommand struct {
 	Actor         Actor
 	Config        command.Config
 	ActorReloader ActorReloader
+	BrowserOpener BrowserOpener
 
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	Browser           bool        `long:"browser" description:"Login in a web browser, using a local redirect to complete the login"`
+	Device            bool        `long:"device" description:"Login by approving a code on another device, for machines without a browser"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
@@ -55,7 +65,7 @@ type LoginCommand struct {
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
 	Origin            string      `long:"origin" description:"Indicates the identity provider to be used for login"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --origin ldap"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE | --browser | --device] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --browser (CF_NAME will open a browser to login)\n   CF_NAME login --device (CF_NAME will provide a url and a code to approve the login from another device)\n   CF_NAME login --origin ldap"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
 }
 
@@ -63,6 +73,7 @@ func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
 	ccClient := shared.NewWrappedCloudControllerClient(config, ui)
 	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, nil, clock.NewClock())
 	cmd.ActorReloader = ActualActorReloader{}
+	cmd.BrowserOpener = browser.Opener{}
 
 	cmd.UI = ui
 	cmd.Config = config
@@ -121,9 +132,14 @@ func (cmd *LoginCommand) Execute(args []string) error {
 	defer cmd.showStatus()
 
 	var authErr error
-	if cmd.SSO || cmd.SSOPasscode != "" {
+	switch {
+	case cmd.SSO || cmd.SSOPasscode != "":
 		authErr = cmd.authenticateSSO()
-	} else {
+	case cmd.Browser:
+		authErr = cmd.authenticateBrowser()
+	case cmd.Device:
+		authErr = cmd.authenticateDevice()
+	default:
 		authErr = cmd.authenticate()
 	}
 
@@ -357,6 +373,52 @@ func (cmd *LoginCommand) authenticateSSO() error {
 	return err
 }
 
+func (cmd *LoginCommand) authenticateBrowser() error {
+	err := cmd.Actor.AuthenticateWithAuthorizationCode(cmd.Origin, func(authorizationURL string) {
+		cmd.UI.DisplayText("Opening a browser to log in. If it does not open, visit:")
+		cmd.UI.DisplayText(authorizationURL)
+		cmd.UI.DisplayNewline()
+
+		if openErr := cmd.BrowserOpener.Open(authorizationURL); openErr != nil {
+			cmd.UI.DisplayWarning("Unable to open a browser: {{.Error}}", map[string]interface{}{
+				"Error": openErr.Error(),
+			})
+		}
+		cmd.UI.DisplayText("Waiting for the login to complete in the browser...")
+	})
+
+	return cmd.displayAuthenticationResult(err)
+}
+
+func (cmd *LoginCommand) authenticateDevice() error {
+	deviceAuthorization, err := cmd.Actor.StartDeviceAuthorization()
+	if err != nil {
+		return cmd.displayAuthenticationResult(err)
+	}
+
+	cmd.UI.DisplayText("To log in, visit {{.URL}} and enter the code {{.Code}}", map[string]interface{}{
+		"URL":  deviceAuthorization.VerificationURI,
+		"Code": deviceAuthorization.UserCode,
+	})
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for the login to be approved...")
+
+	err = cmd.Actor.AuthenticateWithDeviceCode(deviceAuthorization)
+	return cmd.displayAuthenticationResult(err)
+}
+
+func (cmd *LoginCommand) displayAuthenticationResult(err error) error {
+	if err != nil {
+		cmd.UI.DisplayWarning(translatableerror.ConvertToTranslatableError(err).Error())
+		cmd.UI.DisplayNewline()
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	return nil
+}
+
 func (cmd *LoginCommand) groupPrompts(prompts map[string]coreconfig.AuthPrompt) (map[string]coreconfig.AuthPrompt, map[string]coreconfig.AuthPrompt) {
 	var (
 		nonPasswordPrompts = make(map[string]coreconfig.AuthPrompt)
@@ -577,9 +639,49 @@ func (cmd *LoginCommand) validateFlags() error {
 		}
 	}
 
+	if cmd.Browser {
+		if err := cmd.validateLoginFlowFlag("--browser"); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Device {
+		if err := cmd.validateLoginFlowFlag("--device"); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Browser && cmd.Device {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--browser", "--device"},
+		}
+	}
+
 	return nil
 }
 
+// validateLoginFlowFlag rejects credentials flags given alongside a login flow
+// that obtains the credentials on its own.
+func (cmd *LoginCommand) validateLoginFlowFlag(flowFlag string) error {
+	var conflicting string
+	switch {
+	case cmd.SSO:
+		conflicting = "--sso"
+	case cmd.SSOPasscode != "":
+		conflicting = "--sso-passcode"
+	case cmd.Username != "":
+		conflicting = "-u"
+	case cmd.Password != "":
+		conflicting = "-p"
+	default:
+		return nil
+	}
+
+	return translatableerror.ArgumentCombinationError{
+		Args: []string{flowFlag, conflicting},
+	}
+}
+
 func (cmd *LoginCommand) validateTargetSpecificFlags() error {
 	if !cmd.Config.IsCFOnK8s() {
 		return nil
@@ -600,6 +702,12 @@ func (cmd *LoginCommand) validateTargetSpecificFlags() error {
 	if cmd.Origin != "" {
 		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--origin"}
 	}
+	if cmd.Browser {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--browser"}
+	}
+	if cmd.Device {
+		return translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device"}
+	}
 	return nil
 }
 
@@ -31,6 +31,7 @@ var _ = Describe("login Command", func() {
 		fakeActor         *v7fakes.FakeActor
 		fakeConfig        *commandfakes.FakeConfig
 		fakeActorReloader *v7fakes.FakeActorReloader
+		fakeOpener        *v7fakes.FakeBrowserOpener
 		executeErr        error
 		input             *Buffer
 	)
@@ -41,6 +42,7 @@ var _ = Describe("login Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeActor = new(v7fakes.FakeActor)
 		fakeActorReloader = new(v7fakes.FakeActorReloader)
+		fakeOpener = new(v7fakes.FakeBrowserOpener)
 
 		binaryName = "some-executable"
 		fakeConfig.BinaryNameReturns(binaryName)
@@ -52,6 +54,7 @@ var _ = Describe("login Command", func() {
 			Actor:         fakeActor,
 			Config:        fakeConfig,
 			ActorReloader: fakeActorReloader,
+			BrowserOpener: fakeOpener,
 		}
 		cmd.APIEndpoint = ""
 
@@ -103,6 +106,45 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		When("the --browser flag is combined with credentials flags", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.Username = "some-user"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "-u"},
+				}))
+			})
+		})
+
+		When("the --device flag is combined with --sso", func() {
+			BeforeEach(func() {
+				cmd.Device = true
+				cmd.SSO = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device", "--sso"},
+				}))
+			})
+		})
+
+		When("the --browser and the --device flag are used together", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+				cmd.Device = true
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "--device"},
+				}))
+			})
+		})
+
 		When("the user has manually added their client credentials", func() {
 			BeforeEach(func() {
 				fakeConfig.UAAOAuthClientReturns("some-other-client-id")
@@ -179,6 +221,26 @@ var _ = Describe("login Command", func() {
 					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--origin"}))
 				})
 			})
+
+			When("browser flag is provider", func() {
+				BeforeEach(func() {
+					cmd.Browser = true
+				})
+
+				It("returns unsupported flag error", func() {
+					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--browser"}))
+				})
+			})
+
+			When("device flag is provider", func() {
+				BeforeEach(func() {
+					cmd.Device = true
+				})
+
+				It("returns unsupported flag error", func() {
+					Expect(executeErr).To(Equal(translatableerror.NotSupportedOnKubernetesArgumentError{Arg: "--device"}))
+				})
+			})
 		})
 	})
 
@@ -818,6 +880,103 @@ var _ = Describe("login Command", func() {
 		})
 	})
 
+	Describe("Browser login", func() {
+		BeforeEach(func() {
+			cmd.Browser = true
+			cmd.Origin = "some-origin"
+			fakeConfig.TargetReturns("whatever.com")
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+			fakeActor.AuthenticateWithAuthorizationCodeStub = func(_ string, openURL func(string)) error {
+				openURL("https://login.example.com/oauth/authorize?state=abc")
+				return nil
+			}
+		})
+
+		It("opens the authorization URL in a browser and logs in", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.AuthenticateWithAuthorizationCodeCallCount()).To(Equal(1))
+			origin, _ := fakeActor.AuthenticateWithAuthorizationCodeArgsForCall(0)
+			Expect(origin).To(Equal("some-origin"))
+			Expect(fakeActor.GetLoginPromptsCallCount()).To(Equal(0))
+
+			Expect(fakeOpener.OpenCallCount()).To(Equal(1))
+			Expect(fakeOpener.OpenArgsForCall(0)).To(Equal("https://login.example.com/oauth/authorize?state=abc"))
+
+			Expect(testUI.Out).To(Say(`https://login\.example\.com/oauth/authorize\?state=abc`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+		})
+
+		When("the browser cannot be opened", func() {
+			BeforeEach(func() {
+				fakeOpener.OpenReturns(errors.New("no browser"))
+			})
+
+			It("warns and keeps waiting for the login", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("Unable to open a browser: no browser"))
+			})
+		})
+
+		When("the login fails", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithAuthorizationCodeReturns(errors.New("access denied"))
+			})
+
+			It("returns an error", func() {
+				Expect(testUI.Err).To(Say("access denied"))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+			})
+		})
+	})
+
+	Describe("Device login", func() {
+		BeforeEach(func() {
+			cmd.Device = true
+			fakeConfig.TargetReturns("whatever.com")
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "potatoface"}, nil)
+			fakeActor.StartDeviceAuthorizationReturns(v7action.DeviceAuthorization{
+				DeviceCode:      "some-device-code",
+				UserCode:        "ABCD-EFGH",
+				VerificationURI: "https://login.example.com/device",
+			}, nil)
+		})
+
+		It("shows the code to enter and waits for the login to be approved", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`To log in, visit https://login\.example\.com/device and enter the code ABCD-EFGH`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`user:\s+potatoface`))
+
+			Expect(fakeActor.AuthenticateWithDeviceCodeCallCount()).To(Equal(1))
+			Expect(fakeActor.AuthenticateWithDeviceCodeArgsForCall(0).DeviceCode).To(Equal("some-device-code"))
+		})
+
+		When("starting the device authorization fails", func() {
+			BeforeEach(func() {
+				fakeActor.StartDeviceAuthorizationReturns(v7action.DeviceAuthorization{}, errors.New("not supported"))
+			})
+
+			It("returns an error without polling", func() {
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+				Expect(fakeActor.AuthenticateWithDeviceCodeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the device code expires", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithDeviceCodeReturns(actionerror.DeviceAuthorizationExpiredError{})
+			})
+
+			It("returns an error", func() {
+				Expect(testUI.Err).To(Say("Device code expired before the login was approved"))
+				Expect(executeErr).To(MatchError("Unable to authenticate."))
+			})
+		})
+	})
+
 	Describe("Config", func() {
 		When("a user has successfully authenticated", func() {
 			BeforeEach(func() {
@@ -1,25 +1,36 @@
 package v7
 
 import (
+	"context"
 	"os"
 	"os/signal"
+	"regexp"
+	"strconv"
 	"time"
 
-	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/logcache"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type LogsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.AppName         `positional-args:"yes"`
+	Recent          bool                 `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           flag.Timestamp       `long:"since" description:"Only show logs after this time, given as a duration before now (e.g. 1h) or an RFC3339 timestamp. Implies --recent"`
+	Until           flag.Timestamp       `long:"until" description:"Only show logs before this time, given as a duration before now (e.g. 30m) or an RFC3339 timestamp. Implies --recent"`
+	Lines           flag.PositiveInteger `long:"lines" description:"Maximum number of log lines to show (Default: 1000). Implies --recent"`
+	SourceType      string               `long:"source-type" choice:"APP" choice:"RTR" choice:"STG" choice:"CELL" choice:"API" description:"Only show logs from this source"`
+	Instance        types.NullInt        `long:"instance" description:"Only show logs from the app instance with this index"`
+	ProcessType     string               `long:"process" description:"Only show logs from instances of this process type (e.g. web)"`
+	Grep            string               `long:"grep" description:"Only show logs whose message matches this regular expression"`
+	usage           interface{}          `usage:"CF_NAME logs APP_NAME [--recent] [--since TIME] [--until TIME] [--lines N]\n   [--source-type APP|RTR|STG|CELL|API] [--instance INDEX] [--process TYPE] [--grep REGEX]\n\nEXAMPLES:\n   CF_NAME logs my-app --source-type RTR --grep ' 5[0-9][0-9] '\n   CF_NAME logs my-app --since 2h --until 1h --process worker"`
+	relatedCommands interface{}          `related_commands:"app, apps, ssh"`
 
 	LogCacheClient sharedaction.LogCacheClient
 }
@@ -35,7 +46,12 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(true, true)
+	filter, err := cmd.logFilter()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
@@ -54,8 +70,8 @@ func (cmd LogsCommand) Execute(args []string) error {
 		})
 	cmd.UI.DisplayNewline()
 
-	if cmd.Recent {
-		return cmd.displayRecentLogs()
+	if cmd.Recent || cmd.Since.IsSet || cmd.Until.IsSet || cmd.Lines.Value > 0 {
+		return cmd.displayRecentLogs(filter)
 	}
 
 	stop := make(chan struct{})
@@ -66,7 +82,7 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	err = cmd.streamLogs()
+	err = cmd.streamLogs(filter)
 
 	close(stop)
 	<-stoppedRefreshing
@@ -75,12 +91,69 @@ func (cmd LogsCommand) Execute(args []string) error {
 	return err
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
-	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.LogCacheClient,
+// logFilter returns the filter given by the flags, or nil when no filter
+// flags were given.
+func (cmd LogsCommand) logFilter() (*sharedaction.LogFilter, error) {
+	if cmd.Instance.IsSet && cmd.Instance.Value < 0 {
+		return nil, translatableerror.ParseArgumentError{
+			ArgumentName: "--instance",
+			ExpectedType: "an integer greater than or equal to 0",
+		}
+	}
+
+	var pattern *regexp.Regexp
+	if cmd.Grep != "" {
+		var err error
+		pattern, err = regexp.Compile(cmd.Grep)
+		if err != nil {
+			return nil, translatableerror.ParseArgumentError{
+				ArgumentName: "--grep",
+				ExpectedType: "a valid regular expression",
+			}
+		}
+	}
+
+	if !cmd.Since.IsSet && !cmd.Until.IsSet && cmd.Lines.Value == 0 &&
+		cmd.SourceType == "" && !cmd.Instance.IsSet && cmd.ProcessType == "" && pattern == nil {
+		return nil, nil
+	}
+
+	filter := sharedaction.LogFilter{
+		Lines:       int(cmd.Lines.Value),
+		SourceType:  cmd.SourceType,
+		ProcessType: cmd.ProcessType,
+		Pattern:     pattern,
+	}
+	now := time.Now()
+	filter.Since = cmd.Since.Resolve(now)
+	filter.Until = cmd.Until.Resolve(now)
+	if cmd.Instance.IsSet {
+		filter.Instance = strconv.Itoa(cmd.Instance.Value)
+	}
+
+	return &filter, nil
+}
+
+func (cmd LogsCommand) displayRecentLogs(filter *sharedaction.LogFilter) error {
+	var (
+		messages []sharedaction.LogMessage
+		warnings v7action.Warnings
+		err      error
 	)
+	if filter == nil {
+		messages, warnings, err = cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
+			cmd.RequiredArgs.AppName,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.LogCacheClient,
+		)
+	} else {
+		messages, warnings, err = cmd.Actor.GetFilteredRecentLogsForApplicationByNameAndSpace(
+			cmd.RequiredArgs.AppName,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.LogCacheClient,
+			*filter,
+		)
+	}
 
 	for _, message := range messages {
 		cmd.UI.DisplayLogMessage(message, true)
@@ -117,22 +190,31 @@ func (cmd LogsCommand) refreshTokenPeriodically(
 }
 
 func (cmd LogsCommand) handleLogErr(logErr error) {
-	switch logErr.(type) {
-	case actionerror.LogCacheTimeoutError:
-		cmd.UI.DisplayWarning("timeout connecting to log server, no log will be shown")
-	default:
-		cmd.UI.DisplayWarning("Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
-			"Error": logErr,
-		})
-	}
+	displayLogCacheError(cmd.UI, logErr)
 }
 
-func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, stopStreaming, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.LogCacheClient,
+func (cmd LogsCommand) streamLogs(filter *sharedaction.LogFilter) error {
+	var (
+		messages      <-chan sharedaction.LogMessage
+		logErrs       <-chan error
+		stopStreaming context.CancelFunc
+		warnings      v7action.Warnings
+		err           error
 	)
+	if filter == nil {
+		messages, logErrs, stopStreaming, warnings, err = cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
+			cmd.RequiredArgs.AppName,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.LogCacheClient,
+		)
+	} else {
+		messages, logErrs, stopStreaming, warnings, err = cmd.Actor.GetFilteredStreamingLogsForApplicationByNameAndSpace(
+			cmd.RequiredArgs.AppName,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.LogCacheClient,
+			*filter,
+		)
+	}
 
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -10,8 +10,11 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
@@ -58,6 +61,33 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("the --grep pattern is not a valid regular expression", func() {
+		BeforeEach(func() {
+			cmd.Grep = "status=(5"
+		})
+
+		It("returns an argument error without checking the target", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--grep",
+				ExpectedType: "a valid regular expression",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the --instance index is negative", func() {
+		BeforeEach(func() {
+			cmd.Instance = types.NullInt{Value: -1, IsSet: true}
+		})
+
+		It("returns an argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--instance",
+				ExpectedType: "an integer greater than or equal to 0",
+			}))
+		})
+	})
+
 	When("the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -84,6 +114,82 @@ var _ = Describe("logs command", func() {
 			})
 		})
 
+		When("filter flags are provided with a time window", func() {
+			BeforeEach(func() {
+				cmd.Since = flag.Timestamp{Ago: time.Hour, IsSet: true}
+				cmd.Lines = flag.PositiveInteger{Value: 50}
+				cmd.SourceType = "RTR"
+				cmd.Instance = types.NullInt{Value: 2, IsSet: true}
+				cmd.Grep = " 5[0-9][0-9] "
+
+				fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(
+					[]sharedaction.LogMessage{
+						*sharedaction.NewLogMessage("GET /broken 502", "OUT", time.Unix(0, 0), "RTR", "2"),
+					},
+					v7action.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("retrieves the filtered recent logs", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+
+				appName, spaceGUID, client, filter := fakeActor.GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(client).To(Equal(logCacheClient))
+				Expect(filter.Lines).To(Equal(50))
+				Expect(filter.SourceType).To(Equal("RTR"))
+				Expect(filter.Instance).To(Equal("2"))
+				Expect(filter.Pattern.String()).To(Equal(" 5[0-9][0-9] "))
+				Expect(filter.Since).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+				Expect(filter.Until.IsZero()).To(BeTrue())
+			})
+
+			It("displays the matching log messages and warnings", func() {
+				Expect(testUI.Out).To(Say("GET /broken 502"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		When("filter flags are provided without a time window", func() {
+			BeforeEach(func() {
+				cmd.SourceType = "RTR"
+				fakeActor.ScheduleTokenRefreshStub = func(
+					after func(time.Duration) <-chan time.Time,
+					stop chan struct{}, stoppedRefreshing chan struct{}) (<-chan error, error) {
+					go func() {
+						<-stop
+						close(stoppedRefreshing)
+					}()
+					return make(chan error, 1), nil
+				}
+				fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub =
+					func(appName string, spaceGUID string, client sharedaction.LogCacheClient, filter sharedaction.LogFilter) (
+						<-chan sharedaction.LogMessage,
+						<-chan error,
+						context.CancelFunc,
+						v7action.Warnings, error) {
+						logStream := make(chan sharedaction.LogMessage)
+						errorStream := make(chan error)
+						close(logStream)
+						close(errorStream)
+						return logStream, errorStream, func() {}, nil, nil
+					}
+			})
+
+			It("streams the filtered logs", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+
+				_, _, _, filter := fakeActor.GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(filter.SourceType).To(Equal("RTR"))
+			})
+		})
+
 		When("the --recent flag is provided", func() {
 			BeforeEach(func() {
 				cmd.Recent = true
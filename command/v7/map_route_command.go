@@ -8,19 +8,20 @@ import (
 type MapRouteCommand struct {
 	BaseCommand
 
-	RequiredArgs flag.AppDomain   `positional-args:"yes"`
-	Hostname     string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
-	Path         flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
-	Port         int              `long:"port" description:"Port for the TCP route (default: random port)"`
-	AppProtocol  string           `long:"app-protocol" description:"[Beta flag, subject to change] Protocol for the route destination (default: http1). Only applied to HTTP routes"`
+	RequiredArgs flag.AppDomain     `positional-args:"yes"`
+	Hostname     string             `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path         flag.V7RoutePath   `long:"path" description:"Path for the HTTP route"`
+	Port         int                `long:"port" description:"Port for the TCP route (default: random port)"`
+	AppProtocol  string             `long:"app-protocol" description:"[Beta flag, subject to change] Protocol for the route destination (default: http1). Only applied to HTTP routes"`
+	Options      []flag.RouteOption `long:"option" short:"o" description:"Set the value of a per-route option when the route is created, e.g. loadbalancing=least-connection; can specify multiple times"`
 
-	relatedCommands interface{} `related_commands:"create-route, routes, unmap-route"`
+	relatedCommands interface{} `related_commands:"create-route, routes, unmap-route, update-route"`
 }
 
 func (cmd MapRouteCommand) Usage() string {
 	return `
 Map an HTTP route:
-   CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL]
+   CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL] [--option OPTION=VALUE]...
 
 Map a TCP route:
    CF_NAME map-route APP_NAME DOMAIN [--port PORT]`
@@ -32,6 +33,7 @@ CF_NAME map-route my-app example.com
 CF_NAME map-route my-app example.com --hostname myhost                              # myhost.example.com
 CF_NAME map-route my-app example.com --hostname myhost --path foo                   # myhost.example.com/foo
 CF_NAME map-route my-app example.com --hostname myhost --app-protocol http2 # myhost.example.com
+CF_NAME map-route my-app example.com --hostname myhost --option loadbalancing=least-connection # myhost.example.com
 CF_NAME map-route my-app example.com --port 5000                                    # example.com:5000`
 }
 
@@ -80,12 +82,17 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 			cmd.Hostname,
 			path,
 			cmd.Port,
+			routeOptions(cmd.Options),
 		)
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return err
 		}
 		cmd.UI.DisplayOK()
+	} else if len(cmd.Options) > 0 {
+		cmd.UI.DisplayWarning("Route {{.URL}} already exists, its options were not changed. Use 'cf update-route' to change them.", map[string]interface{}{
+			"URL": route.URL,
+		})
 	}
 
 	if cmd.AppProtocol != "" {
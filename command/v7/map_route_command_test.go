@@ -250,12 +250,26 @@ var _ = Describe("map-route Command", func() {
 						Expect(actualPort).To(Equal(cmd.Port))
 
 						Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
-						actualSpaceGUID, actualDomainName, actualHostname, actualPath, actualPort := fakeActor.CreateRouteArgsForCall(0)
+						actualSpaceGUID, actualDomainName, actualHostname, actualPath, actualPort, actualOptions := fakeActor.CreateRouteArgsForCall(0)
 						Expect(actualSpaceGUID).To(Equal(spaceGUID))
 						Expect(actualDomainName).To(Equal("some-domain.com"))
 						Expect(actualHostname).To(Equal(hostname))
 						Expect(actualPath).To(Equal(path))
 						Expect(actualPort).To(Equal(cmd.Port))
+						Expect(actualOptions).To(BeNil())
+					})
+
+					When("options are given", func() {
+						BeforeEach(func() {
+							cmd.Options = []flag.RouteOption{{Key: "loadbalancing", Value: "round-robin"}}
+						})
+
+						It("creates the route with the options", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							_, _, _, _, _, actualOptions := fakeActor.CreateRouteArgsForCall(0)
+							Expect(actualOptions).To(HaveKey("loadbalancing"))
+							Expect(*actualOptions["loadbalancing"]).To(Equal("round-robin"))
+						})
 					})
 				})
 
@@ -268,6 +282,24 @@ var _ = Describe("map-route Command", func() {
 						)
 					})
 
+					When("options are given", func() {
+						BeforeEach(func() {
+							cmd.Options = []flag.RouteOption{{Key: "loadbalancing", Value: "round-robin"}}
+							fakeActor.GetRouteByAttributesReturns(
+								resources.Route{GUID: "route-guid", URL: "host.some-domain.com/path"},
+								v7action.Warnings{"get-route-warnings"},
+								nil,
+							)
+						})
+
+						It("warns that the options of the existing route were not changed", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(testUI.Err).To(Say(`Route host.some-domain.com/path already exists, its options were not changed. Use 'cf update-route' to change them.`))
+							Expect(fakeActor.CreateRouteCallCount()).To(Equal(0))
+							Expect(fakeActor.MapRouteCallCount()).To(Equal(1))
+						})
+					})
+
 					When("getting the destination errors", func() {
 						BeforeEach(func() {
 							fakeActor.GetRouteDestinationByAppGUIDReturns(
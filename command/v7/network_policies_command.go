@@ -1,9 +1,6 @@
 package v7
 
 import (
-	"fmt"
-	"strconv"
-
 	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/v7/shared"
@@ -95,17 +92,11 @@ func (cmd NetworkPoliciesCommand) Execute(args []string) error {
 	}
 
 	for _, policy := range policies {
-		var portEntry string
-		if policy.StartPort == policy.EndPort {
-			portEntry = strconv.Itoa(policy.StartPort)
-		} else {
-			portEntry = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
-		}
 		table = append(table, []string{
 			policy.SourceName,
 			policy.DestinationName,
 			policy.Protocol,
-			portEntry,
+			shared.FormatPortRange(policy.StartPort, policy.EndPort),
 			policy.DestinationSpaceName,
 			policy.DestinationOrgName,
 		})
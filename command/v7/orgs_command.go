@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -24,10 +25,12 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	orgs, warnings, err := cmd.Actor.GetOrganizations(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -35,6 +38,10 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.OrgListKind, shared.NewOrgListOutput(orgs))
+	}
+
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
 	} else {
@@ -161,4 +161,25 @@ var _ = Describe("orgs Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetOrganizationsReturns(
+				[]resources.Organization{{Name: "some-org", GUID: "some-org-guid"}},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the orgs as a versioned document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting orgs"))
+			Expect(testUI.Out).To(Say(`"kind": "org_list"`))
+			Expect(testUI.Out).To(Say(`"name": "some-org"`))
+			Expect(testUI.Out).To(Say(`"guid": "some-org-guid"`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
+	"code.cloudfoundry.org/bytefmt"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
@@ -26,6 +28,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ProgressBar
@@ -43,6 +46,10 @@ type PushActor interface {
 	CreatePushPlans(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
 	// Actualize applies any necessary changes.
 	Actualize(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
+	// ActualizeInParallel applies the changes for several apps at once.
+	ActualizeInParallel(plans []v7pushaction.PushPlan, maxParallel int) <-chan *v7pushaction.PushEvent
+	MatchResources(resources []sharedaction.V3Resource) ([]sharedaction.V3Resource, []sharedaction.V3Resource, v7pushaction.Warnings, error)
+	GetBitsPackageChecksum(pushPlan v7pushaction.PushPlan) (string, error)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . V7ActorForPush
@@ -85,9 +92,11 @@ type PushCommand struct {
 	DockerImage             flag.DockerImage                    `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername          string                              `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	DropletPath             flag.PathWithExistenceCheck         `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
+	DryRun                  bool                                `long:"dry-run" description:"Show the changes push would make without making them. Exits with status 2 when changes are pending"`
 	HealthCheckHTTPEndpoint string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
 	HealthCheckType         flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
 	Instances               flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
+	Lifecycle               flag.AppLifecycle                   `long:"lifecycle" choice:"buildpack" choice:"cnb" description:"App lifecycle used to stage the app. 'cnb' stages with Cloud Native Buildpacks, which can be given as OCI images (e.g. docker://docker.io/paketobuildpacks/nodejs)"`
 	LogRateLimit            string                              `long:"log-rate-limit" short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
 	PathToManifest          flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to manifest"`
 	Memory                  string                              `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
@@ -95,17 +104,18 @@ type PushCommand struct {
 	NoRoute                 bool                                `long:"no-route" description:"Do not map a route to this app"`
 	NoStart                 bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
 	NoWait                  bool                                `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
+	Parallel                flag.PositiveInteger                `long:"parallel" description:"Push up to this many apps from the manifest at the same time. Apps start after the apps listed in their depends-on"`
 	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
 	RedactEnv               bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
 	Stack                   string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	StartCommand            flag.Command                        `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
-	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy, either rolling or null."`
+	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy can be canary, rolling or null."`
 	Task                    bool                                `long:"task" description:"Push an app that is used only to execute tasks. The app will be staged, but not started and will have no route assigned."`
 	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [--dry-run] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--lifecycle (buildpack | cnb)] [-p PATH] [--parallel N] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--strategy (rolling | canary)] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--strategy (rolling | canary)] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
 	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -129,7 +139,13 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
 	cmd.VersionActor = cmd.Actor
-	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+	sharedActor := sharedaction.NewActor(config)
+	if cmd.DryRun {
+		sharedActor.ResourceCache = sharedaction.NewReadOnlyResourceCache(configv3.ResourceCacheFilePath())
+	} else {
+		sharedActor.ResourceCache = sharedaction.NewResourceCache(configv3.ResourceCacheFilePath())
+	}
+	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedActor)
 
 	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
 	if err != nil {
@@ -173,21 +189,34 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
-	transformedManifest, err := cmd.PushActor.HandleFlagOverrides(baseManifest, flagOverrides)
+	err = baseManifest.ValidateDependencies()
 	if err != nil {
 		return err
 	}
 
-	flagOverrides.DockerPassword, err = cmd.GetDockerPassword(flagOverrides.DockerUsername, transformedManifest.ContainsPrivateDockerImages())
+	transformedManifest, err := cmd.PushActor.HandleFlagOverrides(baseManifest, flagOverrides)
 	if err != nil {
 		return err
 	}
 
+	// A dry run never pulls the image, so there is no need to ask for the
+	// docker password.
+	if !cmd.DryRun {
+		flagOverrides.DockerPassword, err = cmd.GetDockerPassword(flagOverrides.DockerUsername, transformedManifest.ContainsPrivateDockerImages())
+		if err != nil {
+			return err
+		}
+	}
+
 	transformedRawManifest, err := cmd.ManifestParser.MarshalManifest(transformedManifest)
 	if err != nil {
 		return err
 	}
 
+	if cmd.DryRun {
+		return cmd.dryRun(transformedManifest, transformedRawManifest, flagOverrides, user)
+	}
+
 	cmd.announcePushing(transformedManifest.AppNames(), user)
 
 	hasManifest := transformedManifest.PathToManifest != ""
@@ -249,7 +278,11 @@ func (cmd PushCommand) Execute(args []string) error {
 		}
 	}()
 
-	for _, plan := range pushPlans {
+	if cmd.Parallel.Value > 1 && len(pushPlans) > 1 {
+		return cmd.actualizeInParallel(pushPlans)
+	}
+
+	for _, plan := range v7pushaction.OrderPushPlansByDependencies(pushPlans) {
 		log.WithField("app_name", plan.Application.Name).Info("actualizing")
 		eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
 		err := cmd.eventStreamHandler(eventStream)
@@ -263,11 +296,300 @@ func (cmd PushCommand) Execute(args []string) error {
 		if err != nil {
 			return cmd.mapErr(plan.Application.Name, err)
 		}
+		cmd.displayCanaryDeployment(plan)
 	}
 
 	return nil
 }
 
+// displayCanaryDeployment tells the user how to continue the deployment of an
+// app pushed with the canary strategy.
+func (cmd PushCommand) displayCanaryDeployment(plan v7pushaction.PushPlan) {
+	if plan.Strategy != constant.DeploymentStrategyCanary {
+		return
+	}
+
+	if plan.NoWait {
+		cmd.UI.DisplayNewline()
+		shared.DisplayBackgroundCanaryDeployment(cmd.UI, plan.Application.Name)
+		return
+	}
+	shared.DisplayPausedDeployment(cmd.UI, plan.Application.Name)
+}
+
+// dryRun displays the manifest diff and the push plans without applying the
+// manifest or actualizing the plans. It returns a
+// PushDryRunChangesPendingError when the push would change the space.
+func (cmd PushCommand) dryRun(manifest manifestparser.Manifest, rawManifest []byte, flagOverrides v7pushaction.FlagOverrides, user configv3.User) error {
+	tokens := map[string]interface{}{
+		"AppName":   strings.Join(manifest.AppNames(), ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	}
+	cmd.UI.DisplayTextWithFlavor("Planning push of {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", tokens)
+	cmd.UI.DisplayText("This is a dry run, nothing will be changed.")
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	changesPending := false
+
+	diff, warnings, err := cmd.Actor.DiffSpaceManifest(spaceGUID, rawManifest)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, isUnexpectedError := err.(ccerror.V3UnexpectedResponseError); !isUnexpectedError {
+			return err
+		}
+		cmd.UI.DisplayWarning("Unable to generate diff.")
+		changesPending = true
+	} else {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Push would update these attributes...")
+
+		err = cmd.DiffDisplayer.DisplayDiff(rawManifest, diff)
+		if err != nil {
+			return err
+		}
+		changesPending = len(diff.Diffs) > 0
+	}
+
+	pushPlans, warnings, err := cmd.PushActor.CreatePushPlans(
+		spaceGUID,
+		cmd.Config.TargetedOrganization().GUID,
+		manifest,
+		flagOverrides,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	// Push plans are created in the same order as the manifest applications.
+	for i, plan := range pushPlans {
+		changesApp, packageWarnings, err := cmd.pushPlanChangesApp(plan)
+		cmd.UI.DisplayWarnings(packageWarnings)
+		if err != nil {
+			return err
+		}
+		if changesApp {
+			changesPending = true
+		}
+
+		uploads, matchedCount, warnings, err := cmd.resourcesToUpload(plan)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.displayPushPlan(plan, manifest.Applications[i], uploads, matchedCount)
+	}
+
+	cmd.UI.DisplayNewline()
+	if changesPending {
+		cmd.UI.DisplayText("Changes are pending. Run the command without --dry-run to apply them.")
+		return translatableerror.PushDryRunChangesPendingError{}
+	}
+
+	cmd.UI.DisplayText("No changes are pending.")
+	return nil
+}
+
+// pushPlanChangesApp reports whether actualizing the plan would change the
+// app. Apps that do not exist yet have no application in their plan. An
+// existing app changes when it is started or stopped, when it gets a droplet,
+// or when its newest ready package differs from the package push would
+// create. Restarting the app with the package it already has does not count
+// as a change.
+func (cmd PushCommand) pushPlanChangesApp(plan v7pushaction.PushPlan) (bool, v7action.Warnings, error) {
+	if plan.Application.GUID == "" ||
+		v7pushaction.ShouldCreateDroplet(plan) ||
+		v7pushaction.ShouldStopApplication(plan) ||
+		(v7pushaction.ShouldRestart(plan) && plan.Application.State != constant.ApplicationStarted) {
+		return true, nil, nil
+	}
+
+	pkg, warnings, err := cmd.Actor.GetNewestReadyPackageForApplication(plan.Application)
+	if err != nil {
+		if _, ok := err.(actionerror.NoEligiblePackagesError); ok {
+			return true, warnings, nil
+		}
+		return false, warnings, err
+	}
+
+	if v7pushaction.ShouldCreateDockerPackage(plan) {
+		return pkg.Type != constant.PackageTypeDocker || pkg.DockerImage != plan.DockerImageCredentials.Path, warnings, nil
+	}
+
+	checksum, err := cmd.PushActor.GetBitsPackageChecksum(plan)
+	if err != nil {
+		return false, warnings, err
+	}
+	return pkg.Type != constant.PackageTypeBits || pkg.Checksum != checksum, warnings, nil
+}
+
+// resourcesToUpload returns the files of the plan that are not already in the
+// resource cache, and how many files are. Like push, it only asks for
+// matches when at least one file is not empty.
+func (cmd PushCommand) resourcesToUpload(plan v7pushaction.PushPlan) ([]sharedaction.V3Resource, int, v7pushaction.Warnings, error) {
+	if !v7pushaction.ShouldCreateBitsPackage(plan) {
+		return nil, 0, nil, nil
+	}
+
+	shouldResourceMatch := false
+	for _, resource := range plan.AllResources {
+		if resource.SizeInBytes != 0 {
+			shouldResourceMatch = true
+		}
+	}
+	if !shouldResourceMatch {
+		return plan.AllResources, 0, nil, nil
+	}
+
+	matched, unmatched, warnings, err := cmd.PushActor.MatchResources(plan.AllResources)
+	if err != nil {
+		return nil, 0, warnings, err
+	}
+
+	matchedCount := 0
+	for _, resource := range matched {
+		if !resource.Mode.IsDir() {
+			matchedCount++
+		}
+	}
+	return unmatched, matchedCount, warnings, nil
+}
+
+func (cmd PushCommand) displayPushPlan(plan v7pushaction.PushPlan, manifestApp manifestparser.Application, uploads []sharedaction.V3Resource, matchedCount int) {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTextWithFlavor("Push plan for app {{.AppName}}:", map[string]interface{}{
+		"AppName": manifestApp.Name,
+	})
+
+	appState := "existing"
+	if plan.Application.GUID == "" {
+		appState = "new"
+	}
+
+	strategy := string(plan.Strategy)
+	if strategy == "" {
+		strategy = "none"
+	}
+
+	start := "yes"
+	if plan.NoStart {
+		start = "no"
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("app:"), appState},
+		{cmd.UI.TranslateText("source:"), cmd.pushPlanSource(plan)},
+	}
+	if plan.DockerImageCredentials.Path == "" {
+		table = append(table,
+			[]string{cmd.UI.TranslateText("lifecycle:"), pushPlanLifecycle(plan, manifestApp)},
+			[]string{cmd.UI.TranslateText("buildpacks:"), pushPlanBuildpacks(plan, manifestApp)},
+		)
+	}
+	table = append(table,
+		[]string{cmd.UI.TranslateText("routes:"), pushPlanRoutes(manifestApp)},
+		[]string{cmd.UI.TranslateText("deployment strategy:"), strategy},
+		[]string{cmd.UI.TranslateText("start:"), start},
+	)
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	if matchedCount > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("{{.Count}} files are already in the resource cache and will not be uploaded.", map[string]interface{}{
+			"Count": matchedCount,
+		})
+	}
+
+	var totalSize int64
+	resourceTable := [][]string{{cmd.UI.TranslateText("path"), cmd.UI.TranslateText("size")}}
+	for _, resource := range uploads {
+		if resource.Mode.IsDir() {
+			continue
+		}
+		totalSize += resource.SizeInBytes
+		resourceTable = append(resourceTable, []string{resource.FilePath, bytefmt.ByteSize(uint64(resource.SizeInBytes))})
+	}
+	if len(resourceTable) == 1 {
+		return
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Files to upload ({{.Count}} files, {{.Size}}):", map[string]interface{}{
+		"Count": len(resourceTable) - 1,
+		"Size":  bytefmt.ByteSize(uint64(totalSize)),
+	})
+	cmd.UI.DisplayTableWithHeader("   ", resourceTable, ui.DefaultTableSpacePadding)
+}
+
+func (cmd PushCommand) pushPlanSource(plan v7pushaction.PushPlan) string {
+	switch {
+	case plan.DockerImageCredentials.Path != "":
+		return cmd.UI.TranslateText("docker image {{.Image}}", map[string]interface{}{"Image": plan.DockerImageCredentials.Path})
+	case plan.DropletPath != "":
+		return cmd.UI.TranslateText("droplet {{.Path}}", map[string]interface{}{"Path": plan.DropletPath})
+	case plan.Archive:
+		return cmd.UI.TranslateText("archive {{.Path}}", map[string]interface{}{"Path": plan.BitsPath})
+	default:
+		return cmd.UI.TranslateText("directory {{.Path}}", map[string]interface{}{"Path": plan.BitsPath})
+	}
+}
+
+func pushPlanBuildpacks(plan v7pushaction.PushPlan, manifestApp manifestparser.Application) string {
+	if names := manifestApp.Buildpacks(); len(names) > 0 {
+		return strings.Join(names, ", ")
+	}
+
+	if len(plan.Application.LifecycleBuildpacks) > 0 {
+		return strings.Join(plan.Application.LifecycleBuildpacks, ", ")
+	}
+
+	return "detected during staging"
+}
+
+func pushPlanLifecycle(plan v7pushaction.PushPlan, manifestApp manifestparser.Application) string {
+	switch {
+	case manifestApp.Lifecycle != "":
+		return string(manifestApp.Lifecycle)
+	case plan.Application.LifecycleType != "":
+		return string(plan.Application.LifecycleType)
+	default:
+		return string(constant.AppLifecycleTypeBuildpack)
+	}
+}
+
+func pushPlanRoutes(manifestApp manifestparser.Application) string {
+	if len(manifestApp.Routes) > 0 {
+		var urls []string
+		for _, route := range manifestApp.Routes {
+			url := route.Route
+			if len(route.Options) > 0 {
+				var options []string
+				for key, value := range route.Options {
+					options = append(options, key+"="+value)
+				}
+				sort.Strings(options)
+				url += " (" + strings.Join(options, ", ") + ")"
+			}
+			urls = append(urls, url)
+		}
+		return strings.Join(urls, ", ")
+	}
+
+	switch {
+	case manifestApp.NoRoute:
+		return "none"
+	case manifestApp.RandomRoute:
+		return "random route"
+	case manifestApp.DefaultRoute:
+		return "default route, unless the app already has routes"
+	default:
+		return "unchanged"
+	}
+}
+
 func (cmd PushCommand) GetBaseManifest(flagOverrides v7pushaction.FlagOverrides) (manifestparser.Manifest, error) {
 	defaultManifest := manifestparser.Manifest{
 		Applications: []manifestparser.Application{
@@ -347,6 +669,7 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		HealthCheckType:     cmd.HealthCheckType.Type,
 		HealthCheckTimeout:  cmd.HealthCheckTimeout.Value,
 		Instances:           cmd.Instances.NullInt,
+		Lifecycle:           constant.AppLifecycleType(cmd.Lifecycle),
 		Memory:              cmd.Memory,
 		NoStart:             cmd.NoStart,
 		NoWait:              cmd.NoWait,
@@ -388,6 +711,14 @@ func (cmd PushCommand) ValidateFlags() error {
 			},
 		}
 
+	case cmd.DockerImage.Path != "" && cmd.Lifecycle != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--lifecycle",
+				"--docker-image, -o",
+			},
+		}
+
 	case cmd.DockerImage.Path != "" && cmd.Stack != "":
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
@@ -436,19 +767,19 @@ func (cmd PushCommand) ValidateFlags() error {
 			},
 		}
 
-	case cmd.NoStart && cmd.Strategy == flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}:
+	case cmd.NoStart && cmd.Strategy.Name != constant.DeploymentStrategyDefault:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
 				"--no-start",
-				"--strategy=rolling",
+				"--strategy=" + string(cmd.Strategy.Name),
 			},
 		}
 
-	case cmd.Task && cmd.Strategy == flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}:
+	case cmd.Task && cmd.Strategy.Name != constant.DeploymentStrategyDefault:
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
 				"--task",
-				"--strategy=rolling",
+				"--strategy=" + string(cmd.Strategy.Name),
 			},
 		}
 
@@ -599,7 +930,7 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 			cmd.stopStreamingFunc()
 		}
 		cmd.stopStreamingFunc = cancelFunc
-		go cmd.getLogs(logStream, errStream)
+		go cmd.getLogs(logStream, errStream, "")
 	case v7pushaction.StagingComplete:
 		if cmd.stopStreamingFunc != nil {
 			cmd.stopStreamingFunc()
@@ -632,7 +963,7 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	return nil
 }
 
-func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStream <-chan error) {
+func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStream <-chan error, appName string) {
 	for {
 		select {
 		case logMessage, open := <-logStream:
@@ -640,7 +971,11 @@ func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStre
 				return
 			}
 			if logMessage.Staging() {
-				cmd.UI.DisplayLogMessage(logMessage, false)
+				if appName != "" {
+					cmd.UI.DisplayLogMessage(appLogMessage{LogMessage: logMessage, appName: appName}, false)
+				} else {
+					cmd.UI.DisplayLogMessage(logMessage, false)
+				}
 			}
 		case err, open := <-errStream:
 			if !open {
@@ -654,3 +989,147 @@ func (cmd PushCommand) getLogs(logStream <-chan sharedaction.LogMessage, errStre
 		}
 	}
 }
+
+// actualizeInParallel pushes the apps at the same time, prefixing every line
+// of progress and staging logs with the app name. The summaries of the apps
+// are displayed once all of them are done.
+func (cmd *PushCommand) actualizeInParallel(plans []v7pushaction.PushPlan) error {
+	stopLogStreams := map[string]context.CancelFunc{}
+	defer func() {
+		for _, stop := range stopLogStreams {
+			stop()
+		}
+	}()
+
+	finalPlans := map[string]v7pushaction.PushPlan{}
+	appErrors := map[string]error{}
+	for event := range cmd.PushActor.ActualizeInParallel(plans, int(cmd.Parallel.Value)) {
+		appName := event.Plan.Application.Name
+		for _, warning := range event.Warnings {
+			cmd.UI.DisplayWarning("[{{.AppName}}] {{.Warning}}", map[string]interface{}{
+				"AppName": appName,
+				"Warning": warning,
+			})
+		}
+
+		if event.Err != nil {
+			if stop, ok := stopLogStreams[appName]; ok {
+				stop()
+				delete(stopLogStreams, appName)
+			}
+			appErrors[appName] = event.Err
+			finalPlans[appName] = event.Plan
+			cmd.UI.DisplayWarning("[{{.AppName}}] {{.Error}}", map[string]interface{}{
+				"AppName": appName,
+				"Error":   translatedErrorMessage(cmd.UI, cmd.mapErr(appName, event.Err)),
+			})
+			continue
+		}
+
+		switch event.Event {
+		case v7pushaction.StartingStaging:
+			cmd.displayAppProgress(appName, "Staging app and tracing logs...")
+			logStream, errStream, stop, warnings, err := cmd.VersionActor.GetStreamingLogsForApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, cmd.LogCacheClient)
+			cmd.UI.DisplayWarnings(warnings)
+			if err != nil {
+				cmd.UI.DisplayWarning("[{{.AppName}}] Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+					"AppName": appName,
+					"Error":   err.Error(),
+				})
+				continue
+			}
+			stopLogStreams[appName] = stop
+			go cmd.getLogs(logStream, errStream, appName)
+		case v7pushaction.StagingComplete:
+			if stop, ok := stopLogStreams[appName]; ok {
+				stop()
+				delete(stopLogStreams, appName)
+			}
+		case v7pushaction.ActualizeComplete:
+			finalPlans[appName] = event.Plan
+			cmd.displayAppProgress(appName, "Push complete")
+		default:
+			if message, ok := parallelPushEventMessages[event.Event]; ok {
+				cmd.displayAppProgress(appName, message)
+			}
+		}
+	}
+
+	var failedApps []string
+	for _, plan := range plans {
+		appName := plan.Application.Name
+		err := appErrors[appName]
+		if cmd.shouldDisplaySummary(err) {
+			summaryErr := cmd.displayAppSummary(finalPlans[appName])
+			if summaryErr != nil {
+				return summaryErr
+			}
+		}
+		if err != nil {
+			failedApps = append(failedApps, appName)
+			continue
+		}
+		cmd.displayCanaryDeployment(plan)
+	}
+
+	if len(failedApps) > 0 {
+		return translatableerror.ParallelPushFailedError{AppNames: failedApps}
+	}
+	return nil
+}
+
+// parallelPushEventMessages are the progress messages displayed for push
+// events when pushing several apps at once.
+var parallelPushEventMessages = map[v7pushaction.Event]string{
+	v7pushaction.CreatingArchive:                 "Packaging files to upload...",
+	v7pushaction.UploadingApplicationWithArchive: "Uploading files...",
+	v7pushaction.UploadingApplication:            "All files found in remote cache; nothing to upload.",
+	v7pushaction.RetryUpload:                     "Retrying upload due to an error...",
+	v7pushaction.UploadWithArchiveComplete:       "Waiting for API to complete processing files...",
+	v7pushaction.UploadingDroplet:                "Uploading droplet bits...",
+	v7pushaction.UploadDropletComplete:           "Waiting for API to complete processing files...",
+	v7pushaction.StoppingApplication:             "Stopping Application...",
+	v7pushaction.StoppingApplicationComplete:     "Application Stopped",
+	v7pushaction.RestartingApplication:           "Waiting for app to start...",
+	v7pushaction.StartingDeployment:              "Starting deployment...",
+	v7pushaction.WaitingForDeployment:            "Waiting for app to deploy...",
+}
+
+func (cmd PushCommand) displayAppProgress(appName string, message string) {
+	cmd.UI.DisplayText("[{{.AppName}}] {{.Message}}", map[string]interface{}{
+		"AppName": appName,
+		"Message": cmd.UI.TranslateText(message),
+	})
+}
+
+// translatedErrorMessage returns the translated message of the error, as it
+// would be displayed if the command failed with it.
+func translatedErrorMessage(ui command.UI, err error) string {
+	translatable, ok := translatableerror.ConvertToTranslatableError(err).(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatable.Translate(func(template string, values ...interface{}) string {
+		if len(values) > 0 {
+			if templateValues, ok := values[0].(map[string]interface{}); ok {
+				return ui.TranslateText(template, templateValues)
+			}
+		}
+		return ui.TranslateText(template)
+	})
+}
+
+// appLogMessage prefixes each line of a log message with the app name.
+type appLogMessage struct {
+	sharedaction.LogMessage
+	appName string
+}
+
+func (message appLogMessage) Message() string {
+	lines := strings.Split(message.LogMessage.Message(), "\n")
+	for i, line := range lines {
+		lines[i] = fmt.Sprintf("[%s] %s", message.appName, line)
+	}
+	return strings.Join(lines, "\n")
+}
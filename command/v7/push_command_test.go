@@ -3,6 +3,7 @@ package v7_test
 import (
 	"context"
 	"errors"
+	"os"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -255,6 +256,24 @@ var _ = Describe("push Command", func() {
 						Expect(actualFlagOverrides).To(Equal(v7pushaction.FlagOverrides{}))
 					})
 
+					When("an app depends on an app that is not in the manifest", func() {
+						BeforeEach(func() {
+							fakeManifestParser.ParseManifestReturns(
+								manifestparser.Manifest{
+									Applications: []manifestparser.Application{
+										{Name: "some-app-name", DependsOn: []string{"some-other-app"}},
+									},
+								},
+								nil,
+							)
+						})
+
+						It("returns the error without applying flag overrides", func() {
+							Expect(executeErr).To(MatchError(manifestparser.InvalidAppDependencyError{AppName: "some-app-name", Dependency: "some-other-app"}))
+							Expect(fakeActor.HandleFlagOverridesCallCount()).To(Equal(0))
+						})
+					})
+
 					When("handling the flag overrides fails", func() {
 						BeforeEach(func() {
 							fakeActor.HandleFlagOverridesReturns(manifestparser.Manifest{}, errors.New("override-handler-error"))
@@ -331,6 +350,281 @@ var _ = Describe("push Command", func() {
 								Expect(actualManifestBytes).To(Equal([]byte("our-manifest")))
 							})
 
+							When("--dry-run is provided", func() {
+								BeforeEach(func() {
+									cmd.DryRun = true
+
+									fakeActor.HandleFlagOverridesReturns(
+										manifestparser.Manifest{
+											Applications: []manifestparser.Application{
+												{
+													Name:      "existing-app",
+													Lifecycle: constant.AppLifecycleTypeCNB,
+													Routes: []manifestparser.Route{
+														{Route: "existing-app.example.com"},
+														{Route: "lb.example.com", Options: map[string]string{"loadbalancing": "least-connection"}},
+													},
+													RemainingManifestFields: map[string]interface{}{
+														"buildpacks": []interface{}{"go_buildpack"},
+													},
+												},
+												{
+													Name:         "new-app",
+													DefaultRoute: true,
+													Docker:       &manifestparser.Docker{Image: "some/image", Username: "some-user"},
+												},
+											},
+										},
+										nil,
+									)
+									fakeDiffActor.DiffSpaceManifestReturns(resources.ManifestDiff{}, v7action.Warnings{"diff-warning"}, nil)
+									fakeActor.CreatePushPlansReturns(
+										[]v7pushaction.PushPlan{
+											{
+												Application: resources.Application{Name: "existing-app", GUID: "existing-app-guid"},
+												BitsPath:    "/some/app",
+												Strategy:    constant.DeploymentStrategyRolling,
+												AllResources: []sharedaction.V3Resource{
+													{FilePath: "some-dir/", Mode: os.ModeDir | 0755},
+													{FilePath: "some-dir/main.go", Mode: 0644, SizeInBytes: 2048},
+													{FilePath: "some-dir/vendor.go", Mode: 0644, SizeInBytes: 4096},
+												},
+											},
+											{
+												DockerImageCredentials: v7action.DockerImageCredentials{Path: "some/image"},
+												NoStart:                true,
+											},
+										},
+										v7action.Warnings{"create-push-plans-warning"},
+										nil,
+									)
+									fakeActor.MatchResourcesReturns(
+										[]sharedaction.V3Resource{
+											{FilePath: "some-dir/vendor.go", Mode: 0644, SizeInBytes: 4096},
+										},
+										[]sharedaction.V3Resource{
+											{FilePath: "some-dir/", Mode: os.ModeDir | 0755},
+											{FilePath: "some-dir/main.go", Mode: 0644, SizeInBytes: 2048},
+										},
+										v7pushaction.Warnings{"match-resources-warning"},
+										nil,
+									)
+								})
+
+								It("displays the diff and the push plans without changing anything", func() {
+									Expect(testUI.Out).To(Say(`Planning push of existing-app, new-app to org some-org / space some-space as some-user\.\.\.`))
+									Expect(testUI.Out).To(Say(`This is a dry run, nothing will be changed\.`))
+									Expect(testUI.Err).To(Say("diff-warning"))
+									Expect(testUI.Out).To(Say(`Push would update these attributes\.\.\.`))
+									Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(1))
+
+									Expect(testUI.Err).To(Say("create-push-plans-warning"))
+									Expect(testUI.Out).To(Say(`Push plan for app existing-app:`))
+									Expect(testUI.Out).To(Say(`app:\s+existing`))
+									Expect(testUI.Out).To(Say(`source:\s+directory /some/app`))
+									Expect(testUI.Out).To(Say(`lifecycle:\s+cnb`))
+									Expect(testUI.Out).To(Say(`buildpacks:\s+go_buildpack`))
+									Expect(testUI.Out).To(Say(`routes:\s+existing-app.example.com, lb.example.com \(loadbalancing=least-connection\)`))
+									Expect(testUI.Out).To(Say(`deployment strategy:\s+rolling`))
+									Expect(testUI.Out).To(Say(`start:\s+yes`))
+									Expect(testUI.Err).To(Say("match-resources-warning"))
+									Expect(testUI.Out).To(Say(`1 files are already in the resource cache and will not be uploaded\.`))
+									Expect(testUI.Out).To(Say(`Files to upload \(1 files, 2K\):`))
+									Expect(testUI.Out).To(Say(`path\s+size`))
+									Expect(testUI.Out).To(Say(`some-dir/main.go\s+2K`))
+									Expect(testUI.Out).NotTo(Say(`vendor.go`))
+
+									Expect(testUI.Out).To(Say(`Push plan for app new-app:`))
+									Expect(testUI.Out).To(Say(`app:\s+new`))
+									Expect(testUI.Out).To(Say(`source:\s+docker image some/image`))
+									Expect(testUI.Out).NotTo(Say(`lifecycle:`))
+									Expect(testUI.Out).NotTo(Say(`buildpacks:`))
+									Expect(testUI.Out).To(Say(`routes:\s+default route, unless the app already has routes`))
+									Expect(testUI.Out).To(Say(`deployment strategy:\s+none`))
+									Expect(testUI.Out).To(Say(`start:\s+no`))
+
+									Expect(fakeActor.MatchResourcesCallCount()).To(Equal(1))
+									Expect(fakeActor.MatchResourcesArgsForCall(0)).To(HaveLen(3))
+									Expect(fakeConfig.DockerPasswordCallCount()).To(Equal(0))
+									Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+									Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+								})
+
+								It("reports that changes are pending", func() {
+									Expect(testUI.Out).To(Say(`Changes are pending\. Run the command without --dry-run to apply them\.`))
+									Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesPendingError{}))
+								})
+
+								When("the manifest matches the space and all apps exist", func() {
+									BeforeEach(func() {
+										fakeActor.CreatePushPlansReturns(
+											[]v7pushaction.PushPlan{
+												{Application: resources.Application{Name: "existing-app", GUID: "existing-app-guid", State: constant.ApplicationStarted, LifecycleBuildpacks: []string{"go_buildpack"}}},
+												{
+													Application:            resources.Application{Name: "new-app", GUID: "new-app-guid", State: constant.ApplicationStarted},
+													DockerImageCredentials: v7action.DockerImageCredentials{Path: "some/image"},
+												},
+											},
+											nil,
+											nil,
+										)
+										fakeDiffActor.GetNewestReadyPackageForApplicationStub = func(app resources.Application) (resources.Package, v7action.Warnings, error) {
+											if app.Name == "new-app" {
+												return resources.Package{Type: constant.PackageTypeDocker, DockerImage: "some/image"}, v7action.Warnings{"new-app-package-warning"}, nil
+											}
+											return resources.Package{Type: constant.PackageTypeBits, Checksum: "some-checksum"}, v7action.Warnings{"existing-app-package-warning"}, nil
+										}
+										fakeActor.GetBitsPackageChecksumReturns("some-checksum", nil)
+									})
+
+									It("compares the packages push would create with the ones the apps have", func() {
+										Expect(fakeDiffActor.GetNewestReadyPackageForApplicationCallCount()).To(Equal(2))
+										Expect(fakeDiffActor.GetNewestReadyPackageForApplicationArgsForCall(0).GUID).To(Equal("existing-app-guid"))
+										Expect(fakeActor.GetBitsPackageChecksumCallCount()).To(Equal(1))
+										Expect(fakeActor.GetBitsPackageChecksumArgsForCall(0).Application.GUID).To(Equal("existing-app-guid"))
+										Expect(testUI.Err).To(Say("existing-app-package-warning"))
+										Expect(testUI.Err).To(Say("new-app-package-warning"))
+									})
+
+									It("reports that no changes are pending, as the apps would only restart with the packages they have", func() {
+										Expect(executeErr).ToNot(HaveOccurred())
+										Expect(testUI.Out).To(Say(`No changes are pending\.`))
+									})
+
+									When("the bits differ from the package of the app", func() {
+										BeforeEach(func() {
+											fakeActor.GetBitsPackageChecksumReturns("some-other-checksum", nil)
+										})
+
+										It("reports that changes are pending", func() {
+											Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesPendingError{}))
+										})
+									})
+
+									When("the docker image differs from the package of the app", func() {
+										BeforeEach(func() {
+											fakeDiffActor.GetNewestReadyPackageForApplicationStub = func(app resources.Application) (resources.Package, v7action.Warnings, error) {
+												if app.Name == "new-app" {
+													return resources.Package{Type: constant.PackageTypeDocker, DockerImage: "some/other-image"}, nil, nil
+												}
+												return resources.Package{Type: constant.PackageTypeBits, Checksum: "some-checksum"}, nil, nil
+											}
+										})
+
+										It("reports that changes are pending", func() {
+											Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesPendingError{}))
+										})
+									})
+
+									When("an app has no ready package", func() {
+										BeforeEach(func() {
+											fakeDiffActor.GetNewestReadyPackageForApplicationStub = nil
+											fakeDiffActor.GetNewestReadyPackageForApplicationReturns(resources.Package{}, nil, actionerror.NoEligiblePackagesError{AppName: "existing-app"})
+										})
+
+										It("reports that changes are pending", func() {
+											Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesPendingError{}))
+										})
+									})
+
+									When("an app is stopped and push would start it", func() {
+										BeforeEach(func() {
+											fakeActor.CreatePushPlansReturns(
+												[]v7pushaction.PushPlan{
+													{Application: resources.Application{Name: "existing-app", GUID: "existing-app-guid", State: constant.ApplicationStopped}},
+												},
+												nil,
+												nil,
+											)
+										})
+
+										It("reports that changes are pending without comparing the packages", func() {
+											Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesPendingError{}))
+											Expect(fakeDiffActor.GetNewestReadyPackageForApplicationCallCount()).To(Equal(0))
+										})
+									})
+
+									When("getting the package of an app fails", func() {
+										BeforeEach(func() {
+											fakeDiffActor.GetNewestReadyPackageForApplicationStub = nil
+											fakeDiffActor.GetNewestReadyPackageForApplicationReturns(resources.Package{}, v7action.Warnings{"package-warning"}, errors.New("package-error"))
+										})
+
+										It("returns the error and the warnings", func() {
+											Expect(executeErr).To(MatchError("package-error"))
+											Expect(testUI.Err).To(Say("package-warning"))
+										})
+									})
+
+									When("computing the checksum of the bits fails", func() {
+										BeforeEach(func() {
+											fakeActor.GetBitsPackageChecksumReturns("", errors.New("checksum-error"))
+										})
+
+										It("returns the error", func() {
+											Expect(executeErr).To(MatchError("checksum-error"))
+										})
+									})
+								})
+
+								When("the manifest diff has changes", func() {
+									BeforeEach(func() {
+										fakeActor.CreatePushPlansReturns(
+											[]v7pushaction.PushPlan{
+												{Application: resources.Application{Name: "existing-app", GUID: "existing-app-guid"}},
+												{Application: resources.Application{Name: "new-app", GUID: "new-app-guid"}},
+											},
+											nil,
+											nil,
+										)
+										fakeDiffActor.DiffSpaceManifestReturns(
+											resources.ManifestDiff{Diffs: []resources.Diff{{Op: resources.ReplaceOperation, Path: "/applications/0/instances", Value: 3}}},
+											nil,
+											nil,
+										)
+									})
+
+									It("reports that changes are pending", func() {
+										Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesPendingError{}))
+									})
+								})
+
+								When("the manifest diff cannot be generated", func() {
+									BeforeEach(func() {
+										fakeDiffActor.DiffSpaceManifestReturns(resources.ManifestDiff{}, nil, ccerror.V3UnexpectedResponseError{})
+									})
+
+									It("warns and still displays the push plans", func() {
+										Expect(testUI.Err).To(Say("Unable to generate diff."))
+										Expect(fakeDiffDisplayer.DisplayDiffCallCount()).To(Equal(0))
+										Expect(testUI.Out).To(Say(`Push plan for app existing-app:`))
+										Expect(executeErr).To(MatchError(translatableerror.PushDryRunChangesPendingError{}))
+									})
+								})
+
+								When("matching the resources fails", func() {
+									BeforeEach(func() {
+										fakeActor.MatchResourcesReturns(nil, nil, v7pushaction.Warnings{"match-resources-warning"}, errors.New("match-error"))
+									})
+
+									It("returns the error and the warnings", func() {
+										Expect(executeErr).To(MatchError("match-error"))
+										Expect(testUI.Err).To(Say("match-resources-warning"))
+									})
+								})
+
+								When("creating the push plans fails", func() {
+									BeforeEach(func() {
+										fakeActor.CreatePushPlansReturns(nil, v7action.Warnings{"create-push-plans-warning"}, errors.New("create-push-plans-error"))
+									})
+
+									It("returns the error", func() {
+										Expect(executeErr).To(MatchError("create-push-plans-error"))
+										Expect(testUI.Err).To(Say("create-push-plans-warning"))
+									})
+								})
+							})
+
 							When("the manifest is successfully parsed", func() {
 								var expectedDiff resources.ManifestDiff
 
@@ -468,6 +762,82 @@ var _ = Describe("push Command", func() {
 										Expect(testUI.Err).To(Say("create-push-plans-warnings"))
 									})
 
+									When("the --parallel flag is set", func() {
+										BeforeEach(func() {
+											cmd.Parallel = flag.PositiveInteger{Value: 2}
+											fakeActor.ActualizeInParallelStub = func(plans []v7pushaction.PushPlan, _ int) <-chan *v7pushaction.PushEvent {
+												return FillInEvents([]Step{
+													{Plan: plans[0], Event: v7pushaction.CreatingArchive},
+													{Plan: plans[1], Event: v7pushaction.CreatingArchive, Warnings: v7pushaction.Warnings{"second-app-warning"}},
+													{Plan: plans[1], Event: v7pushaction.ActualizeComplete},
+													{Plan: plans[0], Event: v7pushaction.ActualizeComplete},
+												})
+											}
+										})
+
+										It("actualizes the apps in parallel and prefixes the events with the app name", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+											Expect(fakeActor.ActualizeInParallelCallCount()).To(Equal(1))
+											plans, maxParallel := fakeActor.ActualizeInParallelArgsForCall(0)
+											Expect(plans).To(HaveLen(2))
+											Expect(maxParallel).To(Equal(2))
+
+											Expect(testUI.Out).To(Say(`\[first-app\] Packaging files to upload...`))
+											Expect(testUI.Out).To(Say(`\[second-app\] Packaging files to upload...`))
+											Expect(testUI.Err).To(Say(`\[second-app\] second-app-warning`))
+											Expect(testUI.Out).To(Say(`\[second-app\] Push complete`))
+											Expect(testUI.Out).To(Say(`\[first-app\] Push complete`))
+										})
+
+										It("displays the summary of every app once all of them are done", func() {
+											Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(2))
+											firstAppName, _, _ := fakeVersionActor.GetDetailedAppSummaryArgsForCall(0)
+											Expect(firstAppName).To(Equal("first-app"))
+											secondAppName, _, _ := fakeVersionActor.GetDetailedAppSummaryArgsForCall(1)
+											Expect(secondAppName).To(Equal("second-app"))
+										})
+
+										When("an app fails to push", func() {
+											BeforeEach(func() {
+												fakeActor.ActualizeInParallelStub = func(plans []v7pushaction.PushPlan, _ int) <-chan *v7pushaction.PushEvent {
+													return FillInEvents([]Step{
+														{Plan: plans[0], Error: errors.New("some-push-error")},
+														{Plan: plans[1], Event: v7pushaction.ActualizeComplete},
+													})
+												}
+											})
+
+											It("displays the error and returns a parallel push error", func() {
+												Expect(executeErr).To(MatchError(translatableerror.ParallelPushFailedError{AppNames: []string{"first-app"}}))
+												Expect(testUI.Err).To(Say(`\[first-app\] some-push-error`))
+												Expect(testUI.Out).To(Say(`\[second-app\] Push complete`))
+											})
+										})
+									})
+
+									When("the apps depend on each other", func() {
+										BeforeEach(func() {
+											fakeActor.CreatePushPlansReturns(
+												[]v7pushaction.PushPlan{
+													{Application: resources.Application{Name: "first-app"}, DependsOn: []string{"second-app"}},
+													{Application: resources.Application{Name: "second-app"}},
+												},
+												nil,
+												nil,
+											)
+										})
+
+										It("actualizes the apps after the apps they depend on", func() {
+											Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+											firstPlan, _ := fakeActor.ActualizeArgsForCall(0)
+											Expect(firstPlan.Application.Name).To(Equal("second-app"))
+											secondPlan, _ := fakeActor.ActualizeArgsForCall(1)
+											Expect(secondPlan.Application.Name).To(Equal("first-app"))
+										})
+									})
+
 									Describe("delegating to Actor.Actualize", func() {
 										When("Actualize returns success", func() {
 											BeforeEach(func() {
@@ -639,6 +1009,32 @@ var _ = Describe("push Command", func() {
 													Expect(executeErr).ToNot(HaveOccurred())
 													Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(2))
 												})
+
+												When("the push plans use the canary strategy", func() {
+													BeforeEach(func() {
+														fakeActor.CreatePushPlansReturns(
+															[]v7pushaction.PushPlan{
+																{Application: resources.Application{Name: "first-app", GUID: "potato"}, Strategy: constant.DeploymentStrategyCanary},
+																{Application: resources.Application{Name: "second-app", GUID: "potato"}, Strategy: constant.DeploymentStrategyCanary, NoWait: true},
+															},
+															v7action.Warnings{"create-push-plans-warnings"},
+															nil,
+														)
+													})
+
+													It("tells the user the canary deployment is paused", func() {
+														Expect(executeErr).ToNot(HaveOccurred())
+														Expect(testUI.Out).To(Say("Canary deployment for app first-app is paused."))
+														Expect(testUI.Out).To(Say(`TIP: Run 'cf continue-deployment first-app'`))
+														Expect(testUI.Out).ToNot(Say("Canary deployment for app second-app is paused."))
+													})
+
+													It("tells the user how to continue the canary deployment that was not waited for", func() {
+														Expect(executeErr).ToNot(HaveOccurred())
+														Expect(testUI.Out).To(Say(`Canary instance for app second-app started, the deployment will pause in the background once the canary is running\.`))
+														Expect(testUI.Out).To(Say(`TIP: Run 'cf continue-deployment second-app'`))
+													})
+												})
 											})
 
 											When("getting the application summary fails", func() {
@@ -1079,6 +1475,7 @@ var _ = Describe("push Command", func() {
 			cmd.Vars = []template.VarKV{{Name: "key", Value: "val"}}
 			cmd.Task = true
 			cmd.LogRateLimit = "512M"
+			cmd.Lifecycle = "cnb"
 		})
 
 		JustBeforeEach(func() {
@@ -1108,6 +1505,7 @@ var _ = Describe("push Command", func() {
 			Expect(overrides.Vars).To(Equal([]template.VarKV{{Name: "key", Value: "val"}}))
 			Expect(overrides.Task).To(BeTrue())
 			Expect(overrides.LogRateLimit).To(Equal("512M"))
+			Expect(overrides.Lifecycle).To(Equal(constant.AppLifecycleTypeCNB))
 		})
 
 		When("a docker image is provided", func() {
@@ -1146,6 +1544,13 @@ var _ = Describe("push Command", func() {
 			},
 			translatableerror.ArgumentCombinationError{Args: []string{"--buildpack, -b", "--docker-image, -o"}}),
 
+		Entry("when docker and lifecycle flags are passed",
+			func() {
+				cmd.DockerImage.Path = "some-docker-image"
+				cmd.Lifecycle = "cnb"
+			},
+			translatableerror.ArgumentCombinationError{Args: []string{"--lifecycle", "--docker-image, -o"}}),
+
 		Entry("when docker and stack flags are passed",
 			func() {
 				cmd.DockerImage.Path = "some-docker-image"
@@ -1218,6 +1623,17 @@ var _ = Describe("push Command", func() {
 				},
 			}),
 
+		Entry("when strategy 'canary' and no-start flags are passed",
+			func() {
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				cmd.NoStart = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--no-start", "--strategy=canary",
+				},
+			}),
+
 		Entry("when strategy is not set and no-start flags are passed",
 			func() {
 				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyDefault}
@@ -1268,5 +1684,16 @@ var _ = Describe("push Command", func() {
 					"--task", "--strategy=rolling",
 				},
 			}),
+
+		Entry("task and canary strategy flags are passed",
+			func() {
+				cmd.Task = true
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--task", "--strategy=canary",
+				},
+			}),
 	)
 })
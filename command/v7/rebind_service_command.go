@@ -0,0 +1,250 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type RebindServiceCommand struct {
+	BaseCommand
+
+	RequiredArgs     flag.BindServiceArgs          `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters for the new binding, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	Restart          bool                          `long:"restart" description:"Restart the app with a rolling deployment once it has been bound again"`
+	relatedCommands  interface{}                   `related_commands:"bind-service, restart, rotate-service-key, unbind-service"`
+
+	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
+
+	Stager shared.AppStager
+}
+
+func (cmd *RebindServiceCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	logCacheClient, err := logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return err
+	}
+
+	cmd.Stager = shared.NewAppStager(cmd.Actor, cmd.UI, cmd.Config, logCacheClient)
+
+	return nil
+}
+
+func (cmd RebindServiceCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	if err := cmd.displayIntro(); err != nil {
+		return err
+	}
+
+	binding, warnings, err := cmd.Actor.GetServiceAppBindingByServiceInstanceAndApp(
+		cmd.RequiredArgs.ServiceInstanceName,
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+	case actionerror.ServiceBindingNotFoundError:
+		return translatableerror.ServiceAppBindingNotFoundError{
+			AppName:             cmd.RequiredArgs.AppName,
+			ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+		}
+	default:
+		return err
+	}
+
+	originalParameters, err := cmd.originalParameters(binding.GUID)
+	if err != nil {
+		return err
+	}
+
+	parameters := types.OptionalObject(cmd.ParametersAsJSON)
+	if !parameters.IsSet {
+		parameters = originalParameters
+	}
+
+	cmd.UI.DisplayText("Unbinding app {{.AppName}} from service instance {{.ServiceInstanceName}}...", cmd.names())
+	if err := cmd.unbind(); err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Binding app {{.AppName}} to service instance {{.ServiceInstanceName}}...", cmd.names())
+	if created, err := cmd.bind(binding.Name, parameters); err != nil {
+		cmd.restoreBinding(created, binding.Name, originalParameters)
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	if !cmd.Restart {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("TIP: Use 'cf restart {{.AppName}} --strategy rolling' to ensure your env variable changes take effect", cmd.names())
+		return nil
+	}
+
+	if err := cmd.restart(); err != nil {
+		cmd.UI.DisplayWarning("App {{.AppName}} is bound to service instance {{.ServiceInstanceName}} with the new binding, but it could not be restarted.", cmd.names())
+		return err
+	}
+
+	return nil
+}
+
+func (cmd RebindServiceCommand) Usage() string {
+	return `CF_NAME rebind-service APP_NAME SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--restart]
+
+Replaces the binding between the app and the service instance with a new one, so that the app
+receives new credentials. The new binding keeps the name of the previous one and, without -c, its
+parameters. Each step waits for the service broker to finish before the next one starts.
+
+If the new binding cannot be created, the app is bound to the service instance again with the
+parameters of the previous binding so that it is not left unbound.
+
+The restart with --restart is not rolled back: if it fails, the app keeps the new binding and can be
+restarted with 'CF_NAME restart APP_NAME --strategy rolling'.`
+}
+
+func (cmd RebindServiceCommand) Examples() string {
+	return `
+CF_NAME rebind-service myapp mydb
+CF_NAME rebind-service myapp mydb -c '{"permissions":"read-only"}' --restart
+`
+}
+
+func (cmd RebindServiceCommand) displayIntro() error {
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Rebinding service instance {{.ServiceInstance}} to app {{.AppName}} in org {{.Org}} / space {{.Space}} as {{.User}}...",
+		map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstanceName,
+			"AppName":         cmd.RequiredArgs.AppName,
+			"User":            user.Name,
+			"Space":           cmd.Config.TargetedSpace().Name,
+			"Org":             cmd.Config.TargetedOrganization().Name,
+		},
+	)
+	cmd.UI.DisplayNewline()
+
+	return nil
+}
+
+func (cmd RebindServiceCommand) unbind() error {
+	stream, warnings, err := cmd.Actor.DeleteServiceAppBinding(v7action.DeleteServiceAppBindingParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+		AppName:             cmd.RequiredArgs.AppName,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	return err
+}
+
+// originalParameters returns the parameters the binding was created with.
+// When the service broker does not return them, the app is bound without
+// parameters unless -c is given.
+func (cmd RebindServiceCommand) originalParameters(bindingGUID string) (types.OptionalObject, error) {
+	parameters, warnings, err := cmd.Actor.GetServiceCredentialBindingParameters(bindingGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		return parameters, nil
+	case actionerror.ServiceCredentialBindingParamsFetchingNotSupportedError:
+		cmd.UI.DisplayWarning("Unable to fetch the parameters of the binding; bindings that are created without -c will have no parameters.")
+		return types.OptionalObject{}, nil
+	default:
+		return types.OptionalObject{}, err
+	}
+}
+
+// bind binds the app and waits for the service broker. It also reports
+// whether the cloud controller accepted the binding, as a binding whose
+// creation failed afterwards is left behind and has to be deleted.
+func (cmd RebindServiceCommand) bind(bindingName string, parameters types.OptionalObject) (bool, error) {
+	stream, warnings, err := cmd.Actor.CreateServiceAppBinding(v7action.CreateServiceAppBindingParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstanceName,
+		AppName:             cmd.RequiredArgs.AppName,
+		BindingName:         bindingName,
+		Parameters:          parameters,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	return true, err
+}
+
+// restoreBinding binds the app again with the parameters of the previous
+// binding after the new binding failed.
+func (cmd RebindServiceCommand) restoreBinding(leftBehind bool, bindingName string, parameters types.OptionalObject) {
+	cmd.UI.DisplayWarning("Creating the new binding failed, binding app {{.AppName}} to service instance {{.ServiceInstanceName}} again...", cmd.names())
+
+	var err error
+	if leftBehind {
+		err = cmd.unbind()
+		if _, ok := err.(actionerror.ServiceBindingNotFoundError); ok {
+			err = nil
+		}
+	}
+	if err == nil {
+		_, err = cmd.bind(bindingName, parameters)
+	}
+
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to bind app {{.AppName}} to service instance {{.ServiceInstanceName}} again: {{.Error}}", map[string]interface{}{
+			"AppName":             cmd.RequiredArgs.AppName,
+			"ServiceInstanceName": cmd.RequiredArgs.ServiceInstanceName,
+			"Error":               translatedErrorMessage(cmd.UI, err),
+		})
+		return
+	}
+
+	cmd.UI.DisplayWarning("App {{.AppName}} is bound to service instance {{.ServiceInstanceName}} again.", cmd.names())
+}
+
+func (cmd RebindServiceCommand) restart() error {
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if !app.Started() {
+		cmd.UI.DisplayText("App {{.AppName}} is stopped, it will use the new binding once it is started.", cmd.names())
+		return nil
+	}
+
+	return cmd.Stager.StartApp(app, "", constant.DeploymentStrategyRolling, false, cmd.Config.TargetedSpace(), cmd.Config.TargetedOrganization(), constant.ApplicationRestarting)
+}
+
+func (cmd RebindServiceCommand) names() map[string]interface{} {
+	return map[string]interface{}{
+		"ServiceInstanceName": cmd.RequiredArgs.ServiceInstanceName,
+		"AppName":             cmd.RequiredArgs.AppName,
+	}
+}
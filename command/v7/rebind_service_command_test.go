@@ -0,0 +1,327 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/shared/sharedfakes"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rebind-service Command", func() {
+	var (
+		cmd             v7.RebindServiceCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeAppStager   *sharedfakes.FakeAppStager
+		executeErr      error
+		fakeActor       *v7fakes.FakeActor
+	)
+
+	const (
+		fakeUserName            = "fake-user-name"
+		fakeServiceInstanceName = "fake-service-instance-name"
+		fakeBindingName         = "fake-binding-name"
+		fakeAppName             = "fake-app-name"
+		fakeOrgName             = "fake-org-name"
+		fakeSpaceName           = "fake-space-name"
+		fakeSpaceGUID           = "fake-space-guid"
+	)
+
+	originalParameters := types.NewOptionalObject(map[string]interface{}{"original": "value"})
+
+	failedStream := func(err error) chan v7action.PollJobEvent {
+		stream := make(chan v7action.PollJobEvent, 1)
+		stream <- v7action.PollJobEvent{State: v7action.JobFailed, Err: err}
+		close(stream)
+		return stream
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeAppStager = new(sharedfakes.FakeAppStager)
+
+		cmd = v7.RebindServiceCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Stager: fakeAppStager,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: fakeSpaceName,
+			GUID: fakeSpaceGUID,
+		})
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: fakeOrgName})
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: fakeUserName}, nil)
+
+		fakeActor.GetServiceAppBindingByServiceInstanceAndAppReturns(
+			resources.ServiceCredentialBinding{GUID: "fake-binding-guid", Name: fakeBindingName},
+			v7action.Warnings{"get binding warning"},
+			nil,
+		)
+		fakeActor.GetServiceCredentialBindingParametersReturns(originalParameters, v7action.Warnings{"get parameters warning"}, nil)
+		fakeActor.DeleteServiceAppBindingReturns(nil, v7action.Warnings{"delete binding warning"}, nil)
+		fakeActor.CreateServiceAppBindingReturns(nil, v7action.Warnings{"create binding warning"}, nil)
+
+		setPositionalFlags(&cmd, fakeAppName, fakeServiceInstanceName)
+		setFlag(&cmd, "-c", `{"foo": "bar"}`)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in, and targeting an org and space", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		actualOrg, actualSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(actualOrg).To(BeTrue())
+		Expect(actualSpace).To(BeTrue())
+	})
+
+	It("replaces the binding, keeping its name", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.GetServiceAppBindingByServiceInstanceAndAppCallCount()).To(Equal(1))
+		serviceInstanceName, appName, spaceGUID := fakeActor.GetServiceAppBindingByServiceInstanceAndAppArgsForCall(0)
+		Expect(serviceInstanceName).To(Equal(fakeServiceInstanceName))
+		Expect(appName).To(Equal(fakeAppName))
+		Expect(spaceGUID).To(Equal(fakeSpaceGUID))
+
+		Expect(fakeActor.GetServiceCredentialBindingParametersCallCount()).To(Equal(1))
+		Expect(fakeActor.GetServiceCredentialBindingParametersArgsForCall(0)).To(Equal("fake-binding-guid"))
+
+		Expect(fakeActor.DeleteServiceAppBindingCallCount()).To(Equal(1))
+		Expect(fakeActor.DeleteServiceAppBindingArgsForCall(0)).To(Equal(v7action.DeleteServiceAppBindingParams{
+			SpaceGUID:           fakeSpaceGUID,
+			ServiceInstanceName: fakeServiceInstanceName,
+			AppName:             fakeAppName,
+		}))
+
+		Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+		Expect(fakeActor.CreateServiceAppBindingArgsForCall(0)).To(Equal(v7action.CreateServiceAppBindingParams{
+			SpaceGUID:           fakeSpaceGUID,
+			ServiceInstanceName: fakeServiceInstanceName,
+			AppName:             fakeAppName,
+			BindingName:         fakeBindingName,
+			Parameters:          types.NewOptionalObject(map[string]interface{}{"foo": "bar"}),
+		}))
+
+		Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+	})
+
+	It("prints the steps, warnings and a tip to restart", func() {
+		Expect(testUI.Out).To(SatisfyAll(
+			Say(`Rebinding service instance %s to app %s in org %s / space %s as %s\.\.\.\n`, fakeServiceInstanceName, fakeAppName, fakeOrgName, fakeSpaceName, fakeUserName),
+			Say(`Unbinding app %s from service instance %s\.\.\.\n`, fakeAppName, fakeServiceInstanceName),
+			Say(`Binding app %s to service instance %s\.\.\.\n`, fakeAppName, fakeServiceInstanceName),
+			Say(`OK\n`),
+			Say(`TIP: Use 'cf restart %s --strategy rolling' to ensure your env variable changes take effect`, fakeAppName),
+		))
+
+		Expect(testUI.Err).To(SatisfyAll(
+			Say("get binding warning"),
+			Say("get parameters warning"),
+			Say("delete binding warning"),
+			Say("create binding warning"),
+		))
+	})
+
+	When("no parameters are given", func() {
+		BeforeEach(func() {
+			cmd.ParametersAsJSON = flag.JSONOrFileWithValidation{}
+		})
+
+		It("binds the app with the parameters of the previous binding", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.CreateServiceAppBindingArgsForCall(0).Parameters).To(Equal(originalParameters))
+		})
+
+		When("the service broker does not return the parameters of the previous binding", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceCredentialBindingParametersReturns(types.OptionalObject{}, nil, actionerror.ServiceCredentialBindingParamsFetchingNotSupportedError{})
+			})
+
+			It("warns and binds the app without parameters", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say(`Unable to fetch the parameters of the binding; bindings that are created without -c will have no parameters\.`))
+				Expect(fakeActor.CreateServiceAppBindingArgsForCall(0).Parameters).To(Equal(types.OptionalObject{}))
+			})
+		})
+	})
+
+	When("getting the parameters of the binding fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceCredentialBindingParametersReturns(types.OptionalObject{}, nil, errors.New("parameters failed"))
+		})
+
+		It("returns the error and does not change anything", func() {
+			Expect(executeErr).To(MatchError("parameters failed"))
+			Expect(fakeActor.DeleteServiceAppBindingCallCount()).To(Equal(0))
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the app is not bound to the service instance", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAppBindingByServiceInstanceAndAppReturns(
+				resources.ServiceCredentialBinding{},
+				v7action.Warnings{"get binding warning"},
+				actionerror.ServiceBindingNotFoundError{},
+			)
+		})
+
+		It("returns an error and does not change anything", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ServiceAppBindingNotFoundError{
+				AppName:             fakeAppName,
+				ServiceInstanceName: fakeServiceInstanceName,
+			}))
+			Expect(fakeActor.DeleteServiceAppBindingCallCount()).To(Equal(0))
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(0))
+		})
+	})
+
+	When("unbinding fails", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteServiceAppBindingReturns(failedStream(errors.New("unbind failed")), nil, nil)
+		})
+
+		It("returns the error without binding again", func() {
+			Expect(executeErr).To(MatchError("unbind failed"))
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(0))
+		})
+	})
+
+	When("creating the new binding fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateServiceAppBindingReturnsOnCall(0, failedStream(errors.New("bind failed")), nil, nil)
+			fakeActor.DeleteServiceAppBindingReturnsOnCall(1, nil, nil, actionerror.ServiceBindingNotFoundError{})
+		})
+
+		It("binds the app again with the parameters of the previous binding and returns the error", func() {
+			Expect(executeErr).To(MatchError("bind failed"))
+
+			Expect(fakeActor.DeleteServiceAppBindingCallCount()).To(Equal(2))
+			Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(2))
+			Expect(fakeActor.CreateServiceAppBindingArgsForCall(1)).To(Equal(v7action.CreateServiceAppBindingParams{
+				SpaceGUID:           fakeSpaceGUID,
+				ServiceInstanceName: fakeServiceInstanceName,
+				AppName:             fakeAppName,
+				BindingName:         fakeBindingName,
+				Parameters:          originalParameters,
+			}))
+
+			Expect(testUI.Err).To(SatisfyAll(
+				Say(`Creating the new binding failed, binding app %s to service instance %s again\.\.\.`, fakeAppName, fakeServiceInstanceName),
+				Say(`App %s is bound to service instance %s again\.`, fakeAppName, fakeServiceInstanceName),
+			))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+
+		When("the cloud controller rejects the new binding", func() {
+			BeforeEach(func() {
+				fakeActor.CreateServiceAppBindingReturnsOnCall(0, nil, nil, errors.New("bind rejected"))
+			})
+
+			It("binds the app again without unbinding it first", func() {
+				Expect(executeErr).To(MatchError("bind rejected"))
+				Expect(fakeActor.DeleteServiceAppBindingCallCount()).To(Equal(1))
+				Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(2))
+			})
+		})
+
+		When("binding the app again fails as well", func() {
+			BeforeEach(func() {
+				fakeActor.CreateServiceAppBindingReturnsOnCall(1, nil, nil, errors.New("restore failed"))
+			})
+
+			It("reports both failures", func() {
+				Expect(executeErr).To(MatchError("bind failed"))
+				Expect(testUI.Err).To(Say(`Unable to bind app %s to service instance %s again: restore failed`, fakeAppName, fakeServiceInstanceName))
+			})
+		})
+	})
+
+	When("--restart is given", func() {
+		BeforeEach(func() {
+			setFlag(&cmd, "--restart")
+		})
+
+		When("the app is started", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					resources.Application{Name: fakeAppName, GUID: "fake-app-guid", State: constant.ApplicationStarted},
+					v7action.Warnings{"get app warning"},
+					nil,
+				)
+			})
+
+			It("restarts the app with a rolling deployment", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+				app, resourceGUID, strategy, noWait, space, org, appAction := fakeAppStager.StartAppArgsForCall(0)
+				Expect(app.GUID).To(Equal("fake-app-guid"))
+				Expect(resourceGUID).To(BeEmpty())
+				Expect(strategy).To(Equal(constant.DeploymentStrategyRolling))
+				Expect(noWait).To(BeFalse())
+				Expect(space.GUID).To(Equal(fakeSpaceGUID))
+				Expect(org.Name).To(Equal(fakeOrgName))
+				Expect(appAction).To(Equal(constant.ApplicationRestarting))
+
+				Expect(testUI.Out).NotTo(Say("TIP"))
+			})
+
+			When("the restart fails", func() {
+				BeforeEach(func() {
+					fakeAppStager.StartAppReturns(errors.New("restart failed"))
+				})
+
+				It("keeps the new binding and returns the error", func() {
+					Expect(executeErr).To(MatchError("restart failed"))
+					Expect(fakeActor.DeleteServiceAppBindingCallCount()).To(Equal(1))
+					Expect(fakeActor.CreateServiceAppBindingCallCount()).To(Equal(1))
+					Expect(testUI.Err).To(Say(`App %s is bound to service instance %s with the new binding, but it could not be restarted\.`, fakeAppName, fakeServiceInstanceName))
+				})
+			})
+		})
+
+		When("the app is stopped", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationByNameAndSpaceReturns(
+					resources.Application{Name: fakeAppName, State: constant.ApplicationStopped},
+					nil,
+					nil,
+				)
+			})
+
+			It("does not start the app", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+				Expect(testUI.Out).To(Say(`App %s is stopped, it will use the new binding once it is started\.`, fakeAppName))
+			})
+		})
+	})
+})
@@ -114,6 +114,22 @@ var _ = Describe("restage Command", func() {
 		})
 	})
 
+	When("it's a canary deploy", func() {
+		BeforeEach(func() {
+			cmd.Strategy.Name = constant.DeploymentStrategyCanary
+		})
+
+		It("does not warn about app downtime", func() {
+			Expect(testUI.Err).NotTo(Say("This action will cause app downtime."))
+		})
+
+		It("passes the canary strategy to the stager", func() {
+			Expect(fakeAppStager.StageAndStartCallCount()).To(Equal(1))
+			_, _, _, _, strategy, _, _ := fakeAppStager.StageAndStartArgsForCall(0)
+			Expect(strategy).To(Equal(constant.DeploymentStrategyCanary))
+		})
+	})
+
 	It("displays that it's restaging", func() {
 		Expect(testUI.Out).To(Say("Restaging app some-app in org some-org / space some-space as steve..."))
 	})
@@ -206,6 +206,34 @@ var _ = Describe("rollback Command", func() {
 				})
 			})
 
+			When("the user does not pass a strategy", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+				})
+
+				It("rolls back with a rolling deployment", func() {
+					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1), "GetStartApp call count")
+
+					_, _, strategy, _, _, _, _ := fakeAppStager.StartAppArgsForCall(0)
+					Expect(strategy).To(Equal(constant.DeploymentStrategyRolling))
+				})
+			})
+
+			When("the user passes the canary strategy", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyCanary}
+				})
+
+				It("rolls back with a canary deployment", func() {
+					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1), "GetStartApp call count")
+
+					_, _, strategy, _, _, _, appAction := fakeAppStager.StartAppArgsForCall(0)
+					Expect(strategy).To(Equal(constant.DeploymentStrategyCanary))
+					Expect(appAction).To(Equal(constant.ApplicationRollingBack))
+				})
+			})
+
 			When("user says yes to prompt", func() {
 				BeforeEach(func() {
 					_, err := input.Write([]byte("y\n"))
@@ -0,0 +1,231 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// temporaryServiceKeySuffix is appended to the name of the service key for
+// the key that is kept while the key is replaced, as key names are unique.
+const temporaryServiceKeySuffix = "-rotating"
+
+type RotateServiceKeyCommand struct {
+	BaseCommand
+
+	RequiredArgs     flag.ServiceInstanceKey       `positional-args:"yes"`
+	ParametersAsJSON flag.JSONOrFileWithValidation `short:"c" description:"Valid JSON object containing service-specific configuration parameters for the new key, provided either in-line or in a file. For a list of supported configuration parameters, see documentation for the particular service offering."`
+	relatedCommands  interface{}                   `related_commands:"create-service-key, delete-service-key, rebind-service, service-key"`
+}
+
+func (cmd RotateServiceKeyCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+		return err
+	}
+
+	if err := cmd.displayIntro(); err != nil {
+		return err
+	}
+
+	key, warnings, err := cmd.Actor.GetServiceKeyByServiceInstanceAndName(
+		cmd.RequiredArgs.ServiceInstance,
+		cmd.RequiredArgs.ServiceKey,
+		cmd.Config.TargetedSpace().GUID,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	originalParameters, err := cmd.originalParameters(key.GUID)
+	if err != nil {
+		return err
+	}
+
+	parameters := types.OptionalObject(cmd.ParametersAsJSON)
+	if !parameters.IsSet {
+		parameters = originalParameters
+	}
+
+	cmd.UI.DisplayText("Creating temporary service key {{.TemporaryKey}}...", cmd.names())
+	if created, err := cmd.createKey(cmd.temporaryKey(), parameters); err != nil {
+		if created {
+			cmd.discardTemporaryKey()
+		}
+		return err
+	}
+
+	cmd.UI.DisplayText("Deleting service key {{.ServiceKey}}...", cmd.names())
+	if err := cmd.deleteKey(cmd.RequiredArgs.ServiceKey); err != nil {
+		cmd.discardTemporaryKey()
+		return err
+	}
+
+	cmd.UI.DisplayText("Creating service key {{.ServiceKey}}...", cmd.names())
+	if created, err := cmd.createKey(cmd.RequiredArgs.ServiceKey, parameters); err != nil {
+		if cmd.restoreKey(created, originalParameters) {
+			cmd.discardTemporaryKey()
+		}
+		return err
+	}
+
+	cmd.UI.DisplayText("Deleting temporary service key {{.TemporaryKey}}...", cmd.names())
+	if err := cmd.deleteKey(cmd.temporaryKey()); err != nil {
+		cmd.UI.DisplayWarning("Service key {{.ServiceKey}} has been replaced, but temporary service key {{.TemporaryKey}} could not be deleted.", cmd.names())
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd RotateServiceKeyCommand) Usage() string {
+	return `
+CF_NAME rotate-service-key SERVICE_INSTANCE SERVICE_KEY [-c PARAMETERS_AS_JSON]
+
+Replaces the service key with a new one of the same name, so that it holds new credentials.
+Without -c, the new key is created with the parameters of the current key.
+
+As key names are unique, a temporary key named SERVICE_KEY-rotating is created with the new
+parameters first. The current key is only deleted once the temporary key has been created, and the
+temporary key is deleted once the new key has been created. Each step waits for the service broker
+to finish before the next one starts.
+
+If a step fails, the changes made so far are rolled back: the temporary key is deleted, and the key
+is created again with its original parameters if it has already been deleted. If the key cannot be
+created again, the temporary key is kept so that the service instance is not left without a key.
+`
+}
+
+func (cmd RotateServiceKeyCommand) Examples() string {
+	return `
+CF_NAME rotate-service-key mydb mykey
+CF_NAME rotate-service-key mydb mykey -c '{"permissions":"read-only"}'
+`
+}
+
+func (cmd RotateServiceKeyCommand) displayIntro() error {
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"Rotating service key {{.ServiceKey}} for service instance {{.ServiceInstance}} as {{.User}}...",
+		map[string]interface{}{
+			"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+			"ServiceKey":      cmd.RequiredArgs.ServiceKey,
+			"User":            user.Name,
+		},
+	)
+	cmd.UI.DisplayNewline()
+
+	return nil
+}
+
+// originalParameters returns the parameters the key was created with. When
+// the service broker does not return them, keys are created without
+// parameters unless -c is given.
+func (cmd RotateServiceKeyCommand) originalParameters(keyGUID string) (types.OptionalObject, error) {
+	parameters, warnings, err := cmd.Actor.GetServiceCredentialBindingParameters(keyGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	switch err.(type) {
+	case nil:
+		return parameters, nil
+	case actionerror.ServiceCredentialBindingParamsFetchingNotSupportedError:
+		cmd.UI.DisplayWarning("Unable to fetch the parameters of service key {{.ServiceKey}}; keys that are created without -c will have no parameters.", cmd.names())
+		return types.OptionalObject{}, nil
+	default:
+		return types.OptionalObject{}, err
+	}
+}
+
+func (cmd RotateServiceKeyCommand) deleteKey(keyName string) error {
+	stream, warnings, err := cmd.Actor.DeleteServiceKeyByServiceInstanceAndName(
+		cmd.RequiredArgs.ServiceInstance,
+		keyName,
+		cmd.Config.TargetedSpace().GUID,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	return err
+}
+
+// createKey creates the key and waits for the service broker. It also
+// reports whether the cloud controller accepted the key, as a key whose
+// creation failed afterwards is left behind and has to be deleted.
+func (cmd RotateServiceKeyCommand) createKey(keyName string, parameters types.OptionalObject) (bool, error) {
+	stream, warnings, err := cmd.Actor.CreateServiceKey(v7action.CreateServiceKeyParams{
+		SpaceGUID:           cmd.Config.TargetedSpace().GUID,
+		ServiceInstanceName: cmd.RequiredArgs.ServiceInstance,
+		ServiceKeyName:      keyName,
+		Parameters:          parameters,
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = shared.WaitForResult(stream, cmd.UI, true)
+	return true, err
+}
+
+// restoreKey creates the key again with its original parameters after the
+// new key failed, and reports whether it succeeded.
+func (cmd RotateServiceKeyCommand) restoreKey(leftBehind bool, parameters types.OptionalObject) bool {
+	cmd.UI.DisplayWarning("Creating the new service key failed, creating service key {{.ServiceKey}} again...", cmd.names())
+
+	var err error
+	if leftBehind {
+		err = cmd.deleteKey(cmd.RequiredArgs.ServiceKey)
+		if _, ok := err.(actionerror.ServiceKeyNotFoundError); ok {
+			err = nil
+		}
+	}
+	if err == nil {
+		_, err = cmd.createKey(cmd.RequiredArgs.ServiceKey, parameters)
+	}
+
+	if err != nil {
+		cmd.UI.DisplayWarning("Unable to create service key {{.ServiceKey}} again: {{.Error}}", map[string]interface{}{
+			"ServiceKey": cmd.RequiredArgs.ServiceKey,
+			"Error":      translatedErrorMessage(cmd.UI, err),
+		})
+		cmd.UI.DisplayWarning("Temporary service key {{.TemporaryKey}} has been kept.", cmd.names())
+		return false
+	}
+
+	cmd.UI.DisplayWarning("Service key {{.ServiceKey}} has been created again.", cmd.names())
+	return true
+}
+
+// discardTemporaryKey deletes the temporary key while rolling back.
+func (cmd RotateServiceKeyCommand) discardTemporaryKey() {
+	err := cmd.deleteKey(cmd.temporaryKey())
+	if _, ok := err.(actionerror.ServiceKeyNotFoundError); ok || err == nil {
+		return
+	}
+
+	cmd.UI.DisplayWarning("Unable to delete temporary service key {{.TemporaryKey}}: {{.Error}}", map[string]interface{}{
+		"TemporaryKey": cmd.temporaryKey(),
+		"Error":        translatedErrorMessage(cmd.UI, err),
+	})
+}
+
+func (cmd RotateServiceKeyCommand) temporaryKey() string {
+	return cmd.RequiredArgs.ServiceKey + temporaryServiceKeySuffix
+}
+
+func (cmd RotateServiceKeyCommand) names() map[string]interface{} {
+	return map[string]interface{}{
+		"ServiceInstance": cmd.RequiredArgs.ServiceInstance,
+		"ServiceKey":      cmd.RequiredArgs.ServiceKey,
+		"TemporaryKey":    cmd.temporaryKey(),
+	}
+}
@@ -0,0 +1,354 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rotate-service-key Command", func() {
+	var (
+		cmd             v7.RotateServiceKeyCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		executeErr      error
+		fakeActor       *v7fakes.FakeActor
+
+		steps          []string
+		createFailures map[string][]error
+		deleteFailures map[string][]error
+	)
+
+	const (
+		fakeUserName            = "fake-user-name"
+		fakeServiceInstanceName = "fake-service-instance-name"
+		fakeServiceKeyName      = "fake-service-key-name"
+		fakeTemporaryKeyName    = "fake-service-key-name-rotating"
+		fakeSpaceGUID           = "fake-space-guid"
+	)
+
+	var (
+		originalParameters = types.NewOptionalObject(map[string]interface{}{"original": "value"})
+		newParameters      = types.NewOptionalObject(map[string]interface{}{"foo": "bar"})
+	)
+
+	failedStream := func(err error) chan v7action.PollJobEvent {
+		stream := make(chan v7action.PollJobEvent, 1)
+		stream <- v7action.PollJobEvent{State: v7action.JobFailed, Err: err}
+		close(stream)
+		return stream
+	}
+
+	// nextFailure returns the stream and error for the next call for a key,
+	// as set up in failures. A streamFailure is returned in the stream, as if
+	// the service broker failed.
+	nextFailure := func(failures map[string][]error, keyName string) (chan v7action.PollJobEvent, error) {
+		if len(failures[keyName]) == 0 {
+			return nil, nil
+		}
+		err := failures[keyName][0]
+		failures[keyName] = failures[keyName][1:]
+		if streamErr, ok := err.(streamFailure); ok {
+			return failedStream(streamErr.err), nil
+		}
+		return nil, err
+	}
+
+	createdWith := func(keyName string, parameters types.OptionalObject) v7action.CreateServiceKeyParams {
+		return v7action.CreateServiceKeyParams{
+			SpaceGUID:           fakeSpaceGUID,
+			ServiceInstanceName: fakeServiceInstanceName,
+			ServiceKeyName:      keyName,
+			Parameters:          parameters,
+		}
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.RotateServiceKeyCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: fakeSpaceGUID})
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: fakeUserName}, nil)
+
+		fakeActor.GetServiceKeyByServiceInstanceAndNameReturns(
+			resources.ServiceCredentialBinding{GUID: "fake-key-guid"},
+			v7action.Warnings{"get key warning"},
+			nil,
+		)
+		fakeActor.GetServiceCredentialBindingParametersReturns(originalParameters, v7action.Warnings{"get parameters warning"}, nil)
+
+		steps = nil
+		createFailures = map[string][]error{}
+		deleteFailures = map[string][]error{}
+		fakeActor.CreateServiceKeyStub = func(params v7action.CreateServiceKeyParams) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+			steps = append(steps, "create "+params.ServiceKeyName)
+			stream, err := nextFailure(createFailures, params.ServiceKeyName)
+			return stream, v7action.Warnings{"create key warning"}, err
+		}
+		fakeActor.DeleteServiceKeyByServiceInstanceAndNameStub = func(_ string, keyName string, _ string) (chan v7action.PollJobEvent, v7action.Warnings, error) {
+			steps = append(steps, "delete "+keyName)
+			stream, err := nextFailure(deleteFailures, keyName)
+			return stream, v7action.Warnings{"delete key warning"}, err
+		}
+
+		setPositionalFlags(&cmd, fakeServiceInstanceName, fakeServiceKeyName)
+		setFlag(&cmd, "-c", `{"foo": "bar"}`)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in, and targeting an org and space", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		actualOrg, actualSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(actualOrg).To(BeTrue())
+		Expect(actualSpace).To(BeTrue())
+	})
+
+	It("creates a temporary key before replacing the key, and deletes the temporary key last", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(fakeActor.GetServiceKeyByServiceInstanceAndNameCallCount()).To(Equal(1))
+		serviceInstanceName, keyName, spaceGUID := fakeActor.GetServiceKeyByServiceInstanceAndNameArgsForCall(0)
+		Expect(serviceInstanceName).To(Equal(fakeServiceInstanceName))
+		Expect(keyName).To(Equal(fakeServiceKeyName))
+		Expect(spaceGUID).To(Equal(fakeSpaceGUID))
+
+		Expect(fakeActor.GetServiceCredentialBindingParametersCallCount()).To(Equal(1))
+		Expect(fakeActor.GetServiceCredentialBindingParametersArgsForCall(0)).To(Equal("fake-key-guid"))
+
+		Expect(steps).To(Equal([]string{
+			"create " + fakeTemporaryKeyName,
+			"delete " + fakeServiceKeyName,
+			"create " + fakeServiceKeyName,
+			"delete " + fakeTemporaryKeyName,
+		}))
+		Expect(fakeActor.CreateServiceKeyArgsForCall(0)).To(Equal(createdWith(fakeTemporaryKeyName, newParameters)))
+		Expect(fakeActor.CreateServiceKeyArgsForCall(1)).To(Equal(createdWith(fakeServiceKeyName, newParameters)))
+
+		serviceInstanceName, _, spaceGUID = fakeActor.DeleteServiceKeyByServiceInstanceAndNameArgsForCall(0)
+		Expect(serviceInstanceName).To(Equal(fakeServiceInstanceName))
+		Expect(spaceGUID).To(Equal(fakeSpaceGUID))
+	})
+
+	It("prints the steps and warnings", func() {
+		Expect(testUI.Out).To(SatisfyAll(
+			Say(`Rotating service key %s for service instance %s as %s\.\.\.\n`, fakeServiceKeyName, fakeServiceInstanceName, fakeUserName),
+			Say(`Creating temporary service key %s\.\.\.\n`, fakeTemporaryKeyName),
+			Say(`Deleting service key %s\.\.\.\n`, fakeServiceKeyName),
+			Say(`Creating service key %s\.\.\.\n`, fakeServiceKeyName),
+			Say(`Deleting temporary service key %s\.\.\.\n`, fakeTemporaryKeyName),
+			Say(`OK\n`),
+		))
+
+		Expect(testUI.Err).To(SatisfyAll(
+			Say("get key warning"),
+			Say("get parameters warning"),
+			Say("create key warning"),
+			Say("delete key warning"),
+		))
+	})
+
+	When("no parameters are given", func() {
+		BeforeEach(func() {
+			cmd.ParametersAsJSON = flag.JSONOrFileWithValidation{}
+		})
+
+		It("creates the keys with the original parameters", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.CreateServiceKeyArgsForCall(0)).To(Equal(createdWith(fakeTemporaryKeyName, originalParameters)))
+			Expect(fakeActor.CreateServiceKeyArgsForCall(1)).To(Equal(createdWith(fakeServiceKeyName, originalParameters)))
+		})
+
+		When("the service broker does not return the original parameters", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceCredentialBindingParametersReturns(types.OptionalObject{}, nil, actionerror.ServiceCredentialBindingParamsFetchingNotSupportedError{})
+			})
+
+			It("warns and creates the keys without parameters", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say(`Unable to fetch the parameters of service key %s; keys that are created without -c will have no parameters\.`, fakeServiceKeyName))
+				Expect(fakeActor.CreateServiceKeyArgsForCall(0)).To(Equal(createdWith(fakeTemporaryKeyName, types.OptionalObject{})))
+				Expect(fakeActor.CreateServiceKeyArgsForCall(1)).To(Equal(createdWith(fakeServiceKeyName, types.OptionalObject{})))
+			})
+		})
+	})
+
+	When("the service key does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceKeyByServiceInstanceAndNameReturns(
+				resources.ServiceCredentialBinding{},
+				v7action.Warnings{"get key warning"},
+				actionerror.ServiceKeyNotFoundError{KeyName: fakeServiceKeyName, ServiceInstanceName: fakeServiceInstanceName},
+			)
+		})
+
+		It("returns the error and does not change anything", func() {
+			Expect(executeErr).To(MatchError(actionerror.ServiceKeyNotFoundError{KeyName: fakeServiceKeyName, ServiceInstanceName: fakeServiceInstanceName}))
+			Expect(steps).To(BeEmpty())
+		})
+	})
+
+	When("getting the original parameters fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceCredentialBindingParametersReturns(types.OptionalObject{}, nil, errors.New("parameters failed"))
+		})
+
+		It("returns the error and does not change anything", func() {
+			Expect(executeErr).To(MatchError("parameters failed"))
+			Expect(steps).To(BeEmpty())
+		})
+	})
+
+	When("the temporary key cannot be created", func() {
+		BeforeEach(func() {
+			createFailures[fakeTemporaryKeyName] = []error{errors.New("name taken")}
+		})
+
+		It("returns the error and does not change anything", func() {
+			Expect(executeErr).To(MatchError("name taken"))
+			Expect(steps).To(Equal([]string{"create " + fakeTemporaryKeyName}))
+		})
+	})
+
+	When("the service broker fails to create the temporary key", func() {
+		BeforeEach(func() {
+			createFailures[fakeTemporaryKeyName] = []error{streamFailure{errors.New("create failed")}}
+		})
+
+		It("deletes the failed temporary key and keeps the key", func() {
+			Expect(executeErr).To(MatchError("create failed"))
+			Expect(steps).To(Equal([]string{
+				"create " + fakeTemporaryKeyName,
+				"delete " + fakeTemporaryKeyName,
+			}))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+
+	When("deleting the key fails", func() {
+		BeforeEach(func() {
+			deleteFailures[fakeServiceKeyName] = []error{streamFailure{errors.New("delete failed")}}
+		})
+
+		It("deletes the temporary key and returns the error", func() {
+			Expect(executeErr).To(MatchError("delete failed"))
+			Expect(steps).To(Equal([]string{
+				"create " + fakeTemporaryKeyName,
+				"delete " + fakeServiceKeyName,
+				"delete " + fakeTemporaryKeyName,
+			}))
+		})
+	})
+
+	When("creating the new key fails", func() {
+		BeforeEach(func() {
+			createFailures[fakeServiceKeyName] = []error{streamFailure{errors.New("create failed")}}
+		})
+
+		It("creates the key again with its original parameters, deletes the temporary key and returns the error", func() {
+			Expect(executeErr).To(MatchError("create failed"))
+
+			Expect(steps).To(Equal([]string{
+				"create " + fakeTemporaryKeyName,
+				"delete " + fakeServiceKeyName,
+				"create " + fakeServiceKeyName,
+				"delete " + fakeServiceKeyName,
+				"create " + fakeServiceKeyName,
+				"delete " + fakeTemporaryKeyName,
+			}))
+			Expect(fakeActor.CreateServiceKeyArgsForCall(2)).To(Equal(createdWith(fakeServiceKeyName, originalParameters)))
+
+			Expect(testUI.Err).To(SatisfyAll(
+				Say(`Creating the new service key failed, creating service key %s again\.\.\.`, fakeServiceKeyName),
+				Say(`Service key %s has been created again\.`, fakeServiceKeyName),
+			))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+
+		When("the cloud controller rejects the new key", func() {
+			BeforeEach(func() {
+				createFailures[fakeServiceKeyName] = []error{errors.New("create rejected")}
+			})
+
+			It("creates the key again without deleting it first", func() {
+				Expect(executeErr).To(MatchError("create rejected"))
+				Expect(steps).To(Equal([]string{
+					"create " + fakeTemporaryKeyName,
+					"delete " + fakeServiceKeyName,
+					"create " + fakeServiceKeyName,
+					"create " + fakeServiceKeyName,
+					"delete " + fakeTemporaryKeyName,
+				}))
+			})
+		})
+
+		When("creating the key again fails as well", func() {
+			BeforeEach(func() {
+				createFailures[fakeServiceKeyName] = append(createFailures[fakeServiceKeyName], errors.New("restore failed"))
+			})
+
+			It("keeps the temporary key and reports both failures", func() {
+				Expect(executeErr).To(MatchError("create failed"))
+				Expect(steps).NotTo(ContainElement("delete " + fakeTemporaryKeyName))
+				Expect(testUI.Err).To(SatisfyAll(
+					Say(`Unable to create service key %s again: restore failed`, fakeServiceKeyName),
+					Say(`Temporary service key %s has been kept\.`, fakeTemporaryKeyName),
+				))
+			})
+		})
+	})
+
+	When("deleting the temporary key fails", func() {
+		BeforeEach(func() {
+			deleteFailures[fakeTemporaryKeyName] = []error{streamFailure{errors.New("delete failed")}}
+		})
+
+		It("keeps the new key and returns the error", func() {
+			Expect(executeErr).To(MatchError("delete failed"))
+			Expect(steps).To(Equal([]string{
+				"create " + fakeTemporaryKeyName,
+				"delete " + fakeServiceKeyName,
+				"create " + fakeServiceKeyName,
+				"delete " + fakeTemporaryKeyName,
+			}))
+			Expect(testUI.Err).To(Say(`Service key %s has been replaced, but temporary service key %s could not be deleted\.`, fakeServiceKeyName, fakeTemporaryKeyName))
+		})
+	})
+})
+
+// streamFailure marks an error that the service broker reports once the
+// cloud controller has accepted the request.
+type streamFailure struct {
+	err error
+}
+
+func (f streamFailure) Error() string {
+	return f.err.Error()
+}
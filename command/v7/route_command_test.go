@@ -192,7 +192,8 @@ var _ = Describe("route Command", func() {
 			destinationB := resources.RouteDestination{App: destAppB, Port: 1337, Protocol: "http2"}
 
 			destinations := []resources.RouteDestination{destinationA, destinationB}
-			route := resources.Route{GUID: "route-guid", Host: cmd.Hostname, Path: cmd.Path.Path, Protocol: "http", Destinations: destinations}
+			leastConnection := "least-connection"
+			route := resources.Route{GUID: "route-guid", Host: cmd.Hostname, Path: cmd.Path.Path, Protocol: "http", Destinations: destinations, Options: map[string]*string{"loadbalancing": &leastConnection}}
 
 			fakeActor.GetRouteByAttributesReturns(
 				route,
@@ -218,6 +219,7 @@ var _ = Describe("route Command", func() {
 			Expect(testUI.Out).To(Say(`host:\s+%s`, cmd.Hostname))
 			Expect(testUI.Out).To(Say(`path:\s+%s`, cmd.Path.Path))
 			Expect(testUI.Out).To(Say(`protocol:\s+http`))
+			Expect(testUI.Out).To(Say(`options:\s+loadbalancing=least-connection`))
 			Expect(testUI.Out).To(Say(`\n`))
 			Expect(testUI.Out).To(Say(`Destinations:`))
 			Expect(testUI.Out).To(Say(`\s+app\s+process\s+port\s+app-protocol`))
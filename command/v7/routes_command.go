@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -38,18 +39,24 @@ func (cmd RoutesCommand) Execute(args []string) error {
 	targetedOrg := cmd.Config.TargetedOrganization()
 	targetedSpace := cmd.Config.TargetedSpace()
 
+	structuredOutput := shared.IsStructuredOutput(cmd.Config)
+
 	if cmd.Orglevel {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":  targetedOrg.Name,
-			"CurrentUser": currentUser.Name,
-		})
+		if !structuredOutput {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":  targetedOrg.Name,
+				"CurrentUser": currentUser.Name,
+			})
+		}
 		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID, cmd.Labels)
 	} else {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":   targetedOrg.Name,
-			"CurrentSpace": targetedSpace.Name,
-			"CurrentUser":  currentUser.Name,
-		})
+		if !structuredOutput {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":   targetedOrg.Name,
+				"CurrentSpace": targetedSpace.Name,
+				"CurrentUser":  currentUser.Name,
+			})
+		}
 		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID, cmd.Labels)
 	}
 
@@ -64,6 +71,10 @@ func (cmd RoutesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if structuredOutput {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.RouteListKind, shared.NewRouteListOutput(routeSummaries))
+	}
+
 	if len(routes) > 0 {
 		cmd.displayRoutesTable(routeSummaries)
 	} else {
@@ -85,6 +96,7 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			cmd.UI.TranslateText("app-protocol"),
 			cmd.UI.TranslateText("apps"),
 			cmd.UI.TranslateText("service instance"),
+			cmd.UI.TranslateText("options"),
 		},
 	}
 
@@ -103,6 +115,7 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			strings.Join(routeSummary.AppProtocols, ", "),
 			strings.Join(routeSummary.AppNames, ", "),
 			routeSummary.ServiceInstanceName,
+			routeSummary.FormattedOptions(),
 		})
 	}
 
@@ -0,0 +1,239 @@
+package v7
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/cf/errors"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"gopkg.in/yaml.v2"
+)
+
+type RunScheduledTasksCommand struct {
+	BaseCommand
+
+	PathToManifest   flag.ManifestPathWithExistenceCheck `short:"f" description:"Path to app manifest"`
+	StateFile        flag.Path                           `long:"state-file" description:"Path to the file that records when each task was last checked and run (Default: ~/.cf/scheduled_tasks.json)"`
+	Vars             []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
+	PathsToVarsFiles []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
+	Watch            bool                                `long:"watch" description:"Keep running and check the schedules every minute"`
+	usage            interface{}                         `usage:"CF_NAME run-scheduled-tasks [-f APP_MANIFEST_PATH] [--state-file PATH] [--watch]\n\n   Runs the tasks listed under 'tasks' for each app in the manifest whose cron schedule has\n   come up since the previous check. The first check of a task only records when it was seen.\n   Schedules are evaluated in the local time zone.\n\n   applications:\n   - name: my-app\n     tasks:\n     - name: nightly-report\n       command: bin/report\n       memory: 256M\n       disk_quota: 1G\n       schedule: \"0 2 * * *\"\n\nEXAMPLES:\n   CF_NAME run-scheduled-tasks -f manifest.yml\n\n   CF_NAME run-scheduled-tasks -f manifest.yml --watch"`
+	relatedCommands  interface{}                         `related_commands:"run-task, task-logs, tasks"`
+
+	ManifestLocator ManifestLocator
+	ManifestParser  ManifestParser
+	Clock           clock.Clock
+	CWD             string
+}
+
+func (cmd *RunScheduledTasksCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.ManifestLocator = manifestparser.NewLocator()
+	cmd.ManifestParser = manifestparser.ManifestParser{}
+	cmd.Clock = clock.NewClock()
+
+	currentDir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	cmd.CWD = currentDir
+
+	return cmd.BaseCommand.Setup(config, ui)
+}
+
+func (cmd RunScheduledTasksCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	pathToManifest, manifest, err := cmd.readManifest()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Checking scheduled tasks in manifest {{.ManifestPath}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"ManifestPath": pathToManifest,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"Username":     user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	tasks, err := cmd.Actor.GetScheduledTasks(cmd.Config.TargetedSpace().GUID, manifest)
+	if err != nil {
+		return err
+	}
+
+	if len(tasks) == 0 {
+		cmd.UI.DisplayText("No scheduled tasks found in manifest.")
+		return nil
+	}
+
+	statePath := string(cmd.StateFile)
+	if statePath == "" {
+		statePath = configv3.ScheduledTasksStateFilePath()
+	}
+
+	history, err := v7action.NewScheduledTaskHistory(statePath)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Watch {
+		err = cmd.runDueTasks(tasks, history)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	for {
+		err = cmd.runDueTasks(tasks, history)
+		if err != nil {
+			if _, ok := err.(translatableerror.ScheduledTasksFailedError); !ok {
+				return err
+			}
+			// Keep watching, the tasks will be retried on their next run
+			cmd.UI.DisplayWarning(translatedErrorMessage(cmd.UI, err))
+		}
+
+		now := cmd.Clock.Now()
+		<-cmd.Clock.After(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+		cmd.UI.DisplayNewline()
+	}
+}
+
+// runDueTasks runs the tasks whose schedule has fired since they were last
+// checked, records the check in the history and displays the schedules.
+func (cmd RunScheduledTasksCommand) runDueTasks(tasks []v7action.ScheduledTask, history *v7action.ScheduledTaskHistory) error {
+	now := cmd.Clock.Now()
+
+	var failedTasks []string
+	for _, task := range tasks {
+		lastChecked, checked := history.LastChecked(task)
+		history.RecordCheck(task, now)
+		if !checked || !task.IsDue(lastChecked, now) {
+			continue
+		}
+
+		cmd.UI.DisplayText("Running task {{.TaskName}} on app {{.AppName}}...", map[string]interface{}{
+			"TaskName": task.Name,
+			"AppName":  task.AppName,
+		})
+
+		createdTask, warnings, err := cmd.Actor.RunScheduledTask(task)
+		cmd.UI.DisplayWarnings(warnings)
+		run := v7action.ScheduledTaskRun{Time: now, TaskGUID: createdTask.GUID, SequenceID: createdTask.SequenceID}
+		if err != nil {
+			run.Error = err.Error()
+			failedTasks = append(failedTasks, task.AppName+"/"+task.Name)
+			cmd.UI.DisplayWarning("Failed to run task {{.TaskName}} on app {{.AppName}}: {{.Error}}", map[string]interface{}{
+				"TaskName": task.Name,
+				"AppName":  task.AppName,
+				"Error":    translatedErrorMessage(cmd.UI, err),
+			})
+		} else {
+			cmd.UI.DisplayText("Task {{.TaskName}} has been submitted with id {{.TaskID}}.", map[string]interface{}{
+				"TaskName": task.Name,
+				"TaskID":   createdTask.SequenceID,
+			})
+		}
+		history.RecordRun(task, run)
+	}
+
+	err := history.Save()
+	if err != nil {
+		return err
+	}
+
+	cmd.displaySchedules(tasks, history, now)
+
+	if len(failedTasks) > 0 {
+		return translatableerror.ScheduledTasksFailedError{TaskNames: failedTasks}
+	}
+	return nil
+}
+
+func (cmd RunScheduledTasksCommand) displaySchedules(tasks []v7action.ScheduledTask, history *v7action.ScheduledTaskHistory, now time.Time) {
+	cmd.UI.DisplayNewline()
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("task"),
+			cmd.UI.TranslateText("schedule"),
+			cmd.UI.TranslateText("last run"),
+			cmd.UI.TranslateText("next run"),
+		},
+	}
+	for _, task := range tasks {
+		lastRun := ""
+		if runs := history.Runs(task); len(runs) > 0 {
+			lastRun = runs[len(runs)-1].Time.Format(time.RFC1123)
+			if runs[len(runs)-1].Error != "" {
+				lastRun += " " + cmd.UI.TranslateText("(failed)")
+			}
+		}
+
+		nextRun := ""
+		if next := task.Schedule.Next(now); !next.IsZero() {
+			nextRun = next.Format(time.RFC1123)
+		}
+
+		table = append(table, []string{task.AppName, task.Name, task.ScheduleSpec, lastRun, nextRun})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+}
+
+func (cmd RunScheduledTasksCommand) readManifest() (string, manifestparser.Manifest, error) {
+	readPath := cmd.CWD
+	if cmd.PathToManifest != "" {
+		readPath = string(cmd.PathToManifest)
+	}
+
+	pathToManifest, exists, err := cmd.ManifestLocator.Path(readPath)
+	if err != nil {
+		return "", manifestparser.Manifest{}, err
+	}
+
+	if !exists {
+		return "", manifestparser.Manifest{}, translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: readPath}
+	}
+
+	var pathsToVarsFiles []string
+	for _, varFilePath := range cmd.PathsToVarsFiles {
+		pathsToVarsFiles = append(pathsToVarsFiles, string(varFilePath))
+	}
+
+	interpolatedManifestBytes, err := cmd.ManifestParser.InterpolateManifest(pathToManifest, pathsToVarsFiles, cmd.Vars)
+	if err != nil {
+		return "", manifestparser.Manifest{}, err
+	}
+
+	manifest, err := cmd.ManifestParser.ParseManifest(pathToManifest, interpolatedManifestBytes)
+	if err != nil {
+		if _, ok := err.(*yaml.TypeError); ok {
+			return "", manifestparser.Manifest{}, errors.New("Unable to run scheduled tasks because the manifest format is invalid.")
+		}
+		return "", manifestparser.Manifest{}, err
+	}
+
+	return pathToManifest, manifest, nil
+}
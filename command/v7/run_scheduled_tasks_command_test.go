@@ -0,0 +1,256 @@
+package v7_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/cron"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("run-scheduled-tasks Command", func() {
+	var (
+		cmd             RunScheduledTasksCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeParser      *v7fakes.FakeManifestParser
+		fakeLocator     *v7fakes.FakeManifestLocator
+		fakeClock       *fakeclock.FakeClock
+		binaryName      string
+		stateDir        string
+		statePath       string
+		scheduledTask   v7action.ScheduledTask
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeParser = new(v7fakes.FakeManifestParser)
+		fakeLocator = new(v7fakes.FakeManifestLocator)
+		fakeClock = fakeclock.NewFakeClock(time.Date(2024, time.January, 1, 10, 30, 0, 0, time.Local))
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		var err error
+		stateDir, err = ioutil.TempDir("", "run-scheduled-tasks")
+		Expect(err).ToNot(HaveOccurred())
+		statePath = filepath.Join(stateDir, "scheduled_tasks.json")
+
+		fakeLocator.PathReturns("/some/path/manifest.yml", true, nil)
+		fakeParser.ParseManifestReturns(manifestparser.Manifest{
+			Applications: []manifestparser.Application{{Name: "some-app"}},
+		}, nil)
+
+		schedule, err := cron.Parse("0 * * * *")
+		Expect(err).ToNot(HaveOccurred())
+		scheduledTask = v7action.ScheduledTask{
+			SpaceGUID:    "some-space-guid",
+			AppName:      "some-app",
+			Name:         "some-task",
+			Command:      "bin/some-task",
+			ScheduleSpec: "0 * * * *",
+			Schedule:     schedule,
+		}
+		fakeActor.GetScheduledTasksReturns([]v7action.ScheduledTask{scheduledTask}, nil)
+
+		cmd = RunScheduledTasksCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			StateFile:       flag.Path(statePath),
+			ManifestParser:  fakeParser,
+			ManifestLocator: fakeLocator,
+			Clock:           fakeClock,
+			CWD:             "fake-directory",
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(stateDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("there is no manifest", func() {
+		BeforeEach(func() {
+			fakeLocator.PathReturns("", false, nil)
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{PathToManifest: "fake-directory"}))
+		})
+	})
+
+	When("the manifest is given with -f", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = "/some/other/path"
+		})
+
+		It("reads the manifest at that path", func() {
+			Expect(fakeLocator.PathCallCount()).To(Equal(1))
+			Expect(fakeLocator.PathArgsForCall(0)).To(Equal("/some/other/path"))
+
+			Expect(fakeParser.ParseManifestCallCount()).To(Equal(1))
+			manifestPath, _ := fakeParser.ParseManifestArgsForCall(0)
+			Expect(manifestPath).To(Equal("/some/path/manifest.yml"))
+		})
+	})
+
+	When("the tasks in the manifest are invalid", func() {
+		BeforeEach(func() {
+			fakeActor.GetScheduledTasksReturns(nil, actionerror.InvalidScheduledTaskError{AppName: "some-app", TaskName: "some-task", Reason: "a schedule is required"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.InvalidScheduledTaskError{AppName: "some-app", TaskName: "some-task", Reason: "a schedule is required"}))
+		})
+	})
+
+	When("the manifest has no tasks", func() {
+		BeforeEach(func() {
+			fakeActor.GetScheduledTasksReturns(nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No scheduled tasks found in manifest."))
+		})
+	})
+
+	When("the task has not been checked before", func() {
+		It("records the check without running the task", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Checking scheduled tasks in manifest /some/path/manifest.yml in org some-org / space some-space as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say(`app\s+task\s+schedule\s+last run\s+next run`))
+			Expect(testUI.Out).To(Say(`some-app\s+some-task\s+0 \* \* \* \*\s+Mon, 01 Jan 2024 11:00:00`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.GetScheduledTasksCallCount()).To(Equal(1))
+			spaceGUID, manifest := fakeActor.GetScheduledTasksArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(manifest.Applications[0].Name).To(Equal("some-app"))
+
+			Expect(fakeActor.RunScheduledTaskCallCount()).To(Equal(0))
+
+			history, err := v7action.NewScheduledTaskHistory(statePath)
+			Expect(err).ToNot(HaveOccurred())
+			lastChecked, ok := history.LastChecked(scheduledTask)
+			Expect(ok).To(BeTrue())
+			Expect(lastChecked.Equal(fakeClock.Now())).To(BeTrue())
+		})
+	})
+
+	When("the task was checked before its schedule fired", func() {
+		BeforeEach(func() {
+			history, err := v7action.NewScheduledTaskHistory(statePath)
+			Expect(err).ToNot(HaveOccurred())
+			history.RecordCheck(scheduledTask, fakeClock.Now().Add(-40*time.Minute))
+			Expect(history.Save()).To(Succeed())
+		})
+
+		When("running the task succeeds", func() {
+			BeforeEach(func() {
+				fakeActor.RunScheduledTaskReturns(resources.Task{GUID: "some-task-guid", SequenceID: 3}, v7action.Warnings{"run-warning"}, nil)
+			})
+
+			It("runs the task and records the run", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.RunScheduledTaskCallCount()).To(Equal(1))
+				Expect(fakeActor.RunScheduledTaskArgsForCall(0)).To(Equal(scheduledTask))
+
+				Expect(testUI.Out).To(Say(`Running task some-task on app some-app\.\.\.`))
+				Expect(testUI.Err).To(Say("run-warning"))
+				Expect(testUI.Out).To(Say(`Task some-task has been submitted with id 3\.`))
+				Expect(testUI.Out).To(Say(`some-app\s+some-task\s+0 \* \* \* \*\s+Mon, 01 Jan 2024 10:30:00 \S+\s+Mon, 01 Jan 2024 11:00:00`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				history, err := v7action.NewScheduledTaskHistory(statePath)
+				Expect(err).ToNot(HaveOccurred())
+				runs := history.Runs(scheduledTask)
+				Expect(runs).To(HaveLen(1))
+				Expect(runs[0].TaskGUID).To(Equal("some-task-guid"))
+				Expect(runs[0].SequenceID).To(Equal(int64(3)))
+			})
+		})
+
+		When("running the task fails", func() {
+			BeforeEach(func() {
+				fakeActor.RunScheduledTaskReturns(resources.Task{}, v7action.Warnings{"run-warning"}, errors.New("run-error"))
+			})
+
+			It("records the failure and returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ScheduledTasksFailedError{TaskNames: []string{"some-app/some-task"}}))
+
+				Expect(testUI.Err).To(Say("run-warning"))
+				Expect(testUI.Err).To(Say("Failed to run task some-task on app some-app: run-error"))
+				Expect(testUI.Out).To(Say(`some-app\s+some-task\s+0 \* \* \* \*\s+Mon, 01 Jan 2024 10:30:00 \S+ \(failed\)`))
+
+				history, err := v7action.NewScheduledTaskHistory(statePath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(history.Runs(scheduledTask)).To(ConsistOf(v7action.ScheduledTaskRun{Time: history.Runs(scheduledTask)[0].Time, Error: "run-error"}))
+			})
+		})
+	})
+
+	When("the task was checked after its schedule last fired", func() {
+		BeforeEach(func() {
+			history, err := v7action.NewScheduledTaskHistory(statePath)
+			Expect(err).ToNot(HaveOccurred())
+			history.RecordCheck(scheduledTask, fakeClock.Now().Add(-10*time.Minute))
+			Expect(history.Save()).To(Succeed())
+		})
+
+		It("does not run the task", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.RunScheduledTaskCallCount()).To(Equal(0))
+		})
+	})
+})
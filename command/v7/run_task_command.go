@@ -3,6 +3,10 @@ package v7
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 )
@@ -13,13 +17,26 @@ type RunTaskCommand struct {
 	RequiredArgs    flag.RunTaskArgsV7      `positional-args:"yes"`
 	Command         string                  `long:"command" short:"c" description:"The command to execute"`
 	Disk            flag.Megabytes          `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Follow          bool                    `long:"follow" short:"f" description:"Display the logs of the task until it completes, then exit with its result"`
 	LogRateLimit    flag.BytesWithUnlimited `short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
 	Memory          flag.Megabytes          `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	Name            string                  `long:"name" description:"Name to give the task (generated if omitted)"`
 	Process         string                  `long:"process" description:"Process type to use as a template for command, memory, and disk for the created task."`
 	Wait            bool                    `long:"wait" short:"w" description:"Wait for the task to complete before exiting"`
-	usage           interface{}             `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--process PROCESS_TYPE]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
-	relatedCommands interface{}             `related_commands:"logs, tasks, terminate-task"`
+	usage           interface{}             `usage:"CF_NAME run-task APP_NAME [--command COMMAND] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [--name TASK_NAME] [--process PROCESS_TYPE] [--wait | --follow]\n\nTIP:\n   Use 'cf task-logs' to display the logs of a single task, or 'cf logs' to display the logs of the app and all its tasks.\n\nEXAMPLES:\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate\n\n   CF_NAME run-task my-app --command \"bundle exec rake db:migrate\" --name migrate --follow\n\n   CF_NAME run-task my-app --process batch_job\n\n   CF_NAME run-task my-app"`
+	relatedCommands interface{}             `related_commands:"logs, task-logs, tasks, terminate-task"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
 }
 
 func (cmd RunTaskCommand) Execute(args []string) error {
@@ -95,7 +112,19 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
-	if cmd.Wait {
+	if cmd.Follow {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Following task logs until it completes execution...")
+		cmd.UI.DisplayNewline()
+
+		err = followTaskLogs(cmd.BaseCommand, cmd.LogCacheClient, application.GUID, task)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Task has completed successfully.")
+	} else if cmd.Wait {
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayText("Waiting for task to complete execution...")
 
@@ -2,8 +2,10 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -385,6 +387,75 @@ var _ = Describe("run-task Command", func() {
 
 					})
 				})
+
+				When("follow is provided", func() {
+					var (
+						logStream   chan sharedaction.LogMessage
+						errorStream chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Name = "some-task-name"
+						cmd.Follow = true
+						fakeActor.RunTaskReturns(
+							resources.Task{GUID: "task-guid", Name: "some-task-name", SequenceID: 3},
+							nil,
+							nil)
+
+						fakeActor.ScheduleTokenRefreshStub = func(
+							after func(time.Duration) <-chan time.Time,
+							stop chan struct{}, stoppedRefreshing chan struct{}) (<-chan error, error) {
+							go func() {
+								<-stop
+								close(stoppedRefreshing)
+							}()
+							return make(chan error), nil
+						}
+
+						logStream = make(chan sharedaction.LogMessage, 2)
+						errorStream = make(chan error)
+						logStream <- *sharedaction.NewLogMessage("task-output-1", "OUT", time.Now(), "APP/TASK/some-task-name", "0")
+						logStream <- *sharedaction.NewLogMessage("task-output-2", "OUT", time.Now(), "APP/TASK/some-task-name", "0")
+						fakeActor.GetStreamingLogsForTaskReturns(logStream, errorStream, func() {})
+
+						fakeActor.PollTaskStub = func(task resources.Task) (resources.Task, v7action.Warnings, error) {
+							Eventually(logStream).Should(BeEmpty())
+							return task, v7action.Warnings{"poll-warnings"}, nil
+						}
+					})
+
+					It("displays the task logs until the task completes", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetStreamingLogsForTaskCallCount()).To(Equal(1))
+						appGUID, task, _ := fakeActor.GetStreamingLogsForTaskArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(task.GUID).To(Equal("task-guid"))
+						Expect(fakeActor.PollTaskArgsForCall(0).GUID).To(Equal("task-guid"))
+
+						Expect(testUI.Out).To(Say(`Following task logs until it completes execution...`))
+						Expect(testUI.Out).To(Say(`task-output-1`))
+						Expect(testUI.Out).To(Say(`task-output-2`))
+						Expect(testUI.Out).To(Say(`Task has completed successfully.`))
+						Expect(testUI.Out).To(Say("OK"))
+						Expect(testUI.Err).To(Say("poll-warnings"))
+					})
+
+					When("the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskStub = func(task resources.Task) (resources.Task, v7action.Warnings, error) {
+								Eventually(logStream).Should(BeEmpty())
+								return task, nil, actionerror.TaskFailedError{}
+							}
+						})
+
+						It("displays the logs and returns a task failed error", func() {
+							Expect(executeErr).To(MatchError(actionerror.TaskFailedError{}))
+							Expect(testUI.Out).To(Say(`task-output-2`))
+							Expect(testUI.Out).ToNot(Say(`Task has completed successfully.`))
+						})
+					})
+				})
 			})
 
 			When("there are errors", func() {
@@ -0,0 +1,157 @@
+package v7
+
+import (
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+type SCPCommand struct {
+	BaseCommand
+
+	RequiredArgs       flag.SCPArgs `positional-args:"yes"`
+	ProcessIndex       uint         `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string       `long:"process" default:"web" description:"App process name"`
+	Recursive          bool         `short:"r" description:"Recursively copy directories"`
+	SkipHostValidation bool         `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME scp [-r] [--process PROCESS] [-i INDEX] SOURCE DESTINATION\n\n   Exactly one of SOURCE and DESTINATION must be a path in the app instance, given\n   as APP_NAME:PATH. Relative remote paths are relative to the home directory of\n   the app instance.\n\nEXAMPLES:\n   CF_NAME scp my-app:app/logs/server.log .\n   CF_NAME scp -r ./config my-app:app/config\n   CF_NAME scp --process worker -i 1 my-app:/tmp/heap.hprof ./heap.hprof"`
+	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, ssh, ssh-code"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	SSHActor  SharedSSHActor
+	SSHClient sharedaction.SecureShellClient
+	Progress  clissh.TransferProgress
+}
+
+func (cmd *SCPCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.Progress = progressbar.NewFileProgressBar()
+
+	return nil
+}
+
+func (cmd SCPCommand) Execute(args []string) error {
+	appName, direction, localPath, remotePath, err := cmd.parsePaths()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	templateValues := map[string]interface{}{
+		"AppName":     appName,
+		"Index":       cmd.ProcessIndex,
+		"LocalPath":   localPath,
+		"OrgName":     cmd.Config.TargetedOrganization().Name,
+		"ProcessType": cmd.ProcessType,
+		"RemotePath":  remotePath,
+		"SpaceName":   cmd.Config.TargetedSpace().Name,
+		"Username":    user.Name,
+	}
+	if direction == sharedaction.SCPDownload {
+		cmd.UI.DisplayTextWithFlavor("Copying {{.RemotePath}} from instance {{.Index}} of {{.ProcessType}} process of app {{.AppName}} to {{.LocalPath}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Copying {{.LocalPath}} to {{.RemotePath}} on instance {{.Index}} of {{.ProcessType}} process of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SSHActor.ExecuteSecureCopy(
+		cmd.SSHClient,
+		sharedaction.SCPOptions{
+			Direction:          direction,
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			LocalPath:          localPath,
+			Passcode:           sshAuth.Passcode,
+			Progress:           cmd.Progress,
+			Recursive:          cmd.Recursive,
+			RemotePath:         remotePath,
+			SkipHostValidation: cmd.SkipHostValidation,
+			Username:           sshAuth.Username,
+		})
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// parsePaths works out which of the source and destination is in the app
+// instance, and so whether the files are uploaded or downloaded.
+func (cmd SCPCommand) parsePaths() (string, sharedaction.SCPDirection, string, string, error) {
+	sourceApp, sourcePath, sourceIsRemote := splitRemotePath(cmd.RequiredArgs.Source)
+	destinationApp, destinationPath, destinationIsRemote := splitRemotePath(cmd.RequiredArgs.Destination)
+
+	switch {
+	case sourceIsRemote && !destinationIsRemote:
+		return sourceApp, sharedaction.SCPDownload, destinationPath, sourcePath, nil
+	case destinationIsRemote && !sourceIsRemote:
+		return destinationApp, sharedaction.SCPUpload, sourcePath, destinationPath, nil
+	default:
+		return "", 0, "", "", translatableerror.IncorrectUsageError{
+			Message: "exactly one of SOURCE and DESTINATION must be an app path in the form APP_NAME:PATH",
+		}
+	}
+}
+
+// splitRemotePath splits an APP_NAME:PATH argument. Arguments without a
+// colon, and on Windows paths such as C:\dir, are local paths.
+func splitRemotePath(arg string) (string, string, bool) {
+	if runtime.GOOS == "windows" && isWindowsVolumePath(arg) {
+		return "", arg, false
+	}
+
+	appName, path, found := strings.Cut(arg, ":")
+	if !found || appName == "" || strings.ContainsAny(appName, `/\`) {
+		return "", arg, false
+	}
+
+	if path == "" {
+		path = "."
+	}
+	return appName, path, true
+}
+
+func isWindowsVolumePath(path string) bool {
+	if len(path) < 2 || path[1] != ':' {
+		return false
+	}
+	letter := path[0]
+	isLetter := ('a' <= letter && letter <= 'z') || ('A' <= letter && letter <= 'Z')
+	return isLetter && (len(path) == 2 || path[2] == '\\' || path[2] == '/')
+}
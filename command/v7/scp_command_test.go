@@ -0,0 +1,196 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scp Command", func() {
+	var (
+		cmd             SCPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		fakeSSHActor    *v7fakes.FakeSharedSSHActor
+		fakeSSHClient   *sharedactionfakes.FakeSecureShellClient
+		fakeProgress    *clisshfakes.FakeTransferProgress
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSSHActor)
+		fakeSSHClient = new(sharedactionfakes.FakeSecureShellClient)
+		fakeProgress = new(clisshfakes.FakeTransferProgress)
+
+		cmd = SCPCommand{
+			RequiredArgs: flag.SCPArgs{Source: "./some-file", Destination: "some-app:/tmp/some-file"},
+
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			SkipHostValidation: true,
+
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			SSHActor:  fakeSSHActor,
+			SSHClient: fakeSSHClient,
+			Progress:  fakeProgress,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v7action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			},
+			v7action.Warnings{"some-warnings"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("neither path is in the app", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SCPArgs{Source: "./some-file", Destination: "/tmp/some-file"}
+		})
+
+		It("returns an IncorrectUsageError before checking the target", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{
+				Message: "exactly one of SOURCE and DESTINATION must be an app path in the form APP_NAME:PATH",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("both paths are in the app", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SCPArgs{Source: "some-app:a", Destination: "some-app:b"}
+		})
+
+		It("returns an IncorrectUsageError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(translatableerror.IncorrectUsageError{}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "steve"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "steve"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the destination is in the app", func() {
+		BeforeEach(func() {
+			cmd.Recursive = true
+		})
+
+		It("uploads the local path to the app instance", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Copying \./some-file to /tmp/some-file on instance 1 of some-process-type process of app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("some-warnings"))
+
+			appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appNameArg).To(Equal("some-app"))
+			Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+			Expect(processTypeArg).To(Equal("some-process-type"))
+			Expect(processIndexArg).To(Equal(uint(1)))
+
+			Expect(fakeSSHActor.ExecuteSecureCopyCallCount()).To(Equal(1))
+			sshClientArg, scpOptionsArg := fakeSSHActor.ExecuteSecureCopyArgsForCall(0)
+			Expect(sshClientArg).To(Equal(fakeSSHClient))
+			Expect(scpOptionsArg).To(Equal(sharedaction.SCPOptions{
+				Direction:          sharedaction.SCPUpload,
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				LocalPath:          "./some-file",
+				Passcode:           "some-passcode",
+				Progress:           fakeProgress,
+				Recursive:          true,
+				RemotePath:         "/tmp/some-file",
+				SkipHostValidation: true,
+				Username:           "some-username",
+			}))
+		})
+	})
+
+	When("the source is in the app", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SCPArgs{Source: "some-app:", Destination: "some-dir"}
+		})
+
+		It("downloads the home directory of the app instance", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Copying \. from instance 1 of some-process-type process of app some-app to some-dir in org some-org / space some-space as steve\.\.\.`))
+
+			_, scpOptionsArg := fakeSSHActor.ExecuteSecureCopyArgsForCall(0)
+			Expect(scpOptionsArg.Direction).To(Equal(sharedaction.SCPDownload))
+			Expect(scpOptionsArg.RemotePath).To(Equal("."))
+			Expect(scpOptionsArg.LocalPath).To(Equal("some-dir"))
+		})
+	})
+
+	When("getting the secure shell configuration fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v7action.SSHAuthentication{}, v7action.Warnings{"some-warnings"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSSHActor.ExecuteSecureCopyCallCount()).To(Equal(0))
+		})
+	})
+
+	When("copying fails", func() {
+		BeforeEach(func() {
+			fakeSSHActor.ExecuteSecureCopyReturns(errors.New("scp: no such file or directory"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("scp: no such file or directory"))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -81,6 +82,10 @@ func (cmd ServiceCommand) fetchAndDisplayDetails() error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.ServiceInstanceKind, shared.NewServiceInstanceOutput(serviceInstanceWithDetails))
+	}
+
 	switch {
 	case serviceInstanceWithDetails.Type == resources.UserProvidedServiceInstance:
 		cmd.displayPropertiesUserProvided(serviceInstanceWithDetails)
@@ -103,6 +108,10 @@ func (cmd ServiceCommand) displayIntro() error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return nil
+	}
+
 	cmd.UI.DisplayTextWithFlavor(
 		"Showing info of service {{.ServiceInstanceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
 		map[string]interface{}{
@@ -832,4 +832,52 @@ var _ = Describe("service command", func() {
 			Expect(executeErr).To(MatchError("explode"))
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetServiceInstanceDetailsReturns(
+				v7action.ServiceInstanceDetails{
+					ServiceInstance: resources.ServiceInstance{
+						GUID: serviceInstanceGUID,
+						Name: serviceInstanceName,
+						Type: resources.ManagedServiceInstance,
+						Tags: types.NewOptionalStringSlice("foo", "bar"),
+						LastOperation: resources.LastOperation{
+							Type:  resources.CreateOperation,
+							State: resources.OperationSucceeded,
+						},
+					},
+					ServiceOffering:   resources.ServiceOffering{Name: "some-offering"},
+					ServicePlan:       resources.ServicePlan{Name: "some-plan"},
+					ServiceBrokerName: "some-broker",
+					UpgradeStatus: v7action.ServiceInstanceUpgradeStatus{
+						State: v7action.ServiceInstanceUpgradeAvailable,
+					},
+					BoundApps: []resources.ServiceCredentialBinding{
+						{Name: "some-binding", AppName: "some-app"},
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the service instance details as a versioned document", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Showing info of service"))
+			Expect(testUI.Out).To(Say(`"kind": "service_instance"`))
+			Expect(testUI.Out).To(Say(`"name": "%s"`, serviceInstanceName))
+			Expect(testUI.Out).To(Say(`"guid": "%s"`, serviceInstanceGUID))
+			Expect(testUI.Out).To(Say(`"broker": "some-broker"`))
+			Expect(testUI.Out).To(Say(`"offering": "some-offering"`))
+			Expect(testUI.Out).To(Say(`"plan": "some-plan"`))
+			Expect(testUI.Out).To(Say(`"state": "succeeded"`))
+			Expect(testUI.Out).To(Say(`"app_name": "some-app"`))
+			Expect(testUI.Out).To(Say(`"binding_name": "some-binding"`))
+			Expect(testUI.Out).To(Say(`"state": "available"`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -32,6 +33,10 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.ServiceInstanceListKind, shared.NewServiceInstanceListOutput(instances))
+	}
+
 	cmd.displayTable(instances)
 	return nil
 }
@@ -46,6 +51,10 @@ func (cmd ServicesCommand) displayMessage() error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return nil
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Getting service instances in org {{.OrgName}} / space {{.SpaceName}} as {{.UserName}}...", map[string]interface{}{
 		"OrgName":   cmd.Config.TargetedOrganization().Name,
 		"SpaceName": cmd.Config.TargetedSpace().Name,
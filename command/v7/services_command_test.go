@@ -213,4 +213,27 @@ var _ = Describe("services command", func() {
 			Expect(executeErr).To(MatchError("a bad thing happened"))
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		})
+
+		It("renders the service instances as a versioned document", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).NotTo(Say("Getting service instances"))
+			Expect(testUI.Out).To(Say(`"kind": "service_instance_list"`))
+			Expect(testUI.Out).To(Say(`"name": "msi1"`))
+			Expect(testUI.Out).To(Say(`"type": "managed"`))
+			Expect(testUI.Out).To(Say(`"offering": "fake-offering-1"`))
+			Expect(testUI.Out).To(Say(`"plan": "fake-plan-1"`))
+			Expect(testUI.Out).To(Say(`"broker": "fake-broker-1"`))
+			Expect(testUI.Out).To(Say(`"last_operation": "create succeeded"`))
+			Expect(testUI.Out).To(Say(`"upgrade_available": true`))
+			Expect(testUI.Out).To(Say(`"name": "upsi1"`))
+			Expect(testUI.Out).To(Say(`"type": "user-provided"`))
+			Expect(testUI.Err).To(Say("something silly"))
+		})
+	})
 })
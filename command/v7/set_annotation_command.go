@@ -0,0 +1,129 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . AnnotationSetter
+
+type AnnotationSetter interface {
+	Execute(resource TargetResource, annotations map[string]types.NullString) error
+}
+
+type SetAnnotationCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SetAnnotationArgs `positional-args:"yes"`
+	FromFile        []string               `long:"from-file" description:"Read the value of an annotation from a file, given as KEY=PATH. Can be specified multiple times."`
+	relatedCommands interface{}            `related_commands:"annotations, unset-annotation"`
+	BuildpackStack  string                 `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	ServiceBroker   string                 `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
+	ServiceOffering string                 `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+
+	AnnotationSetter AnnotationSetter
+}
+
+func (cmd *SetAnnotationCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.AnnotationSetter = &LabelUpdater{
+		UI:          ui,
+		Config:      config,
+		SharedActor: cmd.SharedActor,
+		Actor:       cmd.Actor,
+		Action:      Set,
+		Field:       AnnotationsField,
+	}
+	return nil
+}
+
+func (cmd SetAnnotationCommand) Execute(args []string) error {
+	if len(cmd.RequiredArgs.Annotations) == 0 && len(cmd.FromFile) == 0 {
+		return translatableerror.RequiredArgumentError{ArgumentName: "KEY=VALUE"}
+	}
+
+	targetResource := TargetResource{
+		ResourceType:    cmd.RequiredArgs.ResourceType,
+		ResourceName:    cmd.RequiredArgs.ResourceName,
+		BuildpackStack:  cmd.BuildpackStack,
+		ServiceBroker:   cmd.ServiceBroker,
+		ServiceOffering: cmd.ServiceOffering,
+	}
+
+	annotations := make(map[string]types.NullString)
+	for _, annotation := range cmd.RequiredArgs.Annotations {
+		parts := strings.SplitN(annotation, "=", 2)
+		if len(parts) < 2 {
+			return fmt.Errorf("Metadata error: no value provided for annotation '%s'", annotation)
+		}
+		annotations[parts[0]] = types.NewNullString(parts[1])
+	}
+
+	for _, annotation := range cmd.FromFile {
+		parts := strings.SplitN(annotation, "=", 2)
+		if len(parts) < 2 || parts[1] == "" {
+			return fmt.Errorf("Metadata error: no file provided for annotation '%s'", annotation)
+		}
+
+		value, err := readAnnotationValue(parts[1])
+		if err != nil {
+			return err
+		}
+		annotations[parts[0]] = types.NewNullString(value)
+	}
+
+	return cmd.AnnotationSetter.Execute(targetResource, annotations)
+}
+
+func (cmd SetAnnotationCommand) Usage() string {
+	return `CF_NAME set-annotation RESOURCE RESOURCE_NAME KEY=VALUE... [--from-file KEY=PATH]...`
+}
+
+func (cmd SetAnnotationCommand) Examples() string {
+	return `
+cf set-annotation app dora contact=team@example.com
+cf set-annotation org business owner="Payments team" cost-center=1234
+cf set-annotation app dora --from-file runbook=./RUNBOOK.md
+cf set-annotation buildpack go_buildpack maintainer=platform -s cflinuxfs3`
+}
+
+func (cmd SetAnnotationCommand) Resources() string {
+	return `
+app
+buildpack
+domain
+org
+route
+service-broker
+service-instance
+service-offering
+service-plan
+space
+stack`
+}
+
+// readAnnotationValue returns the contents of the file at path, dropping the
+// single trailing newline most editors add so that the stored value matches
+// what was written.
+func readAnnotationValue(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", translatableerror.FileNotFoundError{Path: path}
+		}
+		return "", err
+	}
+
+	value := strings.TrimSuffix(string(contents), "\n")
+	return strings.TrimSuffix(value, "\r"), nil
+}
@@ -0,0 +1,125 @@
+package v7_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("set-annotation command", func() {
+	var (
+		cmd                  SetAnnotationCommand
+		fakeAnnotationSetter *v7fakes.FakeAnnotationSetter
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeAnnotationSetter = new(v7fakes.FakeAnnotationSetter)
+		cmd = SetAnnotationCommand{
+			AnnotationSetter: fakeAnnotationSetter,
+		}
+		cmd.RequiredArgs = flag.SetAnnotationArgs{
+			ResourceType: "app",
+			ResourceName: "dora",
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no annotations are provided", func() {
+		It("complains about the missing argument", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "KEY=VALUE"}))
+			Expect(fakeAnnotationSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("some provided annotations do not have a value part", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Annotations = []string{"FOO=BAR", "MISSING_EQUALS"}
+		})
+
+		It("complains about the missing equal sign", func() {
+			Expect(executeErr).To(MatchError("Metadata error: no value provided for annotation 'MISSING_EQUALS'"))
+		})
+	})
+
+	When("all the provided annotations are valid", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Annotations = []string{"FOO=BAZ", "FOO=BAR", "QUERY=a=b&c=d", "EMPTY="}
+			cmd.BuildpackStack = "some-stack"
+			cmd.ServiceBroker = "some-service-broker"
+			cmd.ServiceOffering = "some-service-offering"
+		})
+
+		It("calls execute with the right parameters", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeAnnotationSetter.ExecuteCallCount()).To(Equal(1))
+			targetResource, annotations := fakeAnnotationSetter.ExecuteArgsForCall(0)
+			Expect(targetResource).To(Equal(TargetResource{
+				ResourceType:    "app",
+				ResourceName:    "dora",
+				BuildpackStack:  "some-stack",
+				ServiceBroker:   "some-service-broker",
+				ServiceOffering: "some-service-offering",
+			}))
+			Expect(annotations).To(Equal(map[string]types.NullString{
+				"FOO":   types.NewNullString("BAR"),
+				"QUERY": types.NewNullString("a=b&c=d"),
+				"EMPTY": types.NewNullString(""),
+			}))
+		})
+	})
+
+	When("annotations are read from files", func() {
+		var tempDir string
+
+		BeforeEach(func() {
+			tempDir = GinkgoT().TempDir()
+			runbook := filepath.Join(tempDir, "runbook.md")
+			Expect(os.WriteFile(runbook, []byte("# Runbook\n\nRestart it.\n"), 0600)).To(Succeed())
+
+			cmd.RequiredArgs.Annotations = []string{"contact=team@example.com", "runbook=inline"}
+			cmd.FromFile = []string{"runbook=" + runbook}
+		})
+
+		It("uses the file contents without the trailing newline, overriding inline values", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			_, annotations := fakeAnnotationSetter.ExecuteArgsForCall(0)
+			Expect(annotations).To(Equal(map[string]types.NullString{
+				"contact": types.NewNullString("team@example.com"),
+				"runbook": types.NewNullString("# Runbook\n\nRestart it."),
+			}))
+		})
+
+		When("the file does not exist", func() {
+			BeforeEach(func() {
+				cmd.FromFile = []string{"runbook=" + filepath.Join(tempDir, "missing.md")}
+			})
+
+			It("returns a file not found error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.FileNotFoundError{Path: filepath.Join(tempDir, "missing.md")}))
+				Expect(fakeAnnotationSetter.ExecuteCallCount()).To(Equal(0))
+			})
+		})
+
+		When("no path is given", func() {
+			BeforeEach(func() {
+				cmd.FromFile = []string{"runbook"}
+			})
+
+			It("complains about the missing path", func() {
+				Expect(executeErr).To(MatchError("Metadata error: no file provided for annotation 'runbook'"))
+			})
+		})
+	})
+})
@@ -2,6 +2,8 @@ package v7
 
 import (
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type SetHealthCheckCommand struct {
@@ -10,11 +12,20 @@ type SetHealthCheckCommand struct {
 	RequiredArgs      flag.SetHealthCheckArgs `positional-args:"yes"`
 	HTTPEndpoint      string                  `long:"endpoint" default:"/" description:"Path on the app"`
 	InvocationTimeout flag.PositiveInteger    `long:"invocation-timeout" description:"Time (in seconds) that controls individual health check invocations"`
+	Interval          flag.PositiveInteger    `long:"interval" description:"Time (in seconds) between readiness health check invocations, requires --readiness"`
 	ProcessType       string                  `long:"process" default:"web" description:"App process to update"`
-	usage             interface{}             `usage:"CF_NAME set-health-check APP_NAME (process | port | http [--endpoint PATH]) [--process PROCESS] [--invocation-timeout INVOCATION_TIMEOUT]\n\nEXAMPLES:\n   cf set-health-check worker-app process --process worker\n   cf set-health-check my-web-app http --endpoint /foo\n   cf set-health-check my-web-app http --invocation-timeout 10"`
+	Readiness         bool                    `long:"readiness" description:"Set the readiness health check, which decides whether an instance receives traffic, instead of the liveness health check"`
+	usage             interface{}             `usage:"CF_NAME set-health-check APP_NAME (process | port | http [--endpoint PATH]) [--process PROCESS] [--invocation-timeout INVOCATION_TIMEOUT]\n   CF_NAME set-health-check APP_NAME (process | port | http [--endpoint PATH]) --readiness [--process PROCESS] [--invocation-timeout INVOCATION_TIMEOUT] [--interval INTERVAL]\n\nEXAMPLES:\n   cf set-health-check worker-app process --process worker\n   cf set-health-check my-web-app http --endpoint /foo\n   cf set-health-check my-web-app http --invocation-timeout 10\n   cf set-health-check my-web-app http --endpoint /ready --readiness --interval 5"`
 }
 
 func (cmd SetHealthCheckCommand) Execute(args []string) error {
+	if cmd.Interval.Value != 0 && !cmd.Readiness {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--interval",
+			Arg2: "--readiness",
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -25,7 +36,11 @@ func (cmd SetHealthCheckCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Updating health check type for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+	message := "Updating health check type for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	if cmd.Readiness {
+		message = "Updating readiness health check type for app {{.AppName}} process {{.ProcessType}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+	}
+	cmd.UI.DisplayTextWithFlavor(message, map[string]interface{}{
 		"AppName":     cmd.RequiredArgs.AppName,
 		"ProcessType": cmd.ProcessType,
 		"OrgName":     cmd.Config.TargetedOrganization().Name,
@@ -34,6 +49,26 @@ func (cmd SetHealthCheckCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
+	var app resources.Application
+	if cmd.Readiness {
+		app, err = cmd.setReadinessHealthCheck()
+	} else {
+		app, err = cmd.setHealthCheck()
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	if app.Started() {
+		cmd.UI.DisplayText("TIP: An app restart is required for the change to take effect.")
+	}
+
+	return nil
+}
+
+func (cmd SetHealthCheckCommand) setHealthCheck() (resources.Application, error) {
 	app, warnings, err := cmd.Actor.SetApplicationProcessHealthCheckTypeByNameAndSpace(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -42,17 +77,22 @@ func (cmd SetHealthCheckCommand) Execute(args []string) error {
 		cmd.ProcessType,
 		cmd.InvocationTimeout.Value,
 	)
-
 	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return err
-	}
 
-	cmd.UI.DisplayOK()
+	return app, err
+}
 
-	if app.Started() {
-		cmd.UI.DisplayText("TIP: An app restart is required for the change to take effect.")
-	}
+func (cmd SetHealthCheckCommand) setReadinessHealthCheck() (resources.Application, error) {
+	app, warnings, err := cmd.Actor.SetApplicationProcessReadinessHealthCheckByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.RequiredArgs.HealthCheck.Type,
+		cmd.HTTPEndpoint,
+		cmd.ProcessType,
+		cmd.InvocationTimeout.Value,
+		cmd.Interval.Value,
+	)
+	cmd.UI.DisplayWarnings(warnings)
 
-	return nil
+	return app, err
 }
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -148,6 +149,55 @@ var _ = Describe("set-health-check Command", func() {
 		})
 	})
 
+	When("--interval is given without --readiness", func() {
+		BeforeEach(func() {
+			cmd.Interval = flag.PositiveInteger{Value: 5}
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--interval",
+				Arg2: "--readiness",
+			}))
+			Expect(fakeActor.SetApplicationProcessHealthCheckTypeByNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--readiness is given", func() {
+		BeforeEach(func() {
+			cmd.Readiness = true
+			cmd.Interval = flag.PositiveInteger{Value: 5}
+			fakeActor.SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturns(
+				resources.Application{
+					State: constant.ApplicationStarted,
+				},
+				v7action.Warnings{"warning-1", "warning-2"},
+				nil)
+		})
+
+		It("sets the readiness health check instead of the health check", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Updating readiness health check type for app some-app process some-process-type in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`OK`))
+			Expect(testUI.Out).To(Say(`TIP: An app restart is required for the change to take effect\.`))
+
+			Expect(fakeActor.SetApplicationProcessHealthCheckTypeByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeActor.SetApplicationProcessReadinessHealthCheckByNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID, healthCheckType, httpEndpoint, processType, invocationTimeout, interval := fakeActor.SetApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(healthCheckType).To(Equal(constant.HealthCheckType("some-health-check-type")))
+			Expect(httpEndpoint).To(Equal("some-http-endpoint"))
+			Expect(processType).To(Equal("some-process-type"))
+			Expect(invocationTimeout).To(BeEquivalentTo(42))
+			Expect(interval).To(BeEquivalentTo(5))
+
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("warning-2"))
+		})
+	})
+
 	When("app is not started", func() {
 		BeforeEach(func() {
 			fakeActor.SetApplicationProcessHealthCheckTypeByNameAndSpaceReturns(
@@ -56,13 +56,13 @@ type Stager struct {
 }
 
 type stagingAndStartActor interface {
-	CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string) (string, v7action.Warnings, error)
-	CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, v7action.Warnings, error)
+	CreateDeploymentByApplicationAndDroplet(appGUID string, dropletGUID string, strategy constant.DeploymentStrategy) (string, v7action.Warnings, error)
+	CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string, strategy constant.DeploymentStrategy) (string, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	PollStart(app resources.Application, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
-	PollStartForRolling(app resources.Application, deploymentGUID string, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
+	PollStartForDeployment(app resources.Application, deploymentGUID string, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
 	SetApplicationDroplet(appGUID string, dropletGUID string) (v7action.Warnings, error)
 	StagePackage(packageGUID, appName, spaceGUID string) (<-chan resources.Droplet, <-chan v7action.Warnings, <-chan error)
 	StartApplication(appGUID string) (v7action.Warnings, error)
@@ -135,7 +135,7 @@ func (stager *Stager) StartApp(
 	organization configv3.Organization,
 	appAction constant.ApplicationAction,
 ) error {
-	if strategy == constant.DeploymentStrategyRolling {
+	if strategy != constant.DeploymentStrategyDefault {
 		stager.UI.DisplayText("Creating deployment for app {{.AppName}}...\n",
 			map[string]interface{}{
 				"AppName": app.Name,
@@ -150,9 +150,9 @@ func (stager *Stager) StartApp(
 
 		switch appAction {
 		case constant.ApplicationRollingBack:
-			deploymentGUID, warnings, err = stager.Actor.CreateDeploymentByApplicationAndRevision(app.GUID, resourceGuid)
+			deploymentGUID, warnings, err = stager.Actor.CreateDeploymentByApplicationAndRevision(app.GUID, resourceGuid, strategy)
 		default:
-			deploymentGUID, warnings, err = stager.Actor.CreateDeploymentByApplicationAndDroplet(app.GUID, resourceGuid)
+			deploymentGUID, warnings, err = stager.Actor.CreateDeploymentByApplicationAndDroplet(app.GUID, resourceGuid, strategy)
 		}
 
 		stager.UI.DisplayWarnings(warnings)
@@ -166,13 +166,17 @@ func (stager *Stager) StartApp(
 			stager.UI.DisplayText(instanceDetails)
 		}
 
-		warnings, err = stager.Actor.PollStartForRolling(app, deploymentGUID, noWait, handleInstanceDetails)
+		warnings, err = stager.Actor.PollStartForDeployment(app, deploymentGUID, noWait, handleInstanceDetails)
 		stager.UI.DisplayNewline()
 		stager.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return err
 		}
 		if noWait == true {
+			if strategy == constant.DeploymentStrategyCanary {
+				DisplayBackgroundCanaryDeployment(stager.UI, app.Name)
+				return nil
+			}
 			stager.UI.DisplayText("First instance restaged correctly, restaging remaining in the background")
 			return nil
 		}
@@ -258,5 +262,34 @@ func (stager *Stager) StartApp(
 	appSummaryDisplayer := NewAppSummaryDisplayer(stager.UI)
 	appSummaryDisplayer.AppDisplay(summary, false)
 
+	if strategy == constant.DeploymentStrategyCanary {
+		DisplayPausedDeployment(stager.UI, app.Name)
+	}
+
 	return nil
 }
+
+// DisplayPausedDeployment tells the user that a canary deployment is waiting
+// to be continued or canceled.
+func DisplayPausedDeployment(ui command.UI, appName string) {
+	ui.DisplayNewline()
+	ui.DisplayText("Canary deployment for app {{.AppName}} is paused.", map[string]interface{}{
+		"AppName": appName,
+	})
+	displayCanaryTip(ui, appName)
+}
+
+// DisplayBackgroundCanaryDeployment tells the user that a canary deployment
+// started with --no-wait pauses in the background, and how to continue it.
+func DisplayBackgroundCanaryDeployment(ui command.UI, appName string) {
+	ui.DisplayText("Canary instance for app {{.AppName}} started, the deployment will pause in the background once the canary is running.", map[string]interface{}{
+		"AppName": appName,
+	})
+	displayCanaryTip(ui, appName)
+}
+
+func displayCanaryTip(ui command.UI, appName string) {
+	ui.DisplayText("TIP: Run 'cf continue-deployment {{.AppName}}' to promote the canary deployment, or 'cf cancel-deployment {{.AppName}}' to roll back to the previous version.", map[string]interface{}{
+		"AppName": appName,
+	})
+}
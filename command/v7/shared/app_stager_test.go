@@ -383,7 +383,7 @@ var _ = Describe("app stager", func() {
 					nil,
 				)
 
-				fakeActor.PollStartForRollingReturns(
+				fakeActor.PollStartForDeploymentReturns(
 					v7action.Warnings{"poll-start-warning"},
 					nil,
 				)
@@ -405,13 +405,14 @@ var _ = Describe("app stager", func() {
 
 					Expect(testUI.Out).To(Say("Creating deployment for app %s...", app.Name))
 					Expect(fakeActor.CreateDeploymentByApplicationAndRevisionCallCount()).To(Equal(1), "CreateDeployment...")
-					appGUID, revisionGUID := fakeActor.CreateDeploymentByApplicationAndRevisionArgsForCall(0)
+					appGUID, revisionGUID, givenStrategy := fakeActor.CreateDeploymentByApplicationAndRevisionArgsForCall(0)
 					Expect(appGUID).To(Equal(app.GUID))
 					Expect(revisionGUID).To(Equal("revision-guid"))
+					Expect(givenStrategy).To(Equal(constant.DeploymentStrategyRolling))
 					Expect(testUI.Err).To(Say("create-deployment-warning"))
 
 					Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
-					Expect(fakeActor.PollStartForRollingCallCount()).To(Equal(1))
+					Expect(fakeActor.PollStartForDeploymentCallCount()).To(Equal(1))
 					Expect(testUI.Err).To(Say("poll-start-warning"))
 				})
 			})
@@ -422,15 +423,20 @@ var _ = Describe("app stager", func() {
 
 					Expect(testUI.Out).To(Say("Creating deployment for app %s...", app.Name))
 					Expect(fakeActor.CreateDeploymentByApplicationAndDropletCallCount()).To(Equal(1))
-					appGUID, dropletGUID := fakeActor.CreateDeploymentByApplicationAndDropletArgsForCall(0)
+					appGUID, dropletGUID, givenStrategy := fakeActor.CreateDeploymentByApplicationAndDropletArgsForCall(0)
 					Expect(appGUID).To(Equal(app.GUID))
 					Expect(dropletGUID).To(Equal("droplet-guid"))
+					Expect(givenStrategy).To(Equal(constant.DeploymentStrategyRolling))
 					Expect(testUI.Err).To(Say("create-deployment-warning"))
 
 					Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
-					Expect(fakeActor.PollStartForRollingCallCount()).To(Equal(1))
+					Expect(fakeActor.PollStartForDeploymentCallCount()).To(Equal(1))
 					Expect(testUI.Err).To(Say("poll-start-warning"))
 				})
+
+				It("does not report a paused deployment", func() {
+					Expect(testUI.Out).NotTo(Say("Canary deployment for app %s is paused.", app.Name))
+				})
 			})
 
 			When("creating a deployment fails", func() {
@@ -449,7 +455,7 @@ var _ = Describe("app stager", func() {
 
 			When("polling fails for a rolling restage", func() {
 				BeforeEach(func() {
-					fakeActor.PollStartForRollingReturns(
+					fakeActor.PollStartForDeploymentReturns(
 						v7action.Warnings{"poll-start-warning"},
 						errors.New("poll-start-error"),
 					)
@@ -461,6 +467,61 @@ var _ = Describe("app stager", func() {
 			})
 		})
 
+		When("the deployment strategy is canary", func() {
+			BeforeEach(func() {
+				strategy = constant.DeploymentStrategyCanary
+				noWait = false
+				fakeActor.CreateDeploymentByApplicationAndDropletReturns(
+					"some-deployment-guid",
+					v7action.Warnings{"create-deployment-warning"},
+					nil,
+				)
+
+				fakeActor.PollStartForDeploymentReturns(
+					v7action.Warnings{"poll-start-warning"},
+					nil,
+				)
+			})
+
+			It("creates a canary deployment and waits for it to pause", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Creating deployment for app %s...", app.Name))
+				Expect(fakeActor.CreateDeploymentByApplicationAndDropletCallCount()).To(Equal(1))
+				appGUID, dropletGUID, givenStrategy := fakeActor.CreateDeploymentByApplicationAndDropletArgsForCall(0)
+				Expect(appGUID).To(Equal(app.GUID))
+				Expect(dropletGUID).To(Equal("droplet-guid"))
+				Expect(givenStrategy).To(Equal(constant.DeploymentStrategyCanary))
+
+				Expect(testUI.Out).To(Say("Waiting for app to deploy..."))
+				Expect(fakeActor.PollStartForDeploymentCallCount()).To(Equal(1))
+				_, deploymentGUID, _, _ := fakeActor.PollStartForDeploymentArgsForCall(0)
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+			})
+
+			It("reports that the deployment is paused", func() {
+				Expect(testUI.Out).To(Say("Canary deployment for app %s is paused.", app.Name))
+				Expect(testUI.Out).To(Say(`TIP: Run 'cf continue-deployment %s' to promote the canary deployment, or 'cf cancel-deployment %s' to roll back to the previous version.`, app.Name, app.Name))
+			})
+
+			When("the no-wait flag is provided", func() {
+				BeforeEach(func() {
+					noWait = true
+				})
+
+				It("does not report a paused deployment", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).NotTo(Say("Canary deployment for app %s is paused.", app.Name))
+				})
+
+				It("reports that the canary continues in the background", func() {
+					Expect(testUI.Out).NotTo(Say("First instance restaged correctly"))
+					Expect(testUI.Out).To(Say(`Canary instance for app %s started, the deployment will pause in the background once the canary is running\.`, app.Name))
+					Expect(testUI.Out).To(Say(`TIP: Run 'cf continue-deployment %s' to promote the canary deployment`, app.Name))
+				})
+			})
+		})
+
 		When("the deployment strategy is NOT rolling", func() {
 			BeforeEach(func() {
 				fakeActor.StopApplicationReturns(
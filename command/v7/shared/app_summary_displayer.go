@@ -43,6 +43,11 @@ func (display AppSummaryDisplayer) AppDisplay(summary v7action.DetailedApplicati
 			isoRow,
 		}
 	} else {
+		var lifecycleRow []string
+		if summary.LifecycleType == constant.AppLifecycleTypeCNB {
+			lifecycleRow = append(lifecycleRow, display.UI.TranslateText("lifecycle:"), string(summary.LifecycleType))
+		}
+
 		keyValueTable = [][]string{
 			{display.UI.TranslateText("name:"), summary.Application.Name},
 			{display.UI.TranslateText("requested state:"), strings.ToLower(string(summary.State))},
@@ -50,6 +55,7 @@ func (display AppSummaryDisplayer) AppDisplay(summary v7action.DetailedApplicati
 			{display.UI.TranslateText("routes:"), routeSummary(summary.Routes)},
 			{display.UI.TranslateText("last uploaded:"), display.getCreatedTime(summary)},
 			{display.UI.TranslateText("stack:"), summary.CurrentDroplet.Stack},
+			lifecycleRow,
 			{display.UI.TranslateText("buildpacks:"), ""},
 			isoRow,
 		}
@@ -57,7 +63,7 @@ func (display AppSummaryDisplayer) AppDisplay(summary v7action.DetailedApplicati
 
 	display.UI.DisplayKeyValueTable("", keyValueTable, 3)
 
-	if summary.LifecycleType == constant.AppLifecycleTypeBuildpack {
+	if summary.LifecycleType == constant.AppLifecycleTypeBuildpack || summary.LifecycleType == constant.AppLifecycleTypeCNB {
 		display.displayBuildpackTable(summary.CurrentDroplet.Buildpacks)
 	}
 
@@ -140,11 +146,21 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Detailed
 			processSidecars = append(processSidecars, sidecar.Name)
 		}
 
+		var readinessRow []string
+		if process.ReadinessHealthCheckType != "" {
+			readiness := string(process.ReadinessHealthCheckType)
+			if process.ReadinessHealthCheckType == constant.HTTP && process.ReadinessHealthCheckEndpoint != "" {
+				readiness += " " + process.ReadinessHealthCheckEndpoint
+			}
+			readinessRow = append(readinessRow, display.UI.TranslateText("readiness health check:"), readiness)
+		}
+
 		keyValueTable := [][]string{
 			{display.UI.TranslateText("type:"), process.Type},
 			{display.UI.TranslateText("sidecars:"), strings.Join(processSidecars, ", ")},
 			{display.UI.TranslateText("instances:"), fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.TotalInstanceCount())},
 			{display.UI.TranslateText("memory usage:"), fmt.Sprintf("%dM", process.MemoryInMB.Value)},
+			readinessRow,
 			startCommandRow,
 		}
 
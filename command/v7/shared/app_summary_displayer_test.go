@@ -447,6 +447,46 @@ var _ = Describe("app summary displayer", func() {
 			})
 		})
 
+		When("the processes have readiness health checks", func() {
+			BeforeEach(func() {
+				summary = v7action.DetailedApplicationSummary{
+					ApplicationSummary: v7action.ApplicationSummary{
+						Application: resources.Application{
+							GUID:  "some-app-guid",
+							State: constant.ApplicationStarted,
+						},
+						ProcessSummaries: v7action.ProcessSummaries{
+							{
+								Process: resources.Process{
+									Type:                         constant.ProcessTypeWeb,
+									MemoryInMB:                   types.NullUint64{Value: 32, IsSet: true},
+									ReadinessHealthCheckType:     constant.HTTP,
+									ReadinessHealthCheckEndpoint: "/ready",
+								},
+							},
+							{
+								Process: resources.Process{
+									Type:                     "worker",
+									MemoryInMB:               types.NullUint64{Value: 16, IsSet: true},
+									ReadinessHealthCheckType: constant.Process,
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("displays the readiness health check of each process", func() {
+				Expect(testUI.Out).To(Say(`type:\s+web`))
+				Expect(testUI.Out).To(Say(`memory usage:\s+32M`))
+				Expect(testUI.Out).To(Say(`readiness health check:\s+http /ready`))
+
+				Expect(testUI.Out).To(Say(`type:\s+worker`))
+				Expect(testUI.Out).To(Say(`memory usage:\s+16M`))
+				Expect(testUI.Out).To(Say(`readiness health check:\s+process`))
+			})
+		})
+
 		When("the app has sidecars", func() {
 			BeforeEach(func() {
 				summary = v7action.DetailedApplicationSummary{
@@ -690,6 +730,40 @@ var _ = Describe("app summary displayer", func() {
 				Expect(testUI.Out).To(Say(`ruby_buildpack\s+0.0.1\s+some-detect-output\s+ruby_buildpack_name\n`))
 				Expect(testUI.Out).To(Say(`some-buildpack`))
 			})
+
+			It("does not display the lifecycle", func() {
+				Expect(testUI.Out).ToNot(Say("lifecycle:"))
+			})
+		})
+
+		When("the application is a cnb app", func() {
+			BeforeEach(func() {
+				summary = v7action.DetailedApplicationSummary{
+					ApplicationSummary: v7action.ApplicationSummary{
+						Application: resources.Application{
+							LifecycleType: constant.AppLifecycleTypeCNB,
+						},
+					},
+					CurrentDroplet: resources.Droplet{
+						Stack: "cflinuxfs4",
+						Buildpacks: []resources.DropletBuildpack{
+							{
+								Name:          "docker://docker.io/paketobuildpacks/nodejs",
+								BuildpackName: "paketo-buildpacks/nodejs",
+								Version:       "1.2.3",
+							},
+						},
+					},
+				}
+			})
+
+			It("displays the lifecycle, stack and buildpacks", func() {
+				Expect(testUI.Out).To(Say(`stack:\s+cflinuxfs4\n`))
+				Expect(testUI.Out).To(Say(`lifecycle:\s+cnb\n`))
+				Expect(testUI.Out).To(Say(`buildpacks:\s+\n`))
+				Expect(testUI.Out).To(Say(`name\s+version\s+detect output\s+buildpack name\n`))
+				Expect(testUI.Out).To(Say(`docker://docker.io/paketobuildpacks/nodejs\s+1.2.3\s+paketo-buildpacks/nodejs\n`))
+			})
 		})
 	})
 })
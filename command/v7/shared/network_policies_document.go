@@ -0,0 +1,126 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/command/flag"
+	"gopkg.in/yaml.v2"
+)
+
+// NetworkPoliciesDocument is the declarative form of the network policies of
+// a space. It is written by export-network-policies and read back by
+// apply-network-policies. Source apps always belong to the space the document
+// is applied to, so the same document can be applied to a space of the same
+// shape in another org or foundation.
+type NetworkPoliciesDocument struct {
+	Policies []NetworkPolicyEntry `json:"policies" yaml:"policies"`
+}
+
+// NetworkPolicyEntry is a single policy of a NetworkPoliciesDocument. The
+// destination space and org are omitted when they are the ones the document
+// is applied to.
+type NetworkPolicyEntry struct {
+	Source           string `json:"source" yaml:"source"`
+	Destination      string `json:"destination" yaml:"destination"`
+	DestinationSpace string `json:"destination_space,omitempty" yaml:"destination_space,omitempty"`
+	DestinationOrg   string `json:"destination_org,omitempty" yaml:"destination_org,omitempty"`
+	Protocol         string `json:"protocol" yaml:"protocol"`
+	Ports            string `json:"ports" yaml:"ports"`
+}
+
+// NewNetworkPoliciesDocument builds the document for the policies of the
+// space named spaceName in the org named orgName.
+func NewNetworkPoliciesDocument(policies []cfnetworkingaction.Policy, spaceName string, orgName string) NetworkPoliciesDocument {
+	document := NetworkPoliciesDocument{Policies: []NetworkPolicyEntry{}}
+
+	for _, policy := range policies {
+		entry := NetworkPolicyEntry{
+			Source:      policy.SourceName,
+			Destination: policy.DestinationName,
+			Protocol:    policy.Protocol,
+			Ports:       FormatPortRange(policy.StartPort, policy.EndPort),
+		}
+
+		if policy.DestinationSpaceName != spaceName || policy.DestinationOrgName != orgName {
+			entry.DestinationSpace = policy.DestinationSpaceName
+		}
+		if policy.DestinationOrgName != orgName {
+			entry.DestinationOrg = policy.DestinationOrgName
+		}
+
+		document.Policies = append(document.Policies, entry)
+	}
+
+	return document
+}
+
+// ParseNetworkPoliciesDocument reads a document in either YAML or JSON.
+// Unknown keys are rejected so that a typo does not silently drop a policy.
+func ParseNetworkPoliciesDocument(raw []byte) (NetworkPoliciesDocument, error) {
+	var document NetworkPoliciesDocument
+	err := yaml.UnmarshalStrict(raw, &document)
+	return document, err
+}
+
+// ToPolicies returns the policies of the document as they apply to the space
+// named spaceName in the org named orgName. Like add-network-policy, the
+// protocol and ports default to tcp and 8080 when both are omitted.
+func (document NetworkPoliciesDocument) ToPolicies(spaceName string, orgName string) ([]cfnetworkingaction.Policy, error) {
+	var policies []cfnetworkingaction.Policy
+
+	for i, entry := range document.Policies {
+		position := i + 1
+
+		if entry.Source == "" || entry.Destination == "" {
+			return nil, fmt.Errorf("policy %d must have a source and a destination", position)
+		}
+		if entry.DestinationOrg != "" && entry.DestinationSpace == "" {
+			return nil, fmt.Errorf("policy %d has a destination_org without a destination_space", position)
+		}
+
+		protocol := flag.NetworkProtocol{Protocol: "tcp"}
+		port := flag.NetworkPort{StartPort: 8080, EndPort: 8080}
+		switch {
+		case entry.Protocol == "" && entry.Ports == "":
+		case entry.Protocol == "" || entry.Ports == "":
+			return nil, fmt.Errorf("policy %d must have both a protocol and ports, or neither", position)
+		default:
+			if err := protocol.UnmarshalFlag(entry.Protocol); err != nil {
+				return nil, fmt.Errorf("policy %d: %s", position, err)
+			}
+			if err := port.UnmarshalFlag(entry.Ports); err != nil {
+				return nil, fmt.Errorf("policy %d: %s", position, err)
+			}
+		}
+
+		policy := cfnetworkingaction.Policy{
+			SourceName:           entry.Source,
+			DestinationName:      entry.Destination,
+			Protocol:             protocol.Protocol,
+			StartPort:            port.StartPort,
+			EndPort:              port.EndPort,
+			DestinationSpaceName: spaceName,
+			DestinationOrgName:   orgName,
+		}
+		if entry.DestinationSpace != "" {
+			policy.DestinationSpaceName = entry.DestinationSpace
+		}
+		if entry.DestinationOrg != "" {
+			policy.DestinationOrgName = entry.DestinationOrg
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// FormatPortRange renders a port range the way network-policies displays it.
+func FormatPortRange(startPort int, endPort int) string {
+	if startPort == endPort {
+		return strconv.Itoa(startPort)
+	}
+	return fmt.Sprintf("%d-%d", startPort, endPort)
+}
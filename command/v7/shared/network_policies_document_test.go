@@ -0,0 +1,121 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NetworkPoliciesDocument", func() {
+	Describe("NewNetworkPoliciesDocument", func() {
+		It("only names the destination space and org when they differ from the exported ones", func() {
+			document := NewNetworkPoliciesDocument([]cfnetworkingaction.Policy{
+				{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8080, DestinationSpaceName: "dev", DestinationOrgName: "org"},
+				{SourceName: "frontend", DestinationName: "cache", Protocol: "udp", StartPort: 9000, EndPort: 9010, DestinationSpaceName: "shared", DestinationOrgName: "org"},
+				{SourceName: "frontend", DestinationName: "auth", Protocol: "tcp", StartPort: 443, EndPort: 443, DestinationSpaceName: "dev", DestinationOrgName: "platform"},
+			}, "dev", "org")
+
+			Expect(document.Policies).To(Equal([]NetworkPolicyEntry{
+				{Source: "frontend", Destination: "backend", Protocol: "tcp", Ports: "8080"},
+				{Source: "frontend", Destination: "cache", DestinationSpace: "shared", Protocol: "udp", Ports: "9000-9010"},
+				{Source: "frontend", Destination: "auth", DestinationSpace: "dev", DestinationOrg: "platform", Protocol: "tcp", Ports: "443"},
+			}))
+		})
+
+		It("has an empty list of policies when there are none", func() {
+			Expect(NewNetworkPoliciesDocument(nil, "dev", "org").Policies).To(BeEmpty())
+			Expect(NewNetworkPoliciesDocument(nil, "dev", "org").Policies).NotTo(BeNil())
+		})
+	})
+
+	Describe("ParseNetworkPoliciesDocument", func() {
+		It("reads YAML", func() {
+			document, err := ParseNetworkPoliciesDocument([]byte(`
+policies:
+- source: frontend
+  destination: backend
+  protocol: tcp
+  ports: 8080
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(document.Policies).To(Equal([]NetworkPolicyEntry{
+				{Source: "frontend", Destination: "backend", Protocol: "tcp", Ports: "8080"},
+			}))
+		})
+
+		It("reads JSON", func() {
+			document, err := ParseNetworkPoliciesDocument([]byte(`{"policies": [{"source": "frontend", "destination": "backend", "destination_space": "shared", "protocol": "tcp", "ports": "8080-8090"}]}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(document.Policies).To(Equal([]NetworkPolicyEntry{
+				{Source: "frontend", Destination: "backend", DestinationSpace: "shared", Protocol: "tcp", Ports: "8080-8090"},
+			}))
+		})
+
+		It("rejects unknown keys", func() {
+			_, err := ParseNetworkPoliciesDocument([]byte(`
+policies:
+- source: frontend
+  destinaton: backend
+`))
+			Expect(err).To(MatchError(ContainSubstring("destinaton")))
+		})
+	})
+
+	Describe("ToPolicies", func() {
+		var (
+			document NetworkPoliciesDocument
+			policies []cfnetworkingaction.Policy
+			err      error
+		)
+
+		JustBeforeEach(func() {
+			policies, err = document.ToPolicies("dev", "org")
+		})
+
+		When("the document is valid", func() {
+			BeforeEach(func() {
+				document = NetworkPoliciesDocument{Policies: []NetworkPolicyEntry{
+					{Source: "frontend", Destination: "backend", Protocol: "TCP", Ports: "8080-8090"},
+					{Source: "frontend", Destination: "cache", DestinationSpace: "shared"},
+					{Source: "frontend", Destination: "auth", DestinationSpace: "auth", DestinationOrg: "platform", Protocol: "udp", Ports: "53"},
+				}}
+			})
+
+			It("fills in the targeted space and org and the default protocol and ports", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(policies).To(Equal([]cfnetworkingaction.Policy{
+					{SourceName: "frontend", DestinationName: "backend", Protocol: "tcp", StartPort: 8080, EndPort: 8090, DestinationSpaceName: "dev", DestinationOrgName: "org"},
+					{SourceName: "frontend", DestinationName: "cache", Protocol: "tcp", StartPort: 8080, EndPort: 8080, DestinationSpaceName: "shared", DestinationOrgName: "org"},
+					{SourceName: "frontend", DestinationName: "auth", Protocol: "udp", StartPort: 53, EndPort: 53, DestinationSpaceName: "auth", DestinationOrgName: "platform"},
+				}))
+			})
+		})
+
+		DescribeTable("invalid policies",
+			func(entry NetworkPolicyEntry, message string) {
+				_, err := NetworkPoliciesDocument{Policies: []NetworkPolicyEntry{
+					{Source: "frontend", Destination: "backend"},
+					entry,
+				}}.ToPolicies("dev", "org")
+				Expect(err).To(MatchError(message))
+			},
+			Entry("missing source", NetworkPolicyEntry{Destination: "backend"}, "policy 2 must have a source and a destination"),
+			Entry("missing destination", NetworkPolicyEntry{Source: "frontend"}, "policy 2 must have a source and a destination"),
+			Entry("org without space", NetworkPolicyEntry{Source: "frontend", Destination: "backend", DestinationOrg: "platform"}, "policy 2 has a destination_org without a destination_space"),
+			Entry("protocol without ports", NetworkPolicyEntry{Source: "frontend", Destination: "backend", Protocol: "tcp"}, "policy 2 must have both a protocol and ports, or neither"),
+			Entry("unknown protocol", NetworkPolicyEntry{Source: "frontend", Destination: "backend", Protocol: "icmp", Ports: "8080"}, `policy 2: PROTOCOL must be "tcp" or "udp"`),
+			Entry("invalid ports", NetworkPolicyEntry{Source: "frontend", Destination: "backend", Protocol: "tcp", Ports: "http"}, "policy 2: PORT must be a positive integer"),
+		)
+	})
+
+	Describe("FormatPortRange", func() {
+		It("shows a single port once", func() {
+			Expect(FormatPortRange(8080, 8080)).To(Equal("8080"))
+		})
+
+		It("shows a range with a dash", func() {
+			Expect(FormatPortRange(8080, 8090)).To(Equal("8080-8090"))
+		})
+	})
+})
@@ -0,0 +1,590 @@
+package shared
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+// StructuredOutputSchemaVersion is the version of the documents rendered by
+// the '--output' global flag. It must be bumped whenever a field is removed,
+// renamed or changes meaning; adding fields does not require a new version.
+const StructuredOutputSchemaVersion = "1"
+
+const (
+	AppKind                 = "app"
+	AppListKind             = "app_list"
+	AuditEventListKind      = "audit_event_list"
+	BuildpackListKind       = "buildpack_list"
+	DropletListKind         = "droplet_list"
+	OrgListKind             = "org_list"
+	RouteListKind           = "route_list"
+	ServiceInstanceKind     = "service_instance"
+	ServiceInstanceListKind = "service_instance_list"
+	SidecarListKind         = "sidecar_list"
+	SpaceListKind           = "space_list"
+	StackListKind           = "stack_list"
+)
+
+// StructuredOutput is the envelope every structured document is wrapped in.
+type StructuredOutput struct {
+	SchemaVersion string      `json:"schema_version" yaml:"schema_version"`
+	Kind          string      `json:"kind" yaml:"kind"`
+	Data          interface{} `json:"data" yaml:"data"`
+}
+
+// IsStructuredOutput returns true when the user asked for a machine readable
+// document instead of the human readable tables.
+func IsStructuredOutput(config command.Config) bool {
+	return config.OutputFormat() != configv3.OutputFormatDefault
+}
+
+// DisplayStructuredOutput wraps data in a versioned envelope and renders it to
+// the UI in the requested format. Warnings are unaffected and continue to be
+// written to stderr.
+func DisplayStructuredOutput(ui command.UI, format configv3.OutputFormat, kind string, data interface{}) error {
+	document := StructuredOutput{
+		SchemaVersion: StructuredOutputSchemaVersion,
+		Kind:          kind,
+		Data:          data,
+	}
+
+	if format == configv3.OutputFormatYAML {
+		return ui.DisplayYAML(document)
+	}
+	return ui.DisplayJSON("", document)
+}
+
+type AppOutput struct {
+	Name           string          `json:"name" yaml:"name"`
+	GUID           string          `json:"guid" yaml:"guid"`
+	RequestedState string          `json:"requested_state" yaml:"requested_state"`
+	LifecycleType  string          `json:"lifecycle_type" yaml:"lifecycle_type"`
+	Stack          string          `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Buildpacks     []string        `json:"buildpacks" yaml:"buildpacks"`
+	Routes         []string        `json:"routes" yaml:"routes"`
+	Processes      []ProcessOutput `json:"processes" yaml:"processes"`
+}
+
+type DetailedAppOutput struct {
+	AppOutput        `yaml:",inline"`
+	IsolationSegment string         `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	CurrentDroplet   *DropletOutput `json:"current_droplet,omitempty" yaml:"current_droplet,omitempty"`
+}
+
+type ProcessOutput struct {
+	Type                     string           `json:"type" yaml:"type"`
+	Instances                int              `json:"instances" yaml:"instances"`
+	RunningInstances         int              `json:"running_instances" yaml:"running_instances"`
+	MemoryInMB               uint64           `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB                 uint64           `json:"disk_in_mb" yaml:"disk_in_mb"`
+	LogRateLimitInBPS        int              `json:"log_rate_limit_in_bytes_per_second" yaml:"log_rate_limit_in_bytes_per_second"`
+	HealthCheckType          string           `json:"health_check_type" yaml:"health_check_type"`
+	ReadinessHealthCheckType string           `json:"readiness_health_check_type,omitempty" yaml:"readiness_health_check_type,omitempty"`
+	Sidecars                 []string         `json:"sidecars" yaml:"sidecars"`
+	InstanceDetails          []InstanceOutput `json:"instance_details" yaml:"instance_details"`
+}
+
+type InstanceOutput struct {
+	Index            int64   `json:"index" yaml:"index"`
+	State            string  `json:"state" yaml:"state"`
+	UptimeInSeconds  int64   `json:"uptime_in_seconds" yaml:"uptime_in_seconds"`
+	CPUEntitlement   float64 `json:"cpu_entitlement" yaml:"cpu_entitlement"`
+	MemoryUsage      uint64  `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota      uint64  `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage        uint64  `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota        uint64  `json:"disk_quota" yaml:"disk_quota"`
+	LogRate          uint64  `json:"log_rate" yaml:"log_rate"`
+	LogRateLimit     int64   `json:"log_rate_limit" yaml:"log_rate_limit"`
+	IsolationSegment string  `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	Details          string  `json:"details,omitempty" yaml:"details,omitempty"`
+}
+
+type AuditEventOutput struct {
+	GUID             string `json:"guid" yaml:"guid"`
+	CreatedAt        string `json:"created_at" yaml:"created_at"`
+	Type             string `json:"type" yaml:"type"`
+	ActorName        string `json:"actor_name" yaml:"actor_name"`
+	ActorType        string `json:"actor_type" yaml:"actor_type"`
+	TargetGUID       string `json:"target_guid" yaml:"target_guid"`
+	TargetType       string `json:"target_type" yaml:"target_type"`
+	TargetName       string `json:"target_name" yaml:"target_name"`
+	SpaceGUID        string `json:"space_guid,omitempty" yaml:"space_guid,omitempty"`
+	OrganizationGUID string `json:"organization_guid,omitempty" yaml:"organization_guid,omitempty"`
+	Description      string `json:"description" yaml:"description"`
+}
+
+type DropletOutput struct {
+	GUID       string                   `json:"guid" yaml:"guid"`
+	State      string                   `json:"state" yaml:"state"`
+	CreatedAt  string                   `json:"created_at" yaml:"created_at"`
+	Current    bool                     `json:"current" yaml:"current"`
+	Stack      string                   `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Image      string                   `json:"image,omitempty" yaml:"image,omitempty"`
+	Buildpacks []DropletBuildpackOutput `json:"buildpacks" yaml:"buildpacks"`
+}
+
+type DropletBuildpackOutput struct {
+	Name          string `json:"name" yaml:"name"`
+	BuildpackName string `json:"buildpack_name" yaml:"buildpack_name"`
+	Version       string `json:"version" yaml:"version"`
+	DetectOutput  string `json:"detect_output" yaml:"detect_output"`
+}
+
+type ServiceInstanceListItemOutput struct {
+	Name             string   `json:"name" yaml:"name"`
+	Type             string   `json:"type" yaml:"type"`
+	Offering         string   `json:"offering,omitempty" yaml:"offering,omitempty"`
+	Plan             string   `json:"plan,omitempty" yaml:"plan,omitempty"`
+	Broker           string   `json:"broker,omitempty" yaml:"broker,omitempty"`
+	BoundApps        []string `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation    string   `json:"last_operation" yaml:"last_operation"`
+	UpgradeAvailable *bool    `json:"upgrade_available,omitempty" yaml:"upgrade_available,omitempty"`
+}
+
+type ServiceInstanceOutput struct {
+	Name             string               `json:"name" yaml:"name"`
+	GUID             string               `json:"guid" yaml:"guid"`
+	Type             string               `json:"type" yaml:"type"`
+	Broker           string               `json:"broker,omitempty" yaml:"broker,omitempty"`
+	Offering         string               `json:"offering,omitempty" yaml:"offering,omitempty"`
+	Plan             string               `json:"plan,omitempty" yaml:"plan,omitempty"`
+	Description      string               `json:"description,omitempty" yaml:"description,omitempty"`
+	DocumentationURL string               `json:"documentation_url,omitempty" yaml:"documentation_url,omitempty"`
+	DashboardURL     string               `json:"dashboard_url,omitempty" yaml:"dashboard_url,omitempty"`
+	RouteServiceURL  string               `json:"route_service_url,omitempty" yaml:"route_service_url,omitempty"`
+	SyslogDrainURL   string               `json:"syslog_drain_url,omitempty" yaml:"syslog_drain_url,omitempty"`
+	Tags             []string             `json:"tags" yaml:"tags"`
+	OfferingTags     []string             `json:"offering_tags" yaml:"offering_tags"`
+	LastOperation    *LastOperationOutput `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+	BoundApps        []BoundAppOutput     `json:"bound_apps" yaml:"bound_apps"`
+	Sharing          *SharingOutput       `json:"sharing,omitempty" yaml:"sharing,omitempty"`
+	Upgrade          *UpgradeStatusOutput `json:"upgrade,omitempty" yaml:"upgrade,omitempty"`
+}
+
+type LastOperationOutput struct {
+	Type        string `json:"type" yaml:"type"`
+	State       string `json:"state" yaml:"state"`
+	Description string `json:"description" yaml:"description"`
+	CreatedAt   string `json:"created_at" yaml:"created_at"`
+	UpdatedAt   string `json:"updated_at" yaml:"updated_at"`
+}
+
+type BoundAppOutput struct {
+	AppName       string               `json:"app_name" yaml:"app_name"`
+	BindingName   string               `json:"binding_name" yaml:"binding_name"`
+	LastOperation *LastOperationOutput `json:"last_operation,omitempty" yaml:"last_operation,omitempty"`
+}
+
+type SharingOutput struct {
+	SharedFromSpace         string              `json:"shared_from_space,omitempty" yaml:"shared_from_space,omitempty"`
+	SharedFromOrg           string              `json:"shared_from_org,omitempty" yaml:"shared_from_org,omitempty"`
+	SharedWith              []SharedSpaceOutput `json:"shared_with" yaml:"shared_with"`
+	FeatureFlagDisabled     bool                `json:"feature_flag_disabled" yaml:"feature_flag_disabled"`
+	OfferingDisablesSharing bool                `json:"offering_disables_sharing" yaml:"offering_disables_sharing"`
+}
+
+type SharedSpaceOutput struct {
+	Org           string `json:"org" yaml:"org"`
+	Space         string `json:"space" yaml:"space"`
+	BoundAppCount int    `json:"bound_app_count" yaml:"bound_app_count"`
+}
+
+type UpgradeStatusOutput struct {
+	State       string `json:"state" yaml:"state"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+type RouteOutput struct {
+	GUID            string            `json:"guid" yaml:"guid"`
+	URL             string            `json:"url" yaml:"url"`
+	Space           string            `json:"space" yaml:"space"`
+	Host            string            `json:"host" yaml:"host"`
+	Domain          string            `json:"domain" yaml:"domain"`
+	Port            int               `json:"port,omitempty" yaml:"port,omitempty"`
+	Path            string            `json:"path" yaml:"path"`
+	Protocol        string            `json:"protocol" yaml:"protocol"`
+	AppProtocols    []string          `json:"app_protocols" yaml:"app_protocols"`
+	Apps            []string          `json:"apps" yaml:"apps"`
+	ServiceInstance string            `json:"service_instance,omitempty" yaml:"service_instance,omitempty"`
+	Options         map[string]string `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+type NamedResourceOutput struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
+type BuildpackOutput struct {
+	Position int    `json:"position" yaml:"position"`
+	Name     string `json:"name" yaml:"name"`
+	GUID     string `json:"guid" yaml:"guid"`
+	Stack    string `json:"stack" yaml:"stack"`
+	Enabled  bool   `json:"enabled" yaml:"enabled"`
+	Locked   bool   `json:"locked" yaml:"locked"`
+	State    string `json:"state" yaml:"state"`
+	Filename string `json:"filename" yaml:"filename"`
+}
+
+// SidecarOutput reports a null memory_in_mb when the sidecar shares the memory
+// of the processes it runs alongside.
+type SidecarOutput struct {
+	Name         string   `json:"name" yaml:"name"`
+	GUID         string   `json:"guid" yaml:"guid"`
+	Command      string   `json:"command" yaml:"command"`
+	ProcessTypes []string `json:"process_types" yaml:"process_types"`
+	MemoryInMB   *uint64  `json:"memory_in_mb" yaml:"memory_in_mb"`
+	Origin       string   `json:"origin" yaml:"origin"`
+}
+
+type StackOutput struct {
+	Name        string `json:"name" yaml:"name"`
+	GUID        string `json:"guid" yaml:"guid"`
+	Description string `json:"description" yaml:"description"`
+}
+
+func NewAppListOutput(summaries []v7action.ApplicationSummary) []AppOutput {
+	apps := make([]AppOutput, 0, len(summaries))
+	for _, summary := range summaries {
+		apps = append(apps, NewAppOutput(summary))
+	}
+	return apps
+}
+
+func NewAppOutput(summary v7action.ApplicationSummary) AppOutput {
+	app := AppOutput{
+		Name:           summary.Name,
+		GUID:           summary.GUID,
+		RequestedState: strings.ToLower(string(summary.State)),
+		LifecycleType:  string(summary.LifecycleType),
+		Stack:          summary.StackName,
+		Buildpacks:     emptyIfNil(summary.LifecycleBuildpacks),
+		Routes:         []string{},
+		Processes:      make([]ProcessOutput, 0, len(summary.ProcessSummaries)),
+	}
+
+	for _, route := range summary.Routes {
+		app.Routes = append(app.Routes, route.URL)
+	}
+
+	for _, processSummary := range summary.ProcessSummaries {
+		app.Processes = append(app.Processes, newProcessOutput(processSummary))
+	}
+
+	return app
+}
+
+func NewDetailedAppOutput(summary v7action.DetailedApplicationSummary) DetailedAppOutput {
+	app := DetailedAppOutput{
+		AppOutput: NewAppOutput(summary.ApplicationSummary),
+	}
+
+	if name, exists := summary.GetIsolationSegmentName(); exists {
+		app.IsolationSegment = name
+	}
+
+	if summary.CurrentDroplet.GUID != "" {
+		droplet := NewDropletOutput(summary.CurrentDroplet)
+		droplet.Current = true
+		app.CurrentDroplet = &droplet
+	}
+
+	return app
+}
+
+func newProcessOutput(summary v7action.ProcessSummary) ProcessOutput {
+	process := ProcessOutput{
+		Type:                     summary.Type,
+		Instances:                summary.Instances.Value,
+		RunningInstances:         summary.HealthyInstanceCount(),
+		MemoryInMB:               summary.MemoryInMB.Value,
+		DiskInMB:                 summary.DiskInMB.Value,
+		LogRateLimitInBPS:        summary.LogRateLimitInBPS.Value,
+		HealthCheckType:          string(summary.HealthCheckType),
+		ReadinessHealthCheckType: string(summary.ReadinessHealthCheckType),
+		Sidecars:                 make([]string, 0, len(summary.Sidecars)),
+		InstanceDetails:          make([]InstanceOutput, 0, len(summary.InstanceDetails)),
+	}
+
+	for _, sidecar := range summary.Sidecars {
+		process.Sidecars = append(process.Sidecars, sidecar.Name)
+	}
+
+	for _, instance := range summary.InstanceDetails {
+		process.InstanceDetails = append(process.InstanceDetails, InstanceOutput{
+			Index:            instance.Index,
+			State:            strings.ToLower(string(instance.State)),
+			UptimeInSeconds:  int64(instance.Uptime.Seconds()),
+			CPUEntitlement:   instance.CPUEntitlement.Value,
+			MemoryUsage:      instance.MemoryUsage,
+			MemoryQuota:      instance.MemoryQuota,
+			DiskUsage:        instance.DiskUsage,
+			DiskQuota:        instance.DiskQuota,
+			LogRate:          instance.LogRate,
+			LogRateLimit:     instance.LogRateLimit,
+			IsolationSegment: instance.IsolationSegment,
+			Details:          instance.Details,
+		})
+	}
+
+	return process
+}
+
+func NewAuditEventListOutput(events []v7action.AuditEvent) []AuditEventOutput {
+	output := make([]AuditEventOutput, 0, len(events))
+	for _, event := range events {
+		output = append(output, AuditEventOutput{
+			GUID:             event.GUID,
+			CreatedAt:        event.Time.UTC().Format(time.RFC3339),
+			Type:             event.Type,
+			ActorName:        event.ActorName,
+			ActorType:        event.ActorType,
+			TargetGUID:       event.TargetGUID,
+			TargetType:       event.TargetType,
+			TargetName:       event.TargetName,
+			SpaceGUID:        event.SpaceGUID,
+			OrganizationGUID: event.OrganizationGUID,
+			Description:      event.Description,
+		})
+	}
+	return output
+}
+
+func NewDropletListOutput(droplets []resources.Droplet) []DropletOutput {
+	output := make([]DropletOutput, 0, len(droplets))
+	for _, droplet := range droplets {
+		output = append(output, NewDropletOutput(droplet))
+	}
+	return output
+}
+
+func NewDropletOutput(droplet resources.Droplet) DropletOutput {
+	output := DropletOutput{
+		GUID:       droplet.GUID,
+		State:      strings.ToLower(string(droplet.State)),
+		CreatedAt:  droplet.CreatedAt,
+		Current:    droplet.IsCurrent,
+		Stack:      droplet.Stack,
+		Image:      droplet.Image,
+		Buildpacks: make([]DropletBuildpackOutput, 0, len(droplet.Buildpacks)),
+	}
+
+	for _, buildpack := range droplet.Buildpacks {
+		output.Buildpacks = append(output.Buildpacks, DropletBuildpackOutput{
+			Name:          buildpack.Name,
+			BuildpackName: buildpack.BuildpackName,
+			Version:       buildpack.Version,
+			DetectOutput:  buildpack.DetectOutput,
+		})
+	}
+
+	return output
+}
+
+func NewServiceInstanceListOutput(instances []v7action.ServiceInstance) []ServiceInstanceListItemOutput {
+	output := make([]ServiceInstanceListItemOutput, 0, len(instances))
+	for _, instance := range instances {
+		item := ServiceInstanceListItemOutput{
+			Name:          instance.Name,
+			Type:          string(instance.Type),
+			Offering:      instance.ServiceOfferingName,
+			Plan:          instance.ServicePlanName,
+			Broker:        instance.ServiceBrokerName,
+			BoundApps:     emptyIfNil(instance.BoundApps),
+			LastOperation: instance.LastOperation,
+		}
+		if instance.UpgradeAvailable.IsSet {
+			upgradeAvailable := instance.UpgradeAvailable.Value
+			item.UpgradeAvailable = &upgradeAvailable
+		}
+		output = append(output, item)
+	}
+	return output
+}
+
+func NewServiceInstanceOutput(details v7action.ServiceInstanceDetails) ServiceInstanceOutput {
+	output := ServiceInstanceOutput{
+		Name:            details.Name,
+		GUID:            details.GUID,
+		Type:            string(details.Type),
+		RouteServiceURL: details.RouteServiceURL.Value,
+		SyslogDrainURL:  details.SyslogDrainURL.Value,
+		DashboardURL:    details.DashboardURL.Value,
+		Tags:            emptyIfNil(details.Tags.Value),
+		OfferingTags:    emptyIfNil(details.ServiceOffering.Tags.Value),
+		LastOperation:   newLastOperationOutput(details.LastOperation),
+		BoundApps:       make([]BoundAppOutput, 0, len(details.BoundApps)),
+	}
+
+	for _, binding := range details.BoundApps {
+		output.BoundApps = append(output.BoundApps, BoundAppOutput{
+			AppName:       binding.AppName,
+			BindingName:   binding.Name,
+			LastOperation: newLastOperationOutput(binding.LastOperation),
+		})
+	}
+
+	if details.Type == resources.UserProvidedServiceInstance {
+		return output
+	}
+
+	output.Broker = details.ServiceBrokerName
+	output.Offering = details.ServiceOffering.Name
+	output.Plan = details.ServicePlan.Name
+	output.Description = details.ServiceOffering.Description
+	output.DocumentationURL = details.ServiceOffering.DocumentationURL
+
+	sharing := SharingOutput{
+		SharedWith:              []SharedSpaceOutput{},
+		FeatureFlagDisabled:     details.SharedStatus.FeatureFlagIsDisabled,
+		OfferingDisablesSharing: details.SharedStatus.OfferingDisablesSharing,
+	}
+	if details.SharedStatus.IsSharedFromOriginalSpace {
+		sharing.SharedFromSpace = details.SpaceName
+		sharing.SharedFromOrg = details.OrganizationName
+	}
+	for _, usage := range details.SharedStatus.UsageSummary {
+		sharing.SharedWith = append(sharing.SharedWith, SharedSpaceOutput{
+			Org:           usage.OrganizationName,
+			Space:         usage.SpaceName,
+			BoundAppCount: usage.BoundAppCount,
+		})
+	}
+	output.Sharing = &sharing
+
+	output.Upgrade = &UpgradeStatusOutput{Description: details.UpgradeStatus.Description}
+	switch details.UpgradeStatus.State {
+	case v7action.ServiceInstanceUpgradeAvailable:
+		output.Upgrade.State = "available"
+	case v7action.ServiceInstanceUpgradeNotAvailable:
+		output.Upgrade.State = "not_available"
+	default:
+		output.Upgrade.State = "not_supported"
+	}
+
+	return output
+}
+
+func newLastOperationOutput(lastOperation resources.LastOperation) *LastOperationOutput {
+	if lastOperation == (resources.LastOperation{}) {
+		return nil
+	}
+
+	return &LastOperationOutput{
+		Type:        string(lastOperation.Type),
+		State:       string(lastOperation.State),
+		Description: lastOperation.Description,
+		CreatedAt:   lastOperation.CreatedAt,
+		UpdatedAt:   lastOperation.UpdatedAt,
+	}
+}
+
+func NewRouteListOutput(routeSummaries []v7action.RouteSummary) []RouteOutput {
+	output := make([]RouteOutput, 0, len(routeSummaries))
+	for _, routeSummary := range routeSummaries {
+		output = append(output, RouteOutput{
+			GUID:            routeSummary.GUID,
+			URL:             routeSummary.URL,
+			Space:           routeSummary.SpaceName,
+			Host:            routeSummary.Host,
+			Domain:          routeSummary.DomainName,
+			Port:            routeSummary.Port,
+			Path:            routeSummary.Path,
+			Protocol:        routeSummary.Protocol,
+			AppProtocols:    emptyIfNil(routeSummary.AppProtocols),
+			Apps:            emptyIfNil(routeSummary.AppNames),
+			ServiceInstance: routeSummary.ServiceInstanceName,
+			Options:         routeOptionsOutput(routeSummary.Options),
+		})
+	}
+	return output
+}
+
+func routeOptionsOutput(options map[string]*string) map[string]string {
+	if len(options) == 0 {
+		return nil
+	}
+
+	output := map[string]string{}
+	for key, value := range options {
+		if value != nil {
+			output[key] = *value
+		}
+	}
+	return output
+}
+
+func NewSpaceListOutput(spaces []resources.Space) []NamedResourceOutput {
+	output := make([]NamedResourceOutput, 0, len(spaces))
+	for _, space := range spaces {
+		output = append(output, NamedResourceOutput{Name: space.Name, GUID: space.GUID})
+	}
+	return output
+}
+
+func NewOrgListOutput(orgs []resources.Organization) []NamedResourceOutput {
+	output := make([]NamedResourceOutput, 0, len(orgs))
+	for _, org := range orgs {
+		output = append(output, NamedResourceOutput{Name: org.Name, GUID: org.GUID})
+	}
+	return output
+}
+
+func NewBuildpackListOutput(buildpacks []resources.Buildpack) []BuildpackOutput {
+	output := make([]BuildpackOutput, 0, len(buildpacks))
+	for _, buildpack := range buildpacks {
+		output = append(output, BuildpackOutput{
+			Position: buildpack.Position.Value,
+			Name:     buildpack.Name,
+			GUID:     buildpack.GUID,
+			Stack:    buildpack.Stack,
+			Enabled:  buildpack.Enabled.Value,
+			Locked:   buildpack.Locked.Value,
+			State:    buildpack.State,
+			Filename: buildpack.Filename,
+		})
+	}
+	return output
+}
+
+func NewSidecarListOutput(sidecars []resources.Sidecar) []SidecarOutput {
+	output := make([]SidecarOutput, 0, len(sidecars))
+	for _, sidecar := range sidecars {
+		sidecarOutput := SidecarOutput{
+			Name:         sidecar.Name,
+			GUID:         sidecar.GUID,
+			Command:      sidecar.Command.Value,
+			ProcessTypes: emptyIfNil(sidecar.ProcessTypes),
+			Origin:       sidecar.Origin,
+		}
+		if sidecar.MemoryInMB.IsSet {
+			memory := sidecar.MemoryInMB.Value
+			sidecarOutput.MemoryInMB = &memory
+		}
+		output = append(output, sidecarOutput)
+	}
+	return output
+}
+
+func NewStackListOutput(stacks []resources.Stack) []StackOutput {
+	output := make([]StackOutput, 0, len(stacks))
+	for _, stack := range stacks {
+		output = append(output, StackOutput{
+			Name:        stack.Name,
+			GUID:        stack.GUID,
+			Description: stack.Description,
+		})
+	}
+	return output
+}
+
+func emptyIfNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
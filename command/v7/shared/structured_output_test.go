@@ -0,0 +1,310 @@
+package shared_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("structured output", func() {
+	Describe("IsStructuredOutput", func() {
+		var fakeConfig *commandfakes.FakeConfig
+
+		BeforeEach(func() {
+			fakeConfig = new(commandfakes.FakeConfig)
+		})
+
+		It("is false when no format is set", func() {
+			Expect(IsStructuredOutput(fakeConfig)).To(BeFalse())
+		})
+
+		It("is true when a format is set", func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			Expect(IsStructuredOutput(fakeConfig)).To(BeTrue())
+		})
+	})
+
+	Describe("DisplayStructuredOutput", func() {
+		var (
+			output *Buffer
+			testUI *ui.UI
+			format configv3.OutputFormat
+			err    error
+		)
+
+		BeforeEach(func() {
+			output = NewBuffer()
+			testUI = ui.NewTestUI(nil, output, NewBuffer())
+		})
+
+		JustBeforeEach(func() {
+			err = DisplayStructuredOutput(testUI, format, OrgListKind, []NamedResourceOutput{{Name: "some-org", GUID: "some-org-guid"}})
+		})
+
+		When("the format is json", func() {
+			BeforeEach(func() {
+				format = configv3.OutputFormatJSON
+			})
+
+			It("wraps the data in a versioned envelope", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				var document map[string]interface{}
+				Expect(json.Unmarshal(output.Contents(), &document)).To(Succeed())
+				Expect(document).To(Equal(map[string]interface{}{
+					"schema_version": StructuredOutputSchemaVersion,
+					"kind":           "org_list",
+					"data": []interface{}{
+						map[string]interface{}{"name": "some-org", "guid": "some-org-guid"},
+					},
+				}))
+			})
+		})
+
+		When("the format is yaml", func() {
+			BeforeEach(func() {
+				format = configv3.OutputFormatYAML
+			})
+
+			It("wraps the data in a versioned envelope", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output).To(Say(`schema_version: "1"\n`))
+				Expect(output).To(Say(`kind: org_list\n`))
+				Expect(output).To(Say(`data:\n`))
+				Expect(output).To(Say(`- name: some-org\n`))
+				Expect(output).To(Say(`  guid: some-org-guid\n`))
+			})
+		})
+	})
+
+	Describe("NewDetailedAppOutput", func() {
+		It("converts the summary into the output schema", func() {
+			summary := v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: resources.Application{
+						Name:                "some-app",
+						GUID:                "some-app-guid",
+						State:               constant.ApplicationStarted,
+						LifecycleType:       constant.AppLifecycleTypeBuildpack,
+						LifecycleBuildpacks: []string{"ruby_buildpack"},
+						StackName:           "cflinuxfs4",
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: resources.Process{
+								Type:                     constant.ProcessTypeWeb,
+								Instances:                types.NullInt{Value: 2, IsSet: true},
+								MemoryInMB:               types.NullUint64{Value: 32, IsSet: true},
+								DiskInMB:                 types.NullUint64{Value: 64, IsSet: true},
+								HealthCheckType:          constant.Port,
+								ReadinessHealthCheckType: constant.HTTP,
+							},
+							Sidecars: []resources.Sidecar{{Name: "some-sidecar"}},
+							InstanceDetails: []v7action.ProcessInstance{
+								{
+									Index:            0,
+									State:            constant.ProcessInstanceRunning,
+									Uptime:           90 * time.Second,
+									MemoryUsage:      1024,
+									IsolationSegment: "some-iso-seg",
+								},
+								{
+									Index: 1,
+									State: constant.ProcessInstanceCrashed,
+								},
+							},
+						},
+					},
+					Routes: []resources.Route{{URL: "some-app.example.com"}},
+				},
+				CurrentDroplet: resources.Droplet{
+					GUID:       "some-droplet-guid",
+					State:      constant.DropletStaged,
+					Buildpacks: []resources.DropletBuildpack{{Name: "ruby_buildpack", Version: "1.2.3"}},
+				},
+			}
+
+			Expect(NewDetailedAppOutput(summary)).To(Equal(DetailedAppOutput{
+				AppOutput: AppOutput{
+					Name:           "some-app",
+					GUID:           "some-app-guid",
+					RequestedState: "started",
+					LifecycleType:  "buildpack",
+					Stack:          "cflinuxfs4",
+					Buildpacks:     []string{"ruby_buildpack"},
+					Routes:         []string{"some-app.example.com"},
+					Processes: []ProcessOutput{
+						{
+							Type:                     "web",
+							Instances:                2,
+							RunningInstances:         1,
+							MemoryInMB:               32,
+							DiskInMB:                 64,
+							HealthCheckType:          "port",
+							ReadinessHealthCheckType: "http",
+							Sidecars:                 []string{"some-sidecar"},
+							InstanceDetails: []InstanceOutput{
+								{Index: 0, State: "running", UptimeInSeconds: 90, MemoryUsage: 1024, IsolationSegment: "some-iso-seg"},
+								{Index: 1, State: "crashed"},
+							},
+						},
+					},
+				},
+				IsolationSegment: "some-iso-seg",
+				CurrentDroplet: &DropletOutput{
+					GUID:       "some-droplet-guid",
+					State:      "staged",
+					Current:    true,
+					Buildpacks: []DropletBuildpackOutput{{Name: "ruby_buildpack", Version: "1.2.3"}},
+				},
+			}))
+		})
+
+		When("the app has no droplet", func() {
+			It("omits the droplet and renders empty lists", func() {
+				output := NewDetailedAppOutput(v7action.DetailedApplicationSummary{})
+				Expect(output.CurrentDroplet).To(BeNil())
+				Expect(output.Buildpacks).To(BeEmpty())
+				Expect(output.Buildpacks).ToNot(BeNil())
+				Expect(output.Routes).ToNot(BeNil())
+				Expect(output.Processes).ToNot(BeNil())
+			})
+		})
+	})
+
+	Describe("NewServiceInstanceOutput", func() {
+		var details v7action.ServiceInstanceDetails
+
+		BeforeEach(func() {
+			details = v7action.ServiceInstanceDetails{
+				ServiceInstance: resources.ServiceInstance{
+					Name:           "some-instance",
+					GUID:           "some-instance-guid",
+					Tags:           types.NewOptionalStringSlice("foo"),
+					SyslogDrainURL: types.NewOptionalString("https://drain.example.com"),
+				},
+				SpaceName:         "other-space",
+				OrganizationName:  "other-org",
+				ServiceOffering:   resources.ServiceOffering{Name: "some-offering", Description: "some description"},
+				ServicePlan:       resources.ServicePlan{Name: "some-plan"},
+				ServiceBrokerName: "some-broker",
+				SharedStatus: v7action.SharedStatus{
+					IsSharedFromOriginalSpace: true,
+				},
+				UpgradeStatus: v7action.ServiceInstanceUpgradeStatus{
+					State:       v7action.ServiceInstanceUpgradeNotAvailable,
+					Description: "nothing to do",
+				},
+			}
+		})
+
+		When("the instance is managed", func() {
+			BeforeEach(func() {
+				details.Type = resources.ManagedServiceInstance
+			})
+
+			It("includes the offering, sharing and upgrade information", func() {
+				output := NewServiceInstanceOutput(details)
+				Expect(output.Type).To(Equal("managed"))
+				Expect(output.Broker).To(Equal("some-broker"))
+				Expect(output.Offering).To(Equal("some-offering"))
+				Expect(output.Plan).To(Equal("some-plan"))
+				Expect(output.Description).To(Equal("some description"))
+				Expect(output.Tags).To(Equal([]string{"foo"}))
+				Expect(output.OfferingTags).To(Equal([]string{}))
+				Expect(output.LastOperation).To(BeNil())
+				Expect(output.Sharing).To(Equal(&SharingOutput{
+					SharedFromSpace: "other-space",
+					SharedFromOrg:   "other-org",
+					SharedWith:      []SharedSpaceOutput{},
+				}))
+				Expect(output.Upgrade).To(Equal(&UpgradeStatusOutput{
+					State:       "not_available",
+					Description: "nothing to do",
+				}))
+			})
+		})
+
+		When("the instance is user-provided", func() {
+			BeforeEach(func() {
+				details.Type = resources.UserProvidedServiceInstance
+			})
+
+			It("omits the managed-only information", func() {
+				output := NewServiceInstanceOutput(details)
+				Expect(output.Type).To(Equal("user-provided"))
+				Expect(output.SyslogDrainURL).To(Equal("https://drain.example.com"))
+				Expect(output.Broker).To(BeEmpty())
+				Expect(output.Offering).To(BeEmpty())
+				Expect(output.Sharing).To(BeNil())
+				Expect(output.Upgrade).To(BeNil())
+			})
+		})
+	})
+
+	Describe("NewServiceInstanceListOutput", func() {
+		It("only reports upgrade availability when it is known", func() {
+			output := NewServiceInstanceListOutput([]v7action.ServiceInstance{
+				{Name: "known", UpgradeAvailable: types.NewOptionalBoolean(false)},
+				{Name: "unknown"},
+			})
+
+			Expect(output).To(HaveLen(2))
+			Expect(*output[0].UpgradeAvailable).To(BeFalse())
+			Expect(output[1].UpgradeAvailable).To(BeNil())
+			Expect(output[1].BoundApps).To(Equal([]string{}))
+		})
+	})
+
+	Describe("NewRouteListOutput", func() {
+		It("converts the route summaries into the output schema", func() {
+			leastConnection := "least-connection"
+			output := NewRouteListOutput([]v7action.RouteSummary{
+				{
+					Route: resources.Route{
+						GUID:     "route-guid",
+						Host:     "host",
+						Path:     "/path",
+						Port:     1024,
+						Protocol: "tcp",
+						URL:      "host.example.com/path",
+						Options:  map[string]*string{"loadbalancing": &leastConnection},
+					},
+					DomainName:          "example.com",
+					SpaceName:           "some-space",
+					AppNames:            []string{"app-1", "app-2"},
+					AppProtocols:        []string{"http1"},
+					ServiceInstanceName: "some-route-service",
+				},
+			})
+
+			Expect(output).To(Equal([]RouteOutput{
+				{
+					GUID:            "route-guid",
+					URL:             "host.example.com/path",
+					Space:           "some-space",
+					Host:            "host",
+					Domain:          "example.com",
+					Port:            1024,
+					Path:            "/path",
+					Protocol:        "tcp",
+					AppProtocols:    []string{"http1"},
+					Apps:            []string{"app-1", "app-2"},
+					ServiceInstance: "some-route-service",
+					Options:         map[string]string{"loadbalancing": "least-connection"},
+				},
+			}))
+		})
+	})
+})
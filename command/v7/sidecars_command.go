@@ -0,0 +1,93 @@
+package v7
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/sorting"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type SidecarsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME sidecars APP_NAME"`
+	relatedCommands interface{}  `related_commands:"app, create-sidecar, delete-sidecar"`
+}
+
+func (cmd SidecarsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.UI.DisplayTextWithFlavor("Getting sidecars for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	sidecars, warnings, err := cmd.Actor.GetApplicationSidecars(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(sidecars, func(i, j int) bool { return sorting.LessIgnoreCase(sidecars[i].Name, sidecars[j].Name) })
+
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.SidecarListKind, shared.NewSidecarListOutput(sidecars))
+	}
+
+	cmd.displayTable(sidecars)
+
+	return nil
+}
+
+func (cmd SidecarsCommand) displayTable(sidecars []resources.Sidecar) {
+	if len(sidecars) == 0 {
+		cmd.UI.DisplayText("No sidecars found.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("process types"),
+			cmd.UI.TranslateText("memory"),
+			cmd.UI.TranslateText("origin"),
+			cmd.UI.TranslateText("command"),
+		},
+	}
+
+	for _, sidecar := range sidecars {
+		var memory string
+		if sidecar.MemoryInMB.IsSet {
+			memory = fmt.Sprintf("%dM", sidecar.MemoryInMB.Value)
+		}
+
+		table = append(table, []string{
+			sidecar.Name,
+			strings.Join(sidecar.ProcessTypes, ", "),
+			memory,
+			sidecar.Origin,
+			sidecar.Command.Value,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
@@ -0,0 +1,163 @@
+package v7_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("sidecars Command", func() {
+	var (
+		cmd             SidecarsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = SidecarsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the app has sidecars", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns(
+				[]resources.Sidecar{
+					{
+						GUID:         "sidecar-2-guid",
+						Name:         "log-shipper",
+						Command:      types.FilteredString{IsSet: true, Value: "bin/ship"},
+						ProcessTypes: []string{"web", "worker"},
+						Origin:       "user",
+					},
+					{
+						GUID:         "sidecar-1-guid",
+						Name:         "config-agent",
+						Command:      types.FilteredString{IsSet: true, Value: "./agent"},
+						ProcessTypes: []string{"web"},
+						MemoryInMB:   types.NullUint64{IsSet: true, Value: 64},
+						Origin:       "buildpack",
+					},
+				},
+				v7action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("displays the sidecars sorted by name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting sidecars for app some-app in org some-org / space some-space as banana..."))
+			Expect(testUI.Out).To(Say(`name\s+process types\s+memory\s+origin\s+command`))
+			Expect(testUI.Out).To(Say(`config-agent\s+web\s+64M\s+buildpack\s+\./agent`))
+			Expect(testUI.Out).To(Say(`log-shipper\s+web, worker\s+user\s+bin/ship`))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationSidecarsArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		When("structured output is requested", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("prints only the sidecar list document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Getting sidecars"))
+
+				var document map[string]interface{}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &document)).To(Succeed())
+				Expect(document["kind"]).To(Equal("sidecar_list"))
+				Expect(document["data"]).To(Equal([]interface{}{
+					map[string]interface{}{
+						"name":          "config-agent",
+						"guid":          "sidecar-1-guid",
+						"command":       "./agent",
+						"process_types": []interface{}{"web"},
+						"memory_in_mb":  float64(64),
+						"origin":        "buildpack",
+					},
+					map[string]interface{}{
+						"name":          "log-shipper",
+						"guid":          "sidecar-2-guid",
+						"command":       "bin/ship",
+						"process_types": []interface{}{"web", "worker"},
+						"memory_in_mb":  nil,
+						"origin":        "user",
+					},
+				}))
+			})
+		})
+	})
+
+	When("the app has no sidecars", func() {
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No sidecars found."))
+		})
+	})
+
+	When("getting the sidecars fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationSidecarsReturns(nil, v7action.Warnings{"some-warning"}, errors.New("get-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("get-error"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
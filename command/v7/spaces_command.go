@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -24,11 +25,13 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	spaces, warnings, err := cmd.Actor.GetOrganizationSpacesWithLabelSelector(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -36,6 +39,10 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.SpaceListKind, shared.NewSpaceListOutput(spaces))
+	}
+
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
 	} else {
@@ -183,4 +183,25 @@ var _ = Describe("spaces Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
+				[]resources.Space{{Name: "some-space", GUID: "some-space-guid"}},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the spaces as a versioned document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting spaces"))
+			Expect(testUI.Out).To(Say(`"kind": "space_list"`))
+			Expect(testUI.Out).To(Say(`"name": "some-space"`))
+			Expect(testUI.Out).To(Say(`"guid": "some-space-guid"`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
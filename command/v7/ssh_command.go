@@ -1,39 +1,51 @@
 package v7
 
 import (
+	"io"
+	"strconv"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SharedSSHActor
 
 type SharedSSHActor interface {
+	ExecuteSecureCopy(sshClient sharedaction.SecureShellClient, scpOptions sharedaction.SCPOptions) error
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellCommandOnInstances(newSSHClient func() sharedaction.SecureShellClient, instances []sharedaction.InstanceSSHOptions, commands []string, maxParallel int, stdout io.Writer, stderr io.Writer) []sharedaction.InstanceCommandResult
 }
 
 type SSHCommand struct {
 	BaseCommand
 
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	AllInstances            bool                            `long:"all-instances" description:"Run the command on every running instance of the process"`
+	ProcessIndex            flag.InstanceIndex              `long:"app-instance-index" short:"i" description:"App process instance index (Default: 0)"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic (SOCKS5) port forward specification"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	Instances               flag.InstanceIndexes            `long:"instances" description:"Run the command on the given instances of the process, e.g. 0,2-4"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	MaxParallel             flag.PositiveInteger            `long:"max-parallel" default:"10" description:"Maximum number of instances to run the command on at once with --all-instances or --instances"`
+	ProcessType             string                          `long:"process" default:"web" description:"App process name"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]...\n   [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]... [-D [BIND_ADDRESS:]LOCAL_PORT]...\n   [--skip-remote-execution] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty]\n   [--skip-host-validation]\n\n   CF_NAME ssh APP_NAME [--process PROCESS] (--all-instances | --instances INDEXES)\n   [--max-parallel N] -c COMMAND...\n\n   With --all-instances or --instances, the command runs on each instance at the\n   same time. Output lines are prefixed with the instance index, and the exit\n   status of each instance is listed at the end.\n\nEXAMPLES:\n   CF_NAME ssh my-app -N -R 5005:localhost:5005\n   CF_NAME ssh my-app -N -D 1080\n   CF_NAME ssh my-app --all-instances -c \"ps aux\"\n   CF_NAME ssh my-app --instances 0,2-4 --max-parallel 2 -c \"cat /proc/meminfo\""`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
-	SSHActor  SharedSSHActor
-	SSHClient *clissh.SecureShell
+	SSHActor     SharedSSHActor
+	SSHClient    *clissh.SecureShell
+	NewSSHClient func() sharedaction.SecureShellClient
 }
 
 func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
@@ -46,6 +58,9 @@ func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.SharedActor = sharedActor
 	cmd.SSHActor = sharedActor
 	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+		return clissh.NewDefaultSecureShell()
+	}
 
 	return nil
 }
@@ -57,6 +72,10 @@ func (cmd SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.AllInstances || len(cmd.Instances) > 0 {
+		return cmd.executeOnInstances()
+	}
+
 	ttyOption, err := cmd.EvaluateTTYOption()
 	if err != nil {
 		return err
@@ -67,11 +86,21 @@ func (cmd SSHCommand) Execute(args []string) error {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardSpecs []sharedaction.DynamicPortForward
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardSpecs = append(dynamicForwardSpecs, sharedaction.DynamicPortForward(spec))
+	}
+
 	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.ProcessType,
-		cmd.ProcessIndex,
+		cmd.ProcessIndex.Value,
 	)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -81,15 +110,17 @@ func (cmd SSHCommand) Execute(args []string) error {
 	err = cmd.SSHActor.ExecuteSecureShell(
 		cmd.SSHClient,
 		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+			Commands:                cmd.Commands,
+			DynamicPortForwardSpecs: dynamicForwardSpecs,
+			Endpoint:                sshAuth.Endpoint,
+			HostKeyFingerprint:      sshAuth.HostKeyFingerprint,
+			LocalPortForwardSpecs:   forwardSpecs,
+			Passcode:                sshAuth.Passcode,
+			RemotePortForwardSpecs:  remoteForwardSpecs,
+			SkipHostValidation:      cmd.SkipHostValidation,
+			SkipRemoteExecution:     cmd.SkipRemoteExecution,
+			TTYOption:               ttyOption,
+			Username:                sshAuth.Username,
 		})
 	if err != nil {
 		return err
@@ -125,3 +156,109 @@ func (cmd SSHCommand) EvaluateTTYOption() (sharedaction.TTYOption, error) {
 
 	return option, nil
 }
+
+// executeOnInstances runs the command on several instances at once and
+// reports the exit status of each of them.
+func (cmd SSHCommand) executeOnInstances() error {
+	err := cmd.validateInstancesFlags()
+	if err != nil {
+		return err
+	}
+
+	sshAuths, warnings, err := cmd.Actor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.Instances,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(sshAuths) == 0 {
+		cmd.UI.DisplayText("There are no running instances of process {{.ProcessType}}.", map[string]interface{}{
+			"ProcessType": cmd.ProcessType,
+		})
+		return nil
+	}
+
+	var instances []sharedaction.InstanceSSHOptions
+	for _, sshAuth := range sshAuths {
+		instances = append(instances, sharedaction.InstanceSSHOptions{
+			Index:              sshAuth.Index,
+			Username:           sshAuth.Username,
+			GetPasscode:        cmd.Actor.GetSSHPasscode,
+			Endpoint:           sshAuth.Endpoint,
+			HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+			SkipHostValidation: cmd.SkipHostValidation,
+		})
+	}
+
+	results := cmd.SSHActor.ExecuteSecureShellCommandOnInstances(
+		cmd.NewSSHClient,
+		instances,
+		cmd.Commands,
+		int(cmd.MaxParallel.Value),
+		cmd.UI.GetOut(),
+		cmd.UI.GetErr(),
+	)
+
+	table := [][]string{{
+		cmd.UI.TranslateText("instance"),
+		cmd.UI.TranslateText("exit status"),
+	}}
+	failed := 0
+	for _, result := range results {
+		status := strconv.Itoa(result.ExitStatus)
+		if result.Err != nil {
+			status = translatedErrorMessage(cmd.UI, result.Err)
+		}
+		if result.Err != nil || result.ExitStatus != 0 {
+			failed++
+		}
+		table = append(table, []string{"#" + strconv.FormatUint(uint64(result.Index), 10), status})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	if failed > 0 {
+		return translatableerror.SSHCommandFailedOnInstancesError{Failed: failed, Total: len(results)}
+	}
+	return nil
+}
+
+func (cmd SSHCommand) validateInstancesFlags() error {
+	instancesFlag := "--instances"
+	if cmd.AllInstances {
+		instancesFlag = "--all-instances"
+		if len(cmd.Instances) > 0 {
+			return translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "--instances"}}
+		}
+	}
+
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: instancesFlag, Arg2: "--command"}
+	}
+
+	conflicts := []struct {
+		used bool
+		flag string
+	}{
+		{cmd.ProcessIndex.IsSet, "--app-instance-index, -i"},
+		{len(cmd.LocalPortForwardSpecs) > 0, "-L"},
+		{len(cmd.RemotePortForwardSpecs) > 0, "-R"},
+		{len(cmd.DynamicPortForwardSpecs) > 0, "-D"},
+		{cmd.SkipRemoteExecution, "--skip-remote-execution"},
+		{cmd.ForcePseudoTTY, "--force-pseudo-tty"},
+		{cmd.RequestPseudoTTY, "--request-pseudo-tty"},
+	}
+	for _, conflict := range conflicts {
+		if conflict.used {
+			return translatableerror.ArgumentCombinationError{Args: []string{instancesFlag, conflict.flag}}
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package v7_test
 
 import (
 	"errors"
+	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -43,7 +44,7 @@ var _ = Describe("ssh Command", func() {
 			RequiredArgs: flag.AppName{AppName: appName},
 
 			ProcessType:         "some-process-type",
-			ProcessIndex:        1,
+			ProcessIndex:        flag.InstanceIndex{IsSet: true, Value: 1},
 			Commands:            []string{"some", "commands"},
 			SkipHostValidation:  true,
 			SkipRemoteExecution: true,
@@ -157,6 +158,30 @@ var _ = Describe("ssh Command", func() {
 							}))
 						})
 					})
+
+					When("working with remote and dynamic port forwarding", func() {
+						BeforeEach(func() {
+							cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+								{RemoteAddress: "localhost:5005", LocalAddress: "localhost:5005"},
+							}
+							cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+								{LocalAddress: "localhost:1080"},
+							}
+						})
+
+						It("passes along port forwarding information", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+							_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+							Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+								{RemoteAddress: "localhost:5005", LocalAddress: "localhost:5005"},
+							}))
+							Expect(sshOptionsArg.DynamicPortForwardSpecs).To(Equal([]sharedaction.DynamicPortForward{
+								{LocalAddress: "localhost:1080"},
+							}))
+						})
+					})
 				})
 
 				When("executing the secure shell fails", func() {
@@ -186,6 +211,158 @@ var _ = Describe("ssh Command", func() {
 		})
 	})
 
+	Describe("Execute on several instances", func() {
+		BeforeEach(func() {
+			cmd.SkipRemoteExecution = false
+			cmd.ProcessIndex = flag.InstanceIndex{}
+			cmd.AllInstances = true
+			cmd.MaxParallel = flag.PositiveInteger{Value: 3}
+			cmd.NewSSHClient = func() sharedaction.SecureShellClient { return nil }
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+
+			fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns(
+				[]v7action.InstanceSSHAuthentication{
+					{Index: 0, SSHAuthentication: v7action.SSHAuthentication{Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Username: "cf:some-process-guid/0"}},
+					{Index: 2, SSHAuthentication: v7action.SSHAuthentication{Endpoint: "some-endpoint", HostKeyFingerprint: "some-fingerprint", Username: "cf:some-process-guid/2"}},
+				},
+				v7action.Warnings{"some-warnings"},
+				nil,
+			)
+			fakeSSHActor.ExecuteSecureShellCommandOnInstancesReturns([]sharedaction.InstanceCommandResult{
+				{Index: 0, ExitStatus: 0},
+				{Index: 2, ExitStatus: 0},
+			})
+		})
+
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(nil)
+		})
+
+		When("the command succeeds on every instance", func() {
+			It("runs the command on each instance and lists their exit statuses", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Err).To(Say("some-warnings"))
+
+				appNameArg, spaceGUIDArg, processTypeArg, indexesArg := fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall(0)
+				Expect(appNameArg).To(Equal(appName))
+				Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+				Expect(processTypeArg).To(Equal("some-process-type"))
+				Expect(indexesArg).To(BeEmpty())
+
+				Expect(fakeSSHActor.ExecuteSecureShellCommandOnInstancesCallCount()).To(Equal(1))
+				_, instancesArg, commandsArg, maxParallelArg, stdoutArg, stderrArg := fakeSSHActor.ExecuteSecureShellCommandOnInstancesArgsForCall(0)
+				Expect(instancesArg).To(HaveLen(2))
+				for i, index := range []uint{0, 2} {
+					Expect(instancesArg[i].Index).To(Equal(index))
+					Expect(instancesArg[i].Username).To(Equal(fmt.Sprintf("cf:some-process-guid/%d", index)))
+					Expect(instancesArg[i].Endpoint).To(Equal("some-endpoint"))
+					Expect(instancesArg[i].HostKeyFingerprint).To(Equal("some-fingerprint"))
+					Expect(instancesArg[i].SkipHostValidation).To(BeTrue())
+				}
+				Expect(commandsArg).To(Equal([]string{"some", "commands"}))
+				Expect(maxParallelArg).To(Equal(3))
+				Expect(stdoutArg).To(Equal(testUI.Out))
+				Expect(stderrArg).To(Equal(testUI.Err))
+
+				Expect(testUI.Out).To(Say(`instance\s+exit status`))
+				Expect(testUI.Out).To(Say(`#0\s+0`))
+				Expect(testUI.Out).To(Say(`#2\s+0`))
+				Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+			})
+		})
+
+		It("leaves getting each passcode to the instance's worker", func() {
+			Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(0))
+
+			fakeActor.GetSSHPasscodeReturns("some-passcode", nil)
+			_, instancesArg, _, _, _, _ := fakeSSHActor.ExecuteSecureShellCommandOnInstancesArgsForCall(0)
+			passcode, err := instancesArg[1].GetPasscode()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(passcode).To(Equal("some-passcode"))
+			Expect(fakeActor.GetSSHPasscodeCallCount()).To(Equal(1))
+		})
+
+		When("instances are selected", func() {
+			BeforeEach(func() {
+				cmd.AllInstances = false
+				cmd.Instances = flag.InstanceIndexes{0, 2}
+			})
+
+			It("gets the configuration of those instances", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				_, _, _, indexesArg := fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall(0)
+				Expect(indexesArg).To(Equal([]uint{0, 2}))
+			})
+		})
+
+		When("the command fails on some instances", func() {
+			BeforeEach(func() {
+				fakeSSHActor.ExecuteSecureShellCommandOnInstancesReturns([]sharedaction.InstanceCommandResult{
+					{Index: 0, ExitStatus: 3},
+					{Index: 2, Err: errors.New("connection reset")},
+					{Index: 3, Err: actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 3}},
+				})
+			})
+
+			It("lists each failure with its translated message and returns an error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedOnInstancesError{Failed: 3, Total: 3}))
+				Expect(testUI.Out).To(Say(`#0\s+3`))
+				Expect(testUI.Out).To(Say(`#2\s+connection reset`))
+				Expect(testUI.Out).To(Say(`#3\s+Instance 3 of process some-process-type not running`))
+			})
+		})
+
+		When("there are no running instances", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns(nil, nil, nil)
+			})
+
+			It("says so without running anything", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say("There are no running instances of process some-process-type."))
+				Expect(fakeSSHActor.ExecuteSecureShellCommandOnInstancesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the configurations fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns(nil, v7action.Warnings{"some-warnings"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("some-warnings"))
+			})
+		})
+
+		When("no command is given", func() {
+			BeforeEach(func() {
+				cmd.Commands = nil
+			})
+
+			It("returns a RequiredFlagsError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command"}))
+				Expect(fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesCallCount()).To(Equal(0))
+			})
+		})
+
+		DescribeTable("rejects flags that need a single session",
+			func(setFlag func(), conflictingFlag string) {
+				cmd.SkipRemoteExecution = false
+				setFlag()
+				Expect(cmd.Execute(nil)).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", conflictingFlag}}))
+			},
+			Entry("--instances", func() { cmd.Instances = flag.InstanceIndexes{1} }, "--instances"),
+			Entry("-i", func() { cmd.ProcessIndex = flag.InstanceIndex{IsSet: true, Value: 0} }, "--app-instance-index, -i"),
+			Entry("-L", func() { cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{{}} }, "-L"),
+			Entry("-R", func() { cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{{}} }, "-R"),
+			Entry("-D", func() { cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{{}} }, "-D"),
+			Entry("--skip-remote-execution", func() { cmd.SkipRemoteExecution = true }, "--skip-remote-execution"),
+			Entry("--force-pseudo-tty", func() { cmd.ForcePseudoTTY = true }, "--force-pseudo-tty"),
+			Entry("--request-pseudo-tty", func() { cmd.RequestPseudoTTY = true }, "--request-pseudo-tty"),
+		)
+	})
+
 	DescribeTable("EvaluateTTYOption",
 		func(disablePseudoTTY bool, forcePseudoTTY bool, requestPseudoTTY bool, expectedErr error, ttyOption sharedaction.TTYOption) {
 			cmd.DisablePseudoTTY = disablePseudoTTY
@@ -3,6 +3,7 @@ package v7
 import (
 	"sort"
 
+	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/sorting"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -27,10 +28,12 @@ func (cmd StacksCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting stacks as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !shared.IsStructuredOutput(cmd.Config) {
+		cmd.UI.DisplayTextWithFlavor("Getting stacks as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	stacks, warnings, err := cmd.Actor.GetStacks(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -40,6 +43,10 @@ func (cmd StacksCommand) Execute(args []string) error {
 
 	sort.Slice(stacks, func(i, j int) bool { return sorting.LessIgnoreCase(stacks[i].Name, stacks[j].Name) })
 
+	if shared.IsStructuredOutput(cmd.Config) {
+		return shared.DisplayStructuredOutput(cmd.UI, cmd.Config.OutputFormat(), shared.StackListKind, shared.NewStackListOutput(stacks))
+	}
+
 	cmd.displayTable(stacks)
 
 	return nil
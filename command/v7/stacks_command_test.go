@@ -131,4 +131,29 @@ var _ = Describe("stacks Command", func() {
 			})
 		})
 	})
+
+	When("structured output is requested", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			fakeActor.GetStacksReturns(
+				[]resources.Stack{
+					{Name: "stack-b", Description: "second"},
+					{Name: "stack-a", Description: "first"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("renders the sorted stacks as a versioned document", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).ToNot(Say("Getting stacks"))
+			Expect(testUI.Out).To(Say(`"kind": "stack_list"`))
+			Expect(testUI.Out).To(Say(`"name": "stack-a"`))
+			Expect(testUI.Out).To(Say(`"description": "first"`))
+			Expect(testUI.Out).To(Say(`"name": "stack-b"`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -0,0 +1,164 @@
+package v7
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+type TaskLogsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.TaskLogsArgs `positional-args:"yes"`
+	usage           interface{}       `usage:"CF_NAME task-logs APP_NAME (TASK_ID | TASK_NAME)\n\n   If the task is still running, its logs are followed until it completes. The command exits with an error if the task failed.\n   When given a name, the most recently created task with that name is used.\n\nEXAMPLES:\n   CF_NAME task-logs my-app 3\n\n   CF_NAME task-logs my-app migrate"`
+	relatedCommands interface{}       `related_commands:"logs, run-task, tasks"`
+
+	LogCacheClient sharedaction.LogCacheClient
+}
+
+func (cmd *TaskLogsCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	return err
+}
+
+func (cmd TaskLogsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	space := cmd.Config.TargetedSpace()
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var task resources.Task
+	if sequenceID, convErr := strconv.Atoi(cmd.RequiredArgs.Task); convErr == nil {
+		task, warnings, err = cmd.Actor.GetTaskBySequenceIDAndApplication(sequenceID, application.GUID)
+	} else {
+		task, warnings, err = cmd.Actor.GetTaskByNameAndApplication(cmd.RequiredArgs.Task, application.GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Retrieving logs for task {{.TaskName}} ({{.TaskID}}) of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"TaskName":  task.Name,
+			"TaskID":    task.SequenceID,
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": space.Name,
+			"Username":  user.Name,
+		})
+	cmd.UI.DisplayNewline()
+
+	if task.State == constant.TaskSucceeded || task.State == constant.TaskFailed {
+		messages, err := cmd.Actor.GetRecentLogsForTask(application.GUID, task, cmd.LogCacheClient)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			cmd.UI.DisplayLogMessage(message, true)
+		}
+
+		if task.State == constant.TaskFailed {
+			return actionerror.TaskFailedError{}
+		}
+		return nil
+	}
+
+	return followTaskLogs(cmd.BaseCommand, cmd.LogCacheClient, application.GUID, task)
+}
+
+// followTaskLogs displays the logs of task as they arrive until the task
+// completes, returning TaskFailedError if it did not succeed. Log Cache lags
+// behind the task state, so streaming continues for one polling interval after
+// completion to pick up the last lines.
+func followTaskLogs(cmd BaseCommand, client sharedaction.LogCacheClient, appGUID string, task resources.Task) error {
+	stop := make(chan struct{})
+	stoppedRefreshing := make(chan struct{})
+	tokenRefreshErrors, err := cmd.Actor.ScheduleTokenRefresh(time.After, stop, stoppedRefreshing)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		close(stop)
+		<-stoppedRefreshing
+	}()
+
+	messages, logErrs, stopStreaming := cmd.Actor.GetStreamingLogsForTask(appGUID, task, client)
+	defer stopStreaming()
+
+	var (
+		pollWarnings v7action.Warnings
+		pollErr      error
+	)
+	taskCompleted := make(chan struct{})
+	go func() {
+		defer close(taskCompleted)
+		_, pollWarnings, pollErr = cmd.Actor.PollTask(task)
+	}()
+
+	var drained <-chan time.Time
+	for drained == nil || messages != nil || logErrs != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				continue
+			}
+			cmd.UI.DisplayLogMessage(message, true)
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				continue
+			}
+			displayLogCacheError(cmd.UI, logErr)
+		case err := <-tokenRefreshErrors:
+			cmd.UI.DisplayError(err)
+		case <-taskCompleted:
+			taskCompleted = nil
+			drained = time.After(cmd.Config.PollingInterval())
+		case <-drained:
+			messages, logErrs = nil, nil
+		}
+	}
+
+	cmd.UI.DisplayWarnings(pollWarnings)
+	return pollErr
+}
+
+func displayLogCacheError(ui command.UI, logErr error) {
+	switch logErr.(type) {
+	case actionerror.LogCacheTimeoutError:
+		ui.DisplayWarning("timeout connecting to log server, no log will be shown")
+	default:
+		ui.DisplayWarning("Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+			"Error": logErr,
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("task-logs Command", func() {
+	var (
+		cmd             TaskLogsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = TaskLogsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app-name"
+		cmd.RequiredArgs.Task = "3"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			resources.Application{GUID: "some-app-guid"},
+			v7action.Warnings{"get-application-warning"},
+			nil)
+		fakeActor.GetTaskBySequenceIDAndApplicationReturns(
+			resources.Task{GUID: "task-guid", Name: "migrate", SequenceID: 3, State: constant.TaskSucceeded},
+			v7action.Warnings{"get-task-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the task is given by sequence ID", func() {
+		It("looks the task up by sequence ID", func() {
+			Expect(fakeActor.GetTaskBySequenceIDAndApplicationCallCount()).To(Equal(1))
+			sequenceID, appGUID := fakeActor.GetTaskBySequenceIDAndApplicationArgsForCall(0)
+			Expect(sequenceID).To(Equal(3))
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(fakeActor.GetTaskByNameAndApplicationCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`Retrieving logs for task migrate \(3\) of app some-app-name in org some-org / space some-space as some-user...`))
+			Expect(testUI.Err).To(Say("get-application-warning"))
+			Expect(testUI.Err).To(Say("get-task-warning"))
+		})
+	})
+
+	When("the task is given by name", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Task = "migrate"
+			fakeActor.GetTaskByNameAndApplicationReturns(
+				resources.Task{GUID: "task-guid", Name: "migrate", SequenceID: 3, State: constant.TaskSucceeded},
+				nil,
+				nil)
+		})
+
+		It("looks up the most recent task with that name", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetTaskByNameAndApplicationCallCount()).To(Equal(1))
+			name, appGUID := fakeActor.GetTaskByNameAndApplicationArgsForCall(0)
+			Expect(name).To(Equal("migrate"))
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+
+		When("no task has that name", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskByNameAndApplicationReturns(resources.Task{}, nil, actionerror.TaskNotFoundError{Name: "migrate"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskNotFoundError{Name: "migrate"}))
+			})
+		})
+	})
+
+	When("the task has already completed", func() {
+		BeforeEach(func() {
+			fakeActor.GetRecentLogsForTaskReturns([]sharedaction.LogMessage{
+				*sharedaction.NewLogMessage("task-output-1", "OUT", time.Now(), "APP/TASK/migrate", "0"),
+				*sharedaction.NewLogMessage("task-output-2", "OUT", time.Now(), "APP/TASK/migrate", "0"),
+			}, nil)
+		})
+
+		It("displays the recent logs of the task without following", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			appGUID, task, _ := fakeActor.GetRecentLogsForTaskArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(task.GUID).To(Equal("task-guid"))
+
+			Expect(testUI.Out).To(Say("task-output-1"))
+			Expect(testUI.Out).To(Say("task-output-2"))
+			Expect(fakeActor.GetStreamingLogsForTaskCallCount()).To(Equal(0))
+			Expect(fakeActor.PollTaskCallCount()).To(Equal(0))
+		})
+
+		When("the task failed", func() {
+			BeforeEach(func() {
+				fakeActor.GetTaskBySequenceIDAndApplicationReturns(
+					resources.Task{GUID: "task-guid", Name: "migrate", SequenceID: 3, State: constant.TaskFailed},
+					nil,
+					nil)
+			})
+
+			It("displays the logs and returns a task failed error", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskFailedError{}))
+				Expect(testUI.Out).To(Say("task-output-2"))
+			})
+		})
+
+		When("retrieving the logs fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetRecentLogsForTaskReturns(nil, errors.New("log-cache-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("log-cache-error"))
+			})
+		})
+	})
+
+	When("the task is still running", func() {
+		var (
+			logStream   chan sharedaction.LogMessage
+			errorStream chan error
+		)
+
+		BeforeEach(func() {
+			fakeActor.GetTaskBySequenceIDAndApplicationReturns(
+				resources.Task{GUID: "task-guid", Name: "migrate", SequenceID: 3, State: constant.TaskRunning},
+				nil,
+				nil)
+
+			fakeActor.ScheduleTokenRefreshStub = func(
+				after func(time.Duration) <-chan time.Time,
+				stop chan struct{}, stoppedRefreshing chan struct{}) (<-chan error, error) {
+				go func() {
+					<-stop
+					close(stoppedRefreshing)
+				}()
+				return make(chan error), nil
+			}
+
+			logStream = make(chan sharedaction.LogMessage, 1)
+			errorStream = make(chan error, 1)
+			logStream <- *sharedaction.NewLogMessage("task-output", "OUT", time.Now(), "APP/TASK/migrate", "0")
+			errorStream <- errors.New("walk-error")
+			fakeActor.GetStreamingLogsForTaskReturns(logStream, errorStream, func() {})
+
+			fakeActor.PollTaskStub = func(task resources.Task) (resources.Task, v7action.Warnings, error) {
+				Eventually(logStream).Should(BeEmpty())
+				Eventually(errorStream).Should(BeEmpty())
+				return task, v7action.Warnings{"poll-warning"}, nil
+			}
+		})
+
+		It("follows the logs of the task until it completes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("task-output"))
+			Expect(testUI.Err).To(Say("Failed to retrieve logs from Log Cache: walk-error"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+			Expect(fakeActor.GetRecentLogsForTaskCallCount()).To(Equal(0))
+		})
+
+		When("the task fails", func() {
+			BeforeEach(func() {
+				fakeActor.PollTaskReturns(resources.Task{}, nil, actionerror.TaskFailedError{})
+				fakeActor.PollTaskStub = nil
+			})
+
+			It("returns a task failed error", func() {
+				Expect(executeErr).To(MatchError(actionerror.TaskFailedError{}))
+			})
+		})
+
+		When("scheduling the token refresh fails", func() {
+			BeforeEach(func() {
+				fakeActor.ScheduleTokenRefreshStub = nil
+				fakeActor.ScheduleTokenRefreshReturns(nil, errors.New("refresh-error"))
+			})
+
+			It("returns the error without streaming", func() {
+				Expect(executeErr).To(MatchError("refresh-error"))
+				Expect(fakeActor.GetStreamingLogsForTaskCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . AnnotationUnsetter
+
+type AnnotationUnsetter interface {
+	Execute(resource TargetResource, annotations map[string]types.NullString) error
+}
+
+type UnsetAnnotationCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.UnsetAnnotationArgs `positional-args:"yes"`
+	relatedCommands interface{}              `related_commands:"annotations, set-annotation"`
+	BuildpackStack  string                   `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	ServiceBroker   string                   `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
+	ServiceOffering string                   `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+
+	AnnotationUnsetter AnnotationUnsetter
+}
+
+func (cmd *UnsetAnnotationCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.AnnotationUnsetter = &LabelUpdater{
+		UI:          ui,
+		Config:      config,
+		SharedActor: cmd.SharedActor,
+		Actor:       cmd.Actor,
+		Action:      Unset,
+		Field:       AnnotationsField,
+	}
+	return nil
+}
+
+func (cmd UnsetAnnotationCommand) Execute(args []string) error {
+	annotations := make(map[string]types.NullString)
+	for _, value := range cmd.RequiredArgs.AnnotationKeys {
+		annotations[value] = types.NewNullString()
+	}
+
+	targetResource := TargetResource{
+		ResourceType:    cmd.RequiredArgs.ResourceType,
+		ResourceName:    cmd.RequiredArgs.ResourceName,
+		BuildpackStack:  cmd.BuildpackStack,
+		ServiceBroker:   cmd.ServiceBroker,
+		ServiceOffering: cmd.ServiceOffering,
+	}
+	return cmd.AnnotationUnsetter.Execute(targetResource, annotations)
+}
+
+func (cmd UnsetAnnotationCommand) Usage() string {
+	return `CF_NAME unset-annotation RESOURCE RESOURCE_NAME KEY...`
+}
+
+func (cmd UnsetAnnotationCommand) Examples() string {
+	return `
+cf unset-annotation app dora contact
+cf unset-annotation org business owner cost-center
+cf unset-annotation buildpack go_buildpack maintainer -s cflinuxfs3`
+}
+
+func (cmd UnsetAnnotationCommand) Resources() string {
+	return `
+app
+buildpack
+domain
+org
+route
+service-broker
+service-instance
+service-offering
+service-plan
+space
+stack`
+}
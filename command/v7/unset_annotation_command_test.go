@@ -0,0 +1,53 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("unset-annotation command", func() {
+	var (
+		cmd                    v7.UnsetAnnotationCommand
+		resourceName           string
+		fakeAnnotationUnsetter *v7fakes.FakeAnnotationUnsetter
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeAnnotationUnsetter = new(v7fakes.FakeAnnotationUnsetter)
+		cmd = v7.UnsetAnnotationCommand{
+			AnnotationUnsetter: fakeAnnotationUnsetter,
+		}
+
+		cmd.RequiredArgs = flag.UnsetAnnotationArgs{
+			ResourceType:   "anything",
+			ResourceName:   resourceName,
+			AnnotationKeys: []string{"contact", "runbook"},
+		}
+		cmd.BuildpackStack = "some-stack"
+		cmd.ServiceBroker = "some-service-broker"
+		cmd.ServiceOffering = "some-service-offering"
+	})
+
+	It("calls execute with the right parameters", func() {
+		executeErr = cmd.Execute(nil)
+
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeAnnotationUnsetter.ExecuteCallCount()).To(Equal(1))
+		targetResource, keys := fakeAnnotationUnsetter.ExecuteArgsForCall(0)
+		Expect(targetResource.ResourceType).To(Equal(cmd.RequiredArgs.ResourceType))
+		Expect(targetResource.ResourceName).To(Equal(cmd.RequiredArgs.ResourceName))
+		Expect(targetResource.BuildpackStack).To(Equal(cmd.BuildpackStack))
+		Expect(targetResource.ServiceBroker).To(Equal(cmd.ServiceBroker))
+		Expect(targetResource.ServiceOffering).To(Equal(cmd.ServiceOffering))
+		Expect(keys).To(Equal(map[string]types.NullString{
+			"contact": types.NewNullString(),
+			"runbook": types.NewNullString(),
+		}))
+	})
+})
@@ -15,13 +15,15 @@ type UpdateDestinationCommand struct {
 	AppProtocol  string           `long:"app-protocol" description:"New Protocol for the route destination (http1 or http2). Only applied to HTTP routes"`
 	Path         flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
 
-	relatedCommands interface{} `related_commands:"routes, map-route, create-route, unmap-route"`
+	relatedCommands interface{} `related_commands:"routes, map-route, create-route, unmap-route, update-route"`
 }
 
 func (cmd UpdateDestinationCommand) Usage() string {
 	return `
 Edit an existing HTTP route:
-   CF_NAME update-destination APP_NAME DOMAIN [--hostname HOSTNAME] [--app-protocol PROTOCOL] [--path PATH]`
+   CF_NAME update-destination APP_NAME DOMAIN [--hostname HOSTNAME] [--app-protocol PROTOCOL] [--path PATH]
+
+Per-route options such as loadbalancing apply to the route as a whole rather than to one of its destinations. Use 'CF_NAME update-route --option' to change them.`
 }
 
 func (cmd UpdateDestinationCommand) Examples() string {
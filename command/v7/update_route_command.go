@@ -0,0 +1,82 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type UpdateRouteCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.Domain        `positional-args:"yes"`
+	Hostname        string             `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path            flag.V7RoutePath   `long:"path" description:"Path for the HTTP route"`
+	Options         []flag.RouteOption `long:"option" short:"o" description:"Set the value of a per-route option, e.g. loadbalancing=least-connection; can specify multiple times"`
+	RemoveOptions   []string           `long:"remove-option" short:"r" description:"Remove a per-route option, e.g. loadbalancing; can specify multiple times"`
+	usage           interface{}        `usage:"Edit the per-route options of an HTTP route:\n   CF_NAME update-route DOMAIN [--hostname HOSTNAME] [--path PATH] [--option OPTION=VALUE]... [--remove-option OPTION]...\n\nEXAMPLES:\n   CF_NAME update-route example.com --hostname myhost --option loadbalancing=least-connection # myhost.example.com\n   CF_NAME update-route example.com --hostname myhost --path foo --remove-option loadbalancing  # myhost.example.com/foo"`
+	relatedCommands interface{}        `related_commands:"create-route, map-route, route, routes"`
+}
+
+func (cmd UpdateRouteCommand) Execute(args []string) error {
+	options := routeOptions(cmd.Options)
+	if len(options) == 0 && len(cmd.RemoveOptions) == 0 {
+		return translatableerror.IncorrectUsageError{Message: "at least one of --option or --remove-option must be provided"}
+	}
+
+	for _, key := range cmd.RemoveOptions {
+		if _, ok := options[key]; ok {
+			return translatableerror.IncorrectUsageError{Message: "option '" + key + "' cannot be both set and removed"}
+		}
+		if options == nil {
+			options = map[string]*string{}
+		}
+		options[key] = nil
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	domain, warnings, err := cmd.Actor.GetDomainByName(cmd.RequiredArgs.Domain)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	path := cmd.Path.Path
+	route, warnings, err := cmd.Actor.GetRouteByAttributes(domain, cmd.Hostname, path, 0)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating route {{.URL}} for org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...",
+		map[string]interface{}{
+			"URL":       desiredURL(domain.Name, cmd.Hostname, path, 0),
+			"User":      user.Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+		})
+
+	route, warnings, err = cmd.Actor.UpdateRoute(route.GUID, options)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Route {{.URL}} has been updated.", map[string]interface{}{
+		"URL": route.URL,
+	})
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("options:"), route.FormattedOptions()},
+	}, 3)
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,186 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-route Command", func() {
+	var (
+		cmd             UpdateRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = UpdateRouteCommand{
+			RequiredArgs: flag.Domain{Domain: "some-domain.com"},
+			Hostname:     "host",
+			Path:         flag.V7RoutePath{Path: "/path"},
+			Options:      []flag.RouteOption{{Key: "loadbalancing", Value: "least-connection"}},
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetDomainByNameReturns(
+			resources.Domain{Name: "some-domain.com", GUID: "domain-guid"},
+			v7action.Warnings{"get-domain-warnings"},
+			nil,
+		)
+		fakeActor.GetRouteByAttributesReturns(
+			resources.Route{GUID: "route-guid"},
+			v7action.Warnings{"get-route-warnings"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("no options are given", func() {
+		BeforeEach(func() {
+			cmd.Options = nil
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "at least one of --option or --remove-option must be provided"}))
+			Expect(fakeActor.UpdateRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("an option is both set and removed", func() {
+		BeforeEach(func() {
+			cmd.RemoveOptions = []string{"loadbalancing"}
+		})
+
+		It("returns a usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "option 'loadbalancing' cannot be both set and removed"}))
+			Expect(fakeActor.UpdateRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the route does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteByAttributesReturns(
+				resources.Route{},
+				v7action.Warnings{"get-route-warnings"},
+				actionerror.RouteNotFoundError{Host: "host", DomainName: "some-domain.com", Path: "/path"},
+			)
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{Host: "host", DomainName: "some-domain.com", Path: "/path"}))
+			Expect(testUI.Err).To(Say("get-domain-warnings"))
+			Expect(testUI.Err).To(Say("get-route-warnings"))
+			Expect(fakeActor.UpdateRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the route exists", func() {
+		It("looks up the route by its attributes", func() {
+			Expect(fakeActor.GetDomainByNameArgsForCall(0)).To(Equal("some-domain.com"))
+
+			Expect(fakeActor.GetRouteByAttributesCallCount()).To(Equal(1))
+			domain, hostname, path, port := fakeActor.GetRouteByAttributesArgsForCall(0)
+			Expect(domain.GUID).To(Equal("domain-guid"))
+			Expect(hostname).To(Equal("host"))
+			Expect(path).To(Equal("/path"))
+			Expect(port).To(Equal(0))
+		})
+
+		When("updating the route succeeds", func() {
+			BeforeEach(func() {
+				cmd.RemoveOptions = []string{"some-other-option"}
+
+				leastConnection := "least-connection"
+				fakeActor.UpdateRouteReturns(
+					resources.Route{GUID: "route-guid", URL: "host.some-domain.com/path", Options: map[string]*string{"loadbalancing": &leastConnection}},
+					v7action.Warnings{"update-route-warnings"},
+					nil,
+				)
+			})
+
+			It("sets and removes the options and displays the result", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.UpdateRouteCallCount()).To(Equal(1))
+				routeGUID, options := fakeActor.UpdateRouteArgsForCall(0)
+				Expect(routeGUID).To(Equal("route-guid"))
+				Expect(options).To(HaveLen(2))
+				Expect(*options["loadbalancing"]).To(Equal("least-connection"))
+				Expect(options).To(HaveKeyWithValue("some-other-option", BeNil()))
+
+				Expect(testUI.Out).To(Say(`Updating route host\.some-domain\.com/path for org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say(`Route host\.some-domain\.com/path has been updated\.`))
+				Expect(testUI.Out).To(Say(`options:\s+loadbalancing=least-connection`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("update-route-warnings"))
+			})
+		})
+
+		When("updating the route fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateRouteReturns(
+					resources.Route{},
+					v7action.Warnings{"update-route-warnings"},
+					errors.New("update-route-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("update-route-error"))
+				Expect(testUI.Err).To(Say("update-route-warnings"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
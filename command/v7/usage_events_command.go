@@ -0,0 +1,352 @@
+package v7
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+const (
+	usageEventsFormatCSV    = "csv"
+	usageEventsFormatNDJSON = "ndjson"
+)
+
+type UsageEventsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.UsageEventsArgs `positional-args:"yes"`
+	AfterGUID       string               `long:"after-guid" description:"Only export events created after the event with this GUID"`
+	CheckpointFile  string               `long:"checkpoint-file" description:"File holding the GUID of the last exported event. Export resumes after it and the file is updated once the export succeeds."`
+	Format          string               `long:"format" choice:"csv" choice:"ndjson" default:"csv" description:"Format of the exported events"`
+	Path            string               `long:"path" short:"p" description:"Append the events to this file instead of writing them to standard output"`
+	usage           interface{}          `usage:"CF_NAME usage-events TYPE [--after-guid GUID | --checkpoint-file PATH] [--format csv|ndjson] [-p PATH]\n\nTYPES:\n   app, service\n\nEXAMPLES:\n   CF_NAME usage-events app --format ndjson > app-usage.ndjson\n   CF_NAME usage-events service --checkpoint-file service-usage.checkpoint -p service-usage.csv"`
+	relatedCommands interface{}          `related_commands:"audit-events"`
+}
+
+func (cmd UsageEventsCommand) Execute(args []string) error {
+	eventType := strings.ToLower(cmd.RequiredArgs.EventType)
+	if eventType != "app" && eventType != "service" {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "TYPE",
+			ExpectedType: "app or service",
+		}
+	}
+
+	if cmd.AfterGUID != "" && cmd.CheckpointFile != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--after-guid", "--checkpoint-file"},
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	afterGUID := cmd.AfterGUID
+	if cmd.CheckpointFile != "" {
+		afterGUID, err = readCheckpoint(cmd.CheckpointFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Path != "" {
+		user, err := cmd.Actor.GetCurrentUser()
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Exporting {{.Type}} usage events to {{.Path}} as {{.Username}}...", map[string]interface{}{
+			"Type":     eventType,
+			"Path":     cmd.Path,
+			"Username": user.Name,
+		})
+	}
+
+	records, err := cmd.getRecords(eventType, afterGUID)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.writeRecords(records)
+	if err != nil {
+		return err
+	}
+
+	if cmd.CheckpointFile != "" && len(records) > 0 {
+		err = writeCheckpoint(cmd.CheckpointFile, records[len(records)-1].guid())
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.Path != "" {
+		cmd.UI.DisplayText("Exported {{.Count}} events.", map[string]interface{}{
+			"Count": len(records),
+		})
+		cmd.UI.DisplayOK()
+	}
+
+	return nil
+}
+
+func (cmd UsageEventsCommand) getRecords(eventType string, afterGUID string) ([]usageEventRecord, error) {
+	var records []usageEventRecord
+
+	if eventType == "app" {
+		events, warnings, err := cmd.Actor.GetAppUsageEvents(afterGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			records = append(records, newAppUsageEventRecord(event))
+		}
+		return records, nil
+	}
+
+	events, warnings, err := cmd.Actor.GetServiceUsageEvents(afterGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		records = append(records, newServiceUsageEventRecord(event))
+	}
+	return records, nil
+}
+
+func (cmd UsageEventsCommand) writeRecords(records []usageEventRecord) error {
+	out := cmd.UI.GetOut()
+	includeHeader := true
+
+	if cmd.Path != "" {
+		file, err := os.OpenFile(cmd.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		// Appending to an existing export must not repeat the CSV header.
+		info, err := file.Stat()
+		if err != nil {
+			return err
+		}
+		includeHeader = info.Size() == 0
+		out = file
+	}
+
+	if cmd.Format == usageEventsFormatNDJSON {
+		return writeNDJSON(out, records)
+	}
+
+	header := appUsageEventRecord{}.csvHeader()
+	if strings.ToLower(cmd.RequiredArgs.EventType) == "service" {
+		header = serviceUsageEventRecord{}.csvHeader()
+	}
+	return writeCSV(out, header, records, includeHeader)
+}
+
+// usageEventRecord is a usage event flattened for export. Its JSON form is
+// one NDJSON line and its csvRecord matches the csvHeader of its type.
+type usageEventRecord interface {
+	guid() string
+	csvHeader() []string
+	csvRecord() []string
+}
+
+type appUsageEventRecord struct {
+	GUID                          string `json:"guid"`
+	CreatedAt                     string `json:"created_at"`
+	State                         string `json:"state"`
+	PreviousState                 string `json:"previous_state"`
+	AppGUID                       string `json:"app_guid"`
+	AppName                       string `json:"app_name"`
+	ProcessGUID                   string `json:"process_guid"`
+	ProcessType                   string `json:"process_type"`
+	TaskGUID                      string `json:"task_guid"`
+	TaskName                      string `json:"task_name"`
+	SpaceGUID                     string `json:"space_guid"`
+	SpaceName                     string `json:"space_name"`
+	OrganizationGUID              string `json:"organization_guid"`
+	BuildpackGUID                 string `json:"buildpack_guid"`
+	BuildpackName                 string `json:"buildpack_name"`
+	InstanceCount                 int    `json:"instance_count"`
+	PreviousInstanceCount         int    `json:"previous_instance_count"`
+	MemoryInMBPerInstance         int    `json:"memory_in_mb_per_instance"`
+	PreviousMemoryInMBPerInstance int    `json:"previous_memory_in_mb_per_instance"`
+}
+
+func newAppUsageEventRecord(event resources.AppUsageEvent) appUsageEventRecord {
+	return appUsageEventRecord{
+		GUID:                          event.GUID,
+		CreatedAt:                     event.CreatedAt.UTC().Format(time.RFC3339),
+		State:                         event.State,
+		PreviousState:                 event.PreviousState,
+		AppGUID:                       event.AppGUID,
+		AppName:                       event.AppName,
+		ProcessGUID:                   event.ProcessGUID,
+		ProcessType:                   event.ProcessType,
+		TaskGUID:                      event.TaskGUID,
+		TaskName:                      event.TaskName,
+		SpaceGUID:                     event.SpaceGUID,
+		SpaceName:                     event.SpaceName,
+		OrganizationGUID:              event.OrganizationGUID,
+		BuildpackGUID:                 event.BuildpackGUID,
+		BuildpackName:                 event.BuildpackName,
+		InstanceCount:                 event.InstanceCount,
+		PreviousInstanceCount:         event.PreviousInstanceCount,
+		MemoryInMBPerInstance:         event.MemoryInMBPerInstance,
+		PreviousMemoryInMBPerInstance: event.PreviousMemoryInMBPerInstance,
+	}
+}
+
+func (record appUsageEventRecord) guid() string {
+	return record.GUID
+}
+
+func (appUsageEventRecord) csvHeader() []string {
+	return []string{
+		"guid", "created_at", "state", "previous_state",
+		"app_guid", "app_name", "process_guid", "process_type", "task_guid", "task_name",
+		"space_guid", "space_name", "organization_guid", "buildpack_guid", "buildpack_name",
+		"instance_count", "previous_instance_count", "memory_in_mb_per_instance", "previous_memory_in_mb_per_instance",
+	}
+}
+
+func (record appUsageEventRecord) csvRecord() []string {
+	return []string{
+		record.GUID, record.CreatedAt, record.State, record.PreviousState,
+		record.AppGUID, record.AppName, record.ProcessGUID, record.ProcessType, record.TaskGUID, record.TaskName,
+		record.SpaceGUID, record.SpaceName, record.OrganizationGUID, record.BuildpackGUID, record.BuildpackName,
+		strconv.Itoa(record.InstanceCount), strconv.Itoa(record.PreviousInstanceCount),
+		strconv.Itoa(record.MemoryInMBPerInstance), strconv.Itoa(record.PreviousMemoryInMBPerInstance),
+	}
+}
+
+type serviceUsageEventRecord struct {
+	GUID                string `json:"guid"`
+	CreatedAt           string `json:"created_at"`
+	State               string `json:"state"`
+	ServiceInstanceGUID string `json:"service_instance_guid"`
+	ServiceInstanceName string `json:"service_instance_name"`
+	ServiceInstanceType string `json:"service_instance_type"`
+	ServicePlanGUID     string `json:"service_plan_guid"`
+	ServicePlanName     string `json:"service_plan_name"`
+	ServiceOfferingGUID string `json:"service_offering_guid"`
+	ServiceOfferingName string `json:"service_offering_name"`
+	ServiceBrokerGUID   string `json:"service_broker_guid"`
+	ServiceBrokerName   string `json:"service_broker_name"`
+	SpaceGUID           string `json:"space_guid"`
+	SpaceName           string `json:"space_name"`
+	OrganizationGUID    string `json:"organization_guid"`
+}
+
+func newServiceUsageEventRecord(event resources.ServiceUsageEvent) serviceUsageEventRecord {
+	return serviceUsageEventRecord{
+		GUID:                event.GUID,
+		CreatedAt:           event.CreatedAt.UTC().Format(time.RFC3339),
+		State:               event.State,
+		ServiceInstanceGUID: event.ServiceInstanceGUID,
+		ServiceInstanceName: event.ServiceInstanceName,
+		ServiceInstanceType: event.ServiceInstanceType,
+		ServicePlanGUID:     event.ServicePlanGUID,
+		ServicePlanName:     event.ServicePlanName,
+		ServiceOfferingGUID: event.ServiceOfferingGUID,
+		ServiceOfferingName: event.ServiceOfferingName,
+		ServiceBrokerGUID:   event.ServiceBrokerGUID,
+		ServiceBrokerName:   event.ServiceBrokerName,
+		SpaceGUID:           event.SpaceGUID,
+		SpaceName:           event.SpaceName,
+		OrganizationGUID:    event.OrganizationGUID,
+	}
+}
+
+func (record serviceUsageEventRecord) guid() string {
+	return record.GUID
+}
+
+func (serviceUsageEventRecord) csvHeader() []string {
+	return []string{
+		"guid", "created_at", "state",
+		"service_instance_guid", "service_instance_name", "service_instance_type",
+		"service_plan_guid", "service_plan_name", "service_offering_guid", "service_offering_name",
+		"service_broker_guid", "service_broker_name", "space_guid", "space_name", "organization_guid",
+	}
+}
+
+func (record serviceUsageEventRecord) csvRecord() []string {
+	return []string{
+		record.GUID, record.CreatedAt, record.State,
+		record.ServiceInstanceGUID, record.ServiceInstanceName, record.ServiceInstanceType,
+		record.ServicePlanGUID, record.ServicePlanName, record.ServiceOfferingGUID, record.ServiceOfferingName,
+		record.ServiceBrokerGUID, record.ServiceBrokerName, record.SpaceGUID, record.SpaceName, record.OrganizationGUID,
+	}
+}
+
+func writeCSV(out io.Writer, header []string, records []usageEventRecord, includeHeader bool) error {
+	writer := csv.NewWriter(out)
+	if includeHeader {
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+	}
+	for _, record := range records {
+		if err := writer.Write(record.csvRecord()); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeNDJSON(out io.Writer, records []usageEventRecord) error {
+	encoder := json.NewEncoder(out)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readCheckpoint(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(contents)), nil
+}
+
+// writeCheckpoint replaces the checkpoint file in one step so that an
+// interrupted run never leaves a truncated GUID behind.
+func writeCheckpoint(path string, guid string) error {
+	tempFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.WriteString(guid + "\n")
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), path)
+}
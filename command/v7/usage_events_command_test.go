@@ -0,0 +1,275 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("usage-events Command", func() {
+	var (
+		cmd             UsageEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		tempDir         string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = UsageEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.UsageEventsArgs{EventType: "app"},
+			Format:       "csv",
+		}
+
+		var err error
+		tempDir, err = os.MkdirTemp("", "usage-events")
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		createdAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		fakeActor.GetAppUsageEventsReturns(
+			[]resources.AppUsageEvent{
+				{
+					GUID:                  "app-event-guid-1",
+					CreatedAt:             createdAt,
+					State:                 "STARTED",
+					PreviousState:         "STOPPED",
+					AppGUID:               "app-guid",
+					AppName:               "dora",
+					ProcessType:           "web",
+					InstanceCount:         2,
+					PreviousInstanceCount: 1,
+					MemoryInMBPerInstance: 256,
+				},
+				{
+					GUID:      "app-event-guid-2",
+					CreatedAt: createdAt.Add(time.Minute),
+					State:     "STOPPED",
+					AppName:   "dora, the explorer",
+				},
+			},
+			v7action.Warnings{"app-usage-warning"},
+			nil,
+		)
+		fakeActor.GetServiceUsageEventsReturns(
+			[]resources.ServiceUsageEvent{
+				{
+					GUID:                "service-event-guid-1",
+					CreatedAt:           createdAt,
+					State:               "CREATED",
+					ServiceInstanceName: "my-db",
+					ServicePlanName:     "small",
+				},
+			},
+			v7action.Warnings{"service-usage-warning"},
+			nil,
+		)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the type is not app or service", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.EventType = "route"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "TYPE",
+				ExpectedType: "app or service",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--after-guid and --checkpoint-file are both provided", func() {
+		BeforeEach(func() {
+			cmd.AfterGUID = "some-guid"
+			cmd.CheckpointFile = filepath.Join(tempDir, "checkpoint")
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--after-guid", "--checkpoint-file"},
+			}))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("exporting app usage events as CSV", func() {
+		BeforeEach(func() {
+			cmd.AfterGUID = "some-guid"
+		})
+
+		It("writes the CSV to standard out", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.GetAppUsageEventsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetAppUsageEventsArgsForCall(0)).To(Equal("some-guid"))
+
+			Expect(testUI.Out).To(Say(`guid,created_at,state,previous_state,app_guid,app_name,process_guid,process_type,task_guid,task_name,space_guid,space_name,organization_guid,buildpack_guid,buildpack_name,instance_count,previous_instance_count,memory_in_mb_per_instance,previous_memory_in_mb_per_instance\n`))
+			Expect(testUI.Out).To(Say(`app-event-guid-1,2026-10-01T12:00:00Z,STARTED,STOPPED,app-guid,dora,,web,,,,,,,,2,1,256,0\n`))
+			Expect(testUI.Out).To(Say(`app-event-guid-2,2026-10-01T12:01:00Z,STOPPED,,,"dora, the explorer",,,,,,,,,,0,0,0,0\n`))
+			Expect(testUI.Err).To(Say("app-usage-warning"))
+		})
+	})
+
+	When("exporting service usage events as NDJSON", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.EventType = "service"
+			cmd.Format = "ndjson"
+		})
+
+		It("writes one JSON object per line to standard out", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeActor.GetServiceUsageEventsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetServiceUsageEventsArgsForCall(0)).To(Equal(""))
+
+			Expect(testUI.Out).To(Say(`\{"guid":"service-event-guid-1","created_at":"2026-10-01T12:00:00Z","state":"CREATED","service_instance_guid":"","service_instance_name":"my-db",.*"service_plan_name":"small",.*\}\n`))
+			Expect(testUI.Err).To(Say("service-usage-warning"))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			cmd.CheckpointFile = filepath.Join(tempDir, "checkpoint")
+			fakeActor.GetAppUsageEventsReturns(nil, v7action.Warnings{"app-usage-warning"}, errors.New("get-events-error"))
+		})
+
+		It("returns the error and does not write a checkpoint", func() {
+			Expect(executeErr).To(MatchError("get-events-error"))
+			Expect(testUI.Err).To(Say("app-usage-warning"))
+			Expect(filepath.Join(tempDir, "checkpoint")).NotTo(BeAnExistingFile())
+		})
+	})
+
+	When("--path is provided", func() {
+		var exportPath string
+
+		BeforeEach(func() {
+			exportPath = filepath.Join(tempDir, "export.csv")
+			cmd.Path = exportPath
+		})
+
+		It("writes the events to the file and reports progress", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Exporting app usage events to %s as some-user\.\.\.`, regexp.QuoteMeta(exportPath)))
+			Expect(testUI.Out).To(Say(`Exported 2 events\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			contents, err := os.ReadFile(exportPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(HavePrefix("guid,created_at,"))
+			Expect(string(contents)).To(ContainSubstring("app-event-guid-2"))
+		})
+
+		When("the file already has events in it", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(exportPath, []byte("guid,created_at\nold-event,2026-09-01T00:00:00Z\n"), 0600)).To(Succeed())
+			})
+
+			It("appends the events without repeating the header", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+
+				contents, err := os.ReadFile(exportPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(HavePrefix("guid,created_at\nold-event,2026-09-01T00:00:00Z\napp-event-guid-1,"))
+			})
+		})
+	})
+
+	When("--checkpoint-file is provided", func() {
+		var checkpointPath string
+
+		BeforeEach(func() {
+			checkpointPath = filepath.Join(tempDir, "checkpoint")
+			cmd.CheckpointFile = checkpointPath
+		})
+
+		When("the checkpoint file does not exist", func() {
+			It("exports from the beginning and records the last event", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetAppUsageEventsArgsForCall(0)).To(Equal(""))
+
+				contents, err := os.ReadFile(checkpointPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("app-event-guid-2\n"))
+			})
+		})
+
+		When("the checkpoint file exists", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(checkpointPath, []byte("previous-guid\n"), 0600)).To(Succeed())
+			})
+
+			It("resumes after the stored GUID", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetAppUsageEventsArgsForCall(0)).To(Equal("previous-guid"))
+			})
+
+			When("there are no new events", func() {
+				BeforeEach(func() {
+					fakeActor.GetAppUsageEventsReturns(nil, nil, nil)
+				})
+
+				It("leaves the checkpoint unchanged", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					contents, err := os.ReadFile(checkpointPath)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(contents)).To(Equal("previous-guid\n"))
+				})
+			})
+		})
+	})
+})
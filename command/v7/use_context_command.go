@@ -0,0 +1,48 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type UseContextCommand struct {
+	UI              command.UI
+	Config          command.Config
+	RequiredArgs    flag.ContextArgs `positional-args:"yes"`
+	Create          bool             `long:"create" description:"Create an empty context with this name if it does not exist"`
+	usage           interface{}      `usage:"CF_NAME use-context CONTEXT_NAME [--create]\n\n   Contexts hold an API endpoint, its tokens and SSL settings, and the targeted org and space.\n   To run a single command against another context, use the global '--context' flag.\n\nEXAMPLES:\n   CF_NAME use-context staging --create\n   CF_NAME api https://api.staging.example.com\n   CF_NAME login\n\n   CF_NAME use-context production\n\n   CF_NAME apps --context staging"`
+	relatedCommands interface{}      `related_commands:"api, contexts, login, target"`
+}
+
+func (cmd *UseContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	return nil
+}
+
+func (cmd UseContextCommand) Execute(args []string) error {
+	name := cmd.RequiredArgs.Name
+
+	cmd.UI.DisplayTextWithFlavor("Switching to context {{.ContextName}}...", map[string]interface{}{
+		"ContextName": name,
+	})
+
+	err := cmd.Config.SwitchContext(name)
+	if _, notFound := err.(translatableerror.ContextNotFoundError); notFound && cmd.Create {
+		cmd.Config.CreateContext(name)
+	} else if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
+		{cmd.UI.TranslateText("org:"), cmd.Config.TargetedOrganizationName()},
+		{cmd.UI.TranslateText("space:"), cmd.Config.TargetedSpace().Name},
+	}, 3)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("use-context Command", func() {
+	var (
+		cmd        UseContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = UseContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Name = "staging"
+
+		fakeConfig.TargetReturns("https://api.staging.example.com")
+		fakeConfig.TargetedOrganizationNameReturns("staging-org")
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "staging-space"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("switches to the context and displays its target", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeConfig.SwitchContextCallCount()).To(Equal(1))
+		Expect(fakeConfig.SwitchContextArgsForCall(0)).To(Equal("staging"))
+		Expect(fakeConfig.CreateContextCallCount()).To(Equal(0))
+
+		Expect(testUI.Out).To(Say(`Switching to context staging\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`API endpoint:\s+https://api.staging.example.com`))
+		Expect(testUI.Out).To(Say(`org:\s+staging-org`))
+		Expect(testUI.Out).To(Say(`space:\s+staging-space`))
+	})
+
+	When("the context does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.SwitchContextReturns(translatableerror.ContextNotFoundError{Name: "staging"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextNotFoundError{Name: "staging"}))
+			Expect(fakeConfig.CreateContextCallCount()).To(Equal(0))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+
+		When("--create is given", func() {
+			BeforeEach(func() {
+				cmd.Create = true
+			})
+
+			It("creates the context", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConfig.CreateContextCallCount()).To(Equal(1))
+				Expect(fakeConfig.CreateContextArgsForCall(0)).To(Equal("staging"))
+				Expect(testUI.Out).To(Say("OK"))
+			})
+		})
+	})
+})
@@ -18,6 +18,7 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifestparser"
 	"github.com/SermoDigital/jose/jwt"
 )
 
@@ -78,6 +79,29 @@ type FakeActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateWithAuthorizationCodeStub        func(string, func(authorizationURL string)) error
+	authenticateWithAuthorizationCodeMutex       sync.RWMutex
+	authenticateWithAuthorizationCodeArgsForCall []struct {
+		arg1 string
+		arg2 func(authorizationURL string)
+	}
+	authenticateWithAuthorizationCodeReturns struct {
+		result1 error
+	}
+	authenticateWithAuthorizationCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthenticateWithDeviceCodeStub        func(v7action.DeviceAuthorization) error
+	authenticateWithDeviceCodeMutex       sync.RWMutex
+	authenticateWithDeviceCodeArgsForCall []struct {
+		arg1 v7action.DeviceAuthorization
+	}
+	authenticateWithDeviceCodeReturns struct {
+		result1 error
+	}
+	authenticateWithDeviceCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	BindSecurityGroupToSpacesStub        func(string, []resources.Space, constanta.SecurityGroupLifecycle) (v7action.Warnings, error)
 	bindSecurityGroupToSpacesMutex       sync.RWMutex
 	bindSecurityGroupToSpacesArgsForCall []struct {
@@ -128,6 +152,19 @@ type FakeActor struct {
 	clearTargetMutex       sync.RWMutex
 	clearTargetArgsForCall []struct {
 	}
+	ContinueDeploymentStub        func(string) (v7action.Warnings, error)
+	continueDeploymentMutex       sync.RWMutex
+	continueDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	continueDeploymentReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	continueDeploymentReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	CopyPackageStub        func(resources.Application, resources.Application) (resources.Package, v7action.Warnings, error)
 	copyPackageMutex       sync.RWMutex
 	copyPackageArgsForCall []struct {
@@ -192,6 +229,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateApplicationSidecarStub        func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)
+	createApplicationSidecarMutex       sync.RWMutex
+	createApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}
+	createApplicationSidecarReturns struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	createApplicationSidecarReturnsOnCall map[int]struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateBitsPackageByApplicationStub        func(string) (resources.Package, v7action.Warnings, error)
 	createBitsPackageByApplicationMutex       sync.RWMutex
 	createBitsPackageByApplicationArgsForCall []struct {
@@ -222,11 +276,12 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	CreateDeploymentByApplicationAndDropletStub        func(string, string) (string, v7action.Warnings, error)
+	CreateDeploymentByApplicationAndDropletStub        func(string, string, constanta.DeploymentStrategy) (string, v7action.Warnings, error)
 	createDeploymentByApplicationAndDropletMutex       sync.RWMutex
 	createDeploymentByApplicationAndDropletArgsForCall []struct {
 		arg1 string
 		arg2 string
+		arg3 constanta.DeploymentStrategy
 	}
 	createDeploymentByApplicationAndDropletReturns struct {
 		result1 string
@@ -238,11 +293,12 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	CreateDeploymentByApplicationAndRevisionStub        func(string, string) (string, v7action.Warnings, error)
+	CreateDeploymentByApplicationAndRevisionStub        func(string, string, constanta.DeploymentStrategy) (string, v7action.Warnings, error)
 	createDeploymentByApplicationAndRevisionMutex       sync.RWMutex
 	createDeploymentByApplicationAndRevisionArgsForCall []struct {
 		arg1 string
 		arg2 string
+		arg3 constanta.DeploymentStrategy
 	}
 	createDeploymentByApplicationAndRevisionReturns struct {
 		result1 string
@@ -375,7 +431,7 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
-	CreateRouteStub        func(string, string, string, string, int) (resources.Route, v7action.Warnings, error)
+	CreateRouteStub        func(string, string, string, string, int, map[string]*string) (resources.Route, v7action.Warnings, error)
 	createRouteMutex       sync.RWMutex
 	createRouteArgsForCall []struct {
 		arg1 string
@@ -383,6 +439,7 @@ type FakeActor struct {
 		arg3 string
 		arg4 string
 		arg5 int
+		arg6 map[string]*string
 	}
 	createRouteReturns struct {
 		result1 resources.Route
@@ -575,6 +632,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteApplicationSidecarStub        func(string, string, string) (v7action.Warnings, error)
+	deleteApplicationSidecarMutex       sync.RWMutex
+	deleteApplicationSidecarArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteApplicationSidecarReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteApplicationSidecarReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteBuildpackByNameAndStackStub        func(string, string) (v7action.Warnings, error)
 	deleteBuildpackByNameAndStackMutex       sync.RWMutex
 	deleteBuildpackByNameAndStackArgsForCall []struct {
@@ -1024,6 +1096,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAppUsageEventsStub        func(string) ([]resources.AppUsageEvent, v7action.Warnings, error)
+	getAppUsageEventsMutex       sync.RWMutex
+	getAppUsageEventsArgsForCall []struct {
+		arg1 string
+	}
+	getAppUsageEventsReturns struct {
+		result1 []resources.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppUsageEventsReturnsOnCall map[int]struct {
+		result1 []resources.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getApplicationAnnotationsMutex       sync.RWMutex
+	getApplicationAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -1149,6 +1252,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationSidecarsStub        func(string, string) ([]resources.Sidecar, v7action.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -1181,6 +1300,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAuditEventsStub        func(v7action.AuditEventFilter) ([]v7action.AuditEvent, v7action.Warnings, error)
+	getAuditEventsMutex       sync.RWMutex
+	getAuditEventsArgsForCall []struct {
+		arg1 v7action.AuditEventFilter
+	}
+	getAuditEventsReturns struct {
+		result1 []v7action.AuditEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAuditEventsReturnsOnCall map[int]struct {
+		result1 []v7action.AuditEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetBuildpackAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getBuildpackAnnotationsMutex       sync.RWMutex
+	getBuildpackAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getBuildpackAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getBuildpackAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetBuildpackLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getBuildpackLabelsMutex       sync.RWMutex
 	getBuildpackLabelsArgsForCall []struct {
@@ -1271,6 +1421,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDomainAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getDomainAnnotationsMutex       sync.RWMutex
+	getDomainAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getDomainAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDomainAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDomainByNameStub        func(string) (resources.Domain, v7action.Warnings, error)
 	getDomainByNameMutex       sync.RWMutex
 	getDomainByNameArgsForCall []struct {
@@ -1377,6 +1542,46 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetFilteredRecentLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)
+	getFilteredRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}
+	getFilteredRecentLogsForApplicationByNameAndSpaceReturns struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetFilteredStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	getFilteredStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}
+	getFilteredStreamingLogsForApplicationByNameAndSpaceReturns struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
+	getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
 	GetGlobalRunningSecurityGroupsStub        func() ([]resources.SecurityGroup, v7action.Warnings, error)
 	getGlobalRunningSecurityGroupsMutex       sync.RWMutex
 	getGlobalRunningSecurityGroupsArgsForCall []struct {
@@ -1506,6 +1711,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetOrganizationAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getOrganizationAnnotationsMutex       sync.RWMutex
+	getOrganizationAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetOrganizationByNameStub        func(string) (resources.Organization, v7action.Warnings, error)
 	getOrganizationByNameMutex       sync.RWMutex
 	getOrganizationByNameArgsForCall []struct {
@@ -1707,6 +1927,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRecentLogsForTaskStub        func(string, resources.Task, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error)
+	getRecentLogsForTaskMutex       sync.RWMutex
+	getRecentLogsForTaskArgsForCall []struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}
+	getRecentLogsForTaskReturns struct {
+		result1 []sharedaction.LogMessage
+		result2 error
+	}
+	getRecentLogsForTaskReturnsOnCall map[int]struct {
+		result1 []sharedaction.LogMessage
+		result2 error
+	}
 	GetRevisionByApplicationAndVersionStub        func(string, int) (resources.Revision, v7action.Warnings, error)
 	getRevisionByApplicationAndVersionMutex       sync.RWMutex
 	getRevisionByApplicationAndVersionArgsForCall []struct {
@@ -1753,6 +1988,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouteAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getRouteAnnotationsMutex       sync.RWMutex
+	getRouteAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRouteAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -1903,6 +2154,20 @@ type FakeActor struct {
 		result1 string
 		result2 error
 	}
+	GetScheduledTasksStub        func(string, manifestparser.Manifest) ([]v7action.ScheduledTask, error)
+	getScheduledTasksMutex       sync.RWMutex
+	getScheduledTasksArgsForCall []struct {
+		arg1 string
+		arg2 manifestparser.Manifest
+	}
+	getScheduledTasksReturns struct {
+		result1 []v7action.ScheduledTask
+		result2 error
+	}
+	getScheduledTasksReturnsOnCall map[int]struct {
+		result1 []v7action.ScheduledTask
+		result2 error
+	}
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexStub        func(string, string, string, uint) (v7action.SSHAuthentication, v7action.Warnings, error)
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex       sync.RWMutex
 	getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall []struct {
@@ -1921,6 +2186,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub        func(string, string, string, []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)
+	getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex       sync.RWMutex
+	getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 []uint
+	}
+	getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall map[int]struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSecurityGroupStub        func(string) (resources.SecurityGroup, v7action.Warnings, error)
 	getSecurityGroupMutex       sync.RWMutex
 	getSecurityGroupArgsForCall []struct {
@@ -1982,6 +2265,38 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceAppBindingByServiceInstanceAndAppStub        func(string, string, string) (resources.ServiceCredentialBinding, v7action.Warnings, error)
+	getServiceAppBindingByServiceInstanceAndAppMutex       sync.RWMutex
+	getServiceAppBindingByServiceInstanceAndAppArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getServiceAppBindingByServiceInstanceAndAppReturns struct {
+		result1 resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceAppBindingByServiceInstanceAndAppReturnsOnCall map[int]struct {
+		result1 resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceBrokerAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getServiceBrokerAnnotationsMutex       sync.RWMutex
+	getServiceBrokerAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getServiceBrokerAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceBrokerAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceBrokerByNameStub        func(string) (resources.ServiceBroker, v7action.Warnings, error)
 	getServiceBrokerByNameMutex       sync.RWMutex
 	getServiceBrokerByNameArgsForCall []struct {
@@ -2026,6 +2341,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceCredentialBindingParametersStub        func(string) (types.OptionalObject, v7action.Warnings, error)
+	getServiceCredentialBindingParametersMutex       sync.RWMutex
+	getServiceCredentialBindingParametersArgsForCall []struct {
+		arg1 string
+	}
+	getServiceCredentialBindingParametersReturns struct {
+		result1 types.OptionalObject
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceCredentialBindingParametersReturnsOnCall map[int]struct {
+		result1 types.OptionalObject
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceInstanceAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getServiceInstanceAnnotationsMutex       sync.RWMutex
+	getServiceInstanceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceInstanceAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceInstanceByNameAndSpaceStub        func(string, string) (resources.ServiceInstance, v7action.Warnings, error)
 	getServiceInstanceByNameAndSpaceMutex       sync.RWMutex
 	getServiceInstanceByNameAndSpaceArgsForCall []struct {
@@ -2157,6 +2503,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceOfferingAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getServiceOfferingAnnotationsMutex       sync.RWMutex
+	getServiceOfferingAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceOfferingAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceOfferingAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceOfferingLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getServiceOfferingLabelsMutex       sync.RWMutex
 	getServiceOfferingLabelsArgsForCall []struct {
@@ -2173,6 +2535,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServicePlanAnnotationsStub        func(string, string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getServicePlanAnnotationsMutex       sync.RWMutex
+	getServicePlanAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getServicePlanAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServicePlanAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServicePlanByNameOfferingAndBrokerStub        func(string, string, string) (resources.ServicePlan, v7action.Warnings, error)
 	getServicePlanByNameOfferingAndBrokerMutex       sync.RWMutex
 	getServicePlanByNameOfferingAndBrokerArgsForCall []struct {
@@ -2207,6 +2586,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceUsageEventsStub        func(string) ([]resources.ServiceUsageEvent, v7action.Warnings, error)
+	getServiceUsageEventsMutex       sync.RWMutex
+	getServiceUsageEventsArgsForCall []struct {
+		arg1 string
+	}
+	getServiceUsageEventsReturns struct {
+		result1 []resources.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceUsageEventsReturnsOnCall map[int]struct {
+		result1 []resources.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetSpaceAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getSpaceAnnotationsMutex       sync.RWMutex
+	getSpaceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSpaceAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSpaceByNameAndOrganizationStub        func(string, string) (resources.Space, v7action.Warnings, error)
 	getSpaceByNameAndOrganizationMutex       sync.RWMutex
 	getSpaceByNameAndOrganizationArgsForCall []struct {
@@ -2318,12 +2728,27 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetStackByNameStub        func(string) (resources.Stack, v7action.Warnings, error)
-	getStackByNameMutex       sync.RWMutex
-	getStackByNameArgsForCall []struct {
+	GetStackAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getStackAnnotationsMutex       sync.RWMutex
+	getStackAnnotationsArgsForCall []struct {
 		arg1 string
 	}
-	getStackByNameReturns struct {
+	getStackAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getStackAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetStackByNameStub        func(string) (resources.Stack, v7action.Warnings, error)
+	getStackByNameMutex       sync.RWMutex
+	getStackByNameArgsForCall []struct {
+		arg1 string
+	}
+	getStackByNameReturns struct {
 		result1 resources.Stack
 		result2 v7action.Warnings
 		result3 error
@@ -2384,6 +2809,61 @@ type FakeActor struct {
 		result4 v7action.Warnings
 		result5 error
 	}
+	GetStreamingLogsForTaskStub        func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc)
+	getStreamingLogsForTaskMutex       sync.RWMutex
+	getStreamingLogsForTaskArgsForCall []struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}
+	getStreamingLogsForTaskReturns struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
+	getStreamingLogsForTaskReturnsOnCall map[int]struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}
+	GetStreamingMetricsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient, time.Duration) (<-chan []sharedaction.InstanceMetrics, <-chan error, context.CancelFunc, v7action.Warnings, error)
+	getStreamingMetricsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getStreamingMetricsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 time.Duration
+	}
+	getStreamingMetricsForApplicationByNameAndSpaceReturns struct {
+		result1 <-chan []sharedaction.InstanceMetrics
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
+	getStreamingMetricsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan []sharedaction.InstanceMetrics
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}
+	GetTaskByNameAndApplicationStub        func(string, string) (resources.Task, v7action.Warnings, error)
+	getTaskByNameAndApplicationMutex       sync.RWMutex
+	getTaskByNameAndApplicationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getTaskByNameAndApplicationReturns struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
+	getTaskByNameAndApplicationReturnsOnCall map[int]struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetTaskBySequenceIDAndApplicationStub        func(int, string) (resources.Task, v7action.Warnings, error)
 	getTaskBySequenceIDAndApplicationMutex       sync.RWMutex
 	getTaskBySequenceIDAndApplicationArgsForCall []struct {
@@ -2563,19 +3043,19 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
-	PollStartForRollingStub        func(resources.Application, string, bool, func(string)) (v7action.Warnings, error)
-	pollStartForRollingMutex       sync.RWMutex
-	pollStartForRollingArgsForCall []struct {
+	PollStartForDeploymentStub        func(resources.Application, string, bool, func(string)) (v7action.Warnings, error)
+	pollStartForDeploymentMutex       sync.RWMutex
+	pollStartForDeploymentArgsForCall []struct {
 		arg1 resources.Application
 		arg2 string
 		arg3 bool
 		arg4 func(string)
 	}
-	pollStartForRollingReturns struct {
+	pollStartForDeploymentReturns struct {
 		result1 v7action.Warnings
 		result2 error
 	}
-	pollStartForRollingReturnsOnCall map[int]struct {
+	pollStartForDeploymentReturnsOnCall map[int]struct {
 		result1 v7action.Warnings
 		result2 error
 	}
@@ -2650,6 +3130,24 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	QueryMetricsForApplicationByNameAndSpaceStub        func(string, string, string, sharedaction.LogCacheClient) ([]sharedaction.MetricSample, v7action.Warnings, error)
+	queryMetricsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	queryMetricsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 sharedaction.LogCacheClient
+	}
+	queryMetricsForApplicationByNameAndSpaceReturns struct {
+		result1 []sharedaction.MetricSample
+		result2 v7action.Warnings
+		result3 error
+	}
+	queryMetricsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []sharedaction.MetricSample
+		result2 v7action.Warnings
+		result3 error
+	}
 	RefreshAccessTokenStub        func() (string, error)
 	refreshAccessTokenMutex       sync.RWMutex
 	refreshAccessTokenArgsForCall []struct {
@@ -2795,6 +3293,21 @@ type FakeActor struct {
 	revokeAccessAndRefreshTokensReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RunScheduledTaskStub        func(v7action.ScheduledTask) (resources.Task, v7action.Warnings, error)
+	runScheduledTaskMutex       sync.RWMutex
+	runScheduledTaskArgsForCall []struct {
+		arg1 v7action.ScheduledTask
+	}
+	runScheduledTaskReturns struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
+	runScheduledTaskReturnsOnCall map[int]struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}
 	RunTaskStub        func(string, resources.Task) (resources.Task, v7action.Warnings, error)
 	runTaskMutex       sync.RWMutex
 	runTaskArgsForCall []struct {
@@ -2903,6 +3416,27 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub        func(string, string, constanta.HealthCheckType, string, string, int64, int64) (resources.Application, v7action.Warnings, error)
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex       sync.RWMutex
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 constanta.HealthCheckType
+		arg4 string
+		arg5 string
+		arg6 int64
+		arg7 int64
+	}
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
 	SetEnvironmentVariableByApplicationNameAndSpaceStub        func(string, string, v7action.EnvironmentVariablePair) (v7action.Warnings, error)
 	setEnvironmentVariableByApplicationNameAndSpaceMutex       sync.RWMutex
 	setEnvironmentVariableByApplicationNameAndSpaceArgsForCall []struct {
@@ -3062,6 +3596,18 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	StartDeviceAuthorizationStub        func() (v7action.DeviceAuthorization, error)
+	startDeviceAuthorizationMutex       sync.RWMutex
+	startDeviceAuthorizationArgsForCall []struct {
+	}
+	startDeviceAuthorizationReturns struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}
+	startDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}
 	StopApplicationStub        func(string) (v7action.Warnings, error)
 	stopApplicationMutex       sync.RWMutex
 	stopApplicationArgsForCall []struct {
@@ -3075,6 +3621,24 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	StreamAuditEventsStub        func(v7action.AuditEventFilter, time.Duration) (<-chan v7action.AuditEvent, <-chan v7action.Warnings, <-chan error, context.CancelFunc)
+	streamAuditEventsMutex       sync.RWMutex
+	streamAuditEventsArgsForCall []struct {
+		arg1 v7action.AuditEventFilter
+		arg2 time.Duration
+	}
+	streamAuditEventsReturns struct {
+		result1 <-chan v7action.AuditEvent
+		result2 <-chan v7action.Warnings
+		result3 <-chan error
+		result4 context.CancelFunc
+	}
+	streamAuditEventsReturnsOnCall map[int]struct {
+		result1 <-chan v7action.AuditEvent
+		result2 <-chan v7action.Warnings
+		result3 <-chan error
+		result4 context.CancelFunc
+	}
 	TerminateTaskStub        func(string) (resources.Task, v7action.Warnings, error)
 	terminateTaskMutex       sync.RWMutex
 	terminateTaskArgsForCall []struct {
@@ -3224,6 +3788,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	UpdateApplicationAnnotationsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateApplicationAnnotationsByApplicationNameMutex       sync.RWMutex
+	updateApplicationAnnotationsByApplicationNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateApplicationAnnotationsByApplicationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateApplicationAnnotationsByApplicationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateApplicationLabelsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateApplicationLabelsByApplicationNameMutex       sync.RWMutex
 	updateApplicationLabelsByApplicationNameArgsForCall []struct {
@@ -3239,6 +3818,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateBuildpackAnnotationsByBuildpackNameAndStackStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateBuildpackAnnotationsByBuildpackNameAndStackMutex       sync.RWMutex
+	updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateBuildpackByNameAndStackStub        func(string, string, resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	updateBuildpackByNameAndStackMutex       sync.RWMutex
 	updateBuildpackByNameAndStackArgsForCall []struct {
@@ -3286,6 +3880,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateDomainAnnotationsByDomainNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateDomainAnnotationsByDomainNameMutex       sync.RWMutex
+	updateDomainAnnotationsByDomainNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateDomainAnnotationsByDomainNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateDomainAnnotationsByDomainNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateDomainLabelsByDomainNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateDomainLabelsByDomainNameMutex       sync.RWMutex
 	updateDomainLabelsByDomainNameArgsForCall []struct {
@@ -3315,6 +3923,20 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	UpdateOrganizationAnnotationsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateOrganizationAnnotationsByOrganizationNameMutex       sync.RWMutex
+	updateOrganizationAnnotationsByOrganizationNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateOrganizationLabelsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateOrganizationLabelsByOrganizationNameMutex       sync.RWMutex
 	updateOrganizationLabelsByOrganizationNameArgsForCall []struct {
@@ -3359,6 +3981,37 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateRouteStub        func(string, map[string]*string) (resources.Route, v7action.Warnings, error)
+	updateRouteMutex       sync.RWMutex
+	updateRouteArgsForCall []struct {
+		arg1 string
+		arg2 map[string]*string
+	}
+	updateRouteReturns struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateRouteReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	UpdateRouteAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateRouteAnnotationsMutex       sync.RWMutex
+	updateRouteAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateRouteAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateRouteAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateRouteLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateRouteLabelsMutex       sync.RWMutex
 	updateRouteLabelsArgsForCall []struct {
@@ -3417,6 +4070,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceBrokerAnnotationsByServiceBrokerNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceBrokerAnnotationsByServiceBrokerNameMutex       sync.RWMutex
+	updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateServiceBrokerAnnotationsByServiceBrokerNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceBrokerLabelsByServiceBrokerNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceBrokerLabelsByServiceBrokerNameMutex       sync.RWMutex
 	updateServiceBrokerLabelsByServiceBrokerNameArgsForCall []struct {
@@ -3431,6 +4098,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceInstanceAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceInstanceAnnotationsMutex       sync.RWMutex
+	updateServiceInstanceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateServiceInstanceAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceInstanceAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceInstanceLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceInstanceLabelsMutex       sync.RWMutex
 	updateServiceInstanceLabelsArgsForCall []struct {
@@ -3446,6 +4128,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceOfferingAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceOfferingAnnotationsMutex       sync.RWMutex
+	updateServiceOfferingAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateServiceOfferingAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceOfferingAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceOfferingLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceOfferingLabelsMutex       sync.RWMutex
 	updateServiceOfferingLabelsArgsForCall []struct {
@@ -3461,6 +4158,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServicePlanAnnotationsStub        func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServicePlanAnnotationsMutex       sync.RWMutex
+	updateServicePlanAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}
+	updateServicePlanAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServicePlanAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServicePlanLabelsStub        func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServicePlanLabelsMutex       sync.RWMutex
 	updateServicePlanLabelsArgsForCall []struct {
@@ -3477,6 +4190,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateSpaceAnnotationsBySpaceNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateSpaceAnnotationsBySpaceNameMutex       sync.RWMutex
+	updateSpaceAnnotationsBySpaceNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateSpaceAnnotationsBySpaceNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateSpaceAnnotationsBySpaceNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateSpaceFeatureStub        func(string, string, bool, string) (v7action.Warnings, error)
 	updateSpaceFeatureMutex       sync.RWMutex
 	updateSpaceFeatureArgsForCall []struct {
@@ -3524,6 +4252,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateStackAnnotationsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateStackAnnotationsByStackNameMutex       sync.RWMutex
+	updateStackAnnotationsByStackNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateStackAnnotationsByStackNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateStackAnnotationsByStackNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateStackLabelsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateStackLabelsByStackNameMutex       sync.RWMutex
 	updateStackLabelsByStackNameArgsForCall []struct {
@@ -3633,6 +4375,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	WriteBitsPackageArchiveStub        func(string, string) (string, error)
+	writeBitsPackageArchiveMutex       sync.RWMutex
+	writeBitsPackageArchiveArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	writeBitsPackageArchiveReturns struct {
+		result1 string
+		result2 error
+	}
+	writeBitsPackageArchiveReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -3896,46 +4652,169 @@ func (fake *FakeActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeActor) BindSecurityGroupToSpaces(arg1 string, arg2 []resources.Space, arg3 constanta.SecurityGroupLifecycle) (v7action.Warnings, error) {
-	var arg2Copy []resources.Space
-	if arg2 != nil {
-		arg2Copy = make([]resources.Space, len(arg2))
-		copy(arg2Copy, arg2)
-	}
-	fake.bindSecurityGroupToSpacesMutex.Lock()
-	ret, specificReturn := fake.bindSecurityGroupToSpacesReturnsOnCall[len(fake.bindSecurityGroupToSpacesArgsForCall)]
-	fake.bindSecurityGroupToSpacesArgsForCall = append(fake.bindSecurityGroupToSpacesArgsForCall, struct {
+func (fake *FakeActor) AuthenticateWithAuthorizationCode(arg1 string, arg2 func(authorizationURL string)) error {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithAuthorizationCodeReturnsOnCall[len(fake.authenticateWithAuthorizationCodeArgsForCall)]
+	fake.authenticateWithAuthorizationCodeArgsForCall = append(fake.authenticateWithAuthorizationCodeArgsForCall, struct {
 		arg1 string
-		arg2 []resources.Space
-		arg3 constanta.SecurityGroupLifecycle
-	}{arg1, arg2Copy, arg3})
-	stub := fake.BindSecurityGroupToSpacesStub
-	fakeReturns := fake.bindSecurityGroupToSpacesReturns
-	fake.recordInvocation("BindSecurityGroupToSpaces", []interface{}{arg1, arg2Copy, arg3})
-	fake.bindSecurityGroupToSpacesMutex.Unlock()
+		arg2 func(authorizationURL string)
+	}{arg1, arg2})
+	stub := fake.AuthenticateWithAuthorizationCodeStub
+	fakeReturns := fake.authenticateWithAuthorizationCodeReturns
+	fake.recordInvocation("AuthenticateWithAuthorizationCode", []interface{}{arg1, arg2})
+	fake.authenticateWithAuthorizationCodeMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeActor) BindSecurityGroupToSpacesCallCount() int {
-	fake.bindSecurityGroupToSpacesMutex.RLock()
-	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
-	return len(fake.bindSecurityGroupToSpacesArgsForCall)
+func (fake *FakeActor) AuthenticateWithAuthorizationCodeCallCount() int {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	return len(fake.authenticateWithAuthorizationCodeArgsForCall)
 }
 
-func (fake *FakeActor) BindSecurityGroupToSpacesCalls(stub func(string, []resources.Space, constanta.SecurityGroupLifecycle) (v7action.Warnings, error)) {
-	fake.bindSecurityGroupToSpacesMutex.Lock()
-	defer fake.bindSecurityGroupToSpacesMutex.Unlock()
-	fake.BindSecurityGroupToSpacesStub = stub
+func (fake *FakeActor) AuthenticateWithAuthorizationCodeCalls(stub func(string, func(authorizationURL string)) error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = stub
 }
 
-func (fake *FakeActor) BindSecurityGroupToSpacesArgsForCall(i int) (string, []resources.Space, constanta.SecurityGroupLifecycle) {
-	fake.bindSecurityGroupToSpacesMutex.RLock()
+func (fake *FakeActor) AuthenticateWithAuthorizationCodeArgsForCall(i int) (string, func(authorizationURL string)) {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithAuthorizationCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) AuthenticateWithAuthorizationCodeReturns(result1 error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	fake.authenticateWithAuthorizationCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) AuthenticateWithAuthorizationCodeReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	if fake.authenticateWithAuthorizationCodeReturnsOnCall == nil {
+		fake.authenticateWithAuthorizationCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithAuthorizationCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCode(arg1 v7action.DeviceAuthorization) error {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceCodeReturnsOnCall[len(fake.authenticateWithDeviceCodeArgsForCall)]
+	fake.authenticateWithDeviceCodeArgsForCall = append(fake.authenticateWithDeviceCodeArgsForCall, struct {
+		arg1 v7action.DeviceAuthorization
+	}{arg1})
+	stub := fake.AuthenticateWithDeviceCodeStub
+	fakeReturns := fake.authenticateWithDeviceCodeReturns
+	fake.recordInvocation("AuthenticateWithDeviceCode", []interface{}{arg1})
+	fake.authenticateWithDeviceCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeCallCount() int {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	return len(fake.authenticateWithDeviceCodeArgsForCall)
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeCalls(stub func(v7action.DeviceAuthorization) error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = stub
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeArgsForCall(i int) v7action.DeviceAuthorization {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeReturns(result1 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	fake.authenticateWithDeviceCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) AuthenticateWithDeviceCodeReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	if fake.authenticateWithDeviceCodeReturnsOnCall == nil {
+		fake.authenticateWithDeviceCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithDeviceCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) BindSecurityGroupToSpaces(arg1 string, arg2 []resources.Space, arg3 constanta.SecurityGroupLifecycle) (v7action.Warnings, error) {
+	var arg2Copy []resources.Space
+	if arg2 != nil {
+		arg2Copy = make([]resources.Space, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.bindSecurityGroupToSpacesMutex.Lock()
+	ret, specificReturn := fake.bindSecurityGroupToSpacesReturnsOnCall[len(fake.bindSecurityGroupToSpacesArgsForCall)]
+	fake.bindSecurityGroupToSpacesArgsForCall = append(fake.bindSecurityGroupToSpacesArgsForCall, struct {
+		arg1 string
+		arg2 []resources.Space
+		arg3 constanta.SecurityGroupLifecycle
+	}{arg1, arg2Copy, arg3})
+	stub := fake.BindSecurityGroupToSpacesStub
+	fakeReturns := fake.bindSecurityGroupToSpacesReturns
+	fake.recordInvocation("BindSecurityGroupToSpaces", []interface{}{arg1, arg2Copy, arg3})
+	fake.bindSecurityGroupToSpacesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) BindSecurityGroupToSpacesCallCount() int {
+	fake.bindSecurityGroupToSpacesMutex.RLock()
+	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
+	return len(fake.bindSecurityGroupToSpacesArgsForCall)
+}
+
+func (fake *FakeActor) BindSecurityGroupToSpacesCalls(stub func(string, []resources.Space, constanta.SecurityGroupLifecycle) (v7action.Warnings, error)) {
+	fake.bindSecurityGroupToSpacesMutex.Lock()
+	defer fake.bindSecurityGroupToSpacesMutex.Unlock()
+	fake.BindSecurityGroupToSpacesStub = stub
+}
+
+func (fake *FakeActor) BindSecurityGroupToSpacesArgsForCall(i int) (string, []resources.Space, constanta.SecurityGroupLifecycle) {
+	fake.bindSecurityGroupToSpacesMutex.RLock()
 	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
 	argsForCall := fake.bindSecurityGroupToSpacesArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
@@ -4125,6 +5004,70 @@ func (fake *FakeActor) ClearTargetCalls(stub func()) {
 	fake.ClearTargetStub = stub
 }
 
+func (fake *FakeActor) ContinueDeployment(arg1 string) (v7action.Warnings, error) {
+	fake.continueDeploymentMutex.Lock()
+	ret, specificReturn := fake.continueDeploymentReturnsOnCall[len(fake.continueDeploymentArgsForCall)]
+	fake.continueDeploymentArgsForCall = append(fake.continueDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ContinueDeploymentStub
+	fakeReturns := fake.continueDeploymentReturns
+	fake.recordInvocation("ContinueDeployment", []interface{}{arg1})
+	fake.continueDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ContinueDeploymentCallCount() int {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	return len(fake.continueDeploymentArgsForCall)
+}
+
+func (fake *FakeActor) ContinueDeploymentCalls(stub func(string) (v7action.Warnings, error)) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = stub
+}
+
+func (fake *FakeActor) ContinueDeploymentArgsForCall(i int) string {
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
+	argsForCall := fake.continueDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ContinueDeploymentReturns(result1 v7action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	fake.continueDeploymentReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ContinueDeploymentReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.continueDeploymentMutex.Lock()
+	defer fake.continueDeploymentMutex.Unlock()
+	fake.ContinueDeploymentStub = nil
+	if fake.continueDeploymentReturnsOnCall == nil {
+		fake.continueDeploymentReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.continueDeploymentReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) CopyPackage(arg1 resources.Application, arg2 resources.Application) (resources.Package, v7action.Warnings, error) {
 	fake.copyPackageMutex.Lock()
 	ret, specificReturn := fake.copyPackageReturnsOnCall[len(fake.copyPackageArgsForCall)]
@@ -4397,6 +5340,75 @@ func (fake *FakeActor) CreateApplicationInSpaceReturnsOnCall(i int, result1 reso
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) CreateApplicationSidecar(arg1 string, arg2 string, arg3 resources.Sidecar) (resources.Sidecar, v7action.Warnings, error) {
+	fake.createApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.createApplicationSidecarReturnsOnCall[len(fake.createApplicationSidecarArgsForCall)]
+	fake.createApplicationSidecarArgsForCall = append(fake.createApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 resources.Sidecar
+	}{arg1, arg2, arg3})
+	stub := fake.CreateApplicationSidecarStub
+	fakeReturns := fake.createApplicationSidecarReturns
+	fake.recordInvocation("CreateApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.createApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCallCount() int {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	return len(fake.createApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) CreateApplicationSidecarCalls(stub func(string, string, resources.Sidecar) (resources.Sidecar, v7action.Warnings, error)) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) CreateApplicationSidecarArgsForCall(i int) (string, string, resources.Sidecar) {
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.createApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturns(result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	fake.createApplicationSidecarReturns = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CreateApplicationSidecarReturnsOnCall(i int, result1 resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.createApplicationSidecarMutex.Lock()
+	defer fake.createApplicationSidecarMutex.Unlock()
+	fake.CreateApplicationSidecarStub = nil
+	if fake.createApplicationSidecarReturnsOnCall == nil {
+		fake.createApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationSidecarReturnsOnCall[i] = struct {
+		result1 resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CreateBitsPackageByApplication(arg1 string) (resources.Package, v7action.Warnings, error) {
 	fake.createBitsPackageByApplicationMutex.Lock()
 	ret, specificReturn := fake.createBitsPackageByApplicationReturnsOnCall[len(fake.createBitsPackageByApplicationArgsForCall)]
@@ -4531,19 +5543,20 @@ func (fake *FakeActor) CreateBuildpackReturnsOnCall(i int, result1 resources.Bui
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndDroplet(arg1 string, arg2 string) (string, v7action.Warnings, error) {
+func (fake *FakeActor) CreateDeploymentByApplicationAndDroplet(arg1 string, arg2 string, arg3 constanta.DeploymentStrategy) (string, v7action.Warnings, error) {
 	fake.createDeploymentByApplicationAndDropletMutex.Lock()
 	ret, specificReturn := fake.createDeploymentByApplicationAndDropletReturnsOnCall[len(fake.createDeploymentByApplicationAndDropletArgsForCall)]
 	fake.createDeploymentByApplicationAndDropletArgsForCall = append(fake.createDeploymentByApplicationAndDropletArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
+		arg3 constanta.DeploymentStrategy
+	}{arg1, arg2, arg3})
 	stub := fake.CreateDeploymentByApplicationAndDropletStub
 	fakeReturns := fake.createDeploymentByApplicationAndDropletReturns
-	fake.recordInvocation("CreateDeploymentByApplicationAndDroplet", []interface{}{arg1, arg2})
+	fake.recordInvocation("CreateDeploymentByApplicationAndDroplet", []interface{}{arg1, arg2, arg3})
 	fake.createDeploymentByApplicationAndDropletMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -4557,17 +5570,17 @@ func (fake *FakeActor) CreateDeploymentByApplicationAndDropletCallCount() int {
 	return len(fake.createDeploymentByApplicationAndDropletArgsForCall)
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletCalls(stub func(string, string) (string, v7action.Warnings, error)) {
+func (fake *FakeActor) CreateDeploymentByApplicationAndDropletCalls(stub func(string, string, constanta.DeploymentStrategy) (string, v7action.Warnings, error)) {
 	fake.createDeploymentByApplicationAndDropletMutex.Lock()
 	defer fake.createDeploymentByApplicationAndDropletMutex.Unlock()
 	fake.CreateDeploymentByApplicationAndDropletStub = stub
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndDropletArgsForCall(i int) (string, string) {
+func (fake *FakeActor) CreateDeploymentByApplicationAndDropletArgsForCall(i int) (string, string, constanta.DeploymentStrategy) {
 	fake.createDeploymentByApplicationAndDropletMutex.RLock()
 	defer fake.createDeploymentByApplicationAndDropletMutex.RUnlock()
 	argsForCall := fake.createDeploymentByApplicationAndDropletArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeActor) CreateDeploymentByApplicationAndDropletReturns(result1 string, result2 v7action.Warnings, result3 error) {
@@ -4599,19 +5612,20 @@ func (fake *FakeActor) CreateDeploymentByApplicationAndDropletReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevision(arg1 string, arg2 string) (string, v7action.Warnings, error) {
+func (fake *FakeActor) CreateDeploymentByApplicationAndRevision(arg1 string, arg2 string, arg3 constanta.DeploymentStrategy) (string, v7action.Warnings, error) {
 	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
 	ret, specificReturn := fake.createDeploymentByApplicationAndRevisionReturnsOnCall[len(fake.createDeploymentByApplicationAndRevisionArgsForCall)]
 	fake.createDeploymentByApplicationAndRevisionArgsForCall = append(fake.createDeploymentByApplicationAndRevisionArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
+		arg3 constanta.DeploymentStrategy
+	}{arg1, arg2, arg3})
 	stub := fake.CreateDeploymentByApplicationAndRevisionStub
 	fakeReturns := fake.createDeploymentByApplicationAndRevisionReturns
-	fake.recordInvocation("CreateDeploymentByApplicationAndRevision", []interface{}{arg1, arg2})
+	fake.recordInvocation("CreateDeploymentByApplicationAndRevision", []interface{}{arg1, arg2, arg3})
 	fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -4625,17 +5639,17 @@ func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionCallCount() int {
 	return len(fake.createDeploymentByApplicationAndRevisionArgsForCall)
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionCalls(stub func(string, string) (string, v7action.Warnings, error)) {
+func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionCalls(stub func(string, string, constanta.DeploymentStrategy) (string, v7action.Warnings, error)) {
 	fake.createDeploymentByApplicationAndRevisionMutex.Lock()
 	defer fake.createDeploymentByApplicationAndRevisionMutex.Unlock()
 	fake.CreateDeploymentByApplicationAndRevisionStub = stub
 }
 
-func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionArgsForCall(i int) (string, string) {
+func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionArgsForCall(i int) (string, string, constanta.DeploymentStrategy) {
 	fake.createDeploymentByApplicationAndRevisionMutex.RLock()
 	defer fake.createDeploymentByApplicationAndRevisionMutex.RUnlock()
 	argsForCall := fake.createDeploymentByApplicationAndRevisionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeActor) CreateDeploymentByApplicationAndRevisionReturns(result1 string, result2 v7action.Warnings, result3 error) {
@@ -5200,7 +6214,7 @@ func (fake *FakeActor) CreatePrivateDomainReturnsOnCall(i int, result1 v7action.
 	}{result1, result2}
 }
 
-func (fake *FakeActor) CreateRoute(arg1 string, arg2 string, arg3 string, arg4 string, arg5 int) (resources.Route, v7action.Warnings, error) {
+func (fake *FakeActor) CreateRoute(arg1 string, arg2 string, arg3 string, arg4 string, arg5 int, arg6 map[string]*string) (resources.Route, v7action.Warnings, error) {
 	fake.createRouteMutex.Lock()
 	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
 	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
@@ -5209,13 +6223,14 @@ func (fake *FakeActor) CreateRoute(arg1 string, arg2 string, arg3 string, arg4 s
 		arg3 string
 		arg4 string
 		arg5 int
-	}{arg1, arg2, arg3, arg4, arg5})
+		arg6 map[string]*string
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.CreateRouteStub
 	fakeReturns := fake.createRouteReturns
-	fake.recordInvocation("CreateRoute", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("CreateRoute", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.createRouteMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -5229,17 +6244,17 @@ func (fake *FakeActor) CreateRouteCallCount() int {
 	return len(fake.createRouteArgsForCall)
 }
 
-func (fake *FakeActor) CreateRouteCalls(stub func(string, string, string, string, int) (resources.Route, v7action.Warnings, error)) {
+func (fake *FakeActor) CreateRouteCalls(stub func(string, string, string, string, int, map[string]*string) (resources.Route, v7action.Warnings, error)) {
 	fake.createRouteMutex.Lock()
 	defer fake.createRouteMutex.Unlock()
 	fake.CreateRouteStub = stub
 }
 
-func (fake *FakeActor) CreateRouteArgsForCall(i int) (string, string, string, string, int) {
+func (fake *FakeActor) CreateRouteArgsForCall(i int) (string, string, string, string, int, map[string]*string) {
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
 	argsForCall := fake.createRouteArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeActor) CreateRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
@@ -6069,6 +7084,72 @@ func (fake *FakeActor) DeleteApplicationByNameAndSpaceReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DeleteApplicationSidecar(arg1 string, arg2 string, arg3 string) (v7action.Warnings, error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationSidecarReturnsOnCall[len(fake.deleteApplicationSidecarArgsForCall)]
+	fake.deleteApplicationSidecarArgsForCall = append(fake.deleteApplicationSidecarArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteApplicationSidecarStub
+	fakeReturns := fake.deleteApplicationSidecarReturns
+	fake.recordInvocation("DeleteApplicationSidecar", []interface{}{arg1, arg2, arg3})
+	fake.deleteApplicationSidecarMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarCallCount() int {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	return len(fake.deleteApplicationSidecarArgsForCall)
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarCalls(stub func(string, string, string) (v7action.Warnings, error)) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = stub
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarArgsForCall(i int) (string, string, string) {
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
+	argsForCall := fake.deleteApplicationSidecarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	fake.deleteApplicationSidecarReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteApplicationSidecarReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationSidecarMutex.Lock()
+	defer fake.deleteApplicationSidecarMutex.Unlock()
+	fake.DeleteApplicationSidecarStub = nil
+	if fake.deleteApplicationSidecarReturnsOnCall == nil {
+		fake.deleteApplicationSidecarReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationSidecarReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteBuildpackByNameAndStack(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.deleteBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.deleteBuildpackByNameAndStackReturnsOnCall[len(fake.deleteBuildpackByNameAndStackArgsForCall)]
@@ -8065,19 +9146,18 @@ func (fake *FakeActor) GetAppSummariesForSpaceReturnsOnCall(i int, result1 []v7a
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, v7action.Warnings, error) {
-	fake.getApplicationByNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
-	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+func (fake *FakeActor) GetAppUsageEvents(arg1 string) ([]resources.AppUsageEvent, v7action.Warnings, error) {
+	fake.getAppUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getAppUsageEventsReturnsOnCall[len(fake.getAppUsageEventsArgsForCall)]
+	fake.getAppUsageEventsArgsForCall = append(fake.getAppUsageEventsArgsForCall, struct {
 		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetApplicationByNameAndSpaceStub
-	fakeReturns := fake.getApplicationByNameAndSpaceReturns
-	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
-	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	}{arg1})
+	stub := fake.GetAppUsageEventsStub
+	fakeReturns := fake.getAppUsageEventsReturns
+	fake.recordInvocation("GetAppUsageEvents", []interface{}{arg1})
+	fake.getAppUsageEventsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -8085,65 +9165,65 @@ func (fake *FakeActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (r
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetApplicationByNameAndSpaceCallCount() int {
-	fake.getApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
-	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+func (fake *FakeActor) GetAppUsageEventsCallCount() int {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	return len(fake.getAppUsageEventsArgsForCall)
 }
 
-func (fake *FakeActor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (resources.Application, v7action.Warnings, error)) {
-	fake.getApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetApplicationByNameAndSpaceStub = stub
+func (fake *FakeActor) GetAppUsageEventsCalls(stub func(string) ([]resources.AppUsageEvent, v7action.Warnings, error)) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = stub
 }
 
-func (fake *FakeActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
-	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeActor) GetAppUsageEventsArgsForCall(i int) string {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	argsForCall := fake.getAppUsageEventsArgsForCall[i]
+	return argsForCall.arg1
 }
 
-func (fake *FakeActor) GetApplicationByNameAndSpaceReturns(result1 resources.Application, result2 v7action.Warnings, result3 error) {
-	fake.getApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetApplicationByNameAndSpaceStub = nil
-	fake.getApplicationByNameAndSpaceReturns = struct {
-		result1 resources.Application
+func (fake *FakeActor) GetAppUsageEventsReturns(result1 []resources.AppUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	fake.getAppUsageEventsReturns = struct {
+		result1 []resources.AppUsageEvent
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 resources.Application, result2 v7action.Warnings, result3 error) {
-	fake.getApplicationByNameAndSpaceMutex.Lock()
-	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
-	fake.GetApplicationByNameAndSpaceStub = nil
-	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
-		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 resources.Application
+func (fake *FakeActor) GetAppUsageEventsReturnsOnCall(i int, result1 []resources.AppUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	if fake.getAppUsageEventsReturnsOnCall == nil {
+		fake.getAppUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []resources.AppUsageEvent
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
-		result1 resources.Application
+	fake.getAppUsageEventsReturnsOnCall[i] = struct {
+		result1 []resources.AppUsageEvent
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetApplicationDroplets(arg1 string, arg2 string) ([]resources.Droplet, v7action.Warnings, error) {
-	fake.getApplicationDropletsMutex.Lock()
-	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
-	fake.getApplicationDropletsArgsForCall = append(fake.getApplicationDropletsArgsForCall, struct {
+func (fake *FakeActor) GetApplicationAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getApplicationAnnotationsReturnsOnCall[len(fake.getApplicationAnnotationsArgsForCall)]
+	fake.getApplicationAnnotationsArgsForCall = append(fake.getApplicationAnnotationsArgsForCall, struct {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.GetApplicationDropletsStub
-	fakeReturns := fake.getApplicationDropletsReturns
-	fake.recordInvocation("GetApplicationDroplets", []interface{}{arg1, arg2})
-	fake.getApplicationDropletsMutex.Unlock()
+	stub := fake.GetApplicationAnnotationsStub
+	fakeReturns := fake.getApplicationAnnotationsReturns
+	fake.recordInvocation("GetApplicationAnnotations", []interface{}{arg1, arg2})
+	fake.getApplicationAnnotationsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -8153,15 +9233,151 @@ func (fake *FakeActor) GetApplicationDroplets(arg1 string, arg2 string) ([]resou
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetApplicationDropletsCallCount() int {
-	fake.getApplicationDropletsMutex.RLock()
-	defer fake.getApplicationDropletsMutex.RUnlock()
-	return len(fake.getApplicationDropletsArgsForCall)
+func (fake *FakeActor) GetApplicationAnnotationsCallCount() int {
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
+	return len(fake.getApplicationAnnotationsArgsForCall)
 }
 
-func (fake *FakeActor) GetApplicationDropletsCalls(stub func(string, string) ([]resources.Droplet, v7action.Warnings, error)) {
-	fake.getApplicationDropletsMutex.Lock()
-	defer fake.getApplicationDropletsMutex.Unlock()
+func (fake *FakeActor) GetApplicationAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetApplicationAnnotationsArgsForCall(i int) (string, string) {
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
+	argsForCall := fake.getApplicationAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = nil
+	fake.getApplicationAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = nil
+	if fake.getApplicationAnnotationsReturnsOnCall == nil {
+		fake.getApplicationAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, v7action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationByNameAndSpaceStub
+	fakeReturns := fake.getApplicationByNameAndSpaceReturns
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (resources.Application, v7action.Warnings, error)) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationByNameAndSpaceReturns(result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationDroplets(arg1 string, arg2 string) ([]resources.Droplet, v7action.Warnings, error) {
+	fake.getApplicationDropletsMutex.Lock()
+	ret, specificReturn := fake.getApplicationDropletsReturnsOnCall[len(fake.getApplicationDropletsArgsForCall)]
+	fake.getApplicationDropletsArgsForCall = append(fake.getApplicationDropletsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationDropletsStub
+	fakeReturns := fake.getApplicationDropletsReturns
+	fake.recordInvocation("GetApplicationDroplets", []interface{}{arg1, arg2})
+	fake.getApplicationDropletsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationDropletsCallCount() int {
+	fake.getApplicationDropletsMutex.RLock()
+	defer fake.getApplicationDropletsMutex.RUnlock()
+	return len(fake.getApplicationDropletsArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationDropletsCalls(stub func(string, string) ([]resources.Droplet, v7action.Warnings, error)) {
+	fake.getApplicationDropletsMutex.Lock()
+	defer fake.getApplicationDropletsMutex.Unlock()
 	fake.GetApplicationDropletsStub = stub
 }
 
@@ -8606,6 +9822,74 @@ func (fake *FakeActor) GetApplicationRoutesReturnsOnCall(i int, result1 []resour
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationSidecars(arg1 string, arg2 string) ([]resources.Sidecar, v7action.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationSidecarsStub
+	fakeReturns := fake.getApplicationSidecarsReturns
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1, arg2})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationSidecarsCalls(stub func(string, string) ([]resources.Sidecar, v7action.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeActor) GetApplicationSidecarsArgsForCall(i int) (string, string) {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationSidecarsReturns(result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationSidecarsReturnsOnCall(i int, result1 []resources.Sidecar, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []resources.Sidecar
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []resources.Sidecar
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationTasks(arg1 string, arg2 v7action.SortOrder) ([]resources.Task, v7action.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -8747,6 +10031,141 @@ func (fake *FakeActor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetAuditEvents(arg1 v7action.AuditEventFilter) ([]v7action.AuditEvent, v7action.Warnings, error) {
+	fake.getAuditEventsMutex.Lock()
+	ret, specificReturn := fake.getAuditEventsReturnsOnCall[len(fake.getAuditEventsArgsForCall)]
+	fake.getAuditEventsArgsForCall = append(fake.getAuditEventsArgsForCall, struct {
+		arg1 v7action.AuditEventFilter
+	}{arg1})
+	stub := fake.GetAuditEventsStub
+	fakeReturns := fake.getAuditEventsReturns
+	fake.recordInvocation("GetAuditEvents", []interface{}{arg1})
+	fake.getAuditEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAuditEventsCallCount() int {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	return len(fake.getAuditEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetAuditEventsCalls(stub func(v7action.AuditEventFilter) ([]v7action.AuditEvent, v7action.Warnings, error)) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = stub
+}
+
+func (fake *FakeActor) GetAuditEventsArgsForCall(i int) v7action.AuditEventFilter {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	argsForCall := fake.getAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetAuditEventsReturns(result1 []v7action.AuditEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	fake.getAuditEventsReturns = struct {
+		result1 []v7action.AuditEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAuditEventsReturnsOnCall(i int, result1 []v7action.AuditEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	if fake.getAuditEventsReturnsOnCall == nil {
+		fake.getAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AuditEvent
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAuditEventsReturnsOnCall[i] = struct {
+		result1 []v7action.AuditEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetBuildpackAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getBuildpackAnnotationsReturnsOnCall[len(fake.getBuildpackAnnotationsArgsForCall)]
+	fake.getBuildpackAnnotationsArgsForCall = append(fake.getBuildpackAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetBuildpackAnnotationsStub
+	fakeReturns := fake.getBuildpackAnnotationsReturns
+	fake.recordInvocation("GetBuildpackAnnotations", []interface{}{arg1, arg2})
+	fake.getBuildpackAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsCallCount() int {
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
+	return len(fake.getBuildpackAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsArgsForCall(i int) (string, string) {
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
+	argsForCall := fake.getBuildpackAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = nil
+	fake.getBuildpackAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = nil
+	if fake.getBuildpackAnnotationsReturnsOnCall == nil {
+		fake.getBuildpackAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpackAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetBuildpackLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getBuildpackLabelsMutex.Lock()
 	ret, specificReturn := fake.getBuildpackLabelsReturnsOnCall[len(fake.getBuildpackLabelsArgsForCall)]
@@ -9141,6 +10560,73 @@ func (fake *FakeActor) GetDomainReturnsOnCall(i int, result1 resources.Domain, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetDomainAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getDomainAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getDomainAnnotationsReturnsOnCall[len(fake.getDomainAnnotationsArgsForCall)]
+	fake.getDomainAnnotationsArgsForCall = append(fake.getDomainAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDomainAnnotationsStub
+	fakeReturns := fake.getDomainAnnotationsReturns
+	fake.recordInvocation("GetDomainAnnotations", []interface{}{arg1})
+	fake.getDomainAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDomainAnnotationsCallCount() int {
+	fake.getDomainAnnotationsMutex.RLock()
+	defer fake.getDomainAnnotationsMutex.RUnlock()
+	return len(fake.getDomainAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetDomainAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getDomainAnnotationsMutex.Lock()
+	defer fake.getDomainAnnotationsMutex.Unlock()
+	fake.GetDomainAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetDomainAnnotationsArgsForCall(i int) string {
+	fake.getDomainAnnotationsMutex.RLock()
+	defer fake.getDomainAnnotationsMutex.RUnlock()
+	argsForCall := fake.getDomainAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetDomainAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getDomainAnnotationsMutex.Lock()
+	defer fake.getDomainAnnotationsMutex.Unlock()
+	fake.GetDomainAnnotationsStub = nil
+	fake.getDomainAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDomainAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getDomainAnnotationsMutex.Lock()
+	defer fake.getDomainAnnotationsMutex.Unlock()
+	fake.GetDomainAnnotationsStub = nil
+	if fake.getDomainAnnotationsReturnsOnCall == nil {
+		fake.getDomainAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetDomainByName(arg1 string) (resources.Domain, v7action.Warnings, error) {
 	fake.getDomainByNameMutex.Lock()
 	ret, specificReturn := fake.getDomainByNameReturnsOnCall[len(fake.getDomainByNameArgsForCall)]
@@ -9604,8 +11090,154 @@ func (fake *FakeActor) GetFeatureFlagsReturnsOnCall(i int, result1 []resources.F
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error) {
-	fake.getGlobalRunningSecurityGroupsMutex.Lock()
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub
+	fakeReturns := fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturns
+	fake.recordInvocation("GetFilteredRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) ([]sharedaction.LogMessage, v7action.Warnings, error)) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getFilteredRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceReturns(result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = nil
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []sharedaction.LogMessage, result2 v7action.Warnings, result3 error) {
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredRecentLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.LogMessage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []sharedaction.LogMessage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 sharedaction.LogFilter
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub
+	fakeReturns := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturns
+	fake.recordInvocation("GetFilteredStreamingLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, sharedaction.LogFilter) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getFilteredStreamingLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = nil
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetFilteredStreamingLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.LogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+			result4 v7action.Warnings
+			result5 error
+		})
+	}
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetGlobalRunningSecurityGroups() ([]resources.SecurityGroup, v7action.Warnings, error) {
+	fake.getGlobalRunningSecurityGroupsMutex.Lock()
 	ret, specificReturn := fake.getGlobalRunningSecurityGroupsReturnsOnCall[len(fake.getGlobalRunningSecurityGroupsArgsForCall)]
 	fake.getGlobalRunningSecurityGroupsArgsForCall = append(fake.getGlobalRunningSecurityGroupsArgsForCall, struct {
 	}{})
@@ -10172,6 +11804,73 @@ func (fake *FakeActor) GetOrgUsersByRoleTypeReturnsOnCall(i int, result1 map[con
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetOrganizationAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationAnnotationsReturnsOnCall[len(fake.getOrganizationAnnotationsArgsForCall)]
+	fake.getOrganizationAnnotationsArgsForCall = append(fake.getOrganizationAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetOrganizationAnnotationsStub
+	fakeReturns := fake.getOrganizationAnnotationsReturns
+	fake.recordInvocation("GetOrganizationAnnotations", []interface{}{arg1})
+	fake.getOrganizationAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsCallCount() int {
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
+	return len(fake.getOrganizationAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsArgsForCall(i int) string {
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
+	argsForCall := fake.getOrganizationAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = nil
+	fake.getOrganizationAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = nil
+	if fake.getOrganizationAnnotationsReturnsOnCall == nil {
+		fake.getOrganizationAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetOrganizationByName(arg1 string) (resources.Organization, v7action.Warnings, error) {
 	fake.getOrganizationByNameMutex.Lock()
 	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
@@ -11042,6 +12741,72 @@ func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRecentLogsForTask(arg1 string, arg2 resources.Task, arg3 sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error) {
+	fake.getRecentLogsForTaskMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForTaskReturnsOnCall[len(fake.getRecentLogsForTaskArgsForCall)]
+	fake.getRecentLogsForTaskArgsForCall = append(fake.getRecentLogsForTaskArgsForCall, struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3})
+	stub := fake.GetRecentLogsForTaskStub
+	fakeReturns := fake.getRecentLogsForTaskReturns
+	fake.recordInvocation("GetRecentLogsForTask", []interface{}{arg1, arg2, arg3})
+	fake.getRecentLogsForTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskCallCount() int {
+	fake.getRecentLogsForTaskMutex.RLock()
+	defer fake.getRecentLogsForTaskMutex.RUnlock()
+	return len(fake.getRecentLogsForTaskArgsForCall)
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskCalls(stub func(string, resources.Task, sharedaction.LogCacheClient) ([]sharedaction.LogMessage, error)) {
+	fake.getRecentLogsForTaskMutex.Lock()
+	defer fake.getRecentLogsForTaskMutex.Unlock()
+	fake.GetRecentLogsForTaskStub = stub
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskArgsForCall(i int) (string, resources.Task, sharedaction.LogCacheClient) {
+	fake.getRecentLogsForTaskMutex.RLock()
+	defer fake.getRecentLogsForTaskMutex.RUnlock()
+	argsForCall := fake.getRecentLogsForTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskReturns(result1 []sharedaction.LogMessage, result2 error) {
+	fake.getRecentLogsForTaskMutex.Lock()
+	defer fake.getRecentLogsForTaskMutex.Unlock()
+	fake.GetRecentLogsForTaskStub = nil
+	fake.getRecentLogsForTaskReturns = struct {
+		result1 []sharedaction.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) GetRecentLogsForTaskReturnsOnCall(i int, result1 []sharedaction.LogMessage, result2 error) {
+	fake.getRecentLogsForTaskMutex.Lock()
+	defer fake.getRecentLogsForTaskMutex.Unlock()
+	fake.GetRecentLogsForTaskStub = nil
+	if fake.getRecentLogsForTaskReturnsOnCall == nil {
+		fake.getRecentLogsForTaskReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.LogMessage
+			result2 error
+		})
+	}
+	fake.getRecentLogsForTaskReturnsOnCall[i] = struct {
+		result1 []sharedaction.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) GetRevisionByApplicationAndVersion(arg1 string, arg2 int) (resources.Revision, v7action.Warnings, error) {
 	fake.getRevisionByApplicationAndVersionMutex.Lock()
 	ret, specificReturn := fake.getRevisionByApplicationAndVersionReturnsOnCall[len(fake.getRevisionByApplicationAndVersionArgsForCall)]
@@ -11237,6 +13002,74 @@ func (fake *FakeActor) GetRootResponseReturnsOnCall(i int, result1 v7action.Info
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRouteAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getRouteAnnotationsReturnsOnCall[len(fake.getRouteAnnotationsArgsForCall)]
+	fake.getRouteAnnotationsArgsForCall = append(fake.getRouteAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetRouteAnnotationsStub
+	fakeReturns := fake.getRouteAnnotationsReturns
+	fake.recordInvocation("GetRouteAnnotations", []interface{}{arg1, arg2})
+	fake.getRouteAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteAnnotationsCallCount() int {
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
+	return len(fake.getRouteAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetRouteAnnotationsArgsForCall(i int) (string, string) {
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
+	argsForCall := fake.getRouteAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetRouteAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = nil
+	fake.getRouteAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = nil
+	if fake.getRouteAnnotationsReturnsOnCall == nil {
+		fake.getRouteAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRouteByAttributes(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (resources.Route, v7action.Warnings, error) {
 	fake.getRouteByAttributesMutex.Lock()
 	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
@@ -11895,6 +13728,71 @@ func (fake *FakeActor) GetSSHPasscodeReturnsOnCall(i int, result1 string, result
 	}{result1, result2}
 }
 
+func (fake *FakeActor) GetScheduledTasks(arg1 string, arg2 manifestparser.Manifest) ([]v7action.ScheduledTask, error) {
+	fake.getScheduledTasksMutex.Lock()
+	ret, specificReturn := fake.getScheduledTasksReturnsOnCall[len(fake.getScheduledTasksArgsForCall)]
+	fake.getScheduledTasksArgsForCall = append(fake.getScheduledTasksArgsForCall, struct {
+		arg1 string
+		arg2 manifestparser.Manifest
+	}{arg1, arg2})
+	stub := fake.GetScheduledTasksStub
+	fakeReturns := fake.getScheduledTasksReturns
+	fake.recordInvocation("GetScheduledTasks", []interface{}{arg1, arg2})
+	fake.getScheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) GetScheduledTasksCallCount() int {
+	fake.getScheduledTasksMutex.RLock()
+	defer fake.getScheduledTasksMutex.RUnlock()
+	return len(fake.getScheduledTasksArgsForCall)
+}
+
+func (fake *FakeActor) GetScheduledTasksCalls(stub func(string, manifestparser.Manifest) ([]v7action.ScheduledTask, error)) {
+	fake.getScheduledTasksMutex.Lock()
+	defer fake.getScheduledTasksMutex.Unlock()
+	fake.GetScheduledTasksStub = stub
+}
+
+func (fake *FakeActor) GetScheduledTasksArgsForCall(i int) (string, manifestparser.Manifest) {
+	fake.getScheduledTasksMutex.RLock()
+	defer fake.getScheduledTasksMutex.RUnlock()
+	argsForCall := fake.getScheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetScheduledTasksReturns(result1 []v7action.ScheduledTask, result2 error) {
+	fake.getScheduledTasksMutex.Lock()
+	defer fake.getScheduledTasksMutex.Unlock()
+	fake.GetScheduledTasksStub = nil
+	fake.getScheduledTasksReturns = struct {
+		result1 []v7action.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) GetScheduledTasksReturnsOnCall(i int, result1 []v7action.ScheduledTask, result2 error) {
+	fake.getScheduledTasksMutex.Lock()
+	defer fake.getScheduledTasksMutex.Unlock()
+	fake.GetScheduledTasksStub = nil
+	if fake.getScheduledTasksReturnsOnCall == nil {
+		fake.getScheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ScheduledTask
+			result2 error
+		})
+	}
+	fake.getScheduledTasksReturnsOnCall[i] = struct {
+		result1 []v7action.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(arg1 string, arg2 string, arg3 string, arg4 uint) (v7action.SSHAuthentication, v7action.Warnings, error) {
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.Lock()
 	ret, specificReturn := fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturnsOnCall[len(fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall)]
@@ -11965,6 +13863,81 @@ func (fake *FakeActor) GetSecureShellConfigurationByApplicationNameSpaceProcessT
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes(arg1 string, arg2 string, arg3 string, arg4 []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error) {
+	var arg4Copy []uint
+	if arg4 != nil {
+		arg4Copy = make([]uint, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall[len(fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall)]
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall = append(fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 []uint
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub
+	fakeReturns := fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns
+	fake.recordInvocation("GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesCallCount() int {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall)
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesCalls(stub func(string, string, string, []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Lock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Unlock()
+	fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub = stub
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall(i int) (string, string, string, []uint) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RUnlock()
+	argsForCall := fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns(result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Lock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Unlock()
+	fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub = nil
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall(i int, result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Lock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Unlock()
+	fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub = nil
+	if fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall == nil {
+		fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InstanceSSHAuthentication
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall[i] = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSecurityGroup(arg1 string) (resources.SecurityGroup, v7action.Warnings, error) {
 	fake.getSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.getSecurityGroupReturnsOnCall[len(fake.getSecurityGroupArgsForCall)]
@@ -12227,13 +14200,149 @@ func (fake *FakeActor) GetServiceAccessReturnsOnCall(i int, result1 []v7action.S
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetServiceBrokerByName(arg1 string) (resources.ServiceBroker, v7action.Warnings, error) {
-	fake.getServiceBrokerByNameMutex.Lock()
-	ret, specificReturn := fake.getServiceBrokerByNameReturnsOnCall[len(fake.getServiceBrokerByNameArgsForCall)]
-	fake.getServiceBrokerByNameArgsForCall = append(fake.getServiceBrokerByNameArgsForCall, struct {
+func (fake *FakeActor) GetServiceAppBindingByServiceInstanceAndApp(arg1 string, arg2 string, arg3 string) (resources.ServiceCredentialBinding, v7action.Warnings, error) {
+	fake.getServiceAppBindingByServiceInstanceAndAppMutex.Lock()
+	ret, specificReturn := fake.getServiceAppBindingByServiceInstanceAndAppReturnsOnCall[len(fake.getServiceAppBindingByServiceInstanceAndAppArgsForCall)]
+	fake.getServiceAppBindingByServiceInstanceAndAppArgsForCall = append(fake.getServiceAppBindingByServiceInstanceAndAppArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	stub := fake.GetServiceBrokerByNameStub
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetServiceAppBindingByServiceInstanceAndAppStub
+	fakeReturns := fake.getServiceAppBindingByServiceInstanceAndAppReturns
+	fake.recordInvocation("GetServiceAppBindingByServiceInstanceAndApp", []interface{}{arg1, arg2, arg3})
+	fake.getServiceAppBindingByServiceInstanceAndAppMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceAppBindingByServiceInstanceAndAppCallCount() int {
+	fake.getServiceAppBindingByServiceInstanceAndAppMutex.RLock()
+	defer fake.getServiceAppBindingByServiceInstanceAndAppMutex.RUnlock()
+	return len(fake.getServiceAppBindingByServiceInstanceAndAppArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceAppBindingByServiceInstanceAndAppCalls(stub func(string, string, string) (resources.ServiceCredentialBinding, v7action.Warnings, error)) {
+	fake.getServiceAppBindingByServiceInstanceAndAppMutex.Lock()
+	defer fake.getServiceAppBindingByServiceInstanceAndAppMutex.Unlock()
+	fake.GetServiceAppBindingByServiceInstanceAndAppStub = stub
+}
+
+func (fake *FakeActor) GetServiceAppBindingByServiceInstanceAndAppArgsForCall(i int) (string, string, string) {
+	fake.getServiceAppBindingByServiceInstanceAndAppMutex.RLock()
+	defer fake.getServiceAppBindingByServiceInstanceAndAppMutex.RUnlock()
+	argsForCall := fake.getServiceAppBindingByServiceInstanceAndAppArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetServiceAppBindingByServiceInstanceAndAppReturns(result1 resources.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAppBindingByServiceInstanceAndAppMutex.Lock()
+	defer fake.getServiceAppBindingByServiceInstanceAndAppMutex.Unlock()
+	fake.GetServiceAppBindingByServiceInstanceAndAppStub = nil
+	fake.getServiceAppBindingByServiceInstanceAndAppReturns = struct {
+		result1 resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceAppBindingByServiceInstanceAndAppReturnsOnCall(i int, result1 resources.ServiceCredentialBinding, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAppBindingByServiceInstanceAndAppMutex.Lock()
+	defer fake.getServiceAppBindingByServiceInstanceAndAppMutex.Unlock()
+	fake.GetServiceAppBindingByServiceInstanceAndAppStub = nil
+	if fake.getServiceAppBindingByServiceInstanceAndAppReturnsOnCall == nil {
+		fake.getServiceAppBindingByServiceInstanceAndAppReturnsOnCall = make(map[int]struct {
+			result1 resources.ServiceCredentialBinding
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceAppBindingByServiceInstanceAndAppReturnsOnCall[i] = struct {
+		result1 resources.ServiceCredentialBinding
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getServiceBrokerAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokerAnnotationsReturnsOnCall[len(fake.getServiceBrokerAnnotationsArgsForCall)]
+	fake.getServiceBrokerAnnotationsArgsForCall = append(fake.getServiceBrokerAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceBrokerAnnotationsStub
+	fakeReturns := fake.getServiceBrokerAnnotationsReturns
+	fake.recordInvocation("GetServiceBrokerAnnotations", []interface{}{arg1})
+	fake.getServiceBrokerAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsCallCount() int {
+	fake.getServiceBrokerAnnotationsMutex.RLock()
+	defer fake.getServiceBrokerAnnotationsMutex.RUnlock()
+	return len(fake.getServiceBrokerAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getServiceBrokerAnnotationsMutex.Lock()
+	defer fake.getServiceBrokerAnnotationsMutex.Unlock()
+	fake.GetServiceBrokerAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsArgsForCall(i int) string {
+	fake.getServiceBrokerAnnotationsMutex.RLock()
+	defer fake.getServiceBrokerAnnotationsMutex.RUnlock()
+	argsForCall := fake.getServiceBrokerAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerAnnotationsMutex.Lock()
+	defer fake.getServiceBrokerAnnotationsMutex.Unlock()
+	fake.GetServiceBrokerAnnotationsStub = nil
+	fake.getServiceBrokerAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerAnnotationsMutex.Lock()
+	defer fake.getServiceBrokerAnnotationsMutex.Unlock()
+	fake.GetServiceBrokerAnnotationsStub = nil
+	if fake.getServiceBrokerAnnotationsReturnsOnCall == nil {
+		fake.getServiceBrokerAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokerAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerByName(arg1 string) (resources.ServiceBroker, v7action.Warnings, error) {
+	fake.getServiceBrokerByNameMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokerByNameReturnsOnCall[len(fake.getServiceBrokerByNameArgsForCall)]
+	fake.getServiceBrokerByNameArgsForCall = append(fake.getServiceBrokerByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceBrokerByNameStub
 	fakeReturns := fake.getServiceBrokerByNameReturns
 	fake.recordInvocation("GetServiceBrokerByName", []interface{}{arg1})
 	fake.getServiceBrokerByNameMutex.Unlock()
@@ -12420,6 +14529,141 @@ func (fake *FakeActor) GetServiceBrokersReturnsOnCall(i int, result1 []resources
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceCredentialBindingParameters(arg1 string) (types.OptionalObject, v7action.Warnings, error) {
+	fake.getServiceCredentialBindingParametersMutex.Lock()
+	ret, specificReturn := fake.getServiceCredentialBindingParametersReturnsOnCall[len(fake.getServiceCredentialBindingParametersArgsForCall)]
+	fake.getServiceCredentialBindingParametersArgsForCall = append(fake.getServiceCredentialBindingParametersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceCredentialBindingParametersStub
+	fakeReturns := fake.getServiceCredentialBindingParametersReturns
+	fake.recordInvocation("GetServiceCredentialBindingParameters", []interface{}{arg1})
+	fake.getServiceCredentialBindingParametersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceCredentialBindingParametersCallCount() int {
+	fake.getServiceCredentialBindingParametersMutex.RLock()
+	defer fake.getServiceCredentialBindingParametersMutex.RUnlock()
+	return len(fake.getServiceCredentialBindingParametersArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceCredentialBindingParametersCalls(stub func(string) (types.OptionalObject, v7action.Warnings, error)) {
+	fake.getServiceCredentialBindingParametersMutex.Lock()
+	defer fake.getServiceCredentialBindingParametersMutex.Unlock()
+	fake.GetServiceCredentialBindingParametersStub = stub
+}
+
+func (fake *FakeActor) GetServiceCredentialBindingParametersArgsForCall(i int) string {
+	fake.getServiceCredentialBindingParametersMutex.RLock()
+	defer fake.getServiceCredentialBindingParametersMutex.RUnlock()
+	argsForCall := fake.getServiceCredentialBindingParametersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceCredentialBindingParametersReturns(result1 types.OptionalObject, result2 v7action.Warnings, result3 error) {
+	fake.getServiceCredentialBindingParametersMutex.Lock()
+	defer fake.getServiceCredentialBindingParametersMutex.Unlock()
+	fake.GetServiceCredentialBindingParametersStub = nil
+	fake.getServiceCredentialBindingParametersReturns = struct {
+		result1 types.OptionalObject
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceCredentialBindingParametersReturnsOnCall(i int, result1 types.OptionalObject, result2 v7action.Warnings, result3 error) {
+	fake.getServiceCredentialBindingParametersMutex.Lock()
+	defer fake.getServiceCredentialBindingParametersMutex.Unlock()
+	fake.GetServiceCredentialBindingParametersStub = nil
+	if fake.getServiceCredentialBindingParametersReturnsOnCall == nil {
+		fake.getServiceCredentialBindingParametersReturnsOnCall = make(map[int]struct {
+			result1 types.OptionalObject
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceCredentialBindingParametersReturnsOnCall[i] = struct {
+		result1 types.OptionalObject
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getServiceInstanceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceAnnotationsReturnsOnCall[len(fake.getServiceInstanceAnnotationsArgsForCall)]
+	fake.getServiceInstanceAnnotationsArgsForCall = append(fake.getServiceInstanceAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetServiceInstanceAnnotationsStub
+	fakeReturns := fake.getServiceInstanceAnnotationsReturns
+	fake.recordInvocation("GetServiceInstanceAnnotations", []interface{}{arg1, arg2})
+	fake.getServiceInstanceAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsCallCount() int {
+	fake.getServiceInstanceAnnotationsMutex.RLock()
+	defer fake.getServiceInstanceAnnotationsMutex.RUnlock()
+	return len(fake.getServiceInstanceAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getServiceInstanceAnnotationsMutex.Lock()
+	defer fake.getServiceInstanceAnnotationsMutex.Unlock()
+	fake.GetServiceInstanceAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceAnnotationsMutex.RLock()
+	defer fake.getServiceInstanceAnnotationsMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceAnnotationsMutex.Lock()
+	defer fake.getServiceInstanceAnnotationsMutex.Unlock()
+	fake.GetServiceInstanceAnnotationsStub = nil
+	fake.getServiceInstanceAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceAnnotationsMutex.Lock()
+	defer fake.getServiceInstanceAnnotationsMutex.Unlock()
+	fake.GetServiceInstanceAnnotationsStub = nil
+	if fake.getServiceInstanceAnnotationsReturnsOnCall == nil {
+		fake.getServiceInstanceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceInstanceByNameAndSpace(arg1 string, arg2 string) (resources.ServiceInstance, v7action.Warnings, error) {
 	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceByNameAndSpaceArgsForCall)]
@@ -12967,6 +15211,74 @@ func (fake *FakeActor) GetServiceKeysByServiceInstanceReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceOfferingAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getServiceOfferingAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getServiceOfferingAnnotationsReturnsOnCall[len(fake.getServiceOfferingAnnotationsArgsForCall)]
+	fake.getServiceOfferingAnnotationsArgsForCall = append(fake.getServiceOfferingAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetServiceOfferingAnnotationsStub
+	fakeReturns := fake.getServiceOfferingAnnotationsReturns
+	fake.recordInvocation("GetServiceOfferingAnnotations", []interface{}{arg1, arg2})
+	fake.getServiceOfferingAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsCallCount() int {
+	fake.getServiceOfferingAnnotationsMutex.RLock()
+	defer fake.getServiceOfferingAnnotationsMutex.RUnlock()
+	return len(fake.getServiceOfferingAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getServiceOfferingAnnotationsMutex.Lock()
+	defer fake.getServiceOfferingAnnotationsMutex.Unlock()
+	fake.GetServiceOfferingAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsArgsForCall(i int) (string, string) {
+	fake.getServiceOfferingAnnotationsMutex.RLock()
+	defer fake.getServiceOfferingAnnotationsMutex.RUnlock()
+	argsForCall := fake.getServiceOfferingAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceOfferingAnnotationsMutex.Lock()
+	defer fake.getServiceOfferingAnnotationsMutex.Unlock()
+	fake.GetServiceOfferingAnnotationsStub = nil
+	fake.getServiceOfferingAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceOfferingAnnotationsMutex.Lock()
+	defer fake.getServiceOfferingAnnotationsMutex.Unlock()
+	fake.GetServiceOfferingAnnotationsStub = nil
+	if fake.getServiceOfferingAnnotationsReturnsOnCall == nil {
+		fake.getServiceOfferingAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceOfferingAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceOfferingLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getServiceOfferingLabelsMutex.Lock()
 	ret, specificReturn := fake.getServiceOfferingLabelsReturnsOnCall[len(fake.getServiceOfferingLabelsArgsForCall)]
@@ -13035,6 +15347,75 @@ func (fake *FakeActor) GetServiceOfferingLabelsReturnsOnCall(i int, result1 map[
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServicePlanAnnotations(arg1 string, arg2 string, arg3 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getServicePlanAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getServicePlanAnnotationsReturnsOnCall[len(fake.getServicePlanAnnotationsArgsForCall)]
+	fake.getServicePlanAnnotationsArgsForCall = append(fake.getServicePlanAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetServicePlanAnnotationsStub
+	fakeReturns := fake.getServicePlanAnnotationsReturns
+	fake.recordInvocation("GetServicePlanAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.getServicePlanAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsCallCount() int {
+	fake.getServicePlanAnnotationsMutex.RLock()
+	defer fake.getServicePlanAnnotationsMutex.RUnlock()
+	return len(fake.getServicePlanAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsCalls(stub func(string, string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getServicePlanAnnotationsMutex.Lock()
+	defer fake.getServicePlanAnnotationsMutex.Unlock()
+	fake.GetServicePlanAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsArgsForCall(i int) (string, string, string) {
+	fake.getServicePlanAnnotationsMutex.RLock()
+	defer fake.getServicePlanAnnotationsMutex.RUnlock()
+	argsForCall := fake.getServicePlanAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServicePlanAnnotationsMutex.Lock()
+	defer fake.getServicePlanAnnotationsMutex.Unlock()
+	fake.GetServicePlanAnnotationsStub = nil
+	fake.getServicePlanAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServicePlanAnnotationsMutex.Lock()
+	defer fake.getServicePlanAnnotationsMutex.Unlock()
+	fake.GetServicePlanAnnotationsStub = nil
+	if fake.getServicePlanAnnotationsReturnsOnCall == nil {
+		fake.getServicePlanAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServicePlanByNameOfferingAndBroker(arg1 string, arg2 string, arg3 string) (resources.ServicePlan, v7action.Warnings, error) {
 	fake.getServicePlanByNameOfferingAndBrokerMutex.Lock()
 	ret, specificReturn := fake.getServicePlanByNameOfferingAndBrokerReturnsOnCall[len(fake.getServicePlanByNameOfferingAndBrokerArgsForCall)]
@@ -13173,6 +15554,141 @@ func (fake *FakeActor) GetServicePlanLabelsReturnsOnCall(i int, result1 map[stri
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceUsageEvents(arg1 string) ([]resources.ServiceUsageEvent, v7action.Warnings, error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getServiceUsageEventsReturnsOnCall[len(fake.getServiceUsageEventsArgsForCall)]
+	fake.getServiceUsageEventsArgsForCall = append(fake.getServiceUsageEventsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceUsageEventsStub
+	fakeReturns := fake.getServiceUsageEventsReturns
+	fake.recordInvocation("GetServiceUsageEvents", []interface{}{arg1})
+	fake.getServiceUsageEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceUsageEventsCallCount() int {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	return len(fake.getServiceUsageEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceUsageEventsCalls(stub func(string) ([]resources.ServiceUsageEvent, v7action.Warnings, error)) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = stub
+}
+
+func (fake *FakeActor) GetServiceUsageEventsArgsForCall(i int) string {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	argsForCall := fake.getServiceUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceUsageEventsReturns(result1 []resources.ServiceUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	fake.getServiceUsageEventsReturns = struct {
+		result1 []resources.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceUsageEventsReturnsOnCall(i int, result1 []resources.ServiceUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	if fake.getServiceUsageEventsReturnsOnCall == nil {
+		fake.getServiceUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []resources.ServiceUsageEvent
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceUsageEventsReturnsOnCall[i] = struct {
+		result1 []resources.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSpaceAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getSpaceAnnotationsReturnsOnCall[len(fake.getSpaceAnnotationsArgsForCall)]
+	fake.getSpaceAnnotationsArgsForCall = append(fake.getSpaceAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetSpaceAnnotationsStub
+	fakeReturns := fake.getSpaceAnnotationsReturns
+	fake.recordInvocation("GetSpaceAnnotations", []interface{}{arg1, arg2})
+	fake.getSpaceAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsCallCount() int {
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
+	return len(fake.getSpaceAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsArgsForCall(i int) (string, string) {
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
+	argsForCall := fake.getSpaceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = nil
+	fake.getSpaceAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = nil
+	if fake.getSpaceAnnotationsReturnsOnCall == nil {
+		fake.getSpaceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (resources.Space, v7action.Warnings, error) {
 	fake.getSpaceByNameAndOrganizationMutex.Lock()
 	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
@@ -13630,19 +16146,86 @@ func (fake *FakeActor) GetSpaceUsersByRoleTypeReturns(result1 map[constanta.Role
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetSpaceUsersByRoleTypeReturnsOnCall(i int, result1 map[constanta.RoleType][]resources.User, result2 v7action.Warnings, result3 error) {
-	fake.getSpaceUsersByRoleTypeMutex.Lock()
-	defer fake.getSpaceUsersByRoleTypeMutex.Unlock()
-	fake.GetSpaceUsersByRoleTypeStub = nil
-	if fake.getSpaceUsersByRoleTypeReturnsOnCall == nil {
-		fake.getSpaceUsersByRoleTypeReturnsOnCall = make(map[int]struct {
-			result1 map[constanta.RoleType][]resources.User
+func (fake *FakeActor) GetSpaceUsersByRoleTypeReturnsOnCall(i int, result1 map[constanta.RoleType][]resources.User, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceUsersByRoleTypeMutex.Lock()
+	defer fake.getSpaceUsersByRoleTypeMutex.Unlock()
+	fake.GetSpaceUsersByRoleTypeStub = nil
+	if fake.getSpaceUsersByRoleTypeReturnsOnCall == nil {
+		fake.getSpaceUsersByRoleTypeReturnsOnCall = make(map[int]struct {
+			result1 map[constanta.RoleType][]resources.User
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsersByRoleTypeReturnsOnCall[i] = struct {
+		result1 map[constanta.RoleType][]resources.User
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStackAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getStackAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getStackAnnotationsReturnsOnCall[len(fake.getStackAnnotationsArgsForCall)]
+	fake.getStackAnnotationsArgsForCall = append(fake.getStackAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStackAnnotationsStub
+	fakeReturns := fake.getStackAnnotationsReturns
+	fake.recordInvocation("GetStackAnnotations", []interface{}{arg1})
+	fake.getStackAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStackAnnotationsCallCount() int {
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
+	return len(fake.getStackAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetStackAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetStackAnnotationsArgsForCall(i int) string {
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
+	argsForCall := fake.getStackAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetStackAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = nil
+	fake.getStackAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStackAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = nil
+	if fake.getStackAnnotationsReturnsOnCall == nil {
+		fake.getStackAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
 			result2 v7action.Warnings
 			result3 error
 		})
 	}
-	fake.getSpaceUsersByRoleTypeReturnsOnCall[i] = struct {
-		result1 map[constanta.RoleType][]resources.User
+	fake.getStackAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -13924,6 +16507,219 @@ func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOnCall
 	}{result1, result2, result3, result4, result5}
 }
 
+func (fake *FakeActor) GetStreamingLogsForTask(arg1 string, arg2 resources.Task, arg3 sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForTaskReturnsOnCall[len(fake.getStreamingLogsForTaskArgsForCall)]
+	fake.getStreamingLogsForTaskArgsForCall = append(fake.getStreamingLogsForTaskArgsForCall, struct {
+		arg1 string
+		arg2 resources.Task
+		arg3 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3})
+	stub := fake.GetStreamingLogsForTaskStub
+	fakeReturns := fake.getStreamingLogsForTaskReturns
+	fake.recordInvocation("GetStreamingLogsForTask", []interface{}{arg1, arg2, arg3})
+	fake.getStreamingLogsForTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskCallCount() int {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	return len(fake.getStreamingLogsForTaskArgsForCall)
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskCalls(stub func(string, resources.Task, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc)) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = stub
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskArgsForCall(i int) (string, resources.Task, sharedaction.LogCacheClient) {
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskReturns(result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = nil
+	fake.getStreamingLogsForTaskReturns = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStreamingLogsForTaskReturnsOnCall(i int, result1 <-chan sharedaction.LogMessage, result2 <-chan error, result3 context.CancelFunc) {
+	fake.getStreamingLogsForTaskMutex.Lock()
+	defer fake.getStreamingLogsForTaskMutex.Unlock()
+	fake.GetStreamingLogsForTaskStub = nil
+	if fake.getStreamingLogsForTaskReturnsOnCall == nil {
+		fake.getStreamingLogsForTaskReturnsOnCall = make(map[int]struct {
+			result1 <-chan sharedaction.LogMessage
+			result2 <-chan error
+			result3 context.CancelFunc
+		})
+	}
+	fake.getStreamingLogsForTaskReturnsOnCall[i] = struct {
+		result1 <-chan sharedaction.LogMessage
+		result2 <-chan error
+		result3 context.CancelFunc
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStreamingMetricsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient, arg4 time.Duration) (<-chan []sharedaction.InstanceMetrics, <-chan error, context.CancelFunc, v7action.Warnings, error) {
+	fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingMetricsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingMetricsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getStreamingMetricsForApplicationByNameAndSpaceArgsForCall = append(fake.getStreamingMetricsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 sharedaction.LogCacheClient
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetStreamingMetricsForApplicationByNameAndSpaceStub
+	fakeReturns := fake.getStreamingMetricsForApplicationByNameAndSpaceReturns
+	fake.recordInvocation("GetStreamingMetricsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4, ret.result5
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4, fakeReturns.result5
+}
+
+func (fake *FakeActor) GetStreamingMetricsForApplicationByNameAndSpaceCallCount() int {
+	fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingMetricsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) GetStreamingMetricsForApplicationByNameAndSpaceCalls(stub func(string, string, sharedaction.LogCacheClient, time.Duration) (<-chan []sharedaction.InstanceMetrics, <-chan error, context.CancelFunc, v7action.Warnings, error)) {
+	fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingMetricsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) GetStreamingMetricsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, sharedaction.LogCacheClient, time.Duration) {
+	fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getStreamingMetricsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetStreamingMetricsForApplicationByNameAndSpaceReturns(result1 <-chan []sharedaction.InstanceMetrics, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingMetricsForApplicationByNameAndSpaceStub = nil
+	fake.getStreamingMetricsForApplicationByNameAndSpaceReturns = struct {
+		result1 <-chan []sharedaction.InstanceMetrics
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetStreamingMetricsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 <-chan []sharedaction.InstanceMetrics, result2 <-chan error, result3 context.CancelFunc, result4 v7action.Warnings, result5 error) {
+	fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingMetricsForApplicationByNameAndSpaceStub = nil
+	if fake.getStreamingMetricsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingMetricsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan []sharedaction.InstanceMetrics
+			result2 <-chan error
+			result3 context.CancelFunc
+			result4 v7action.Warnings
+			result5 error
+		})
+	}
+	fake.getStreamingMetricsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan []sharedaction.InstanceMetrics
+		result2 <-chan error
+		result3 context.CancelFunc
+		result4 v7action.Warnings
+		result5 error
+	}{result1, result2, result3, result4, result5}
+}
+
+func (fake *FakeActor) GetTaskByNameAndApplication(arg1 string, arg2 string) (resources.Task, v7action.Warnings, error) {
+	fake.getTaskByNameAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getTaskByNameAndApplicationReturnsOnCall[len(fake.getTaskByNameAndApplicationArgsForCall)]
+	fake.getTaskByNameAndApplicationArgsForCall = append(fake.getTaskByNameAndApplicationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetTaskByNameAndApplicationStub
+	fakeReturns := fake.getTaskByNameAndApplicationReturns
+	fake.recordInvocation("GetTaskByNameAndApplication", []interface{}{arg1, arg2})
+	fake.getTaskByNameAndApplicationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetTaskByNameAndApplicationCallCount() int {
+	fake.getTaskByNameAndApplicationMutex.RLock()
+	defer fake.getTaskByNameAndApplicationMutex.RUnlock()
+	return len(fake.getTaskByNameAndApplicationArgsForCall)
+}
+
+func (fake *FakeActor) GetTaskByNameAndApplicationCalls(stub func(string, string) (resources.Task, v7action.Warnings, error)) {
+	fake.getTaskByNameAndApplicationMutex.Lock()
+	defer fake.getTaskByNameAndApplicationMutex.Unlock()
+	fake.GetTaskByNameAndApplicationStub = stub
+}
+
+func (fake *FakeActor) GetTaskByNameAndApplicationArgsForCall(i int) (string, string) {
+	fake.getTaskByNameAndApplicationMutex.RLock()
+	defer fake.getTaskByNameAndApplicationMutex.RUnlock()
+	argsForCall := fake.getTaskByNameAndApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetTaskByNameAndApplicationReturns(result1 resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.getTaskByNameAndApplicationMutex.Lock()
+	defer fake.getTaskByNameAndApplicationMutex.Unlock()
+	fake.GetTaskByNameAndApplicationStub = nil
+	fake.getTaskByNameAndApplicationReturns = struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetTaskByNameAndApplicationReturnsOnCall(i int, result1 resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.getTaskByNameAndApplicationMutex.Lock()
+	defer fake.getTaskByNameAndApplicationMutex.Unlock()
+	fake.GetTaskByNameAndApplicationStub = nil
+	if fake.getTaskByNameAndApplicationReturnsOnCall == nil {
+		fake.getTaskByNameAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 resources.Task
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getTaskByNameAndApplicationReturnsOnCall[i] = struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetTaskBySequenceIDAndApplication(arg1 int, arg2 string) (resources.Task, v7action.Warnings, error) {
 	fake.getTaskBySequenceIDAndApplicationMutex.Lock()
 	ret, specificReturn := fake.getTaskBySequenceIDAndApplicationReturnsOnCall[len(fake.getTaskBySequenceIDAndApplicationArgsForCall)]
@@ -14719,19 +17515,19 @@ func (fake *FakeActor) PollStartReturnsOnCall(i int, result1 v7action.Warnings,
 	}{result1, result2}
 }
 
-func (fake *FakeActor) PollStartForRolling(arg1 resources.Application, arg2 string, arg3 bool, arg4 func(string)) (v7action.Warnings, error) {
-	fake.pollStartForRollingMutex.Lock()
-	ret, specificReturn := fake.pollStartForRollingReturnsOnCall[len(fake.pollStartForRollingArgsForCall)]
-	fake.pollStartForRollingArgsForCall = append(fake.pollStartForRollingArgsForCall, struct {
+func (fake *FakeActor) PollStartForDeployment(arg1 resources.Application, arg2 string, arg3 bool, arg4 func(string)) (v7action.Warnings, error) {
+	fake.pollStartForDeploymentMutex.Lock()
+	ret, specificReturn := fake.pollStartForDeploymentReturnsOnCall[len(fake.pollStartForDeploymentArgsForCall)]
+	fake.pollStartForDeploymentArgsForCall = append(fake.pollStartForDeploymentArgsForCall, struct {
 		arg1 resources.Application
 		arg2 string
 		arg3 bool
 		arg4 func(string)
 	}{arg1, arg2, arg3, arg4})
-	stub := fake.PollStartForRollingStub
-	fakeReturns := fake.pollStartForRollingReturns
-	fake.recordInvocation("PollStartForRolling", []interface{}{arg1, arg2, arg3, arg4})
-	fake.pollStartForRollingMutex.Unlock()
+	stub := fake.PollStartForDeploymentStub
+	fakeReturns := fake.pollStartForDeploymentReturns
+	fake.recordInvocation("PollStartForDeployment", []interface{}{arg1, arg2, arg3, arg4})
+	fake.pollStartForDeploymentMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3, arg4)
 	}
@@ -14741,46 +17537,46 @@ func (fake *FakeActor) PollStartForRolling(arg1 resources.Application, arg2 stri
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeActor) PollStartForRollingCallCount() int {
-	fake.pollStartForRollingMutex.RLock()
-	defer fake.pollStartForRollingMutex.RUnlock()
-	return len(fake.pollStartForRollingArgsForCall)
+func (fake *FakeActor) PollStartForDeploymentCallCount() int {
+	fake.pollStartForDeploymentMutex.RLock()
+	defer fake.pollStartForDeploymentMutex.RUnlock()
+	return len(fake.pollStartForDeploymentArgsForCall)
 }
 
-func (fake *FakeActor) PollStartForRollingCalls(stub func(resources.Application, string, bool, func(string)) (v7action.Warnings, error)) {
-	fake.pollStartForRollingMutex.Lock()
-	defer fake.pollStartForRollingMutex.Unlock()
-	fake.PollStartForRollingStub = stub
+func (fake *FakeActor) PollStartForDeploymentCalls(stub func(resources.Application, string, bool, func(string)) (v7action.Warnings, error)) {
+	fake.pollStartForDeploymentMutex.Lock()
+	defer fake.pollStartForDeploymentMutex.Unlock()
+	fake.PollStartForDeploymentStub = stub
 }
 
-func (fake *FakeActor) PollStartForRollingArgsForCall(i int) (resources.Application, string, bool, func(string)) {
-	fake.pollStartForRollingMutex.RLock()
-	defer fake.pollStartForRollingMutex.RUnlock()
-	argsForCall := fake.pollStartForRollingArgsForCall[i]
+func (fake *FakeActor) PollStartForDeploymentArgsForCall(i int) (resources.Application, string, bool, func(string)) {
+	fake.pollStartForDeploymentMutex.RLock()
+	defer fake.pollStartForDeploymentMutex.RUnlock()
+	argsForCall := fake.pollStartForDeploymentArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeActor) PollStartForRollingReturns(result1 v7action.Warnings, result2 error) {
-	fake.pollStartForRollingMutex.Lock()
-	defer fake.pollStartForRollingMutex.Unlock()
-	fake.PollStartForRollingStub = nil
-	fake.pollStartForRollingReturns = struct {
+func (fake *FakeActor) PollStartForDeploymentReturns(result1 v7action.Warnings, result2 error) {
+	fake.pollStartForDeploymentMutex.Lock()
+	defer fake.pollStartForDeploymentMutex.Unlock()
+	fake.PollStartForDeploymentStub = nil
+	fake.pollStartForDeploymentReturns = struct {
 		result1 v7action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeActor) PollStartForRollingReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
-	fake.pollStartForRollingMutex.Lock()
-	defer fake.pollStartForRollingMutex.Unlock()
-	fake.PollStartForRollingStub = nil
-	if fake.pollStartForRollingReturnsOnCall == nil {
-		fake.pollStartForRollingReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) PollStartForDeploymentReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.pollStartForDeploymentMutex.Lock()
+	defer fake.pollStartForDeploymentMutex.Unlock()
+	fake.PollStartForDeploymentStub = nil
+	if fake.pollStartForDeploymentReturnsOnCall == nil {
+		fake.pollStartForDeploymentReturnsOnCall = make(map[int]struct {
 			result1 v7action.Warnings
 			result2 error
 		})
 	}
-	fake.pollStartForRollingReturnsOnCall[i] = struct {
+	fake.pollStartForDeploymentReturnsOnCall[i] = struct {
 		result1 v7action.Warnings
 		result2 error
 	}{result1, result2}
@@ -15113,6 +17909,76 @@ func (fake *FakeActor) PurgeServiceOfferingByNameAndBrokerReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeActor) QueryMetricsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 string, arg4 sharedaction.LogCacheClient) ([]sharedaction.MetricSample, v7action.Warnings, error) {
+	fake.queryMetricsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.queryMetricsForApplicationByNameAndSpaceReturnsOnCall[len(fake.queryMetricsForApplicationByNameAndSpaceArgsForCall)]
+	fake.queryMetricsForApplicationByNameAndSpaceArgsForCall = append(fake.queryMetricsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 sharedaction.LogCacheClient
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.QueryMetricsForApplicationByNameAndSpaceStub
+	fakeReturns := fake.queryMetricsForApplicationByNameAndSpaceReturns
+	fake.recordInvocation("QueryMetricsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.queryMetricsForApplicationByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) QueryMetricsForApplicationByNameAndSpaceCallCount() int {
+	fake.queryMetricsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.queryMetricsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.queryMetricsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) QueryMetricsForApplicationByNameAndSpaceCalls(stub func(string, string, string, sharedaction.LogCacheClient) ([]sharedaction.MetricSample, v7action.Warnings, error)) {
+	fake.queryMetricsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.queryMetricsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.QueryMetricsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) QueryMetricsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, string, sharedaction.LogCacheClient) {
+	fake.queryMetricsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.queryMetricsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.queryMetricsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) QueryMetricsForApplicationByNameAndSpaceReturns(result1 []sharedaction.MetricSample, result2 v7action.Warnings, result3 error) {
+	fake.queryMetricsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.queryMetricsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.QueryMetricsForApplicationByNameAndSpaceStub = nil
+	fake.queryMetricsForApplicationByNameAndSpaceReturns = struct {
+		result1 []sharedaction.MetricSample
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) QueryMetricsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []sharedaction.MetricSample, result2 v7action.Warnings, result3 error) {
+	fake.queryMetricsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.queryMetricsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.QueryMetricsForApplicationByNameAndSpaceStub = nil
+	if fake.queryMetricsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.queryMetricsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.MetricSample
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.queryMetricsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []sharedaction.MetricSample
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) RefreshAccessToken() (string, error) {
 	fake.refreshAccessTokenMutex.Lock()
 	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
@@ -15763,6 +18629,73 @@ func (fake *FakeActor) RevokeAccessAndRefreshTokensReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeActor) RunScheduledTask(arg1 v7action.ScheduledTask) (resources.Task, v7action.Warnings, error) {
+	fake.runScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.runScheduledTaskReturnsOnCall[len(fake.runScheduledTaskArgsForCall)]
+	fake.runScheduledTaskArgsForCall = append(fake.runScheduledTaskArgsForCall, struct {
+		arg1 v7action.ScheduledTask
+	}{arg1})
+	stub := fake.RunScheduledTaskStub
+	fakeReturns := fake.runScheduledTaskReturns
+	fake.recordInvocation("RunScheduledTask", []interface{}{arg1})
+	fake.runScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) RunScheduledTaskCallCount() int {
+	fake.runScheduledTaskMutex.RLock()
+	defer fake.runScheduledTaskMutex.RUnlock()
+	return len(fake.runScheduledTaskArgsForCall)
+}
+
+func (fake *FakeActor) RunScheduledTaskCalls(stub func(v7action.ScheduledTask) (resources.Task, v7action.Warnings, error)) {
+	fake.runScheduledTaskMutex.Lock()
+	defer fake.runScheduledTaskMutex.Unlock()
+	fake.RunScheduledTaskStub = stub
+}
+
+func (fake *FakeActor) RunScheduledTaskArgsForCall(i int) v7action.ScheduledTask {
+	fake.runScheduledTaskMutex.RLock()
+	defer fake.runScheduledTaskMutex.RUnlock()
+	argsForCall := fake.runScheduledTaskArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) RunScheduledTaskReturns(result1 resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.runScheduledTaskMutex.Lock()
+	defer fake.runScheduledTaskMutex.Unlock()
+	fake.RunScheduledTaskStub = nil
+	fake.runScheduledTaskReturns = struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) RunScheduledTaskReturnsOnCall(i int, result1 resources.Task, result2 v7action.Warnings, result3 error) {
+	fake.runScheduledTaskMutex.Lock()
+	defer fake.runScheduledTaskMutex.Unlock()
+	fake.RunScheduledTaskStub = nil
+	if fake.runScheduledTaskReturnsOnCall == nil {
+		fake.runScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 resources.Task
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.runScheduledTaskReturnsOnCall[i] = struct {
+		result1 resources.Task
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) RunTask(arg1 string, arg2 resources.Task) (resources.Task, v7action.Warnings, error) {
 	fake.runTaskMutex.Lock()
 	ret, specificReturn := fake.runTaskReturnsOnCall[len(fake.runTaskArgsForCall)]
@@ -16235,6 +19168,79 @@ func (fake *FakeActor) SetApplicationProcessHealthCheckTypeByNameAndSpaceReturns
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) SetApplicationProcessReadinessHealthCheckByNameAndSpace(arg1 string, arg2 string, arg3 constanta.HealthCheckType, arg4 string, arg5 string, arg6 int64, arg7 int64) (resources.Application, v7action.Warnings, error) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall[len(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall)]
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall = append(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 constanta.HealthCheckType
+		arg4 string
+		arg5 string
+		arg6 int64
+		arg7 int64
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub
+	fakeReturns := fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns
+	fake.recordInvocation("SetApplicationProcessReadinessHealthCheckByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceCallCount() int {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
+	return len(fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceCalls(stub func(string, string, constanta.HealthCheckType, string, string, int64, int64) (resources.Application, v7action.Warnings, error)) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Lock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Unlock()
+	fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall(i int) (string, string, constanta.HealthCheckType, string, string, int64, int64) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeActor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturns(result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Lock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Unlock()
+	fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub = nil
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturns = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) SetApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall(i int, result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Lock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.Unlock()
+	fake.SetApplicationProcessReadinessHealthCheckByNameAndSpaceStub = nil
+	if fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall == nil {
+		fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) SetEnvironmentVariableByApplicationNameAndSpace(arg1 string, arg2 string, arg3 v7action.EnvironmentVariablePair) (v7action.Warnings, error) {
 	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.setEnvironmentVariableByApplicationNameAndSpaceReturnsOnCall[len(fake.setEnvironmentVariableByApplicationNameAndSpaceArgsForCall)]
@@ -16956,8 +19962,64 @@ func (fake *FakeActor) StartApplicationReturnsOnCall(i int, result1 v7action.War
 			result2 error
 		})
 	}
-	fake.startApplicationReturnsOnCall[i] = struct {
-		result1 v7action.Warnings
+	fake.startApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) StartDeviceAuthorization() (v7action.DeviceAuthorization, error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.startDeviceAuthorizationReturnsOnCall[len(fake.startDeviceAuthorizationArgsForCall)]
+	fake.startDeviceAuthorizationArgsForCall = append(fake.startDeviceAuthorizationArgsForCall, struct {
+	}{})
+	stub := fake.StartDeviceAuthorizationStub
+	fakeReturns := fake.startDeviceAuthorizationReturns
+	fake.recordInvocation("StartDeviceAuthorization", []interface{}{})
+	fake.startDeviceAuthorizationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) StartDeviceAuthorizationCallCount() int {
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
+	return len(fake.startDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeActor) StartDeviceAuthorizationCalls(stub func() (v7action.DeviceAuthorization, error)) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeActor) StartDeviceAuthorizationReturns(result1 v7action.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	fake.startDeviceAuthorizationReturns = struct {
+		result1 v7action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) StartDeviceAuthorizationReturnsOnCall(i int, result1 v7action.DeviceAuthorization, result2 error) {
+	fake.startDeviceAuthorizationMutex.Lock()
+	defer fake.startDeviceAuthorizationMutex.Unlock()
+	fake.StartDeviceAuthorizationStub = nil
+	if fake.startDeviceAuthorizationReturnsOnCall == nil {
+		fake.startDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 v7action.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.startDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 v7action.DeviceAuthorization
 		result2 error
 	}{result1, result2}
 }
@@ -17026,6 +20088,77 @@ func (fake *FakeActor) StopApplicationReturnsOnCall(i int, result1 v7action.Warn
 	}{result1, result2}
 }
 
+func (fake *FakeActor) StreamAuditEvents(arg1 v7action.AuditEventFilter, arg2 time.Duration) (<-chan v7action.AuditEvent, <-chan v7action.Warnings, <-chan error, context.CancelFunc) {
+	fake.streamAuditEventsMutex.Lock()
+	ret, specificReturn := fake.streamAuditEventsReturnsOnCall[len(fake.streamAuditEventsArgsForCall)]
+	fake.streamAuditEventsArgsForCall = append(fake.streamAuditEventsArgsForCall, struct {
+		arg1 v7action.AuditEventFilter
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.StreamAuditEventsStub
+	fakeReturns := fake.streamAuditEventsReturns
+	fake.recordInvocation("StreamAuditEvents", []interface{}{arg1, arg2})
+	fake.streamAuditEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeActor) StreamAuditEventsCallCount() int {
+	fake.streamAuditEventsMutex.RLock()
+	defer fake.streamAuditEventsMutex.RUnlock()
+	return len(fake.streamAuditEventsArgsForCall)
+}
+
+func (fake *FakeActor) StreamAuditEventsCalls(stub func(v7action.AuditEventFilter, time.Duration) (<-chan v7action.AuditEvent, <-chan v7action.Warnings, <-chan error, context.CancelFunc)) {
+	fake.streamAuditEventsMutex.Lock()
+	defer fake.streamAuditEventsMutex.Unlock()
+	fake.StreamAuditEventsStub = stub
+}
+
+func (fake *FakeActor) StreamAuditEventsArgsForCall(i int) (v7action.AuditEventFilter, time.Duration) {
+	fake.streamAuditEventsMutex.RLock()
+	defer fake.streamAuditEventsMutex.RUnlock()
+	argsForCall := fake.streamAuditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) StreamAuditEventsReturns(result1 <-chan v7action.AuditEvent, result2 <-chan v7action.Warnings, result3 <-chan error, result4 context.CancelFunc) {
+	fake.streamAuditEventsMutex.Lock()
+	defer fake.streamAuditEventsMutex.Unlock()
+	fake.StreamAuditEventsStub = nil
+	fake.streamAuditEventsReturns = struct {
+		result1 <-chan v7action.AuditEvent
+		result2 <-chan v7action.Warnings
+		result3 <-chan error
+		result4 context.CancelFunc
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeActor) StreamAuditEventsReturnsOnCall(i int, result1 <-chan v7action.AuditEvent, result2 <-chan v7action.Warnings, result3 <-chan error, result4 context.CancelFunc) {
+	fake.streamAuditEventsMutex.Lock()
+	defer fake.streamAuditEventsMutex.Unlock()
+	fake.StreamAuditEventsStub = nil
+	if fake.streamAuditEventsReturnsOnCall == nil {
+		fake.streamAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 <-chan v7action.AuditEvent
+			result2 <-chan v7action.Warnings
+			result3 <-chan error
+			result4 context.CancelFunc
+		})
+	}
+	fake.streamAuditEventsReturnsOnCall[i] = struct {
+		result1 <-chan v7action.AuditEvent
+		result2 <-chan v7action.Warnings
+		result3 <-chan error
+		result4 context.CancelFunc
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeActor) TerminateTask(arg1 string) (resources.Task, v7action.Warnings, error) {
 	fake.terminateTaskMutex.Lock()
 	ret, specificReturn := fake.terminateTaskReturnsOnCall[len(fake.terminateTaskArgsForCall)]
@@ -17687,6 +20820,72 @@ func (fake *FakeActor) UpdateApplicationReturnsOnCall(i int, result1 resources.A
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	ret, specificReturn := fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)]
+	fake.updateApplicationAnnotationsByApplicationNameArgsForCall = append(fake.updateApplicationAnnotationsByApplicationNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateApplicationAnnotationsByApplicationNameStub
+	fakeReturns := fake.updateApplicationAnnotationsByApplicationNameReturns
+	fake.recordInvocation("UpdateApplicationAnnotationsByApplicationName", []interface{}{arg1, arg2, arg3})
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameCallCount() int {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	return len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = stub
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	argsForCall := fake.updateApplicationAnnotationsByApplicationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	fake.updateApplicationAnnotationsByApplicationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	if fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall == nil {
+		fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateApplicationLabelsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateApplicationLabelsByApplicationNameMutex.Lock()
 	ret, specificReturn := fake.updateApplicationLabelsByApplicationNameReturnsOnCall[len(fake.updateApplicationLabelsByApplicationNameArgsForCall)]
@@ -17753,6 +20952,72 @@ func (fake *FakeActor) UpdateApplicationLabelsByApplicationNameReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStack(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	ret, specificReturn := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)]
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall = append(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub
+	fakeReturns := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns
+	fake.recordInvocation("UpdateBuildpackAnnotationsByBuildpackNameAndStack", []interface{}{arg1, arg2, arg3})
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCallCount() int {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	return len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = stub
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	argsForCall := fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	if fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall == nil {
+		fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateBuildpackByNameAndStack(arg1 string, arg2 string, arg3 resources.Buildpack) (resources.Buildpack, v7action.Warnings, error) {
 	fake.updateBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackByNameAndStackReturnsOnCall[len(fake.updateBuildpackByNameAndStackArgsForCall)]
@@ -17954,6 +21219,71 @@ func (fake *FakeActor) UpdateDestinationReturnsOnCall(i int, result1 v7action.Wa
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	ret, specificReturn := fake.updateDomainAnnotationsByDomainNameReturnsOnCall[len(fake.updateDomainAnnotationsByDomainNameArgsForCall)]
+	fake.updateDomainAnnotationsByDomainNameArgsForCall = append(fake.updateDomainAnnotationsByDomainNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateDomainAnnotationsByDomainNameStub
+	fakeReturns := fake.updateDomainAnnotationsByDomainNameReturns
+	fake.recordInvocation("UpdateDomainAnnotationsByDomainName", []interface{}{arg1, arg2})
+	fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameCallCount() int {
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
+	return len(fake.updateDomainAnnotationsByDomainNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = stub
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
+	argsForCall := fake.updateDomainAnnotationsByDomainNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = nil
+	fake.updateDomainAnnotationsByDomainNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = nil
+	if fake.updateDomainAnnotationsByDomainNameReturnsOnCall == nil {
+		fake.updateDomainAnnotationsByDomainNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateDomainAnnotationsByDomainNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateDomainLabelsByDomainName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateDomainLabelsByDomainNameMutex.Lock()
 	ret, specificReturn := fake.updateDomainLabelsByDomainNameReturnsOnCall[len(fake.updateDomainLabelsByDomainNameArgsForCall)]
@@ -18086,6 +21416,71 @@ func (fake *FakeActor) UpdateManagedServiceInstanceReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)]
+	fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall = append(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateOrganizationAnnotationsByOrganizationNameStub
+	fakeReturns := fake.updateOrganizationAnnotationsByOrganizationNameReturns
+	fake.recordInvocation("UpdateOrganizationAnnotationsByOrganizationName", []interface{}{arg1, arg2})
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameCallCount() int {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	return len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = stub
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	argsForCall := fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	fake.updateOrganizationAnnotationsByOrganizationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	if fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall == nil {
+		fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateOrganizationLabelsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateOrganizationLabelsByOrganizationNameMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationLabelsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationLabelsByOrganizationNameArgsForCall)]
@@ -18244,40 +21639,174 @@ func (fake *FakeActor) UpdateProcessByTypeAndApplicationCallCount() int {
 	return len(fake.updateProcessByTypeAndApplicationArgsForCall)
 }
 
-func (fake *FakeActor) UpdateProcessByTypeAndApplicationCalls(stub func(string, string, resources.Process) (v7action.Warnings, error)) {
-	fake.updateProcessByTypeAndApplicationMutex.Lock()
-	defer fake.updateProcessByTypeAndApplicationMutex.Unlock()
-	fake.UpdateProcessByTypeAndApplicationStub = stub
+func (fake *FakeActor) UpdateProcessByTypeAndApplicationCalls(stub func(string, string, resources.Process) (v7action.Warnings, error)) {
+	fake.updateProcessByTypeAndApplicationMutex.Lock()
+	defer fake.updateProcessByTypeAndApplicationMutex.Unlock()
+	fake.UpdateProcessByTypeAndApplicationStub = stub
+}
+
+func (fake *FakeActor) UpdateProcessByTypeAndApplicationArgsForCall(i int) (string, string, resources.Process) {
+	fake.updateProcessByTypeAndApplicationMutex.RLock()
+	defer fake.updateProcessByTypeAndApplicationMutex.RUnlock()
+	argsForCall := fake.updateProcessByTypeAndApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateProcessByTypeAndApplicationReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateProcessByTypeAndApplicationMutex.Lock()
+	defer fake.updateProcessByTypeAndApplicationMutex.Unlock()
+	fake.UpdateProcessByTypeAndApplicationStub = nil
+	fake.updateProcessByTypeAndApplicationReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateProcessByTypeAndApplicationReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateProcessByTypeAndApplicationMutex.Lock()
+	defer fake.updateProcessByTypeAndApplicationMutex.Unlock()
+	fake.UpdateProcessByTypeAndApplicationStub = nil
+	if fake.updateProcessByTypeAndApplicationReturnsOnCall == nil {
+		fake.updateProcessByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateProcessByTypeAndApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateRoute(arg1 string, arg2 map[string]*string) (resources.Route, v7action.Warnings, error) {
+	fake.updateRouteMutex.Lock()
+	ret, specificReturn := fake.updateRouteReturnsOnCall[len(fake.updateRouteArgsForCall)]
+	fake.updateRouteArgsForCall = append(fake.updateRouteArgsForCall, struct {
+		arg1 string
+		arg2 map[string]*string
+	}{arg1, arg2})
+	stub := fake.UpdateRouteStub
+	fakeReturns := fake.updateRouteReturns
+	fake.recordInvocation("UpdateRoute", []interface{}{arg1, arg2})
+	fake.updateRouteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateRouteCallCount() int {
+	fake.updateRouteMutex.RLock()
+	defer fake.updateRouteMutex.RUnlock()
+	return len(fake.updateRouteArgsForCall)
+}
+
+func (fake *FakeActor) UpdateRouteCalls(stub func(string, map[string]*string) (resources.Route, v7action.Warnings, error)) {
+	fake.updateRouteMutex.Lock()
+	defer fake.updateRouteMutex.Unlock()
+	fake.UpdateRouteStub = stub
+}
+
+func (fake *FakeActor) UpdateRouteArgsForCall(i int) (string, map[string]*string) {
+	fake.updateRouteMutex.RLock()
+	defer fake.updateRouteMutex.RUnlock()
+	argsForCall := fake.updateRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.updateRouteMutex.Lock()
+	defer fake.updateRouteMutex.Unlock()
+	fake.UpdateRouteStub = nil
+	fake.updateRouteReturns = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateRouteReturnsOnCall(i int, result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.updateRouteMutex.Lock()
+	defer fake.updateRouteMutex.Unlock()
+	fake.UpdateRouteStub = nil
+	if fake.updateRouteReturnsOnCall == nil {
+		fake.updateRouteReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateRouteReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateRouteAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateRouteAnnotationsReturnsOnCall[len(fake.updateRouteAnnotationsArgsForCall)]
+	fake.updateRouteAnnotationsArgsForCall = append(fake.updateRouteAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateRouteAnnotationsStub
+	fakeReturns := fake.updateRouteAnnotationsReturns
+	fake.recordInvocation("UpdateRouteAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateRouteAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateRouteAnnotationsCallCount() int {
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
+	return len(fake.updateRouteAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateRouteAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = stub
 }
 
-func (fake *FakeActor) UpdateProcessByTypeAndApplicationArgsForCall(i int) (string, string, resources.Process) {
-	fake.updateProcessByTypeAndApplicationMutex.RLock()
-	defer fake.updateProcessByTypeAndApplicationMutex.RUnlock()
-	argsForCall := fake.updateProcessByTypeAndApplicationArgsForCall[i]
+func (fake *FakeActor) UpdateRouteAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateRouteAnnotationsArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeActor) UpdateProcessByTypeAndApplicationReturns(result1 v7action.Warnings, result2 error) {
-	fake.updateProcessByTypeAndApplicationMutex.Lock()
-	defer fake.updateProcessByTypeAndApplicationMutex.Unlock()
-	fake.UpdateProcessByTypeAndApplicationStub = nil
-	fake.updateProcessByTypeAndApplicationReturns = struct {
+func (fake *FakeActor) UpdateRouteAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = nil
+	fake.updateRouteAnnotationsReturns = struct {
 		result1 v7action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeActor) UpdateProcessByTypeAndApplicationReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
-	fake.updateProcessByTypeAndApplicationMutex.Lock()
-	defer fake.updateProcessByTypeAndApplicationMutex.Unlock()
-	fake.UpdateProcessByTypeAndApplicationStub = nil
-	if fake.updateProcessByTypeAndApplicationReturnsOnCall == nil {
-		fake.updateProcessByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) UpdateRouteAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = nil
+	if fake.updateRouteAnnotationsReturnsOnCall == nil {
+		fake.updateRouteAnnotationsReturnsOnCall = make(map[int]struct {
 			result1 v7action.Warnings
 			result2 error
 		})
 	}
-	fake.updateProcessByTypeAndApplicationReturnsOnCall[i] = struct {
+	fake.updateRouteAnnotationsReturnsOnCall[i] = struct {
 		result1 v7action.Warnings
 		result2 error
 	}{result1, result2}
@@ -18545,6 +22074,71 @@ func (fake *FakeActor) UpdateServiceBrokerReturnsOnCall(i int, result1 v7action.
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	ret, specificReturn := fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall[len(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall)]
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall = append(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub
+	fakeReturns := fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturns
+	fake.recordInvocation("UpdateServiceBrokerAnnotationsByServiceBrokerName", []interface{}{arg1, arg2})
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameCallCount() int {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
+	return len(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = stub
+}
+
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
+	argsForCall := fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = nil
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = nil
+	if fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall == nil {
+		fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateServiceBrokerLabelsByServiceBrokerName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.Lock()
 	ret, specificReturn := fake.updateServiceBrokerLabelsByServiceBrokerNameReturnsOnCall[len(fake.updateServiceBrokerLabelsByServiceBrokerNameArgsForCall)]
@@ -18610,6 +22204,72 @@ func (fake *FakeActor) UpdateServiceBrokerLabelsByServiceBrokerNameReturnsOnCall
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateServiceInstanceAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceAnnotationsReturnsOnCall[len(fake.updateServiceInstanceAnnotationsArgsForCall)]
+	fake.updateServiceInstanceAnnotationsArgsForCall = append(fake.updateServiceInstanceAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateServiceInstanceAnnotationsStub
+	fakeReturns := fake.updateServiceInstanceAnnotationsReturns
+	fake.recordInvocation("UpdateServiceInstanceAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsCallCount() int {
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
+	return len(fake.updateServiceInstanceAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = stub
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServiceInstanceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = nil
+	fake.updateServiceInstanceAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = nil
+	if fake.updateServiceInstanceAnnotationsReturnsOnCall == nil {
+		fake.updateServiceInstanceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceInstanceAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateServiceInstanceLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceInstanceLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServiceInstanceLabelsReturnsOnCall[len(fake.updateServiceInstanceLabelsArgsForCall)]
@@ -18676,6 +22336,72 @@ func (fake *FakeActor) UpdateServiceInstanceLabelsReturnsOnCall(i int, result1 v
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateServiceOfferingAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServiceOfferingAnnotationsReturnsOnCall[len(fake.updateServiceOfferingAnnotationsArgsForCall)]
+	fake.updateServiceOfferingAnnotationsArgsForCall = append(fake.updateServiceOfferingAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateServiceOfferingAnnotationsStub
+	fakeReturns := fake.updateServiceOfferingAnnotationsReturns
+	fake.recordInvocation("UpdateServiceOfferingAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsCallCount() int {
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
+	return len(fake.updateServiceOfferingAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = stub
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServiceOfferingAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = nil
+	fake.updateServiceOfferingAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = nil
+	if fake.updateServiceOfferingAnnotationsReturnsOnCall == nil {
+		fake.updateServiceOfferingAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceOfferingAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateServiceOfferingLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceOfferingLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServiceOfferingLabelsReturnsOnCall[len(fake.updateServiceOfferingLabelsArgsForCall)]
@@ -18742,6 +22468,73 @@ func (fake *FakeActor) UpdateServiceOfferingLabelsReturnsOnCall(i int, result1 v
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateServicePlanAnnotations(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServicePlanAnnotationsReturnsOnCall[len(fake.updateServicePlanAnnotationsArgsForCall)]
+	fake.updateServicePlanAnnotationsArgsForCall = append(fake.updateServicePlanAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateServicePlanAnnotationsStub
+	fakeReturns := fake.updateServicePlanAnnotationsReturns
+	fake.recordInvocation("UpdateServicePlanAnnotations", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateServicePlanAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsCallCount() int {
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
+	return len(fake.updateServicePlanAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsCalls(stub func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = stub
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsArgsForCall(i int) (string, string, string, map[string]types.NullString) {
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServicePlanAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = nil
+	fake.updateServicePlanAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = nil
+	if fake.updateServicePlanAnnotationsReturnsOnCall == nil {
+		fake.updateServicePlanAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServicePlanAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateServicePlanLabels(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServicePlanLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServicePlanLabelsReturnsOnCall[len(fake.updateServicePlanLabelsArgsForCall)]
@@ -18809,6 +22602,72 @@ func (fake *FakeActor) UpdateServicePlanLabelsReturnsOnCall(i int, result1 v7act
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)]
+	fake.updateSpaceAnnotationsBySpaceNameArgsForCall = append(fake.updateSpaceAnnotationsBySpaceNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateSpaceAnnotationsBySpaceNameStub
+	fakeReturns := fake.updateSpaceAnnotationsBySpaceNameReturns
+	fake.recordInvocation("UpdateSpaceAnnotationsBySpaceName", []interface{}{arg1, arg2, arg3})
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameCallCount() int {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	return len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = stub
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	argsForCall := fake.updateSpaceAnnotationsBySpaceNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	fake.updateSpaceAnnotationsBySpaceNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	if fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall == nil {
+		fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateSpaceFeature(arg1 string, arg2 string, arg3 bool, arg4 string) (v7action.Warnings, error) {
 	fake.updateSpaceFeatureMutex.Lock()
 	ret, specificReturn := fake.updateSpaceFeatureReturnsOnCall[len(fake.updateSpaceFeatureArgsForCall)]
@@ -19009,6 +22868,71 @@ func (fake *FakeActor) UpdateSpaceQuotaReturnsOnCall(i int, result1 v7action.War
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateStackAnnotationsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	ret, specificReturn := fake.updateStackAnnotationsByStackNameReturnsOnCall[len(fake.updateStackAnnotationsByStackNameArgsForCall)]
+	fake.updateStackAnnotationsByStackNameArgsForCall = append(fake.updateStackAnnotationsByStackNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateStackAnnotationsByStackNameStub
+	fakeReturns := fake.updateStackAnnotationsByStackNameReturns
+	fake.recordInvocation("UpdateStackAnnotationsByStackName", []interface{}{arg1, arg2})
+	fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameCallCount() int {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	return len(fake.updateStackAnnotationsByStackNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = stub
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	argsForCall := fake.updateStackAnnotationsByStackNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	fake.updateStackAnnotationsByStackNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	if fake.updateStackAnnotationsByStackNameReturnsOnCall == nil {
+		fake.updateStackAnnotationsByStackNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateStackAnnotationsByStackNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateStackLabelsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateStackLabelsByStackNameMutex.Lock()
 	ret, specificReturn := fake.updateStackLabelsByStackNameReturnsOnCall[len(fake.updateStackLabelsByStackNameArgsForCall)]
@@ -19482,6 +23406,71 @@ func (fake *FakeActor) UploadDropletReturnsOnCall(i int, result1 v7action.Warnin
 	}{result1, result2}
 }
 
+func (fake *FakeActor) WriteBitsPackageArchive(arg1 string, arg2 string) (string, error) {
+	fake.writeBitsPackageArchiveMutex.Lock()
+	ret, specificReturn := fake.writeBitsPackageArchiveReturnsOnCall[len(fake.writeBitsPackageArchiveArgsForCall)]
+	fake.writeBitsPackageArchiveArgsForCall = append(fake.writeBitsPackageArchiveArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.WriteBitsPackageArchiveStub
+	fakeReturns := fake.writeBitsPackageArchiveReturns
+	fake.recordInvocation("WriteBitsPackageArchive", []interface{}{arg1, arg2})
+	fake.writeBitsPackageArchiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) WriteBitsPackageArchiveCallCount() int {
+	fake.writeBitsPackageArchiveMutex.RLock()
+	defer fake.writeBitsPackageArchiveMutex.RUnlock()
+	return len(fake.writeBitsPackageArchiveArgsForCall)
+}
+
+func (fake *FakeActor) WriteBitsPackageArchiveCalls(stub func(string, string) (string, error)) {
+	fake.writeBitsPackageArchiveMutex.Lock()
+	defer fake.writeBitsPackageArchiveMutex.Unlock()
+	fake.WriteBitsPackageArchiveStub = stub
+}
+
+func (fake *FakeActor) WriteBitsPackageArchiveArgsForCall(i int) (string, string) {
+	fake.writeBitsPackageArchiveMutex.RLock()
+	defer fake.writeBitsPackageArchiveMutex.RUnlock()
+	argsForCall := fake.writeBitsPackageArchiveArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) WriteBitsPackageArchiveReturns(result1 string, result2 error) {
+	fake.writeBitsPackageArchiveMutex.Lock()
+	defer fake.writeBitsPackageArchiveMutex.Unlock()
+	fake.WriteBitsPackageArchiveStub = nil
+	fake.writeBitsPackageArchiveReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) WriteBitsPackageArchiveReturnsOnCall(i int, result1 string, result2 error) {
+	fake.writeBitsPackageArchiveMutex.Lock()
+	defer fake.writeBitsPackageArchiveMutex.Unlock()
+	fake.WriteBitsPackageArchiveStub = nil
+	if fake.writeBitsPackageArchiveReturnsOnCall == nil {
+		fake.writeBitsPackageArchiveReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.writeBitsPackageArchiveReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -19493,6 +23482,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.assignIsolationSegmentToSpaceByNameAndSpaceMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
 	fake.bindSecurityGroupToSpacesMutex.RLock()
 	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
 	fake.cancelDeploymentMutex.RLock()
@@ -19501,6 +23494,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.checkRouteMutex.RUnlock()
 	fake.clearTargetMutex.RLock()
 	defer fake.clearTargetMutex.RUnlock()
+	fake.continueDeploymentMutex.RLock()
+	defer fake.continueDeploymentMutex.RUnlock()
 	fake.copyPackageMutex.RLock()
 	defer fake.copyPackageMutex.RUnlock()
 	fake.createAndUploadBitsPackageByApplicationNameAndSpaceMutex.RLock()
@@ -19509,6 +23504,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
 	defer fake.createApplicationInSpaceMutex.RUnlock()
+	fake.createApplicationSidecarMutex.RLock()
+	defer fake.createApplicationSidecarMutex.RUnlock()
 	fake.createBitsPackageByApplicationMutex.RLock()
 	defer fake.createBitsPackageByApplicationMutex.RUnlock()
 	fake.createBuildpackMutex.RLock()
@@ -19559,6 +23556,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
 	fake.deleteApplicationByNameAndSpaceMutex.RLock()
 	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	fake.deleteApplicationSidecarMutex.RLock()
+	defer fake.deleteApplicationSidecarMutex.RUnlock()
 	fake.deleteBuildpackByNameAndStackMutex.RLock()
 	defer fake.deleteBuildpackByNameAndStackMutex.RUnlock()
 	fake.deleteDomainMutex.RLock()
@@ -19619,6 +23618,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getAppFeatureMutex.RUnlock()
 	fake.getAppSummariesForSpaceMutex.RLock()
 	defer fake.getAppSummariesForSpaceMutex.RUnlock()
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
@@ -19635,10 +23638,16 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
 	fake.getBuildpackLabelsMutex.RLock()
 	defer fake.getBuildpackLabelsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
@@ -19651,6 +23660,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getDetailedAppSummaryMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
+	fake.getDomainAnnotationsMutex.RLock()
+	defer fake.getDomainAnnotationsMutex.RUnlock()
 	fake.getDomainByNameMutex.RLock()
 	defer fake.getDomainByNameMutex.RUnlock()
 	fake.getDomainLabelsMutex.RLock()
@@ -19665,6 +23676,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getFeatureFlagByNameMutex.RUnlock()
 	fake.getFeatureFlagsMutex.RLock()
 	defer fake.getFeatureFlagsMutex.RUnlock()
+	fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getFilteredStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getGlobalRunningSecurityGroupsMutex.RLock()
 	defer fake.getGlobalRunningSecurityGroupsMutex.RUnlock()
 	fake.getGlobalStagingSecurityGroupsMutex.RLock()
@@ -19683,6 +23698,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getNewestReadyPackageForApplicationMutex.RUnlock()
 	fake.getOrgUsersByRoleTypeMutex.RLock()
 	defer fake.getOrgUsersByRoleTypeMutex.RUnlock()
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
 	defer fake.getOrganizationByNameMutex.RUnlock()
 	fake.getOrganizationDomainsMutex.RLock()
@@ -19709,12 +23726,16 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getRecentLogsForTaskMutex.RLock()
+	defer fake.getRecentLogsForTaskMutex.RUnlock()
 	fake.getRevisionByApplicationAndVersionMutex.RLock()
 	defer fake.getRevisionByApplicationAndVersionMutex.RUnlock()
 	fake.getRevisionsByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getRevisionsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRootResponseMutex.RLock()
 	defer fake.getRootResponseMutex.RUnlock()
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
@@ -19735,8 +23756,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSSHEnabledByAppNameMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
+	fake.getScheduledTasksMutex.RLock()
+	defer fake.getScheduledTasksMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RUnlock()
 	fake.getSecurityGroupMutex.RLock()
 	defer fake.getSecurityGroupMutex.RUnlock()
 	fake.getSecurityGroupSummaryMutex.RLock()
@@ -19745,12 +23770,20 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getServiceAccessMutex.RLock()
 	defer fake.getServiceAccessMutex.RUnlock()
+	fake.getServiceAppBindingByServiceInstanceAndAppMutex.RLock()
+	defer fake.getServiceAppBindingByServiceInstanceAndAppMutex.RUnlock()
+	fake.getServiceBrokerAnnotationsMutex.RLock()
+	defer fake.getServiceBrokerAnnotationsMutex.RUnlock()
 	fake.getServiceBrokerByNameMutex.RLock()
 	defer fake.getServiceBrokerByNameMutex.RUnlock()
 	fake.getServiceBrokerLabelsMutex.RLock()
 	defer fake.getServiceBrokerLabelsMutex.RUnlock()
 	fake.getServiceBrokersMutex.RLock()
 	defer fake.getServiceBrokersMutex.RUnlock()
+	fake.getServiceCredentialBindingParametersMutex.RLock()
+	defer fake.getServiceCredentialBindingParametersMutex.RUnlock()
+	fake.getServiceInstanceAnnotationsMutex.RLock()
+	defer fake.getServiceInstanceAnnotationsMutex.RUnlock()
 	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
 	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
 	fake.getServiceInstanceDetailsMutex.RLock()
@@ -19767,12 +23800,20 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RUnlock()
 	fake.getServiceKeysByServiceInstanceMutex.RLock()
 	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
+	fake.getServiceOfferingAnnotationsMutex.RLock()
+	defer fake.getServiceOfferingAnnotationsMutex.RUnlock()
 	fake.getServiceOfferingLabelsMutex.RLock()
 	defer fake.getServiceOfferingLabelsMutex.RUnlock()
+	fake.getServicePlanAnnotationsMutex.RLock()
+	defer fake.getServicePlanAnnotationsMutex.RUnlock()
 	fake.getServicePlanByNameOfferingAndBrokerMutex.RLock()
 	defer fake.getServicePlanByNameOfferingAndBrokerMutex.RUnlock()
 	fake.getServicePlanLabelsMutex.RLock()
 	defer fake.getServicePlanLabelsMutex.RUnlock()
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
 	fake.getSpaceByNameAndOrganizationMutex.RLock()
 	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
 	fake.getSpaceFeatureMutex.RLock()
@@ -19787,6 +23828,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSpaceSummaryByNameAndOrganizationMutex.RUnlock()
 	fake.getSpaceUsersByRoleTypeMutex.RLock()
 	defer fake.getSpaceUsersByRoleTypeMutex.RUnlock()
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
 	fake.getStackByNameMutex.RLock()
 	defer fake.getStackByNameMutex.RUnlock()
 	fake.getStackLabelsMutex.RLock()
@@ -19795,6 +23838,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getStacksMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForTaskMutex.RLock()
+	defer fake.getStreamingLogsForTaskMutex.RUnlock()
+	fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingMetricsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getTaskByNameAndApplicationMutex.RLock()
+	defer fake.getTaskByNameAndApplicationMutex.RUnlock()
 	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
 	defer fake.getTaskBySequenceIDAndApplicationMutex.RUnlock()
 	fake.getUAAAPIVersionMutex.RLock()
@@ -19819,8 +23868,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.pollPackageMutex.RUnlock()
 	fake.pollStartMutex.RLock()
 	defer fake.pollStartMutex.RUnlock()
-	fake.pollStartForRollingMutex.RLock()
-	defer fake.pollStartForRollingMutex.RUnlock()
+	fake.pollStartForDeploymentMutex.RLock()
+	defer fake.pollStartForDeploymentMutex.RUnlock()
 	fake.pollTaskMutex.RLock()
 	defer fake.pollTaskMutex.RUnlock()
 	fake.pollUploadBuildpackJobMutex.RLock()
@@ -19831,6 +23880,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.purgeServiceInstanceMutex.RUnlock()
 	fake.purgeServiceOfferingByNameAndBrokerMutex.RLock()
 	defer fake.purgeServiceOfferingByNameAndBrokerMutex.RUnlock()
+	fake.queryMetricsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.queryMetricsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
 	fake.renameApplicationByNameAndSpaceGUIDMutex.RLock()
@@ -19851,6 +23902,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.restartApplicationMutex.RUnlock()
 	fake.revokeAccessAndRefreshTokensMutex.RLock()
 	defer fake.revokeAccessAndRefreshTokensMutex.RUnlock()
+	fake.runScheduledTaskMutex.RLock()
+	defer fake.runScheduledTaskMutex.RUnlock()
 	fake.runTaskMutex.RLock()
 	defer fake.runTaskMutex.RUnlock()
 	fake.scaleProcessByApplicationMutex.RLock()
@@ -19865,6 +23918,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.setApplicationManifestMutex.RUnlock()
 	fake.setApplicationProcessHealthCheckTypeByNameAndSpaceMutex.RLock()
 	defer fake.setApplicationProcessHealthCheckTypeByNameAndSpaceMutex.RUnlock()
+	fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RLock()
+	defer fake.setApplicationProcessReadinessHealthCheckByNameAndSpaceMutex.RUnlock()
 	fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RLock()
 	defer fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RUnlock()
 	fake.setEnvironmentVariableGroupMutex.RLock()
@@ -19887,8 +23942,12 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.stagePackageMutex.RUnlock()
 	fake.startApplicationMutex.RLock()
 	defer fake.startApplicationMutex.RUnlock()
+	fake.startDeviceAuthorizationMutex.RLock()
+	defer fake.startDeviceAuthorizationMutex.RUnlock()
 	fake.stopApplicationMutex.RLock()
 	defer fake.stopApplicationMutex.RUnlock()
+	fake.streamAuditEventsMutex.RLock()
+	defer fake.streamAuditEventsMutex.RUnlock()
 	fake.terminateTaskMutex.RLock()
 	defer fake.terminateTaskMutex.RUnlock()
 	fake.unbindSecurityGroupMutex.RLock()
@@ -19909,24 +23968,36 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.updateAppFeatureMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
 	fake.updateApplicationLabelsByApplicationNameMutex.RLock()
 	defer fake.updateApplicationLabelsByApplicationNameMutex.RUnlock()
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
 	fake.updateBuildpackByNameAndStackMutex.RLock()
 	defer fake.updateBuildpackByNameAndStackMutex.RUnlock()
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RLock()
 	defer fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RUnlock()
 	fake.updateDestinationMutex.RLock()
 	defer fake.updateDestinationMutex.RUnlock()
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
 	fake.updateDomainLabelsByDomainNameMutex.RLock()
 	defer fake.updateDomainLabelsByDomainNameMutex.RUnlock()
 	fake.updateManagedServiceInstanceMutex.RLock()
 	defer fake.updateManagedServiceInstanceMutex.RUnlock()
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
 	fake.updateOrganizationLabelsByOrganizationNameMutex.RLock()
 	defer fake.updateOrganizationLabelsByOrganizationNameMutex.RUnlock()
 	fake.updateOrganizationQuotaMutex.RLock()
 	defer fake.updateOrganizationQuotaMutex.RUnlock()
 	fake.updateProcessByTypeAndApplicationMutex.RLock()
 	defer fake.updateProcessByTypeAndApplicationMutex.RUnlock()
+	fake.updateRouteMutex.RLock()
+	defer fake.updateRouteMutex.RUnlock()
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
 	fake.updateRouteLabelsMutex.RLock()
 	defer fake.updateRouteLabelsMutex.RUnlock()
 	fake.updateSecurityGroupMutex.RLock()
@@ -19935,20 +24006,32 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.updateSecurityGroupGloballyEnabledMutex.RUnlock()
 	fake.updateServiceBrokerMutex.RLock()
 	defer fake.updateServiceBrokerMutex.RUnlock()
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
 	fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.RLock()
 	defer fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.RUnlock()
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
 	fake.updateServiceInstanceLabelsMutex.RLock()
 	defer fake.updateServiceInstanceLabelsMutex.RUnlock()
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
 	fake.updateServiceOfferingLabelsMutex.RLock()
 	defer fake.updateServiceOfferingLabelsMutex.RUnlock()
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
 	fake.updateServicePlanLabelsMutex.RLock()
 	defer fake.updateServicePlanLabelsMutex.RUnlock()
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
 	fake.updateSpaceFeatureMutex.RLock()
 	defer fake.updateSpaceFeatureMutex.RUnlock()
 	fake.updateSpaceLabelsBySpaceNameMutex.RLock()
 	defer fake.updateSpaceLabelsBySpaceNameMutex.RUnlock()
 	fake.updateSpaceQuotaMutex.RLock()
 	defer fake.updateSpaceQuotaMutex.RUnlock()
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
 	fake.updateStackLabelsByStackNameMutex.RLock()
 	defer fake.updateStackLabelsByStackNameMutex.RUnlock()
 	fake.updateUserPasswordMutex.RLock()
@@ -19963,6 +24046,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.uploadBuildpackMutex.RUnlock()
 	fake.uploadDropletMutex.RLock()
 	defer fake.uploadDropletMutex.RUnlock()
+	fake.writeBitsPackageArchiveMutex.RLock()
+	defer fake.writeBitsPackageArchiveMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
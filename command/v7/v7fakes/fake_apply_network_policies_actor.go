@@ -0,0 +1,131 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeApplyNetworkPoliciesActor struct {
+	ApplyNetworkPoliciesStub        func(string, []cfnetworkingaction.Policy, bool) (cfnetworkingaction.NetworkPolicyChanges, cfnetworkingaction.Warnings, error)
+	applyNetworkPoliciesMutex       sync.RWMutex
+	applyNetworkPoliciesArgsForCall []struct {
+		arg1 string
+		arg2 []cfnetworkingaction.Policy
+		arg3 bool
+	}
+	applyNetworkPoliciesReturns struct {
+		result1 cfnetworkingaction.NetworkPolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	applyNetworkPoliciesReturnsOnCall map[int]struct {
+		result1 cfnetworkingaction.NetworkPolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyNetworkPolicies(arg1 string, arg2 []cfnetworkingaction.Policy, arg3 bool) (cfnetworkingaction.NetworkPolicyChanges, cfnetworkingaction.Warnings, error) {
+	var arg2Copy []cfnetworkingaction.Policy
+	if arg2 != nil {
+		arg2Copy = make([]cfnetworkingaction.Policy, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.applyNetworkPoliciesMutex.Lock()
+	ret, specificReturn := fake.applyNetworkPoliciesReturnsOnCall[len(fake.applyNetworkPoliciesArgsForCall)]
+	fake.applyNetworkPoliciesArgsForCall = append(fake.applyNetworkPoliciesArgsForCall, struct {
+		arg1 string
+		arg2 []cfnetworkingaction.Policy
+		arg3 bool
+	}{arg1, arg2Copy, arg3})
+	stub := fake.ApplyNetworkPoliciesStub
+	fakeReturns := fake.applyNetworkPoliciesReturns
+	fake.recordInvocation("ApplyNetworkPolicies", []interface{}{arg1, arg2Copy, arg3})
+	fake.applyNetworkPoliciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyNetworkPoliciesCallCount() int {
+	fake.applyNetworkPoliciesMutex.RLock()
+	defer fake.applyNetworkPoliciesMutex.RUnlock()
+	return len(fake.applyNetworkPoliciesArgsForCall)
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyNetworkPoliciesCalls(stub func(string, []cfnetworkingaction.Policy, bool) (cfnetworkingaction.NetworkPolicyChanges, cfnetworkingaction.Warnings, error)) {
+	fake.applyNetworkPoliciesMutex.Lock()
+	defer fake.applyNetworkPoliciesMutex.Unlock()
+	fake.ApplyNetworkPoliciesStub = stub
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyNetworkPoliciesArgsForCall(i int) (string, []cfnetworkingaction.Policy, bool) {
+	fake.applyNetworkPoliciesMutex.RLock()
+	defer fake.applyNetworkPoliciesMutex.RUnlock()
+	argsForCall := fake.applyNetworkPoliciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyNetworkPoliciesReturns(result1 cfnetworkingaction.NetworkPolicyChanges, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.applyNetworkPoliciesMutex.Lock()
+	defer fake.applyNetworkPoliciesMutex.Unlock()
+	fake.ApplyNetworkPoliciesStub = nil
+	fake.applyNetworkPoliciesReturns = struct {
+		result1 cfnetworkingaction.NetworkPolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) ApplyNetworkPoliciesReturnsOnCall(i int, result1 cfnetworkingaction.NetworkPolicyChanges, result2 cfnetworkingaction.Warnings, result3 error) {
+	fake.applyNetworkPoliciesMutex.Lock()
+	defer fake.applyNetworkPoliciesMutex.Unlock()
+	fake.ApplyNetworkPoliciesStub = nil
+	if fake.applyNetworkPoliciesReturnsOnCall == nil {
+		fake.applyNetworkPoliciesReturnsOnCall = make(map[int]struct {
+			result1 cfnetworkingaction.NetworkPolicyChanges
+			result2 cfnetworkingaction.Warnings
+			result3 error
+		})
+	}
+	fake.applyNetworkPoliciesReturnsOnCall[i] = struct {
+		result1 cfnetworkingaction.NetworkPolicyChanges
+		result2 cfnetworkingaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.applyNetworkPoliciesMutex.RLock()
+	defer fake.applyNetworkPoliciesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeApplyNetworkPoliciesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ApplyNetworkPoliciesActor = new(FakeApplyNetworkPoliciesActor)
@@ -4,6 +4,7 @@ package v7fakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7pushaction"
 	v7 "code.cloudfoundry.org/cli/command/v7"
@@ -23,6 +24,18 @@ type FakePushActor struct {
 	actualizeReturnsOnCall map[int]struct {
 		result1 <-chan *v7pushaction.PushEvent
 	}
+	ActualizeInParallelStub        func([]v7pushaction.PushPlan, int) <-chan *v7pushaction.PushEvent
+	actualizeInParallelMutex       sync.RWMutex
+	actualizeInParallelArgsForCall []struct {
+		arg1 []v7pushaction.PushPlan
+		arg2 int
+	}
+	actualizeInParallelReturns struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}
+	actualizeInParallelReturnsOnCall map[int]struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}
 	CreatePushPlansStub        func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
 	createPushPlansMutex       sync.RWMutex
 	createPushPlansArgsForCall []struct {
@@ -41,6 +54,19 @@ type FakePushActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetBitsPackageChecksumStub        func(v7pushaction.PushPlan) (string, error)
+	getBitsPackageChecksumMutex       sync.RWMutex
+	getBitsPackageChecksumArgsForCall []struct {
+		arg1 v7pushaction.PushPlan
+	}
+	getBitsPackageChecksumReturns struct {
+		result1 string
+		result2 error
+	}
+	getBitsPackageChecksumReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	HandleFlagOverridesStub        func(manifestparser.Manifest, v7pushaction.FlagOverrides) (manifestparser.Manifest, error)
 	handleFlagOverridesMutex       sync.RWMutex
 	handleFlagOverridesArgsForCall []struct {
@@ -55,6 +81,23 @@ type FakePushActor struct {
 		result1 manifestparser.Manifest
 		result2 error
 	}
+	MatchResourcesStub        func([]sharedaction.V3Resource) ([]sharedaction.V3Resource, []sharedaction.V3Resource, v7pushaction.Warnings, error)
+	matchResourcesMutex       sync.RWMutex
+	matchResourcesArgsForCall []struct {
+		arg1 []sharedaction.V3Resource
+	}
+	matchResourcesReturns struct {
+		result1 []sharedaction.V3Resource
+		result2 []sharedaction.V3Resource
+		result3 v7pushaction.Warnings
+		result4 error
+	}
+	matchResourcesReturnsOnCall map[int]struct {
+		result1 []sharedaction.V3Resource
+		result2 []sharedaction.V3Resource
+		result3 v7pushaction.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -66,15 +109,16 @@ func (fake *FakePushActor) Actualize(arg1 v7pushaction.PushPlan, arg2 v7pushacti
 		arg1 v7pushaction.PushPlan
 		arg2 v7pushaction.ProgressBar
 	}{arg1, arg2})
+	stub := fake.ActualizeStub
+	fakeReturns := fake.actualizeReturns
 	fake.recordInvocation("Actualize", []interface{}{arg1, arg2})
 	fake.actualizeMutex.Unlock()
-	if fake.ActualizeStub != nil {
-		return fake.ActualizeStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.actualizeReturns
 	return fakeReturns.result1
 }
 
@@ -120,6 +164,73 @@ func (fake *FakePushActor) ActualizeReturnsOnCall(i int, result1 <-chan *v7pusha
 	}{result1}
 }
 
+func (fake *FakePushActor) ActualizeInParallel(arg1 []v7pushaction.PushPlan, arg2 int) <-chan *v7pushaction.PushEvent {
+	var arg1Copy []v7pushaction.PushPlan
+	if arg1 != nil {
+		arg1Copy = make([]v7pushaction.PushPlan, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.actualizeInParallelMutex.Lock()
+	ret, specificReturn := fake.actualizeInParallelReturnsOnCall[len(fake.actualizeInParallelArgsForCall)]
+	fake.actualizeInParallelArgsForCall = append(fake.actualizeInParallelArgsForCall, struct {
+		arg1 []v7pushaction.PushPlan
+		arg2 int
+	}{arg1Copy, arg2})
+	stub := fake.ActualizeInParallelStub
+	fakeReturns := fake.actualizeInParallelReturns
+	fake.recordInvocation("ActualizeInParallel", []interface{}{arg1Copy, arg2})
+	fake.actualizeInParallelMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePushActor) ActualizeInParallelCallCount() int {
+	fake.actualizeInParallelMutex.RLock()
+	defer fake.actualizeInParallelMutex.RUnlock()
+	return len(fake.actualizeInParallelArgsForCall)
+}
+
+func (fake *FakePushActor) ActualizeInParallelCalls(stub func([]v7pushaction.PushPlan, int) <-chan *v7pushaction.PushEvent) {
+	fake.actualizeInParallelMutex.Lock()
+	defer fake.actualizeInParallelMutex.Unlock()
+	fake.ActualizeInParallelStub = stub
+}
+
+func (fake *FakePushActor) ActualizeInParallelArgsForCall(i int) ([]v7pushaction.PushPlan, int) {
+	fake.actualizeInParallelMutex.RLock()
+	defer fake.actualizeInParallelMutex.RUnlock()
+	argsForCall := fake.actualizeInParallelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePushActor) ActualizeInParallelReturns(result1 <-chan *v7pushaction.PushEvent) {
+	fake.actualizeInParallelMutex.Lock()
+	defer fake.actualizeInParallelMutex.Unlock()
+	fake.ActualizeInParallelStub = nil
+	fake.actualizeInParallelReturns = struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}{result1}
+}
+
+func (fake *FakePushActor) ActualizeInParallelReturnsOnCall(i int, result1 <-chan *v7pushaction.PushEvent) {
+	fake.actualizeInParallelMutex.Lock()
+	defer fake.actualizeInParallelMutex.Unlock()
+	fake.ActualizeInParallelStub = nil
+	if fake.actualizeInParallelReturnsOnCall == nil {
+		fake.actualizeInParallelReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v7pushaction.PushEvent
+		})
+	}
+	fake.actualizeInParallelReturnsOnCall[i] = struct {
+		result1 <-chan *v7pushaction.PushEvent
+	}{result1}
+}
+
 func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error) {
 	fake.createPushPlansMutex.Lock()
 	ret, specificReturn := fake.createPushPlansReturnsOnCall[len(fake.createPushPlansArgsForCall)]
@@ -129,15 +240,16 @@ func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 manife
 		arg3 manifestparser.Manifest
 		arg4 v7pushaction.FlagOverrides
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.CreatePushPlansStub
+	fakeReturns := fake.createPushPlansReturns
 	fake.recordInvocation("CreatePushPlans", []interface{}{arg1, arg2, arg3, arg4})
 	fake.createPushPlansMutex.Unlock()
-	if fake.CreatePushPlansStub != nil {
-		return fake.CreatePushPlansStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.createPushPlansReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -189,6 +301,70 @@ func (fake *FakePushActor) CreatePushPlansReturnsOnCall(i int, result1 []v7pusha
 	}{result1, result2, result3}
 }
 
+func (fake *FakePushActor) GetBitsPackageChecksum(arg1 v7pushaction.PushPlan) (string, error) {
+	fake.getBitsPackageChecksumMutex.Lock()
+	ret, specificReturn := fake.getBitsPackageChecksumReturnsOnCall[len(fake.getBitsPackageChecksumArgsForCall)]
+	fake.getBitsPackageChecksumArgsForCall = append(fake.getBitsPackageChecksumArgsForCall, struct {
+		arg1 v7pushaction.PushPlan
+	}{arg1})
+	stub := fake.GetBitsPackageChecksumStub
+	fakeReturns := fake.getBitsPackageChecksumReturns
+	fake.recordInvocation("GetBitsPackageChecksum", []interface{}{arg1})
+	fake.getBitsPackageChecksumMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePushActor) GetBitsPackageChecksumCallCount() int {
+	fake.getBitsPackageChecksumMutex.RLock()
+	defer fake.getBitsPackageChecksumMutex.RUnlock()
+	return len(fake.getBitsPackageChecksumArgsForCall)
+}
+
+func (fake *FakePushActor) GetBitsPackageChecksumCalls(stub func(v7pushaction.PushPlan) (string, error)) {
+	fake.getBitsPackageChecksumMutex.Lock()
+	defer fake.getBitsPackageChecksumMutex.Unlock()
+	fake.GetBitsPackageChecksumStub = stub
+}
+
+func (fake *FakePushActor) GetBitsPackageChecksumArgsForCall(i int) v7pushaction.PushPlan {
+	fake.getBitsPackageChecksumMutex.RLock()
+	defer fake.getBitsPackageChecksumMutex.RUnlock()
+	argsForCall := fake.getBitsPackageChecksumArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePushActor) GetBitsPackageChecksumReturns(result1 string, result2 error) {
+	fake.getBitsPackageChecksumMutex.Lock()
+	defer fake.getBitsPackageChecksumMutex.Unlock()
+	fake.GetBitsPackageChecksumStub = nil
+	fake.getBitsPackageChecksumReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushActor) GetBitsPackageChecksumReturnsOnCall(i int, result1 string, result2 error) {
+	fake.getBitsPackageChecksumMutex.Lock()
+	defer fake.getBitsPackageChecksumMutex.Unlock()
+	fake.GetBitsPackageChecksumStub = nil
+	if fake.getBitsPackageChecksumReturnsOnCall == nil {
+		fake.getBitsPackageChecksumReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getBitsPackageChecksumReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePushActor) HandleFlagOverrides(arg1 manifestparser.Manifest, arg2 v7pushaction.FlagOverrides) (manifestparser.Manifest, error) {
 	fake.handleFlagOverridesMutex.Lock()
 	ret, specificReturn := fake.handleFlagOverridesReturnsOnCall[len(fake.handleFlagOverridesArgsForCall)]
@@ -196,15 +372,16 @@ func (fake *FakePushActor) HandleFlagOverrides(arg1 manifestparser.Manifest, arg
 		arg1 manifestparser.Manifest
 		arg2 v7pushaction.FlagOverrides
 	}{arg1, arg2})
+	stub := fake.HandleFlagOverridesStub
+	fakeReturns := fake.handleFlagOverridesReturns
 	fake.recordInvocation("HandleFlagOverrides", []interface{}{arg1, arg2})
 	fake.handleFlagOverridesMutex.Unlock()
-	if fake.HandleFlagOverridesStub != nil {
-		return fake.HandleFlagOverridesStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.handleFlagOverridesReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -253,15 +430,96 @@ func (fake *FakePushActor) HandleFlagOverridesReturnsOnCall(i int, result1 manif
 	}{result1, result2}
 }
 
+func (fake *FakePushActor) MatchResources(arg1 []sharedaction.V3Resource) ([]sharedaction.V3Resource, []sharedaction.V3Resource, v7pushaction.Warnings, error) {
+	var arg1Copy []sharedaction.V3Resource
+	if arg1 != nil {
+		arg1Copy = make([]sharedaction.V3Resource, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.matchResourcesMutex.Lock()
+	ret, specificReturn := fake.matchResourcesReturnsOnCall[len(fake.matchResourcesArgsForCall)]
+	fake.matchResourcesArgsForCall = append(fake.matchResourcesArgsForCall, struct {
+		arg1 []sharedaction.V3Resource
+	}{arg1Copy})
+	stub := fake.MatchResourcesStub
+	fakeReturns := fake.matchResourcesReturns
+	fake.recordInvocation("MatchResources", []interface{}{arg1Copy})
+	fake.matchResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakePushActor) MatchResourcesCallCount() int {
+	fake.matchResourcesMutex.RLock()
+	defer fake.matchResourcesMutex.RUnlock()
+	return len(fake.matchResourcesArgsForCall)
+}
+
+func (fake *FakePushActor) MatchResourcesCalls(stub func([]sharedaction.V3Resource) ([]sharedaction.V3Resource, []sharedaction.V3Resource, v7pushaction.Warnings, error)) {
+	fake.matchResourcesMutex.Lock()
+	defer fake.matchResourcesMutex.Unlock()
+	fake.MatchResourcesStub = stub
+}
+
+func (fake *FakePushActor) MatchResourcesArgsForCall(i int) []sharedaction.V3Resource {
+	fake.matchResourcesMutex.RLock()
+	defer fake.matchResourcesMutex.RUnlock()
+	argsForCall := fake.matchResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePushActor) MatchResourcesReturns(result1 []sharedaction.V3Resource, result2 []sharedaction.V3Resource, result3 v7pushaction.Warnings, result4 error) {
+	fake.matchResourcesMutex.Lock()
+	defer fake.matchResourcesMutex.Unlock()
+	fake.MatchResourcesStub = nil
+	fake.matchResourcesReturns = struct {
+		result1 []sharedaction.V3Resource
+		result2 []sharedaction.V3Resource
+		result3 v7pushaction.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakePushActor) MatchResourcesReturnsOnCall(i int, result1 []sharedaction.V3Resource, result2 []sharedaction.V3Resource, result3 v7pushaction.Warnings, result4 error) {
+	fake.matchResourcesMutex.Lock()
+	defer fake.matchResourcesMutex.Unlock()
+	fake.MatchResourcesStub = nil
+	if fake.matchResourcesReturnsOnCall == nil {
+		fake.matchResourcesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.V3Resource
+			result2 []sharedaction.V3Resource
+			result3 v7pushaction.Warnings
+			result4 error
+		})
+	}
+	fake.matchResourcesReturnsOnCall[i] = struct {
+		result1 []sharedaction.V3Resource
+		result2 []sharedaction.V3Resource
+		result3 v7pushaction.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualizeMutex.RLock()
 	defer fake.actualizeMutex.RUnlock()
+	fake.actualizeInParallelMutex.RLock()
+	defer fake.actualizeInParallelMutex.RUnlock()
 	fake.createPushPlansMutex.RLock()
 	defer fake.createPushPlansMutex.RUnlock()
+	fake.getBitsPackageChecksumMutex.RLock()
+	defer fake.getBitsPackageChecksumMutex.RUnlock()
 	fake.handleFlagOverridesMutex.RLock()
 	defer fake.handleFlagOverridesMutex.RUnlock()
+	fake.matchResourcesMutex.RLock()
+	defer fake.matchResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
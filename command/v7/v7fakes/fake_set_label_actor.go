@@ -23,6 +23,21 @@ type FakeSetLabelActor struct {
 		result1 configv3.User
 		result2 error
 	}
+	UpdateApplicationAnnotationsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateApplicationAnnotationsByApplicationNameMutex       sync.RWMutex
+	updateApplicationAnnotationsByApplicationNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateApplicationAnnotationsByApplicationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateApplicationAnnotationsByApplicationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateApplicationLabelsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateApplicationLabelsByApplicationNameMutex       sync.RWMutex
 	updateApplicationLabelsByApplicationNameArgsForCall []struct {
@@ -38,6 +53,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateBuildpackAnnotationsByBuildpackNameAndStackStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateBuildpackAnnotationsByBuildpackNameAndStackMutex       sync.RWMutex
+	updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateBuildpackLabelsByBuildpackNameAndStackStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateBuildpackLabelsByBuildpackNameAndStackMutex       sync.RWMutex
 	updateBuildpackLabelsByBuildpackNameAndStackArgsForCall []struct {
@@ -53,6 +83,20 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateDomainAnnotationsByDomainNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateDomainAnnotationsByDomainNameMutex       sync.RWMutex
+	updateDomainAnnotationsByDomainNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateDomainAnnotationsByDomainNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateDomainAnnotationsByDomainNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateDomainLabelsByDomainNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateDomainLabelsByDomainNameMutex       sync.RWMutex
 	updateDomainLabelsByDomainNameArgsForCall []struct {
@@ -67,6 +111,20 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateOrganizationAnnotationsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateOrganizationAnnotationsByOrganizationNameMutex       sync.RWMutex
+	updateOrganizationAnnotationsByOrganizationNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateOrganizationLabelsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateOrganizationLabelsByOrganizationNameMutex       sync.RWMutex
 	updateOrganizationLabelsByOrganizationNameArgsForCall []struct {
@@ -81,6 +139,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateRouteAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateRouteAnnotationsMutex       sync.RWMutex
+	updateRouteAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateRouteAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateRouteAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateRouteLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateRouteLabelsMutex       sync.RWMutex
 	updateRouteLabelsArgsForCall []struct {
@@ -96,6 +169,20 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceBrokerAnnotationsByServiceBrokerNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceBrokerAnnotationsByServiceBrokerNameMutex       sync.RWMutex
+	updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateServiceBrokerAnnotationsByServiceBrokerNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceBrokerLabelsByServiceBrokerNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceBrokerLabelsByServiceBrokerNameMutex       sync.RWMutex
 	updateServiceBrokerLabelsByServiceBrokerNameArgsForCall []struct {
@@ -110,6 +197,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceInstanceAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceInstanceAnnotationsMutex       sync.RWMutex
+	updateServiceInstanceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateServiceInstanceAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceInstanceAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceInstanceLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceInstanceLabelsMutex       sync.RWMutex
 	updateServiceInstanceLabelsArgsForCall []struct {
@@ -125,6 +227,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceOfferingAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceOfferingAnnotationsMutex       sync.RWMutex
+	updateServiceOfferingAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateServiceOfferingAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceOfferingAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceOfferingLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceOfferingLabelsMutex       sync.RWMutex
 	updateServiceOfferingLabelsArgsForCall []struct {
@@ -140,6 +257,22 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServicePlanAnnotationsStub        func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServicePlanAnnotationsMutex       sync.RWMutex
+	updateServicePlanAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}
+	updateServicePlanAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServicePlanAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServicePlanLabelsStub        func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServicePlanLabelsMutex       sync.RWMutex
 	updateServicePlanLabelsArgsForCall []struct {
@@ -156,6 +289,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateSpaceAnnotationsBySpaceNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateSpaceAnnotationsBySpaceNameMutex       sync.RWMutex
+	updateSpaceAnnotationsBySpaceNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateSpaceAnnotationsBySpaceNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateSpaceAnnotationsBySpaceNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateSpaceLabelsBySpaceNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateSpaceLabelsBySpaceNameMutex       sync.RWMutex
 	updateSpaceLabelsBySpaceNameArgsForCall []struct {
@@ -171,6 +319,20 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateStackAnnotationsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateStackAnnotationsByStackNameMutex       sync.RWMutex
+	updateStackAnnotationsByStackNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateStackAnnotationsByStackNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateStackAnnotationsByStackNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateStackLabelsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateStackLabelsByStackNameMutex       sync.RWMutex
 	updateStackLabelsByStackNameArgsForCall []struct {
@@ -194,15 +356,16 @@ func (fake *FakeSetLabelActor) GetCurrentUser() (configv3.User, error) {
 	ret, specificReturn := fake.getCurrentUserReturnsOnCall[len(fake.getCurrentUserArgsForCall)]
 	fake.getCurrentUserArgsForCall = append(fake.getCurrentUserArgsForCall, struct {
 	}{})
+	stub := fake.GetCurrentUserStub
+	fakeReturns := fake.getCurrentUserReturns
 	fake.recordInvocation("GetCurrentUser", []interface{}{})
 	fake.getCurrentUserMutex.Unlock()
-	if fake.GetCurrentUserStub != nil {
-		return fake.GetCurrentUserStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getCurrentUserReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -244,6 +407,72 @@ func (fake *FakeSetLabelActor) GetCurrentUserReturnsOnCall(i int, result1 config
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	ret, specificReturn := fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)]
+	fake.updateApplicationAnnotationsByApplicationNameArgsForCall = append(fake.updateApplicationAnnotationsByApplicationNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateApplicationAnnotationsByApplicationNameStub
+	fakeReturns := fake.updateApplicationAnnotationsByApplicationNameReturns
+	fake.recordInvocation("UpdateApplicationAnnotationsByApplicationName", []interface{}{arg1, arg2, arg3})
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameCallCount() int {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	return len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	argsForCall := fake.updateApplicationAnnotationsByApplicationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	fake.updateApplicationAnnotationsByApplicationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	if fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall == nil {
+		fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateApplicationLabelsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateApplicationLabelsByApplicationNameMutex.Lock()
 	ret, specificReturn := fake.updateApplicationLabelsByApplicationNameReturnsOnCall[len(fake.updateApplicationLabelsByApplicationNameArgsForCall)]
@@ -252,15 +481,16 @@ func (fake *FakeSetLabelActor) UpdateApplicationLabelsByApplicationName(arg1 str
 		arg2 string
 		arg3 map[string]types.NullString
 	}{arg1, arg2, arg3})
+	stub := fake.UpdateApplicationLabelsByApplicationNameStub
+	fakeReturns := fake.updateApplicationLabelsByApplicationNameReturns
 	fake.recordInvocation("UpdateApplicationLabelsByApplicationName", []interface{}{arg1, arg2, arg3})
 	fake.updateApplicationLabelsByApplicationNameMutex.Unlock()
-	if fake.UpdateApplicationLabelsByApplicationNameStub != nil {
-		return fake.UpdateApplicationLabelsByApplicationNameStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateApplicationLabelsByApplicationNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -309,6 +539,72 @@ func (fake *FakeSetLabelActor) UpdateApplicationLabelsByApplicationNameReturnsOn
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStack(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	ret, specificReturn := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)]
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall = append(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub
+	fakeReturns := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns
+	fake.recordInvocation("UpdateBuildpackAnnotationsByBuildpackNameAndStack", []interface{}{arg1, arg2, arg3})
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCallCount() int {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	return len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	argsForCall := fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	if fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall == nil {
+		fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateBuildpackLabelsByBuildpackNameAndStack(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackLabelsByBuildpackNameAndStackReturnsOnCall[len(fake.updateBuildpackLabelsByBuildpackNameAndStackArgsForCall)]
@@ -317,15 +613,16 @@ func (fake *FakeSetLabelActor) UpdateBuildpackLabelsByBuildpackNameAndStack(arg1
 		arg2 string
 		arg3 map[string]types.NullString
 	}{arg1, arg2, arg3})
+	stub := fake.UpdateBuildpackLabelsByBuildpackNameAndStackStub
+	fakeReturns := fake.updateBuildpackLabelsByBuildpackNameAndStackReturns
 	fake.recordInvocation("UpdateBuildpackLabelsByBuildpackNameAndStack", []interface{}{arg1, arg2, arg3})
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.Unlock()
-	if fake.UpdateBuildpackLabelsByBuildpackNameAndStackStub != nil {
-		return fake.UpdateBuildpackLabelsByBuildpackNameAndStackStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateBuildpackLabelsByBuildpackNameAndStackReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -374,6 +671,71 @@ func (fake *FakeSetLabelActor) UpdateBuildpackLabelsByBuildpackNameAndStackRetur
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	ret, specificReturn := fake.updateDomainAnnotationsByDomainNameReturnsOnCall[len(fake.updateDomainAnnotationsByDomainNameArgsForCall)]
+	fake.updateDomainAnnotationsByDomainNameArgsForCall = append(fake.updateDomainAnnotationsByDomainNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateDomainAnnotationsByDomainNameStub
+	fakeReturns := fake.updateDomainAnnotationsByDomainNameReturns
+	fake.recordInvocation("UpdateDomainAnnotationsByDomainName", []interface{}{arg1, arg2})
+	fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameCallCount() int {
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
+	return len(fake.updateDomainAnnotationsByDomainNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
+	argsForCall := fake.updateDomainAnnotationsByDomainNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = nil
+	fake.updateDomainAnnotationsByDomainNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = nil
+	if fake.updateDomainAnnotationsByDomainNameReturnsOnCall == nil {
+		fake.updateDomainAnnotationsByDomainNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateDomainAnnotationsByDomainNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateDomainLabelsByDomainName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateDomainLabelsByDomainNameMutex.Lock()
 	ret, specificReturn := fake.updateDomainLabelsByDomainNameReturnsOnCall[len(fake.updateDomainLabelsByDomainNameArgsForCall)]
@@ -381,15 +743,16 @@ func (fake *FakeSetLabelActor) UpdateDomainLabelsByDomainName(arg1 string, arg2
 		arg1 string
 		arg2 map[string]types.NullString
 	}{arg1, arg2})
+	stub := fake.UpdateDomainLabelsByDomainNameStub
+	fakeReturns := fake.updateDomainLabelsByDomainNameReturns
 	fake.recordInvocation("UpdateDomainLabelsByDomainName", []interface{}{arg1, arg2})
 	fake.updateDomainLabelsByDomainNameMutex.Unlock()
-	if fake.UpdateDomainLabelsByDomainNameStub != nil {
-		return fake.UpdateDomainLabelsByDomainNameStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateDomainLabelsByDomainNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -438,6 +801,71 @@ func (fake *FakeSetLabelActor) UpdateDomainLabelsByDomainNameReturnsOnCall(i int
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)]
+	fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall = append(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateOrganizationAnnotationsByOrganizationNameStub
+	fakeReturns := fake.updateOrganizationAnnotationsByOrganizationNameReturns
+	fake.recordInvocation("UpdateOrganizationAnnotationsByOrganizationName", []interface{}{arg1, arg2})
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameCallCount() int {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	return len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	argsForCall := fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	fake.updateOrganizationAnnotationsByOrganizationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	if fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall == nil {
+		fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateOrganizationLabelsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateOrganizationLabelsByOrganizationNameMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationLabelsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationLabelsByOrganizationNameArgsForCall)]
@@ -445,15 +873,16 @@ func (fake *FakeSetLabelActor) UpdateOrganizationLabelsByOrganizationName(arg1 s
 		arg1 string
 		arg2 map[string]types.NullString
 	}{arg1, arg2})
+	stub := fake.UpdateOrganizationLabelsByOrganizationNameStub
+	fakeReturns := fake.updateOrganizationLabelsByOrganizationNameReturns
 	fake.recordInvocation("UpdateOrganizationLabelsByOrganizationName", []interface{}{arg1, arg2})
 	fake.updateOrganizationLabelsByOrganizationNameMutex.Unlock()
-	if fake.UpdateOrganizationLabelsByOrganizationNameStub != nil {
-		return fake.UpdateOrganizationLabelsByOrganizationNameStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateOrganizationLabelsByOrganizationNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -502,6 +931,72 @@ func (fake *FakeSetLabelActor) UpdateOrganizationLabelsByOrganizationNameReturns
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateRouteAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateRouteAnnotationsReturnsOnCall[len(fake.updateRouteAnnotationsArgsForCall)]
+	fake.updateRouteAnnotationsArgsForCall = append(fake.updateRouteAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateRouteAnnotationsStub
+	fakeReturns := fake.updateRouteAnnotationsReturns
+	fake.recordInvocation("UpdateRouteAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateRouteAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsCallCount() int {
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
+	return len(fake.updateRouteAnnotationsArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateRouteAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = nil
+	fake.updateRouteAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = nil
+	if fake.updateRouteAnnotationsReturnsOnCall == nil {
+		fake.updateRouteAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateRouteAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateRouteLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateRouteLabelsMutex.Lock()
 	ret, specificReturn := fake.updateRouteLabelsReturnsOnCall[len(fake.updateRouteLabelsArgsForCall)]
@@ -510,15 +1005,16 @@ func (fake *FakeSetLabelActor) UpdateRouteLabels(arg1 string, arg2 string, arg3
 		arg2 string
 		arg3 map[string]types.NullString
 	}{arg1, arg2, arg3})
+	stub := fake.UpdateRouteLabelsStub
+	fakeReturns := fake.updateRouteLabelsReturns
 	fake.recordInvocation("UpdateRouteLabels", []interface{}{arg1, arg2, arg3})
 	fake.updateRouteLabelsMutex.Unlock()
-	if fake.UpdateRouteLabelsStub != nil {
-		return fake.UpdateRouteLabelsStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateRouteLabelsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -567,6 +1063,71 @@ func (fake *FakeSetLabelActor) UpdateRouteLabelsReturnsOnCall(i int, result1 v7a
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	ret, specificReturn := fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall[len(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall)]
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall = append(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub
+	fakeReturns := fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturns
+	fake.recordInvocation("UpdateServiceBrokerAnnotationsByServiceBrokerName", []interface{}{arg1, arg2})
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameCallCount() int {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
+	return len(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
+	argsForCall := fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = nil
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = nil
+	if fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall == nil {
+		fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateServiceBrokerLabelsByServiceBrokerName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.Lock()
 	ret, specificReturn := fake.updateServiceBrokerLabelsByServiceBrokerNameReturnsOnCall[len(fake.updateServiceBrokerLabelsByServiceBrokerNameArgsForCall)]
@@ -574,15 +1135,16 @@ func (fake *FakeSetLabelActor) UpdateServiceBrokerLabelsByServiceBrokerName(arg1
 		arg1 string
 		arg2 map[string]types.NullString
 	}{arg1, arg2})
+	stub := fake.UpdateServiceBrokerLabelsByServiceBrokerNameStub
+	fakeReturns := fake.updateServiceBrokerLabelsByServiceBrokerNameReturns
 	fake.recordInvocation("UpdateServiceBrokerLabelsByServiceBrokerName", []interface{}{arg1, arg2})
 	fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.Unlock()
-	if fake.UpdateServiceBrokerLabelsByServiceBrokerNameStub != nil {
-		return fake.UpdateServiceBrokerLabelsByServiceBrokerNameStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateServiceBrokerLabelsByServiceBrokerNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -631,6 +1193,72 @@ func (fake *FakeSetLabelActor) UpdateServiceBrokerLabelsByServiceBrokerNameRetur
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceAnnotationsReturnsOnCall[len(fake.updateServiceInstanceAnnotationsArgsForCall)]
+	fake.updateServiceInstanceAnnotationsArgsForCall = append(fake.updateServiceInstanceAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateServiceInstanceAnnotationsStub
+	fakeReturns := fake.updateServiceInstanceAnnotationsReturns
+	fake.recordInvocation("UpdateServiceInstanceAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsCallCount() int {
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
+	return len(fake.updateServiceInstanceAnnotationsArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServiceInstanceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = nil
+	fake.updateServiceInstanceAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = nil
+	if fake.updateServiceInstanceAnnotationsReturnsOnCall == nil {
+		fake.updateServiceInstanceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceInstanceAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateServiceInstanceLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceInstanceLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServiceInstanceLabelsReturnsOnCall[len(fake.updateServiceInstanceLabelsArgsForCall)]
@@ -639,15 +1267,16 @@ func (fake *FakeSetLabelActor) UpdateServiceInstanceLabels(arg1 string, arg2 str
 		arg2 string
 		arg3 map[string]types.NullString
 	}{arg1, arg2, arg3})
+	stub := fake.UpdateServiceInstanceLabelsStub
+	fakeReturns := fake.updateServiceInstanceLabelsReturns
 	fake.recordInvocation("UpdateServiceInstanceLabels", []interface{}{arg1, arg2, arg3})
 	fake.updateServiceInstanceLabelsMutex.Unlock()
-	if fake.UpdateServiceInstanceLabelsStub != nil {
-		return fake.UpdateServiceInstanceLabelsStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateServiceInstanceLabelsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -696,6 +1325,72 @@ func (fake *FakeSetLabelActor) UpdateServiceInstanceLabelsReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServiceOfferingAnnotationsReturnsOnCall[len(fake.updateServiceOfferingAnnotationsArgsForCall)]
+	fake.updateServiceOfferingAnnotationsArgsForCall = append(fake.updateServiceOfferingAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateServiceOfferingAnnotationsStub
+	fakeReturns := fake.updateServiceOfferingAnnotationsReturns
+	fake.recordInvocation("UpdateServiceOfferingAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsCallCount() int {
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
+	return len(fake.updateServiceOfferingAnnotationsArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServiceOfferingAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = nil
+	fake.updateServiceOfferingAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = nil
+	if fake.updateServiceOfferingAnnotationsReturnsOnCall == nil {
+		fake.updateServiceOfferingAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceOfferingAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateServiceOfferingLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceOfferingLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServiceOfferingLabelsReturnsOnCall[len(fake.updateServiceOfferingLabelsArgsForCall)]
@@ -704,15 +1399,16 @@ func (fake *FakeSetLabelActor) UpdateServiceOfferingLabels(arg1 string, arg2 str
 		arg2 string
 		arg3 map[string]types.NullString
 	}{arg1, arg2, arg3})
+	stub := fake.UpdateServiceOfferingLabelsStub
+	fakeReturns := fake.updateServiceOfferingLabelsReturns
 	fake.recordInvocation("UpdateServiceOfferingLabels", []interface{}{arg1, arg2, arg3})
 	fake.updateServiceOfferingLabelsMutex.Unlock()
-	if fake.UpdateServiceOfferingLabelsStub != nil {
-		return fake.UpdateServiceOfferingLabelsStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateServiceOfferingLabelsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -761,6 +1457,73 @@ func (fake *FakeSetLabelActor) UpdateServiceOfferingLabelsReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotations(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServicePlanAnnotationsReturnsOnCall[len(fake.updateServicePlanAnnotationsArgsForCall)]
+	fake.updateServicePlanAnnotationsArgsForCall = append(fake.updateServicePlanAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateServicePlanAnnotationsStub
+	fakeReturns := fake.updateServicePlanAnnotationsReturns
+	fake.recordInvocation("UpdateServicePlanAnnotations", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateServicePlanAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsCallCount() int {
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
+	return len(fake.updateServicePlanAnnotationsArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsCalls(stub func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsArgsForCall(i int) (string, string, string, map[string]types.NullString) {
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServicePlanAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = nil
+	fake.updateServicePlanAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = nil
+	if fake.updateServicePlanAnnotationsReturnsOnCall == nil {
+		fake.updateServicePlanAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServicePlanAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateServicePlanLabels(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServicePlanLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServicePlanLabelsReturnsOnCall[len(fake.updateServicePlanLabelsArgsForCall)]
@@ -770,15 +1533,16 @@ func (fake *FakeSetLabelActor) UpdateServicePlanLabels(arg1 string, arg2 string,
 		arg3 string
 		arg4 map[string]types.NullString
 	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateServicePlanLabelsStub
+	fakeReturns := fake.updateServicePlanLabelsReturns
 	fake.recordInvocation("UpdateServicePlanLabels", []interface{}{arg1, arg2, arg3, arg4})
 	fake.updateServicePlanLabelsMutex.Unlock()
-	if fake.UpdateServicePlanLabelsStub != nil {
-		return fake.UpdateServicePlanLabelsStub(arg1, arg2, arg3, arg4)
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateServicePlanLabelsReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -827,6 +1591,72 @@ func (fake *FakeSetLabelActor) UpdateServicePlanLabelsReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)]
+	fake.updateSpaceAnnotationsBySpaceNameArgsForCall = append(fake.updateSpaceAnnotationsBySpaceNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateSpaceAnnotationsBySpaceNameStub
+	fakeReturns := fake.updateSpaceAnnotationsBySpaceNameReturns
+	fake.recordInvocation("UpdateSpaceAnnotationsBySpaceName", []interface{}{arg1, arg2, arg3})
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameCallCount() int {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	return len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	argsForCall := fake.updateSpaceAnnotationsBySpaceNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	fake.updateSpaceAnnotationsBySpaceNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	if fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall == nil {
+		fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateSpaceLabelsBySpaceName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateSpaceLabelsBySpaceNameMutex.Lock()
 	ret, specificReturn := fake.updateSpaceLabelsBySpaceNameReturnsOnCall[len(fake.updateSpaceLabelsBySpaceNameArgsForCall)]
@@ -835,15 +1665,16 @@ func (fake *FakeSetLabelActor) UpdateSpaceLabelsBySpaceName(arg1 string, arg2 st
 		arg2 string
 		arg3 map[string]types.NullString
 	}{arg1, arg2, arg3})
+	stub := fake.UpdateSpaceLabelsBySpaceNameStub
+	fakeReturns := fake.updateSpaceLabelsBySpaceNameReturns
 	fake.recordInvocation("UpdateSpaceLabelsBySpaceName", []interface{}{arg1, arg2, arg3})
 	fake.updateSpaceLabelsBySpaceNameMutex.Unlock()
-	if fake.UpdateSpaceLabelsBySpaceNameStub != nil {
-		return fake.UpdateSpaceLabelsBySpaceNameStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateSpaceLabelsBySpaceNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -892,6 +1723,71 @@ func (fake *FakeSetLabelActor) UpdateSpaceLabelsBySpaceNameReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	ret, specificReturn := fake.updateStackAnnotationsByStackNameReturnsOnCall[len(fake.updateStackAnnotationsByStackNameArgsForCall)]
+	fake.updateStackAnnotationsByStackNameArgsForCall = append(fake.updateStackAnnotationsByStackNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateStackAnnotationsByStackNameStub
+	fakeReturns := fake.updateStackAnnotationsByStackNameReturns
+	fake.recordInvocation("UpdateStackAnnotationsByStackName", []interface{}{arg1, arg2})
+	fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameCallCount() int {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	return len(fake.updateStackAnnotationsByStackNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	argsForCall := fake.updateStackAnnotationsByStackNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	fake.updateStackAnnotationsByStackNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	if fake.updateStackAnnotationsByStackNameReturnsOnCall == nil {
+		fake.updateStackAnnotationsByStackNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateStackAnnotationsByStackNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateStackLabelsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateStackLabelsByStackNameMutex.Lock()
 	ret, specificReturn := fake.updateStackLabelsByStackNameReturnsOnCall[len(fake.updateStackLabelsByStackNameArgsForCall)]
@@ -899,15 +1795,16 @@ func (fake *FakeSetLabelActor) UpdateStackLabelsByStackName(arg1 string, arg2 ma
 		arg1 string
 		arg2 map[string]types.NullString
 	}{arg1, arg2})
+	stub := fake.UpdateStackLabelsByStackNameStub
+	fakeReturns := fake.updateStackLabelsByStackNameReturns
 	fake.recordInvocation("UpdateStackLabelsByStackName", []interface{}{arg1, arg2})
 	fake.updateStackLabelsByStackNameMutex.Unlock()
-	if fake.UpdateStackLabelsByStackNameStub != nil {
-		return fake.UpdateStackLabelsByStackNameStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.updateStackLabelsByStackNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -961,26 +1858,48 @@ func (fake *FakeSetLabelActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getCurrentUserMutex.RLock()
 	defer fake.getCurrentUserMutex.RUnlock()
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
 	fake.updateApplicationLabelsByApplicationNameMutex.RLock()
 	defer fake.updateApplicationLabelsByApplicationNameMutex.RUnlock()
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RLock()
 	defer fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RUnlock()
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
 	fake.updateDomainLabelsByDomainNameMutex.RLock()
 	defer fake.updateDomainLabelsByDomainNameMutex.RUnlock()
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
 	fake.updateOrganizationLabelsByOrganizationNameMutex.RLock()
 	defer fake.updateOrganizationLabelsByOrganizationNameMutex.RUnlock()
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
 	fake.updateRouteLabelsMutex.RLock()
 	defer fake.updateRouteLabelsMutex.RUnlock()
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
 	fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.RLock()
 	defer fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.RUnlock()
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
 	fake.updateServiceInstanceLabelsMutex.RLock()
 	defer fake.updateServiceInstanceLabelsMutex.RUnlock()
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
 	fake.updateServiceOfferingLabelsMutex.RLock()
 	defer fake.updateServiceOfferingLabelsMutex.RUnlock()
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
 	fake.updateServicePlanLabelsMutex.RLock()
 	defer fake.updateServicePlanLabelsMutex.RUnlock()
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
 	fake.updateSpaceLabelsBySpaceNameMutex.RLock()
 	defer fake.updateSpaceLabelsBySpaceNameMutex.RUnlock()
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
 	fake.updateStackLabelsByStackNameMutex.RLock()
 	defer fake.updateStackLabelsByStackNameMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -2,6 +2,7 @@
 package v7fakes
 
 import (
+	"io"
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -9,6 +10,18 @@ import (
 )
 
 type FakeSharedSSHActor struct {
+	ExecuteSecureCopyStub        func(sharedaction.SecureShellClient, sharedaction.SCPOptions) error
+	executeSecureCopyMutex       sync.RWMutex
+	executeSecureCopyArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SCPOptions
+	}
+	executeSecureCopyReturns struct {
+		result1 error
+	}
+	executeSecureCopyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ExecuteSecureShellStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions) error
 	executeSecureShellMutex       sync.RWMutex
 	executeSecureShellArgsForCall []struct {
@@ -21,10 +34,88 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellCommandOnInstancesStub        func(func() sharedaction.SecureShellClient, []sharedaction.InstanceSSHOptions, []string, int, io.Writer, io.Writer) []sharedaction.InstanceCommandResult
+	executeSecureShellCommandOnInstancesMutex       sync.RWMutex
+	executeSecureShellCommandOnInstancesArgsForCall []struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 []sharedaction.InstanceSSHOptions
+		arg3 []string
+		arg4 int
+		arg5 io.Writer
+		arg6 io.Writer
+	}
+	executeSecureShellCommandOnInstancesReturns struct {
+		result1 []sharedaction.InstanceCommandResult
+	}
+	executeSecureShellCommandOnInstancesReturnsOnCall map[int]struct {
+		result1 []sharedaction.InstanceCommandResult
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureCopy(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SCPOptions) error {
+	fake.executeSecureCopyMutex.Lock()
+	ret, specificReturn := fake.executeSecureCopyReturnsOnCall[len(fake.executeSecureCopyArgsForCall)]
+	fake.executeSecureCopyArgsForCall = append(fake.executeSecureCopyArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SCPOptions
+	}{arg1, arg2})
+	stub := fake.ExecuteSecureCopyStub
+	fakeReturns := fake.executeSecureCopyReturns
+	fake.recordInvocation("ExecuteSecureCopy", []interface{}{arg1, arg2})
+	fake.executeSecureCopyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyCallCount() int {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	return len(fake.executeSecureCopyArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyCalls(stub func(sharedaction.SecureShellClient, sharedaction.SCPOptions) error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SCPOptions) {
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
+	argsForCall := fake.executeSecureCopyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyReturns(result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	fake.executeSecureCopyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureCopyReturnsOnCall(i int, result1 error) {
+	fake.executeSecureCopyMutex.Lock()
+	defer fake.executeSecureCopyMutex.Unlock()
+	fake.ExecuteSecureCopyStub = nil
+	if fake.executeSecureCopyReturnsOnCall == nil {
+		fake.executeSecureCopyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureCopyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) ExecuteSecureShell(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions) error {
 	fake.executeSecureShellMutex.Lock()
 	ret, specificReturn := fake.executeSecureShellReturnsOnCall[len(fake.executeSecureShellArgsForCall)]
@@ -32,15 +123,16 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShell(arg1 sharedaction.SecureShell
 		arg1 sharedaction.SecureShellClient
 		arg2 sharedaction.SSHOptions
 	}{arg1, arg2})
+	stub := fake.ExecuteSecureShellStub
+	fakeReturns := fake.executeSecureShellReturns
 	fake.recordInvocation("ExecuteSecureShell", []interface{}{arg1, arg2})
 	fake.executeSecureShellMutex.Unlock()
-	if fake.ExecuteSecureShellStub != nil {
-		return fake.ExecuteSecureShellStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.executeSecureShellReturns
 	return fakeReturns.result1
 }
 
@@ -86,11 +178,91 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandOnInstances(arg1 func() sharedaction.SecureShellClient, arg2 []sharedaction.InstanceSSHOptions, arg3 []string, arg4 int, arg5 io.Writer, arg6 io.Writer) []sharedaction.InstanceCommandResult {
+	var arg2Copy []sharedaction.InstanceSSHOptions
+	if arg2 != nil {
+		arg2Copy = make([]sharedaction.InstanceSSHOptions, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.executeSecureShellCommandOnInstancesMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellCommandOnInstancesReturnsOnCall[len(fake.executeSecureShellCommandOnInstancesArgsForCall)]
+	fake.executeSecureShellCommandOnInstancesArgsForCall = append(fake.executeSecureShellCommandOnInstancesArgsForCall, struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 []sharedaction.InstanceSSHOptions
+		arg3 []string
+		arg4 int
+		arg5 io.Writer
+		arg6 io.Writer
+	}{arg1, arg2Copy, arg3Copy, arg4, arg5, arg6})
+	stub := fake.ExecuteSecureShellCommandOnInstancesStub
+	fakeReturns := fake.executeSecureShellCommandOnInstancesReturns
+	fake.recordInvocation("ExecuteSecureShellCommandOnInstances", []interface{}{arg1, arg2Copy, arg3Copy, arg4, arg5, arg6})
+	fake.executeSecureShellCommandOnInstancesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandOnInstancesCallCount() int {
+	fake.executeSecureShellCommandOnInstancesMutex.RLock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.RUnlock()
+	return len(fake.executeSecureShellCommandOnInstancesArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandOnInstancesCalls(stub func(func() sharedaction.SecureShellClient, []sharedaction.InstanceSSHOptions, []string, int, io.Writer, io.Writer) []sharedaction.InstanceCommandResult) {
+	fake.executeSecureShellCommandOnInstancesMutex.Lock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellCommandOnInstancesStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandOnInstancesArgsForCall(i int) (func() sharedaction.SecureShellClient, []sharedaction.InstanceSSHOptions, []string, int, io.Writer, io.Writer) {
+	fake.executeSecureShellCommandOnInstancesMutex.RLock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.RUnlock()
+	argsForCall := fake.executeSecureShellCommandOnInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandOnInstancesReturns(result1 []sharedaction.InstanceCommandResult) {
+	fake.executeSecureShellCommandOnInstancesMutex.Lock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellCommandOnInstancesStub = nil
+	fake.executeSecureShellCommandOnInstancesReturns = struct {
+		result1 []sharedaction.InstanceCommandResult
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellCommandOnInstancesReturnsOnCall(i int, result1 []sharedaction.InstanceCommandResult) {
+	fake.executeSecureShellCommandOnInstancesMutex.Lock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellCommandOnInstancesStub = nil
+	if fake.executeSecureShellCommandOnInstancesReturnsOnCall == nil {
+		fake.executeSecureShellCommandOnInstancesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.InstanceCommandResult
+		})
+	}
+	fake.executeSecureShellCommandOnInstancesReturnsOnCall[i] = struct {
+		result1 []sharedaction.InstanceCommandResult
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.executeSecureCopyMutex.RLock()
+	defer fake.executeSecureCopyMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellCommandOnInstancesMutex.RLock()
+	defer fake.executeSecureShellCommandOnInstancesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
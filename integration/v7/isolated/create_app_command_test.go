@@ -35,7 +35,7 @@ var _ = Describe("create-app command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("create-app - Create an Application in the target space"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say(`cf create-app APP_NAME \[--app-type \(buildpack | docker\)\]`))
+				Eventually(session).Should(Say(`cf create-app APP_NAME \[--app-type \(buildpack | docker | cnb\)\]`))
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say(`--app-type\s+App lifecycle type to stage and run the app \(Default: buildpack\)`))
 				Eventually(session).Should(Say("SEE ALSO:"))
@@ -40,16 +40,20 @@ var _ = Describe("set-health-check command", func() {
 				Eventually(session).Should(Say("set-health-check - Change type of health check performed on an app's process"))
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say(`cf set-health-check APP_NAME \(process \| port \| http \[--endpoint PATH\]\) \[--process PROCESS\] \[--invocation-timeout INVOCATION_TIMEOUT\]`))
+				Eventually(session).Should(Say(`cf set-health-check APP_NAME \(process \| port \| http \[--endpoint PATH\]\) --readiness \[--process PROCESS\] \[--invocation-timeout INVOCATION_TIMEOUT\] \[--interval INTERVAL\]`))
 
 				Eventually(session).Should(Say("EXAMPLES:"))
 				Eventually(session).Should(Say("cf set-health-check worker-app process --process worker"))
 				Eventually(session).Should(Say("cf set-health-check my-web-app http --endpoint /foo"))
 				Eventually(session).Should(Say("cf set-health-check my-web-app http --invocation-timeout 10"))
+				Eventually(session).Should(Say("cf set-health-check my-web-app http --endpoint /ready --readiness --interval 5"))
 
 				Eventually(session).Should(Say("OPTIONS:"))
 				Eventually(session).Should(Say(`--endpoint\s+Path on the app \(Default: /\)`))
 				Eventually(session).Should(Say(`--invocation-timeout\s+Time \(in seconds\) that controls individual health check invocations`))
+				Eventually(session).Should(Say(`--interval\s+Time \(in seconds\) between readiness health check invocations, requires --readiness`))
 				Eventually(session).Should(Say(`--process\s+App process to update \(Default: web\)`))
+				Eventually(session).Should(Say(`--readiness\s+Set the readiness health check, which decides whether an instance receives traffic, instead of the liveness health check`))
 
 				Eventually(session).Should(Exit(0))
 			})
@@ -56,6 +56,14 @@ func (a Application) MarshalJSON() ([]byte, error) {
 				ccApp.setBuildpackLifecycle(a)
 			}
 		}
+	} else if a.LifecycleType == constant.AppLifecycleTypeCNB {
+		// The lifecycle is always sent, otherwise the app would get the
+		// default buildpack lifecycle
+		if a.hasAutodetectedBuildpack() {
+			ccApp.setAutodetectedBuildpackLifecycle(a)
+		} else {
+			ccApp.setBuildpackLifecycle(a)
+		}
 	}
 
 	return json.Marshal(ccApp)
@@ -125,7 +133,7 @@ func (ccApp *ccApplication) setAutodetectedBuildpackLifecycle(a Application) {
 			Stack      string   `json:"stack,omitempty"`
 		} `json:"data"`
 	}
-	nullBuildpackLifecycle.Type = constant.AppLifecycleTypeBuildpack
+	nullBuildpackLifecycle.Type = a.LifecycleType
 	nullBuildpackLifecycle.Data.Stack = a.StackName
 	ccApp.Lifecycle = nullBuildpackLifecycle
 }
@@ -12,6 +12,7 @@ type Deployment struct {
 	State         constant.DeploymentState
 	StatusValue   constant.DeploymentStatusValue
 	StatusReason  constant.DeploymentStatusReason
+	Strategy      constant.DeploymentStrategy
 	RevisionGUID  string
 	DropletGUID   string
 	CreatedAt     string
@@ -30,9 +31,10 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 	}
 
 	var ccDeployment struct {
-		Droplet       *Droplet      `json:"droplet,omitempty"`
-		Revision      *Revision     `json:"revision,omitempty"`
-		Relationships Relationships `json:"relationships,omitempty"`
+		Droplet       *Droplet                    `json:"droplet,omitempty"`
+		Revision      *Revision                   `json:"revision,omitempty"`
+		Strategy      constant.DeploymentStrategy `json:"strategy,omitempty"`
+		Relationships Relationships               `json:"relationships,omitempty"`
 	}
 
 	if d.DropletGUID != "" {
@@ -43,6 +45,7 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 		ccDeployment.Revision = &Revision{d.RevisionGUID}
 	}
 
+	ccDeployment.Strategy = d.Strategy
 	ccDeployment.Relationships = d.Relationships
 
 	return json.Marshal(ccDeployment)
@@ -51,10 +54,11 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON helps unmarshal a Cloud Controller Deployment response.
 func (d *Deployment) UnmarshalJSON(data []byte) error {
 	var ccDeployment struct {
-		GUID          string                   `json:"guid,omitempty"`
-		CreatedAt     string                   `json:"created_at,omitempty"`
-		Relationships Relationships            `json:"relationships,omitempty"`
-		State         constant.DeploymentState `json:"state,omitempty"`
+		GUID          string                      `json:"guid,omitempty"`
+		CreatedAt     string                      `json:"created_at,omitempty"`
+		Relationships Relationships               `json:"relationships,omitempty"`
+		State         constant.DeploymentState    `json:"state,omitempty"`
+		Strategy      constant.DeploymentStrategy `json:"strategy,omitempty"`
 		Status        struct {
 			Value  constant.DeploymentStatusValue  `json:"value"`
 			Reason constant.DeploymentStatusReason `json:"reason"`
@@ -73,6 +77,7 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 	d.State = ccDeployment.State
 	d.StatusValue = ccDeployment.Status.Value
 	d.StatusReason = ccDeployment.Status.Reason
+	d.Strategy = ccDeployment.Strategy
 	d.DropletGUID = ccDeployment.Droplet.GUID
 	d.NewProcesses = ccDeployment.NewProcesses
 
@@ -9,6 +9,9 @@ import (
 
 // Package represents a Cloud Controller V3 Package.
 type Package struct {
+	// Checksum is the SHA256 checksum of the bits of a bits package.
+	Checksum string
+
 	// CreatedAt is the time with zone when the object was created.
 	CreatedAt string
 
@@ -84,6 +87,10 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 			Image    string `json:"image"`
 			Username string `json:"username"`
 			Password string `json:"password"`
+			Checksum struct {
+				Type  string `json:"type"`
+				Value string `json:"value"`
+			} `json:"checksum"`
 		} `json:"data"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccPackage)
@@ -100,6 +107,9 @@ func (p *Package) UnmarshalJSON(data []byte) error {
 	p.DockerImage = ccPackage.Data.Image
 	p.DockerUsername = ccPackage.Data.Username
 	p.DockerPassword = ccPackage.Data.Password
+	if ccPackage.Data.Checksum.Type == "sha256" {
+		p.Checksum = ccPackage.Data.Checksum.Value
+	}
 
 	return nil
 }
@@ -18,11 +18,18 @@ type Process struct {
 	HealthCheckEndpoint          string
 	HealthCheckInvocationTimeout int64
 	HealthCheckTimeout           int64
-	Instances                    types.NullInt
-	MemoryInMB                   types.NullUint64
-	DiskInMB                     types.NullUint64
-	LogRateLimitInBPS            types.NullInt
-	AppGUID                      string
+	// ReadinessHealthCheckType is the check that decides whether an instance
+	// receives traffic, as opposed to HealthCheckType which decides whether
+	// it is restarted.
+	ReadinessHealthCheckType              constant.HealthCheckType
+	ReadinessHealthCheckEndpoint          string
+	ReadinessHealthCheckInvocationTimeout int64
+	ReadinessHealthCheckInterval          int64
+	Instances                             types.NullInt
+	MemoryInMB                            types.NullUint64
+	DiskInMB                              types.NullUint64
+	LogRateLimitInBPS                     types.NullInt
+	AppGUID                               string
 }
 
 func (p Process) MarshalJSON() ([]byte, error) {
@@ -34,6 +41,7 @@ func (p Process) MarshalJSON() ([]byte, error) {
 	marshalDisk(p, &ccProcess)
 	marshalLogRateLimit(p, &ccProcess)
 	marshalHealthCheck(p, &ccProcess)
+	marshalReadinessHealthCheck(p, &ccProcess)
 
 	return json.Marshal(ccProcess)
 }
@@ -57,6 +65,15 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 				Timeout           int64  `json:"timeout"`
 			} `json:"data"`
 		} `json:"health_check"`
+
+		ReadinessHealthCheck struct {
+			Type constant.HealthCheckType `json:"type"`
+			Data struct {
+				Endpoint          string `json:"endpoint"`
+				InvocationTimeout int64  `json:"invocation_timeout"`
+				Interval          int64  `json:"interval"`
+			} `json:"data"`
+		} `json:"readiness_health_check"`
 	}
 
 	err := cloudcontroller.DecodeJSON(data, &ccProcess)
@@ -71,6 +88,10 @@ func (p *Process) UnmarshalJSON(data []byte) error {
 	p.HealthCheckInvocationTimeout = ccProcess.HealthCheck.Data.InvocationTimeout
 	p.HealthCheckTimeout = ccProcess.HealthCheck.Data.Timeout
 	p.HealthCheckType = ccProcess.HealthCheck.Type
+	p.ReadinessHealthCheckType = ccProcess.ReadinessHealthCheck.Type
+	p.ReadinessHealthCheckEndpoint = ccProcess.ReadinessHealthCheck.Data.Endpoint
+	p.ReadinessHealthCheckInvocationTimeout = ccProcess.ReadinessHealthCheck.Data.InvocationTimeout
+	p.ReadinessHealthCheckInterval = ccProcess.ReadinessHealthCheck.Data.Interval
 	p.Instances = ccProcess.Instances
 	p.MemoryInMB = ccProcess.MemoryInMB
 	p.LogRateLimitInBPS = ccProcess.LogRateLimitInBPS
@@ -89,6 +110,15 @@ type healthCheck struct {
 	} `json:"data"`
 }
 
+type readinessHealthCheck struct {
+	Type constant.HealthCheckType `json:"type,omitempty"`
+	Data struct {
+		Endpoint          interface{} `json:"endpoint,omitempty"`
+		InvocationTimeout int64       `json:"invocation_timeout,omitempty"`
+		Interval          int64       `json:"interval,omitempty"`
+	} `json:"data"`
+}
+
 type marshalProcess struct {
 	Command           interface{} `json:"command,omitempty"`
 	Instances         json.Number `json:"instances,omitempty"`
@@ -96,7 +126,8 @@ type marshalProcess struct {
 	DiskInMB          json.Number `json:"disk_in_mb,omitempty"`
 	LogRateLimitInBPS json.Number `json:"log_rate_limit_in_bytes_per_second,omitempty"`
 
-	HealthCheck *healthCheck `json:"health_check,omitempty"`
+	HealthCheck          *healthCheck          `json:"health_check,omitempty"`
+	ReadinessHealthCheck *readinessHealthCheck `json:"readiness_health_check,omitempty"`
 }
 
 func marshalCommand(p Process, ccProcess *marshalProcess) {
@@ -123,6 +154,18 @@ func marshalHealthCheck(p Process, ccProcess *marshalProcess) {
 	}
 }
 
+func marshalReadinessHealthCheck(p Process, ccProcess *marshalProcess) {
+	if p.ReadinessHealthCheckType != "" || p.ReadinessHealthCheckEndpoint != "" || p.ReadinessHealthCheckInvocationTimeout != 0 || p.ReadinessHealthCheckInterval != 0 {
+		ccProcess.ReadinessHealthCheck = new(readinessHealthCheck)
+		ccProcess.ReadinessHealthCheck.Type = p.ReadinessHealthCheckType
+		ccProcess.ReadinessHealthCheck.Data.InvocationTimeout = p.ReadinessHealthCheckInvocationTimeout
+		ccProcess.ReadinessHealthCheck.Data.Interval = p.ReadinessHealthCheckInterval
+		if p.ReadinessHealthCheckEndpoint != "" {
+			ccProcess.ReadinessHealthCheck.Data.Endpoint = p.ReadinessHealthCheckEndpoint
+		}
+	}
+}
+
 func marshalInstances(p Process, ccProcess *marshalProcess) {
 	if p.Instances.IsSet {
 		ccProcess.Instances = json.Number(fmt.Sprint(p.Instances.Value))
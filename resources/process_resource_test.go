@@ -112,6 +112,33 @@ var _ = Describe("Process", func() {
 			})
 		})
 
+		When("readiness health check type http is provided", func() {
+			BeforeEach(func() {
+				process = resources.Process{
+					ReadinessHealthCheckType:              constant.HTTP,
+					ReadinessHealthCheckEndpoint:          "/ready",
+					ReadinessHealthCheckInvocationTimeout: 5,
+					ReadinessHealthCheckInterval:          10,
+				}
+			})
+
+			It("sets the readiness health check", func() {
+				Expect(string(processBytes)).To(MatchJSON(`{"readiness_health_check":{"type":"http", "data": {"endpoint": "/ready", "invocation_timeout": 5, "interval": 10}}}`))
+			})
+		})
+
+		When("readiness health check type port is provided", func() {
+			BeforeEach(func() {
+				process = resources.Process{
+					ReadinessHealthCheckType: constant.Port,
+				}
+			})
+
+			It("sets only the readiness health check type to port", func() {
+				Expect(string(processBytes)).To(MatchJSON(`{"readiness_health_check":{"type":"port", "data": {}}}`))
+			})
+		})
+
 		When("process has no fields provided", func() {
 			BeforeEach(func() {
 				process = resources.Process{}
@@ -184,5 +211,20 @@ var _ = Describe("Process", func() {
 				}))
 			})
 		})
+
+		When("a readiness health check is provided", func() {
+			BeforeEach(func() {
+				processBytes = []byte(`{"readiness_health_check":{"type":"http", "data": {"endpoint": "/ready", "invocation_timeout": 5, "interval": 10}}}`)
+			})
+
+			It("sets the readiness health check", func() {
+				Expect(process).To(MatchFields(IgnoreExtras, Fields{
+					"ReadinessHealthCheckType":              Equal(constant.HTTP),
+					"ReadinessHealthCheckEndpoint":          Equal("/ready"),
+					"ReadinessHealthCheckInvocationTimeout": BeEquivalentTo(5),
+					"ReadinessHealthCheckInterval":          BeEquivalentTo(10),
+				}))
+			})
+		})
 	})
 })
@@ -2,6 +2,8 @@ package resources
 
 import (
 	"encoding/json"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
@@ -31,6 +33,9 @@ type Route struct {
 	URL          string
 	Destinations []RouteDestination
 	Metadata     *Metadata
+	// Options are the per-route options, such as the load-balancing
+	// algorithm. When updating a route, a nil value removes the option.
+	Options map[string]*string
 }
 
 func (r Route) MarshalJSON() ([]byte, error) {
@@ -49,12 +54,13 @@ func (r Route) MarshalJSON() ([]byte, error) {
 
 	// Building up the request body in ccRoute
 	type ccRoute struct {
-		GUID          string         `json:"guid,omitempty"`
-		Host          string         `json:"host,omitempty"`
-		Path          string         `json:"path,omitempty"`
-		Protocol      string         `json:"protocol,omitempty"`
-		Port          int            `json:"port,omitempty"`
-		Relationships *Relationships `json:"relationships,omitempty"`
+		GUID          string             `json:"guid,omitempty"`
+		Host          string             `json:"host,omitempty"`
+		Path          string             `json:"path,omitempty"`
+		Protocol      string             `json:"protocol,omitempty"`
+		Port          int                `json:"port,omitempty"`
+		Options       map[string]*string `json:"options,omitempty"`
+		Relationships *Relationships     `json:"relationships,omitempty"`
 	}
 
 	ccR := ccRoute{
@@ -63,6 +69,7 @@ func (r Route) MarshalJSON() ([]byte, error) {
 		Path:     r.Path,
 		Protocol: r.Protocol,
 		Port:     r.Port,
+		Options:  r.Options,
 	}
 
 	if r.SpaceGUID != "" {
@@ -85,6 +92,7 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 		URL          string             `json:"url,omitempty"`
 		Destinations []RouteDestination `json:"destinations,omitempty"`
 		Metadata     *Metadata          `json:"metadata,omitempty"`
+		Options      map[string]*string `json:"options,omitempty"`
 
 		Relationships struct {
 			Space struct {
@@ -115,6 +123,20 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 	r.URL = alias.URL
 	r.Destinations = alias.Destinations
 	r.Metadata = alias.Metadata
+	r.Options = alias.Options
 
 	return nil
 }
+
+// FormattedOptions returns the options of the route as a comma separated
+// list of KEY=VALUE pairs, sorted by key.
+func (r Route) FormattedOptions() string {
+	var options []string
+	for key, value := range r.Options {
+		if value != nil {
+			options = append(options, key+"="+*value)
+		}
+	}
+	sort.Strings(options)
+	return strings.Join(options, ", ")
+}
@@ -0,0 +1,56 @@
+package resources
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route", func() {
+	var leastConnection = "least-connection"
+
+	DescribeTable(
+		"Marshaling and Unmarshaling",
+		func(route Route, serialized string) {
+			By("marshalling", func() {
+				Expect(json.Marshal(route)).To(MatchJSON(serialized))
+			})
+
+			By("unmarshaling", func() {
+				var parsed Route
+				Expect(json.Unmarshal([]byte(serialized), &parsed)).NotTo(HaveOccurred())
+				Expect(parsed).To(Equal(route))
+			})
+		},
+		Entry("empty", Route{}, `{}`),
+		Entry("host and path", Route{Host: "some-host", Path: "/some-path"}, `{"host": "some-host", "path": "/some-path"}`),
+		Entry(
+			"options",
+			Route{GUID: "some-guid", Options: map[string]*string{"loadbalancing": &leastConnection}},
+			`{"guid": "some-guid", "options": {"loadbalancing": "least-connection"}}`,
+		),
+	)
+
+	It("marshals removed options as null", func() {
+		route := Route{Options: map[string]*string{"loadbalancing": nil}}
+		Expect(json.Marshal(route)).To(MatchJSON(`{"options": {"loadbalancing": null}}`))
+	})
+
+	Describe("FormattedOptions", func() {
+		It("lists the options sorted by key", func() {
+			roundRobin := "round-robin"
+			other := "some-value"
+			route := Route{Options: map[string]*string{
+				"loadbalancing": &roundRobin,
+				"another":       &other,
+				"removed":       nil,
+			}}
+			Expect(route.FormattedOptions()).To(Equal("another=some-value, loadbalancing=round-robin"))
+		})
+
+		It("is empty when the route has no options", func() {
+			Expect(Route{}.FormattedOptions()).To(BeEmpty())
+		})
+	})
+})
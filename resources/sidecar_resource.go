@@ -1,9 +1,37 @@
 package resources
 
-import "code.cloudfoundry.org/cli/types"
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/types"
+)
 
 type Sidecar struct {
-	GUID    string               `json:"guid"`
-	Name    string               `json:"name"`
-	Command types.FilteredString `json:"command"`
+	GUID         string               `json:"guid"`
+	Name         string               `json:"name"`
+	Command      types.FilteredString `json:"command"`
+	ProcessTypes []string             `json:"process_types"`
+	MemoryInMB   types.NullUint64     `json:"memory_in_mb"`
+	// Origin is "user" for sidecars created through the API or a manifest and
+	// "buildpack" for sidecars contributed during staging.
+	Origin string `json:"origin"`
+}
+
+// MarshalJSON converts a Sidecar into a Cloud Controller sidecar request.
+func (s Sidecar) MarshalJSON() ([]byte, error) {
+	var ccSidecar struct {
+		Name         string   `json:"name"`
+		Command      string   `json:"command"`
+		ProcessTypes []string `json:"process_types,omitempty"`
+		MemoryInMB   *uint64  `json:"memory_in_mb,omitempty"`
+	}
+
+	ccSidecar.Name = s.Name
+	ccSidecar.Command = s.Command.Value
+	ccSidecar.ProcessTypes = s.ProcessTypes
+	if s.MemoryInMB.IsSet {
+		ccSidecar.MemoryInMB = &s.MemoryInMB.Value
+	}
+
+	return json.Marshal(ccSidecar)
 }
@@ -0,0 +1,151 @@
+package resources
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// AppUsageEvent records a change to the running state, instance count or
+// memory of an app process or task, as used for billing.
+type AppUsageEvent struct {
+	GUID                          string
+	CreatedAt                     time.Time
+	State                         string
+	PreviousState                 string
+	AppGUID                       string
+	AppName                       string
+	ProcessGUID                   string
+	ProcessType                   string
+	TaskGUID                      string
+	TaskName                      string
+	SpaceGUID                     string
+	SpaceName                     string
+	OrganizationGUID              string
+	BuildpackGUID                 string
+	BuildpackName                 string
+	InstanceCount                 int
+	PreviousInstanceCount         int
+	MemoryInMBPerInstance         int
+	PreviousMemoryInMBPerInstance int
+}
+
+func (e *AppUsageEvent) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		GUID      string    `json:"guid"`
+		CreatedAt time.Time `json:"created_at"`
+		State     struct {
+			Current  string `json:"current"`
+			Previous string `json:"previous"`
+		} `json:"state"`
+		App                   usageEventReference `json:"app"`
+		Process               usageEventReference `json:"process"`
+		Task                  usageEventReference `json:"task"`
+		Space                 usageEventReference `json:"space"`
+		Organization          usageEventReference `json:"organization"`
+		Buildpack             usageEventReference `json:"buildpack"`
+		InstanceCount         usageEventChange    `json:"instance_count"`
+		MemoryInMBPerInstance usageEventChange    `json:"memory_in_mb_per_instance"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccEvent)
+	if err != nil {
+		return err
+	}
+
+	*e = AppUsageEvent{
+		GUID:                          ccEvent.GUID,
+		CreatedAt:                     ccEvent.CreatedAt,
+		State:                         ccEvent.State.Current,
+		PreviousState:                 ccEvent.State.Previous,
+		AppGUID:                       ccEvent.App.GUID,
+		AppName:                       ccEvent.App.Name,
+		ProcessGUID:                   ccEvent.Process.GUID,
+		ProcessType:                   ccEvent.Process.Type,
+		TaskGUID:                      ccEvent.Task.GUID,
+		TaskName:                      ccEvent.Task.Name,
+		SpaceGUID:                     ccEvent.Space.GUID,
+		SpaceName:                     ccEvent.Space.Name,
+		OrganizationGUID:              ccEvent.Organization.GUID,
+		BuildpackGUID:                 ccEvent.Buildpack.GUID,
+		BuildpackName:                 ccEvent.Buildpack.Name,
+		InstanceCount:                 ccEvent.InstanceCount.Current,
+		PreviousInstanceCount:         ccEvent.InstanceCount.Previous,
+		MemoryInMBPerInstance:         ccEvent.MemoryInMBPerInstance.Current,
+		PreviousMemoryInMBPerInstance: ccEvent.MemoryInMBPerInstance.Previous,
+	}
+
+	return nil
+}
+
+// ServiceUsageEvent records the creation, update or deletion of a service
+// instance, as used for billing.
+type ServiceUsageEvent struct {
+	GUID                string
+	CreatedAt           time.Time
+	State               string
+	ServiceInstanceGUID string
+	ServiceInstanceName string
+	ServiceInstanceType string
+	ServicePlanGUID     string
+	ServicePlanName     string
+	ServiceOfferingGUID string
+	ServiceOfferingName string
+	ServiceBrokerGUID   string
+	ServiceBrokerName   string
+	SpaceGUID           string
+	SpaceName           string
+	OrganizationGUID    string
+}
+
+func (e *ServiceUsageEvent) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		GUID            string              `json:"guid"`
+		CreatedAt       time.Time           `json:"created_at"`
+		State           string              `json:"state"`
+		ServiceInstance usageEventReference `json:"service_instance"`
+		ServicePlan     usageEventReference `json:"service_plan"`
+		ServiceOffering usageEventReference `json:"service_offering"`
+		ServiceBroker   usageEventReference `json:"service_broker"`
+		Space           usageEventReference `json:"space"`
+		Organization    usageEventReference `json:"organization"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccEvent)
+	if err != nil {
+		return err
+	}
+
+	*e = ServiceUsageEvent{
+		GUID:                ccEvent.GUID,
+		CreatedAt:           ccEvent.CreatedAt,
+		State:               ccEvent.State,
+		ServiceInstanceGUID: ccEvent.ServiceInstance.GUID,
+		ServiceInstanceName: ccEvent.ServiceInstance.Name,
+		ServiceInstanceType: ccEvent.ServiceInstance.Type,
+		ServicePlanGUID:     ccEvent.ServicePlan.GUID,
+		ServicePlanName:     ccEvent.ServicePlan.Name,
+		ServiceOfferingGUID: ccEvent.ServiceOffering.GUID,
+		ServiceOfferingName: ccEvent.ServiceOffering.Name,
+		ServiceBrokerGUID:   ccEvent.ServiceBroker.GUID,
+		ServiceBrokerName:   ccEvent.ServiceBroker.Name,
+		SpaceGUID:           ccEvent.Space.GUID,
+		SpaceName:           ccEvent.Space.Name,
+		OrganizationGUID:    ccEvent.Organization.GUID,
+	}
+
+	return nil
+}
+
+// usageEventReference is the guid/name/type triple usage events use to refer
+// to other resources. Any of the fields can be null.
+type usageEventReference struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type usageEventChange struct {
+	Current  int `json:"current"`
+	Previous int `json:"previous"`
+}
@@ -0,0 +1,31 @@
+// Package browser opens URLs in the user's default web browser.
+package browser
+
+import (
+	"os/exec"
+	"runtime"
+)
+
+// Opener opens URLs with the platform's default handler.
+type Opener struct{}
+
+// Open starts the default browser on url without waiting for it to exit.
+func (Opener) Open(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	case "darwin":
+		cmd = exec.Command("open", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() {
+		_ = cmd.Wait()
+	}()
+	return nil
+}
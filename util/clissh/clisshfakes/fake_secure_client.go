@@ -44,6 +44,20 @@ type FakeSecureClient struct {
 		result1 net.Conn
 		result2 error
 	}
+	ListenStub        func(string, string) (net.Listener, error)
+	listenMutex       sync.RWMutex
+	listenArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listenReturns struct {
+		result1 net.Listener
+		result2 error
+	}
+	listenReturnsOnCall map[int]struct {
+		result1 net.Listener
+		result2 error
+	}
 	NewSessionStub        func() (clissh.SecureSession, error)
 	newSessionMutex       sync.RWMutex
 	newSessionArgsForCall []struct {
@@ -75,15 +89,16 @@ func (fake *FakeSecureClient) Close() error {
 	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
 	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
 	}{})
+	stub := fake.CloseStub
+	fakeReturns := fake.closeReturns
 	fake.recordInvocation("Close", []interface{}{})
 	fake.closeMutex.Unlock()
-	if fake.CloseStub != nil {
-		return fake.CloseStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.closeReturns
 	return fakeReturns.result1
 }
 
@@ -127,15 +142,16 @@ func (fake *FakeSecureClient) Conn() ssh.Conn {
 	ret, specificReturn := fake.connReturnsOnCall[len(fake.connArgsForCall)]
 	fake.connArgsForCall = append(fake.connArgsForCall, struct {
 	}{})
+	stub := fake.ConnStub
+	fakeReturns := fake.connReturns
 	fake.recordInvocation("Conn", []interface{}{})
 	fake.connMutex.Unlock()
-	if fake.ConnStub != nil {
-		return fake.ConnStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.connReturns
 	return fakeReturns.result1
 }
 
@@ -181,15 +197,16 @@ func (fake *FakeSecureClient) Dial(arg1 string, arg2 string) (net.Conn, error) {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
+	stub := fake.DialStub
+	fakeReturns := fake.dialReturns
 	fake.recordInvocation("Dial", []interface{}{arg1, arg2})
 	fake.dialMutex.Unlock()
-	if fake.DialStub != nil {
-		return fake.DialStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.dialReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -238,20 +255,86 @@ func (fake *FakeSecureClient) DialReturnsOnCall(i int, result1 net.Conn, result2
 	}{result1, result2}
 }
 
+func (fake *FakeSecureClient) Listen(arg1 string, arg2 string) (net.Listener, error) {
+	fake.listenMutex.Lock()
+	ret, specificReturn := fake.listenReturnsOnCall[len(fake.listenArgsForCall)]
+	fake.listenArgsForCall = append(fake.listenArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ListenStub
+	fakeReturns := fake.listenReturns
+	fake.recordInvocation("Listen", []interface{}{arg1, arg2})
+	fake.listenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSecureClient) ListenCallCount() int {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	return len(fake.listenArgsForCall)
+}
+
+func (fake *FakeSecureClient) ListenCalls(stub func(string, string) (net.Listener, error)) {
+	fake.listenMutex.Lock()
+	defer fake.listenMutex.Unlock()
+	fake.ListenStub = stub
+}
+
+func (fake *FakeSecureClient) ListenArgsForCall(i int) (string, string) {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	argsForCall := fake.listenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSecureClient) ListenReturns(result1 net.Listener, result2 error) {
+	fake.listenMutex.Lock()
+	defer fake.listenMutex.Unlock()
+	fake.ListenStub = nil
+	fake.listenReturns = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureClient) ListenReturnsOnCall(i int, result1 net.Listener, result2 error) {
+	fake.listenMutex.Lock()
+	defer fake.listenMutex.Unlock()
+	fake.ListenStub = nil
+	if fake.listenReturnsOnCall == nil {
+		fake.listenReturnsOnCall = make(map[int]struct {
+			result1 net.Listener
+			result2 error
+		})
+	}
+	fake.listenReturnsOnCall[i] = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureClient) NewSession() (clissh.SecureSession, error) {
 	fake.newSessionMutex.Lock()
 	ret, specificReturn := fake.newSessionReturnsOnCall[len(fake.newSessionArgsForCall)]
 	fake.newSessionArgsForCall = append(fake.newSessionArgsForCall, struct {
 	}{})
+	stub := fake.NewSessionStub
+	fakeReturns := fake.newSessionReturns
 	fake.recordInvocation("NewSession", []interface{}{})
 	fake.newSessionMutex.Unlock()
-	if fake.NewSessionStub != nil {
-		return fake.NewSessionStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.newSessionReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -298,15 +381,16 @@ func (fake *FakeSecureClient) Wait() error {
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
 	fake.waitArgsForCall = append(fake.waitArgsForCall, struct {
 	}{})
+	stub := fake.WaitStub
+	fakeReturns := fake.waitReturns
 	fake.recordInvocation("Wait", []interface{}{})
 	fake.waitMutex.Unlock()
-	if fake.WaitStub != nil {
-		return fake.WaitStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.waitReturns
 	return fakeReturns.result1
 }
 
@@ -354,6 +438,8 @@ func (fake *FakeSecureClient) Invocations() map[string][][]interface{} {
 	defer fake.connMutex.RUnlock()
 	fake.dialMutex.RLock()
 	defer fake.dialMutex.RUnlock()
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
 	fake.newSessionMutex.RLock()
 	defer fake.newSessionMutex.RUnlock()
 	fake.waitMutex.RLock()
@@ -0,0 +1,146 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package clisshfakes
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
+
+type FakeTransferProgress struct {
+	DoneStub        func()
+	doneMutex       sync.RWMutex
+	doneArgsForCall []struct {
+	}
+	WrapStub        func(string, io.Reader, int64) io.Reader
+	wrapMutex       sync.RWMutex
+	wrapArgsForCall []struct {
+		arg1 string
+		arg2 io.Reader
+		arg3 int64
+	}
+	wrapReturns struct {
+		result1 io.Reader
+	}
+	wrapReturnsOnCall map[int]struct {
+		result1 io.Reader
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTransferProgress) Done() {
+	fake.doneMutex.Lock()
+	fake.doneArgsForCall = append(fake.doneArgsForCall, struct {
+	}{})
+	stub := fake.DoneStub
+	fake.recordInvocation("Done", []interface{}{})
+	fake.doneMutex.Unlock()
+	if stub != nil {
+		fake.DoneStub()
+	}
+}
+
+func (fake *FakeTransferProgress) DoneCallCount() int {
+	fake.doneMutex.RLock()
+	defer fake.doneMutex.RUnlock()
+	return len(fake.doneArgsForCall)
+}
+
+func (fake *FakeTransferProgress) DoneCalls(stub func()) {
+	fake.doneMutex.Lock()
+	defer fake.doneMutex.Unlock()
+	fake.DoneStub = stub
+}
+
+func (fake *FakeTransferProgress) Wrap(arg1 string, arg2 io.Reader, arg3 int64) io.Reader {
+	fake.wrapMutex.Lock()
+	ret, specificReturn := fake.wrapReturnsOnCall[len(fake.wrapArgsForCall)]
+	fake.wrapArgsForCall = append(fake.wrapArgsForCall, struct {
+		arg1 string
+		arg2 io.Reader
+		arg3 int64
+	}{arg1, arg2, arg3})
+	stub := fake.WrapStub
+	fakeReturns := fake.wrapReturns
+	fake.recordInvocation("Wrap", []interface{}{arg1, arg2, arg3})
+	fake.wrapMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTransferProgress) WrapCallCount() int {
+	fake.wrapMutex.RLock()
+	defer fake.wrapMutex.RUnlock()
+	return len(fake.wrapArgsForCall)
+}
+
+func (fake *FakeTransferProgress) WrapCalls(stub func(string, io.Reader, int64) io.Reader) {
+	fake.wrapMutex.Lock()
+	defer fake.wrapMutex.Unlock()
+	fake.WrapStub = stub
+}
+
+func (fake *FakeTransferProgress) WrapArgsForCall(i int) (string, io.Reader, int64) {
+	fake.wrapMutex.RLock()
+	defer fake.wrapMutex.RUnlock()
+	argsForCall := fake.wrapArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTransferProgress) WrapReturns(result1 io.Reader) {
+	fake.wrapMutex.Lock()
+	defer fake.wrapMutex.Unlock()
+	fake.WrapStub = nil
+	fake.wrapReturns = struct {
+		result1 io.Reader
+	}{result1}
+}
+
+func (fake *FakeTransferProgress) WrapReturnsOnCall(i int, result1 io.Reader) {
+	fake.wrapMutex.Lock()
+	defer fake.wrapMutex.Unlock()
+	fake.WrapStub = nil
+	if fake.wrapReturnsOnCall == nil {
+		fake.wrapReturnsOnCall = make(map[int]struct {
+			result1 io.Reader
+		})
+	}
+	fake.wrapReturnsOnCall[i] = struct {
+		result1 io.Reader
+	}{result1}
+}
+
+func (fake *FakeTransferProgress) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.doneMutex.RLock()
+	defer fake.doneMutex.RUnlock()
+	fake.wrapMutex.RLock()
+	defer fake.wrapMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTransferProgress) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ clissh.TransferProgress = new(FakeTransferProgress)
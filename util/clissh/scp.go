@@ -0,0 +1,372 @@
+package clissh
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . TransferProgress
+
+// TransferProgress reports the progress of each file copied by Upload and
+// Download.
+type TransferProgress interface {
+	// Wrap returns a reader that reports progress as the size bytes of the
+	// named file are read from reader.
+	Wrap(name string, reader io.Reader, size int64) io.Reader
+	// Done is called once the file has been copied.
+	Done()
+}
+
+// Upload copies the local file or directory at localPath to remotePath using
+// the scp protocol. Directories are only copied when recursive is set.
+func (c *SecureShell) Upload(localPath string, remotePath string, recursive bool, progress TransferProgress) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() && !recursive {
+		return fmt.Errorf("%s is a directory, use -r to copy directories", localPath)
+	}
+
+	return c.runSCP(scpCommand("-t", remotePath, recursive), func(transfer *scpTransfer) error {
+		err := transfer.awaitConfirmation()
+		if err != nil {
+			return err
+		}
+		return transfer.send(localPath, info, progress)
+	})
+}
+
+// Download copies the remote file or directory at remotePath to localPath
+// using the scp protocol. Directories are only copied when recursive is set.
+func (c *SecureShell) Download(remotePath string, localPath string, recursive bool, progress TransferProgress) error {
+	return c.runSCP(scpCommand("-f", remotePath, recursive), func(transfer *scpTransfer) error {
+		return transfer.receive(localPath, progress)
+	})
+}
+
+func (c *SecureShell) runSCP(command string, transfer func(*scpTransfer) error) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	stderr, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+	stderrBuffer := &bytes.Buffer{}
+	stderrCopied := make(chan struct{})
+	go func() {
+		defer close(stderrCopied)
+		_, _ = io.Copy(stderrBuffer, stderr)
+	}()
+
+	err = session.Start(command)
+	if err != nil {
+		return err
+	}
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	err = transfer(&scpTransfer{
+		writer: stdin,
+		reader: bufio.NewReader(stdout),
+	})
+	if err != nil {
+		return err
+	}
+	_ = stdin.Close()
+
+	waitErr := session.Wait()
+	<-stderrCopied
+
+	if waitErr != nil {
+		if message := strings.TrimSpace(stderrBuffer.String()); message != "" {
+			return errors.New(message)
+		}
+		return waitErr
+	}
+	return nil
+}
+
+func scpCommand(mode string, remotePath string, recursive bool) string {
+	command := "scp " + mode
+	if recursive {
+		command += " -r"
+	}
+	return command + " " + shellQuote(remotePath)
+}
+
+// shellQuote quotes path so that it is passed to the remote scp as a single
+// argument.
+func shellQuote(path string) string {
+	return "'" + strings.ReplaceAll(path, "'", `'"'"'`) + "'"
+}
+
+// scpTransfer is one side of the scp protocol: each file is sent as a C
+// message followed by its contents, and directories are bracketed by D and E
+// messages. Every message is acknowledged with a zero byte, or a 1 or 2
+// followed by an error message.
+type scpTransfer struct {
+	writer io.Writer
+	reader *bufio.Reader
+}
+
+func (transfer *scpTransfer) awaitConfirmation() error {
+	status, err := transfer.reader.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case 0:
+		return nil
+	case 1, 2:
+		message, err := transfer.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		return errors.New(strings.TrimSpace(message))
+	default:
+		return fmt.Errorf("invalid scp acknowledgement: %x", status)
+	}
+}
+
+func (transfer *scpTransfer) confirm() error {
+	_, err := transfer.writer.Write([]byte{0})
+	return err
+}
+
+func (transfer *scpTransfer) send(path string, info os.FileInfo, progress TransferProgress) error {
+	if info.IsDir() {
+		return transfer.sendDirectory(path, info, progress)
+	}
+	return transfer.sendFile(path, info, progress)
+}
+
+func (transfer *scpTransfer) sendFile(path string, info os.FileInfo, progress TransferProgress) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(transfer.writer, "C%04o %d %s\n", info.Mode().Perm(), info.Size(), info.Name())
+	if err != nil {
+		return err
+	}
+	err = transfer.awaitConfirmation()
+	if err != nil {
+		return err
+	}
+
+	var reader io.Reader = file
+	if progress != nil {
+		reader = progress.Wrap(info.Name(), file, info.Size())
+		defer progress.Done()
+	}
+	_, err = io.CopyN(transfer.writer, reader, info.Size())
+	if err != nil {
+		return err
+	}
+
+	err = transfer.confirm()
+	if err != nil {
+		return err
+	}
+	return transfer.awaitConfirmation()
+}
+
+func (transfer *scpTransfer) sendDirectory(path string, info os.FileInfo, progress TransferProgress) error {
+	_, err := fmt.Fprintf(transfer.writer, "D%04o 0 %s\n", info.Mode().Perm(), info.Name())
+	if err != nil {
+		return err
+	}
+	err = transfer.awaitConfirmation()
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		entryInfo, err := os.Stat(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if !entryInfo.IsDir() && !entryInfo.Mode().IsRegular() {
+			continue
+		}
+
+		err = transfer.send(filepath.Join(path, entry.Name()), entryInfo, progress)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(transfer.writer, "E\n")
+	if err != nil {
+		return err
+	}
+	return transfer.awaitConfirmation()
+}
+
+func (transfer *scpTransfer) receive(localPath string, progress TransferProgress) error {
+	localIsDir := false
+	if info, err := os.Stat(localPath); err == nil {
+		localIsDir = info.IsDir()
+	}
+
+	// targetPath places a received entry in the innermost open directory. At
+	// the top level it goes inside localPath if that is a directory, and
+	// replaces localPath otherwise.
+	var directories []string
+	targetPath := func(name string) string {
+		if len(directories) > 0 {
+			return filepath.Join(directories[len(directories)-1], name)
+		}
+		if localIsDir {
+			return filepath.Join(localPath, name)
+		}
+		return localPath
+	}
+
+	err := transfer.confirm()
+	if err != nil {
+		return err
+	}
+
+	for {
+		messageType, err := transfer.reader.ReadByte()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if messageType == 1 || messageType == 2 {
+			message, readErr := transfer.reader.ReadString('\n')
+			if readErr != nil {
+				return readErr
+			}
+			return errors.New(strings.TrimSpace(message))
+		}
+
+		line, err := transfer.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSuffix(line, "\n")
+
+		switch messageType {
+		case 'T':
+		case 'C':
+			mode, size, name, parseErr := parseSCPEntry(line)
+			if parseErr != nil {
+				return parseErr
+			}
+			err = transfer.confirm()
+			if err != nil {
+				return err
+			}
+			err = transfer.receiveFile(targetPath(name), name, mode, size, progress)
+			if err != nil {
+				return err
+			}
+			err = transfer.awaitConfirmation()
+			if err != nil {
+				return err
+			}
+		case 'D':
+			mode, _, name, parseErr := parseSCPEntry(line)
+			if parseErr != nil {
+				return parseErr
+			}
+			path := targetPath(name)
+			err = os.MkdirAll(path, mode|0700)
+			if err != nil {
+				return err
+			}
+			directories = append(directories, path)
+		case 'E':
+			if len(directories) == 0 {
+				return errors.New("unexpected end of directory in scp transfer")
+			}
+			directories = directories[:len(directories)-1]
+		default:
+			return fmt.Errorf("unexpected scp message type: %q", messageType)
+		}
+
+		err = transfer.confirm()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (transfer *scpTransfer) receiveFile(path string, name string, mode os.FileMode, size int64, progress TransferProgress) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var reader io.Reader = transfer.reader
+	if progress != nil {
+		reader = progress.Wrap(name, transfer.reader, size)
+		defer progress.Done()
+	}
+	_, err = io.CopyN(file, reader, size)
+	return err
+}
+
+// parseSCPEntry parses the "MODE SIZE NAME" of a C or D message.
+func parseSCPEntry(line string) (os.FileMode, int64, string, error) {
+	fields := strings.SplitN(line, " ", 3)
+	if len(fields) != 3 {
+		return 0, 0, "", fmt.Errorf("invalid scp message: %q", line)
+	}
+
+	mode, err := strconv.ParseUint(fields[0], 8, 32)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("invalid scp file mode: %q", fields[0])
+	}
+
+	size, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil || size < 0 {
+		return 0, 0, "", fmt.Errorf("invalid scp file size: %q", fields[1])
+	}
+
+	name := fields[2]
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return 0, 0, "", fmt.Errorf("invalid scp file name: %q", name)
+	}
+
+	return os.FileMode(mode).Perm(), size, name, nil
+}
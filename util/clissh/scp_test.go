@@ -0,0 +1,214 @@
+//go:build !windows && !386
+// +build !windows,!386
+
+package clissh_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
+	"code.cloudfoundry.org/diego-ssh/scp"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCP", func() {
+	var (
+		fakeSecureDialer  *clisshfakes.FakeSecureDialer
+		fakeSecureClient  *clisshfakes.FakeSecureClient
+		fakeSecureSession *clisshfakes.FakeSecureSession
+		fakeProgress      *clisshfakes.FakeTransferProgress
+		secureShell       *SecureShell
+
+		localDir  string
+		remoteDir string
+	)
+
+	BeforeEach(func() {
+		fakeSecureDialer = new(clisshfakes.FakeSecureDialer)
+		fakeSecureClient = new(clisshfakes.FakeSecureClient)
+		fakeSecureSession = new(clisshfakes.FakeSecureSession)
+		fakeProgress = new(clisshfakes.FakeTransferProgress)
+		fakeProgress.WrapStub = func(_ string, reader io.Reader, _ int64) io.Reader {
+			return reader
+		}
+
+		fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+		fakeSecureClient.NewSessionReturns(fakeSecureSession, nil)
+		fakeSecureClient.ConnReturns(new(fake_ssh.FakeConn))
+
+		// The session runs the scp command with the scp implementation of the
+		// Diego SSH daemon, connected to the client through buffered OS pipes
+		// in place of the SSH channel.
+		serverStdin, clientStdin, err := os.Pipe()
+		Expect(err).NotTo(HaveOccurred())
+		clientStdout, serverStdout, err := os.Pipe()
+		Expect(err).NotTo(HaveOccurred())
+		serverDone := make(chan error, 1)
+
+		fakeSecureSession.StdinPipeReturns(clientStdin, nil)
+		fakeSecureSession.StdoutPipeReturns(clientStdout, nil)
+		fakeSecureSession.StderrPipeReturns(new(bytes.Buffer), nil)
+		fakeSecureSession.StartStub = func(command string) error {
+			copier, err := scp.NewFromCommand(command, serverStdin, serverStdout, io.Discard, lagertest.NewTestLogger("scp"))
+			if err != nil {
+				return err
+			}
+			go func() {
+				serverDone <- copier.Copy()
+				serverStdout.Close()
+			}()
+			return nil
+		}
+		fakeSecureSession.WaitStub = func() error {
+			return <-serverDone
+		}
+		fakeSecureSession.CloseStub = func() error {
+			serverStdin.Close()
+			clientStdout.Close()
+			return nil
+		}
+
+		localDir = GinkgoT().TempDir()
+		remoteDir = GinkgoT().TempDir()
+
+		secureShell = NewSecureShell(fakeSecureDialer, new(clisshfakes.FakeTerminalHelper), new(clisshfakes.FakeListenerFactory), DefaultKeepAliveInterval)
+		Expect(secureShell.Connect("some-user", "some-passcode", "some-endpoint", "some-fingerprint", true)).To(Succeed())
+	})
+
+	Describe("Upload", func() {
+		When("uploading a file", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(filepath.Join(localDir, "some-file"), []byte("some-contents"), 0640)).To(Succeed())
+			})
+
+			It("copies the file to the remote path", func() {
+				err := secureShell.Upload(filepath.Join(localDir, "some-file"), filepath.Join(remoteDir, "copied-file"), false, fakeProgress)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -t '" + filepath.Join(remoteDir, "copied-file") + "'"))
+
+				contents, err := os.ReadFile(filepath.Join(remoteDir, "copied-file"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-contents"))
+
+				Expect(fakeProgress.WrapCallCount()).To(Equal(1))
+				name, _, size := fakeProgress.WrapArgsForCall(0)
+				Expect(name).To(Equal("some-file"))
+				Expect(size).To(BeEquivalentTo(len("some-contents")))
+				Expect(fakeProgress.DoneCallCount()).To(Equal(1))
+			})
+		})
+
+		When("uploading a directory recursively", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(filepath.Join(localDir, "some-dir", "nested"), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(localDir, "some-dir", "a"), []byte("a-contents"), 0644)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(localDir, "some-dir", "nested", "b"), []byte("b-contents"), 0644)).To(Succeed())
+			})
+
+			It("copies the directory tree into the remote directory", func() {
+				err := secureShell.Upload(filepath.Join(localDir, "some-dir"), remoteDir, true, fakeProgress)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -t -r '" + remoteDir + "'"))
+
+				contents, err := os.ReadFile(filepath.Join(remoteDir, "some-dir", "a"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("a-contents"))
+
+				contents, err = os.ReadFile(filepath.Join(remoteDir, "some-dir", "nested", "b"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("b-contents"))
+
+				Expect(fakeProgress.DoneCallCount()).To(Equal(2))
+			})
+		})
+
+		When("uploading a directory without recursive", func() {
+			It("returns an error without starting a session", func() {
+				err := secureShell.Upload(localDir, remoteDir, false, fakeProgress)
+				Expect(err).To(MatchError(localDir + " is a directory, use -r to copy directories"))
+				Expect(fakeSecureClient.NewSessionCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the remote side rejects the file", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(filepath.Join(localDir, "some-file"), []byte("some-contents"), 0640)).To(Succeed())
+			})
+
+			It("returns the remote error", func() {
+				err := secureShell.Upload(filepath.Join(localDir, "some-file"), filepath.Join(remoteDir, "missing", "copied-file"), false, fakeProgress)
+				Expect(err).To(MatchError(ContainSubstring("no such file or directory")))
+			})
+		})
+	})
+
+	Describe("Download", func() {
+		When("downloading a file", func() {
+			BeforeEach(func() {
+				Expect(os.WriteFile(filepath.Join(remoteDir, "some-file"), []byte("some-contents"), 0600)).To(Succeed())
+			})
+
+			It("copies the file into the local directory", func() {
+				err := secureShell.Download(filepath.Join(remoteDir, "some-file"), localDir, false, fakeProgress)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("scp -f '" + filepath.Join(remoteDir, "some-file") + "'"))
+
+				contents, err := os.ReadFile(filepath.Join(localDir, "some-file"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-contents"))
+
+				name, _, size := fakeProgress.WrapArgsForCall(0)
+				Expect(name).To(Equal("some-file"))
+				Expect(size).To(BeEquivalentTo(len("some-contents")))
+				Expect(fakeProgress.DoneCallCount()).To(Equal(1))
+			})
+
+			It("copies the file to a new local path", func() {
+				err := secureShell.Download(filepath.Join(remoteDir, "some-file"), filepath.Join(localDir, "renamed"), false, fakeProgress)
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := os.ReadFile(filepath.Join(localDir, "renamed"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-contents"))
+			})
+		})
+
+		When("downloading a directory recursively", func() {
+			BeforeEach(func() {
+				Expect(os.MkdirAll(filepath.Join(remoteDir, "some-dir", "nested"), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(remoteDir, "some-dir", "a"), []byte("a-contents"), 0644)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(remoteDir, "some-dir", "nested", "b"), []byte("b-contents"), 0644)).To(Succeed())
+			})
+
+			It("copies the directory tree into the local directory", func() {
+				err := secureShell.Download(filepath.Join(remoteDir, "some-dir"), localDir, true, fakeProgress)
+				Expect(err).NotTo(HaveOccurred())
+
+				contents, err := os.ReadFile(filepath.Join(localDir, "some-dir", "a"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("a-contents"))
+
+				contents, err = os.ReadFile(filepath.Join(localDir, "some-dir", "nested", "b"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("b-contents"))
+			})
+		})
+
+		When("the remote file does not exist", func() {
+			It("returns the remote error", func() {
+				err := secureShell.Download(filepath.Join(remoteDir, "missing"), localDir, false, fakeProgress)
+				Expect(err).To(MatchError(ContainSubstring("no such file or directory")))
+			})
+		})
+	})
+})
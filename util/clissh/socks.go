@@ -0,0 +1,110 @@
+package clissh
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// The subset of SOCKS5 (RFC 1928) needed to serve CONNECT requests without
+// authentication.
+const (
+	socksVersion5 = 0x05
+
+	socksMethodNoAuth       = 0x00
+	socksMethodNoAcceptable = 0xff
+
+	socksCommandConnect = 0x01
+
+	socksAddressIPv4   = 0x01
+	socksAddressDomain = 0x03
+	socksAddressIPv6   = 0x04
+
+	socksSucceeded               = 0x00
+	socksGeneralFailure          = 0x01
+	socksCommandNotSupported     = 0x07
+	socksAddressTypeNotSupported = 0x08
+)
+
+// readSOCKSRequest performs the server side of the SOCKS5 handshake and
+// returns the address the client asked to connect to.
+func readSOCKSRequest(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	if header[0] != socksVersion5 {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+
+	method := byte(socksMethodNoAcceptable)
+	for _, m := range methods {
+		if m == socksMethodNoAuth {
+			method = socksMethodNoAuth
+		}
+	}
+	if _, err := conn.Write([]byte{socksVersion5, method}); err != nil {
+		return "", err
+	}
+	if method == socksMethodNoAcceptable {
+		return "", errors.New("SOCKS client requires authentication")
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	if request[1] != socksCommandConnect {
+		_ = writeSOCKSReply(conn, socksCommandNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+
+	var host string
+	switch request[3] {
+	case socksAddressIPv4, socksAddressIPv6:
+		size := net.IPv4len
+		if request[3] == socksAddressIPv6 {
+			size = net.IPv6len
+		}
+		ip := make(net.IP, size)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = ip.String()
+	case socksAddressDomain:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		domain := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return "", err
+		}
+		host = string(domain)
+	default:
+		_ = writeSOCKSReply(conn, socksAddressTypeNotSupported)
+		return "", fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// writeSOCKSReply sends a reply with the given status. The bound address is
+// always reported as 0.0.0.0:0 since the connection is made by the server.
+func writeSOCKSReply(conn io.Writer, status byte) error {
+	_, err := conn.Write([]byte{socksVersion5, status, 0x00, socksAddressIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
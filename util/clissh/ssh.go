@@ -37,6 +37,19 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+// RemotePortForward listens on RemoteAddress inside the container and
+// forwards each connection to LocalAddress on this machine.
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+// DynamicPortForward runs a SOCKS5 proxy on LocalAddress that connects to the
+// requested destinations from inside the container.
+type DynamicPortForward struct {
+	LocalAddress string
+}
+
 type SecureShell struct {
 	secureDialer    SecureDialer
 	secureClient    SecureClient
@@ -44,6 +57,7 @@ type SecureShell struct {
 	listenerFactory ListenerFactory
 
 	localListeners    []net.Listener
+	remoteListeners   []net.Listener
 	keepAliveInterval time.Duration
 }
 
@@ -79,6 +93,9 @@ func (c *SecureShell) Close() error {
 	for _, listener := range c.localListeners {
 		listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -205,6 +222,47 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	return result
 }
 
+// ExecuteCommand runs commands without a terminal or input and copies their
+// output to stdout and stderr. A non-zero exit status is returned as an
+// *ssh.ExitError.
+func (c *SecureShell) ExecuteCommand(commands []string, stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(strings.Join(commands, " "))
+	if err != nil {
+		return err
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndDone(wg, stdout, outPipe)
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+	return result
+}
+
 func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward) error {
 	for _, spec := range localPortForwardSpecs {
 		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
@@ -219,6 +277,34 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 	return nil
 }
 
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return fmt.Errorf("Remote port forwarding to %s failed: %s", spec.RemoteAddress, err.Error())
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		go c.remoteForwardAcceptLoop(listener, spec.LocalAddress)
+	}
+
+	return nil
+}
+
+func (c *SecureShell) DynamicPortForward(dynamicPortForwardSpecs []DynamicPortForward) error {
+	for _, spec := range dynamicPortForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go c.acceptLoop(listener, c.handleSOCKSConnection)
+	}
+
+	return nil
+}
+
 func (c *SecureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
@@ -241,24 +327,49 @@ func (c *SecureShell) getWindowDimensions(terminalFd uintptr) (width int, height
 }
 
 func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+	forwardConnection(conn, targetAddr, c.secureClient.Dial)
+}
+
+// handleSOCKSConnection reads the destination of a SOCKS5 client and connects
+// it through the secure client.
+func (c *SecureShell) handleSOCKSConnection(conn net.Conn) {
 	defer conn.Close()
 
+	targetAddr, err := readSOCKSRequest(conn)
+	if err != nil {
+		log.Errorln("socks handshake:", err)
+		return
+	}
+
 	target, err := c.secureClient.Dial("tcp", targetAddr)
 	if err != nil {
+		_ = writeSOCKSReply(conn, socksGeneralFailure)
 		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
 		return
 	}
 	defer target.Close()
 
-	wg := &sync.WaitGroup{}
-	wg.Add(2)
+	err = writeSOCKSReply(conn, socksSucceeded)
+	if err != nil {
+		return
+	}
 
-	go copyAndClose(wg, conn, target)
-	go copyAndClose(wg, target, conn)
-	wg.Wait()
+	copyBothWays(conn, target)
 }
 
 func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+	c.acceptLoop(listener, func(conn net.Conn) {
+		c.handleForwardConnection(conn, addr)
+	})
+}
+
+func (c *SecureShell) remoteForwardAcceptLoop(listener net.Listener, addr string) {
+	c.acceptLoop(listener, func(conn net.Conn) {
+		forwardConnection(conn, addr, net.Dial)
+	})
+}
+
+func (c *SecureShell) acceptLoop(listener net.Listener, handle func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -271,7 +382,7 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
@@ -354,6 +465,15 @@ func copyAndDone(wg *sync.WaitGroup, dest io.Writer, src io.Reader) {
 	wg.Done()
 }
 
+func copyBothWays(conn net.Conn, target net.Conn) {
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go copyAndClose(wg, conn, target)
+	go copyAndClose(wg, target, conn)
+	wg.Wait()
+}
+
 func fingerprintCallback(skipHostValidation bool, expectedFingerprint string) ssh.HostKeyCallback {
 	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
 		if skipHostValidation {
@@ -383,6 +503,21 @@ func fingerprintCallback(skipHostValidation bool, expectedFingerprint string) ss
 	}
 }
 
+// forwardConnection dials targetAddr and copies data between it and conn
+// until either side closes.
+func forwardConnection(conn net.Conn, targetAddr string, dial func(network, address string) (net.Conn, error)) {
+	defer conn.Close()
+
+	target, err := dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	copyBothWays(conn, target)
+}
+
 func hexSha1Fingerprint(key ssh.PublicKey) string {
 	sum := sha1.Sum(key.Marshal())
 	return strings.Replace(fmt.Sprintf("% x", sum), " ", ":", -1)
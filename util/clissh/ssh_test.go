@@ -8,11 +8,13 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -32,6 +34,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
 )
 
 func BlockAcceptOnClose(fake *fake_net.FakeListener) {
@@ -186,6 +189,11 @@ var _ = Describe("CLI SSH", Serial, func() {
 
 		BeforeEach(func() {
 			stdin = new(fake_io.FakeReadCloser)
+			// Without a stub the fake returns no data and no error forever,
+			// which keeps the stdin copy spinning and recording calls.
+			stdin.ReadStub = func(p []byte) (int, error) {
+				return 0, io.EOF
+			}
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -1149,6 +1157,72 @@ var _ = Describe("CLI SSH", Serial, func() {
 		})
 	})
 
+	Describe("ExecuteCommand", Serial, func() {
+		var (
+			stdout, stderr *bytes.Buffer
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			stdout = new(bytes.Buffer)
+			stderr = new(bytes.Buffer)
+
+			stdoutPipe.ReadStub = strings.NewReader("some-output\n").Read
+			stderrPipe.ReadStub = strings.NewReader("some-error-output\n").Read
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			executeErr = secureShell.ExecuteCommand([]string{"echo", "hello"}, stdout, stderr)
+		})
+
+		It("runs the command without a terminal and copies its output", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("echo hello"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.StdinPipeCallCount()).To(Equal(0))
+
+			Expect(stdout.String()).To(Equal("some-output\n"))
+			Expect(stderr.String()).To(Equal("some-error-output\n"))
+			Expect(fakeSecureSession.CloseCallCount()).To(Equal(1))
+		})
+
+		When("the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("exit status 3"))
+			})
+
+			It("returns the error from waiting on the session", func() {
+				Expect(executeErr).To(MatchError("exit status 3"))
+			})
+		})
+
+		When("starting the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.StartReturns(errors.New("start failed"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("start failed"))
+				Expect(fakeSecureSession.WaitCallCount()).To(Equal(0))
+			})
+		})
+
+		When("allocating the session fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("no sessions"))
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("SSH session allocation failed: no sessions"))
+			})
+		})
+	})
+
 	Describe("Wait", Serial, func() {
 		var waitErr error
 
@@ -1203,6 +1277,198 @@ var _ = Describe("CLI SSH", Serial, func() {
 		})
 	})
 
+	Describe("RemotePortForward", Serial, func() {
+		var (
+			forwardErr     error
+			echoListener   net.Listener
+			remoteListener net.Listener
+			forwardSpecs   []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn) //nolint:errcheck
+						conn.Close()
+					}()
+				}
+			}()
+
+			fakeSecureClient.ListenStub = func(network string, address string) (net.Listener, error) {
+				var listenErr error
+				remoteListener, listenErr = net.Listen(network, address)
+				return remoteListener, listenErr
+			}
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "127.0.0.1:0",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("listens on the remote address through the secure client", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+
+			network, address := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(address).To(Equal("127.0.0.1:0"))
+		})
+
+		It("copies data between remote connections and the local address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := "Hello from the container\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		When("listening on the remote address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenStub = nil
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("Remote port forwarding to 127.0.0.1:0 failed: tcpip-forward request denied"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", Serial, func() {
+		var (
+			forwardErr    error
+			echoListener  net.Listener
+			socksListener net.Listener
+		)
+
+		socksDial := func(address string) (net.Conn, error) {
+			dialer, err := proxy.SOCKS5("tcp", socksListener.Addr().String(), nil, proxy.Direct)
+			Expect(err).NotTo(HaveOccurred())
+			return dialer.Dial("tcp", address)
+		}
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn) //nolint:errcheck
+						conn.Close()
+					}()
+				}
+			}()
+
+			fakeListenerFactory.ListenStub = func(network string, address string) (net.Listener, error) {
+				var listenErr error
+				socksListener, listenErr = net.Listen(network, address)
+				return socksListener, listenErr
+			}
+			fakeSecureClient.DialStub = net.Dial
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward([]DynamicPortForward{{LocalAddress: "127.0.0.1:0"}})
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("connects SOCKS clients to the requested address through the secure client", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			conn, err := socksDial(echoListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			network, address := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(address).To(Equal(echoListener.Addr().String()))
+
+			msg := "Hello through the proxy\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		It("passes host names to the secure client unresolved", func() {
+			fakeSecureClient.DialStub = nil
+			fakeSecureClient.DialReturns(nil, errors.New("no such host"))
+
+			_, err := socksDial("some-internal-route.apps.internal:8080")
+			Expect(err).To(HaveOccurred())
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			_, address := fakeSecureClient.DialArgsForCall(0)
+			Expect(address).To(Equal("some-internal-route.apps.internal:8080"))
+		})
+
+		When("the secure client cannot reach the requested address", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("connection refused"))
+			})
+
+			It("reports the failure to the SOCKS client", func() {
+				_, err := socksDial(echoListener.Addr().String())
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		When("listening fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenStub = nil
+				fakeListenerFactory.ListenReturns(nil, errors.New("address in use"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("address in use"))
+			})
+		})
+	})
+
 	Describe("Close", Serial, func() {
 		JustBeforeEach(func() {
 			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
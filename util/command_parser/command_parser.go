@@ -56,6 +56,8 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 	cfConfig := p.Config
 	cfConfig.Flags = configv3.FlagOverride{
 		Verbose: common.Commands.VerboseOrVersion,
+		Output:  common.Commands.Output.Format,
+		Context: common.Commands.Context,
 	}
 	defer p.UI.FlushDeferred()
 
@@ -64,6 +66,11 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		return p.handleError(err)
 	}
 
+	err = cfConfig.ApplyContextOverride()
+	if err != nil {
+		return p.handleError(err)
+	}
+
 	err = cfConfig.CreatePluginHome()
 	if err != nil {
 		return p.handleError(err)
@@ -121,6 +128,8 @@ func (p *CommandParser) handleError(passedErr error) error {
 	case translatableerror.CurlExit22Error:
 		p.UI.DisplayError(translatedErr)
 		return passedErr
+	case translatableerror.PushDryRunChangesPendingError:
+		return passedErr
 	}
 
 	p.UI.DisplayError(translatedErr)
@@ -233,6 +242,8 @@ func (p *CommandParser) parse(args []string, commandList interface{}) (int, erro
 		return exitError.ExitStatus(), nil
 	} else if curlError, ok := err.(translatableerror.CurlExit22Error); ok {
 		return 22, curlError
+	} else if _, ok := err.(translatableerror.PushDryRunChangesPendingError); ok {
+		return 2, nil
 	}
 
 	fmt.Fprintf(os.Stderr, "Unexpected error: %s\n", err.Error())
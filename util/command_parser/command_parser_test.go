@@ -2,6 +2,7 @@ package command_parser_test
 
 import (
 	"code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/util/command_parser"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -49,6 +50,8 @@ var _ = Describe("Command 'Parser'", func() {
 			// and the absence of -v relies on the default value of
 			// common.Commands.VerboseOrVersion to be false
 			common.Commands.VerboseOrVersion = false
+			common.Commands.Output = flag.OutputFormat{}
+			common.Commands.Context = ""
 			var err error
 
 			parser, err = command_parser.NewCommandParser(v3Config)
@@ -77,4 +80,76 @@ var _ = Describe("Command 'Parser'", func() {
 		})
 
 	})
+
+	Describe("the output flag", func() {
+		var parser command_parser.CommandParser
+
+		BeforeEach(func() {
+			// Needed because the command-table is a singleton
+			common.Commands.VerboseOrVersion = false
+			common.Commands.Output = flag.OutputFormat{}
+			common.Commands.Context = ""
+			var err error
+
+			parser, err = command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("sets the output format", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"--output", "json", "help"})
+			Expect(exitCode).To(Equal(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parser.Config.Flags).To(Equal(configv3.FlagOverride{Output: configv3.OutputFormatJSON}))
+		})
+
+		It("sets the output format after the command-name", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"help", "--output", "YAML"})
+			Expect(exitCode).To(Equal(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parser.Config.Flags).To(Equal(configv3.FlagOverride{Output: configv3.OutputFormatYAML}))
+		})
+
+		It("doesn't set an output format by default", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"help"})
+			Expect(exitCode).To(Equal(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parser.Config.Flags).To(Equal(configv3.FlagOverride{}))
+		})
+	})
+
+	Describe("the context flag", func() {
+		var parser command_parser.CommandParser
+
+		BeforeEach(func() {
+			// Needed because the command-table is a singleton
+			common.Commands.VerboseOrVersion = false
+			common.Commands.Output = flag.OutputFormat{}
+			common.Commands.Context = ""
+			GinkgoT().Setenv("CF_HOME", GinkgoT().TempDir())
+
+			v3Config.ConfigFile.Target = "https://api.default.example.com"
+			v3Config.ConfigFile.Contexts = map[string]configv3.TargetContext{
+				"staging": {Target: "https://api.staging.example.com"},
+			}
+			var err error
+
+			parser, err = command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("runs the command against the named context", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"help", "--context", "staging"})
+			Expect(exitCode).To(Equal(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parser.Config.Flags).To(Equal(configv3.FlagOverride{Context: "staging"}))
+			Expect(parser.Config.Target()).To(Equal("https://api.staging.example.com"))
+		})
+
+		It("fails when the context does not exist", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"help", "--context", "production"})
+			Expect(exitCode).To(Equal(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parser.Config.Target()).To(Equal("https://api.default.example.com"))
+		})
+	})
 })
@@ -0,0 +1,204 @@
+package configv3
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// DefaultContextName is the name of the context used until another one is
+// selected. Configs written before contexts existed are treated as holding
+// only this context.
+const DefaultContextName = "default"
+
+// TargetContext holds what is needed to work with one foundation: the API
+// endpoints, the tokens used against them and the targeted org and space.
+type TargetContext struct {
+	AccessToken              string       `json:"AccessToken"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	CFOnK8s                  CFOnK8s      `json:"CFOnK8s"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	LogCacheEndpoint         string       `json:"LogCacheEndPoint"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string       `json:"NetworkPolicyV1Endpoint"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	RefreshToken             string       `json:"RefreshToken"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	Target                   string       `json:"Target"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+}
+
+// NamedContext is a TargetContext together with its name.
+type NamedContext struct {
+	Name string
+	TargetContext
+}
+
+// Contexts returns all contexts sorted by name. The current context reflects
+// any changes made while running the current command.
+func (config *Config) Contexts() []NamedContext {
+	contexts := config.contextsWith(config.CurrentContext())
+
+	named := make([]NamedContext, 0, len(contexts))
+	for name, context := range contexts {
+		named = append(named, NamedContext{Name: name, TargetContext: context})
+	}
+	sort.Slice(named, func(i, j int) bool { return named[i].Name < named[j].Name })
+
+	return named
+}
+
+// CurrentContext returns the name of the context commands run against. This
+// is based off of:
+//  1. The '--context' global flag if set
+//  2. The context last selected with 'use-context'
+//  3. Defaults to DefaultContextName
+func (config *Config) CurrentContext() string {
+	if config.Flags.Context != "" {
+		return config.Flags.Context
+	}
+	return config.selectedContext()
+}
+
+// CreateContext adds an empty context with the given name and makes it the
+// current one. Client credentials and the SSH client are reset to defaults.
+func (config *Config) CreateContext(name string) {
+	config.ConfigFile.Contexts = config.contextsWith(config.CurrentContext())
+	config.ConfigFile.Contexts[name] = TargetContext{
+		Target:               DefaultTarget,
+		SSHOAuthClient:       DefaultSSHOAuthClient,
+		UAAOAuthClient:       DefaultUAAOAuthClient,
+		UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+	}
+	config.useContext(name)
+}
+
+// SwitchContext makes the named context the current one, saving the state of
+// the previous one. It returns a ContextNotFoundError when there is no context
+// with that name.
+func (config *Config) SwitchContext(name string) error {
+	contexts := config.contextsWith(config.CurrentContext())
+	if _, ok := contexts[name]; !ok {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	config.ConfigFile.Contexts = contexts
+	config.useContext(name)
+	return nil
+}
+
+// ApplyContextOverride loads the context named by the '--context' global flag
+// for the duration of the command. The selected context stays the same; on
+// write, changes such as refreshed tokens are saved to the overriding context.
+func (config *Config) ApplyContextOverride() error {
+	name := config.Flags.Context
+	if name == "" || name == config.selectedContext() {
+		return nil
+	}
+
+	context, ok := config.ConfigFile.Contexts[name]
+	if !ok {
+		return translatableerror.ContextNotFoundError{Name: name}
+	}
+
+	config.ConfigFile.Contexts = config.contextsWith(config.selectedContext())
+	context.applyTo(&config.ConfigFile)
+	return nil
+}
+
+// configFileToWrite returns the config file with the current context saved to
+// the list of contexts and the selected context at the top level.
+func (config *Config) configFileToWrite() JSONConfig {
+	configFile := config.ConfigFile
+	if len(configFile.Contexts) == 0 && config.Flags.Context == "" {
+		return configFile
+	}
+
+	configFile.Contexts = config.contextsWith(config.CurrentContext())
+	if selected, ok := configFile.Contexts[config.selectedContext()]; ok {
+		selected.applyTo(&configFile)
+	}
+
+	return configFile
+}
+
+// contextsWith returns a copy of the saved contexts with the named one set to
+// the state at the top level of the config file.
+func (config *Config) contextsWith(current string) map[string]TargetContext {
+	contexts := make(map[string]TargetContext, len(config.ConfigFile.Contexts)+1)
+	for name, context := range config.ConfigFile.Contexts {
+		contexts[name] = context
+	}
+	contexts[current] = contextFromConfigFile(config.ConfigFile)
+
+	return contexts
+}
+
+func (config *Config) selectedContext() string {
+	if config.ConfigFile.CurrentContext != "" {
+		return config.ConfigFile.CurrentContext
+	}
+	return DefaultContextName
+}
+
+func (config *Config) useContext(name string) {
+	config.ConfigFile.Contexts[name].applyTo(&config.ConfigFile)
+	config.ConfigFile.CurrentContext = name
+	config.Flags.Context = ""
+}
+
+func contextFromConfigFile(configFile JSONConfig) TargetContext {
+	return TargetContext{
+		AccessToken:              configFile.AccessToken,
+		APIVersion:               configFile.APIVersion,
+		AuthorizationEndpoint:    configFile.AuthorizationEndpoint,
+		CFOnK8s:                  configFile.CFOnK8s,
+		DopplerEndpoint:          configFile.DopplerEndpoint,
+		LogCacheEndpoint:         configFile.LogCacheEndpoint,
+		MinCLIVersion:            configFile.MinCLIVersion,
+		MinRecommendedCLIVersion: configFile.MinRecommendedCLIVersion,
+		NetworkPolicyV1Endpoint:  configFile.NetworkPolicyV1Endpoint,
+		TargetedOrganization:     configFile.TargetedOrganization,
+		RefreshToken:             configFile.RefreshToken,
+		RoutingEndpoint:          configFile.RoutingEndpoint,
+		TargetedSpace:            configFile.TargetedSpace,
+		SSHOAuthClient:           configFile.SSHOAuthClient,
+		SkipSSLValidation:        configFile.SkipSSLValidation,
+		Target:                   configFile.Target,
+		UAAEndpoint:              configFile.UAAEndpoint,
+		UAAGrantType:             configFile.UAAGrantType,
+		UAAOAuthClient:           configFile.UAAOAuthClient,
+		UAAOAuthClientSecret:     configFile.UAAOAuthClientSecret,
+	}
+}
+
+func (context TargetContext) applyTo(configFile *JSONConfig) {
+	configFile.AccessToken = context.AccessToken
+	configFile.APIVersion = context.APIVersion
+	configFile.AuthorizationEndpoint = context.AuthorizationEndpoint
+	configFile.CFOnK8s = context.CFOnK8s
+	configFile.DopplerEndpoint = context.DopplerEndpoint
+	configFile.LogCacheEndpoint = context.LogCacheEndpoint
+	configFile.MinCLIVersion = context.MinCLIVersion
+	configFile.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+	configFile.NetworkPolicyV1Endpoint = context.NetworkPolicyV1Endpoint
+	configFile.TargetedOrganization = context.TargetedOrganization
+	configFile.RefreshToken = context.RefreshToken
+	configFile.RoutingEndpoint = context.RoutingEndpoint
+	configFile.TargetedSpace = context.TargetedSpace
+	configFile.SSHOAuthClient = context.SSHOAuthClient
+	configFile.SkipSSLValidation = context.SkipSSLValidation
+	configFile.Target = context.Target
+	configFile.UAAEndpoint = context.UAAEndpoint
+	configFile.UAAGrantType = context.UAAGrantType
+	configFile.UAAOAuthClient = context.UAAOAuthClient
+	configFile.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+}
@@ -0,0 +1,141 @@
+package configv3_test
+
+import (
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+
+		config.SetTargetInformation(TargetInformationArgs{Api: "https://api.prod.example.com", SkipSSLValidation: true})
+		config.SetTokenInformation("prod-access-token", "prod-refresh-token", "ssh-client")
+		config.SetOrganizationInformation("prod-org-guid", "prod-org")
+		config.V7SetSpaceInformation("prod-space-guid", "prod-space")
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	reload := func() *Config {
+		Expect(config.WriteConfig()).To(Succeed())
+		reloaded, err := LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		return reloaded
+	}
+
+	When("no context has been created", func() {
+		It("treats the config as the default context", func() {
+			Expect(config.CurrentContext()).To(Equal(DefaultContextName))
+
+			contexts := config.Contexts()
+			Expect(contexts).To(HaveLen(1))
+			Expect(contexts[0].Name).To(Equal(DefaultContextName))
+			Expect(contexts[0].Target).To(Equal("https://api.prod.example.com"))
+			Expect(contexts[0].TargetedSpace.Name).To(Equal("prod-space"))
+		})
+
+		It("does not write any contexts", func() {
+			Expect(reload().ConfigFile.Contexts).To(BeEmpty())
+		})
+	})
+
+	Describe("CreateContext", func() {
+		BeforeEach(func() {
+			config.CreateContext("staging")
+		})
+
+		It("switches to an empty context", func() {
+			Expect(config.CurrentContext()).To(Equal("staging"))
+			Expect(config.Target()).To(Equal(DefaultTarget))
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.SkipSSLValidation()).To(BeFalse())
+			Expect(config.HasTargetedOrganization()).To(BeFalse())
+			Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+		})
+
+		It("keeps the previous context", func() {
+			reloaded := reload()
+			Expect(reloaded.CurrentContext()).To(Equal("staging"))
+
+			contexts := reloaded.Contexts()
+			Expect(contexts).To(HaveLen(2))
+			Expect(contexts[0].Name).To(Equal(DefaultContextName))
+			Expect(contexts[0].AccessToken).To(Equal("prod-access-token"))
+			Expect(contexts[1].Name).To(Equal("staging"))
+		})
+	})
+
+	Describe("SwitchContext", func() {
+		BeforeEach(func() {
+			config.CreateContext("staging")
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.staging.example.com"})
+			config.SetTokenInformation("staging-access-token", "staging-refresh-token", "ssh-client")
+		})
+
+		It("restores the named context and saves the current one", func() {
+			Expect(config.SwitchContext(DefaultContextName)).To(Succeed())
+			Expect(config.Target()).To(Equal("https://api.prod.example.com"))
+			Expect(config.AccessToken()).To(Equal("prod-access-token"))
+			Expect(config.SkipSSLValidation()).To(BeTrue())
+			Expect(config.TargetedOrganizationName()).To(Equal("prod-org"))
+
+			reloaded := reload()
+			Expect(reloaded.CurrentContext()).To(Equal(DefaultContextName))
+			Expect(reloaded.SwitchContext("staging")).To(Succeed())
+			Expect(reloaded.Target()).To(Equal("https://api.staging.example.com"))
+			Expect(reloaded.AccessToken()).To(Equal("staging-access-token"))
+		})
+
+		It("returns an error for an unknown context", func() {
+			Expect(config.SwitchContext("production")).To(MatchError(translatableerror.ContextNotFoundError{Name: "production"}))
+			Expect(config.CurrentContext()).To(Equal("staging"))
+		})
+	})
+
+	Describe("ApplyContextOverride", func() {
+		BeforeEach(func() {
+			config.CreateContext("staging")
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.staging.example.com"})
+			config.SetTokenInformation("staging-access-token", "staging-refresh-token", "ssh-client")
+			Expect(config.SwitchContext(DefaultContextName)).To(Succeed())
+
+			config = reload()
+		})
+
+		It("runs against the overriding context without switching to it", func() {
+			config.Flags.Context = "staging"
+			Expect(config.ApplyContextOverride()).To(Succeed())
+			Expect(config.CurrentContext()).To(Equal("staging"))
+			Expect(config.Target()).To(Equal("https://api.staging.example.com"))
+
+			config.SetAccessToken("refreshed-staging-access-token")
+
+			reloaded := reload()
+			Expect(reloaded.CurrentContext()).To(Equal(DefaultContextName))
+			Expect(reloaded.Target()).To(Equal("https://api.prod.example.com"))
+			Expect(reloaded.AccessToken()).To(Equal("prod-access-token"))
+
+			Expect(reloaded.SwitchContext("staging")).To(Succeed())
+			Expect(reloaded.AccessToken()).To(Equal("refreshed-staging-access-token"))
+		})
+
+		It("returns an error for an unknown context", func() {
+			config.Flags.Context = "production"
+			Expect(config.ApplyContextOverride()).To(MatchError(translatableerror.ContextNotFoundError{Name: "production"}))
+		})
+	})
+})
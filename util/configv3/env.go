@@ -15,6 +15,7 @@ type EnvOverride struct {
 	CFLogLevel       string
 	CFPassword       string
 	CFPluginHome     string
+	CFReproducible   string
 	CFStagingTimeout string
 	CFStartupTimeout string
 	CFTrace          string
@@ -112,6 +113,22 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// ReproducibleArchives returns whether app bits archives should be built
+// deterministically, so that the same source produces the same package
+// checksum on every machine. This is based on the following:
+//   1. The $CF_REPRODUCIBLE_ARCHIVES environment variable if set
+//   2. Defaults to false
+func (config *Config) ReproducibleArchives() bool {
+	if config.ENV.CFReproducible != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFReproducible)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return false
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
 //   1. The $CF_STAGING_TIMEOUT environment variable if set
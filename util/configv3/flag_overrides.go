@@ -3,4 +3,6 @@ package configv3
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
 	Verbose bool
+	Output  OutputFormat
+	Context string
 }
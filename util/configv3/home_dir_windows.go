@@ -13,6 +13,18 @@ func ConfigFilePath() string {
 	return filepath.Join(homeDirectory(), ".cf", "config.json")
 }
 
+// ResourceCacheFilePath returns the location of the file that caches the
+// SHA1 fingerprints of pushed app files
+func ResourceCacheFilePath() string {
+	return filepath.Join(configDirectory(), "resource_cache.json")
+}
+
+// ScheduledTasksStateFilePath returns the location of the file that records
+// when scheduled tasks were last checked and run
+func ScheduledTasksStateFilePath() string {
+	return filepath.Join(configDirectory(), "scheduled_tasks.json")
+}
+
 func configDirectory() string {
 	return filepath.Join(homeDirectory(), ".cf")
 }
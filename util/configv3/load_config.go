@@ -122,6 +122,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
 		CFPassword:       os.Getenv("CF_PASSWORD"),
 		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
+		CFReproducible:   os.Getenv("CF_REPRODUCIBLE_ARCHIVES"),
 		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
 		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
 		CFTrace:          os.Getenv("CF_TRACE"),
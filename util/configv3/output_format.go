@@ -0,0 +1,23 @@
+package configv3
+
+// OutputFormat is the format that list and detail commands render their
+// results in.
+type OutputFormat string
+
+const (
+	// OutputFormatDefault means results are rendered as human readable tables.
+	OutputFormatDefault OutputFormat = ""
+
+	// OutputFormatJSON means results are rendered as JSON documents.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML means results are rendered as YAML documents.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat returns the output format based off:
+//  1. The '--output' global flag if set (json/yaml)
+//  2. Defaults to OutputFormatDefault if nothing is set
+func (config *Config) OutputFormat() OutputFormat {
+	return config.Flags.Output
+}
@@ -0,0 +1,32 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("returns the format from the global flag",
+		func(flagVal OutputFormat, expected OutputFormat) {
+			config, err := LoadConfig(FlagOverride{Output: flagVal})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(config.OutputFormat()).To(Equal(expected))
+		},
+
+		Entry("no flag set", OutputFormatDefault, OutputFormatDefault),
+		Entry("json", OutputFormatJSON, OutputFormatJSON),
+		Entry("yaml", OutputFormatYAML, OutputFormatYAML),
+	)
+})
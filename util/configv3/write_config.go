@@ -13,7 +13,7 @@ import (
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
 func (c *Config) WriteConfig() error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	rawConfig, err := json.MarshalIndent(c.configFileToWrite(), "", "  ")
 	if err != nil {
 		return err
 	}
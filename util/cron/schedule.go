@@ -0,0 +1,227 @@
+// Package cron parses cron schedules and finds the times they fire at.
+//
+// A schedule has the five standard fields: minute, hour, day of month, month
+// and day of week. Fields accept '*', single values, ranges ('1-5'), lists
+// ('1,15') and steps ('*/15', '0-30/10'). Months and days of the week can also
+// be given by their three letter names ('jan', 'mon'). The @yearly,
+// @annually, @monthly, @weekly, @daily, @midnight and @hourly shorthands are
+// supported as well.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron schedule.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// When both the day of month and the day of week are restricted, a day
+	// matches if either of them matches.
+	restrictedDayOfMonth bool
+	restrictedDayOfWeek  bool
+}
+
+// ParseError is returned when a schedule cannot be parsed.
+type ParseError struct {
+	Spec   string
+	Reason string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("invalid cron schedule '%s': %s", e.Spec, e.Reason)
+}
+
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField     = field{name: "minute", min: 0, max: 59}
+	hourField       = field{name: "hour", min: 0, max: 23}
+	dayOfMonthField = field{name: "day of month", min: 1, max: 31}
+	monthField      = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is accepted as Sunday, like most cron implementations do.
+	dayOfWeekField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var shorthands = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a cron schedule.
+func Parse(spec string) (Schedule, error) {
+	expanded := strings.TrimSpace(spec)
+	if strings.HasPrefix(expanded, "@") {
+		var ok bool
+		expanded, ok = shorthands[strings.ToLower(expanded)]
+		if !ok {
+			return Schedule{}, ParseError{Spec: spec, Reason: "unknown shorthand"}
+		}
+	}
+
+	fields := strings.Fields(expanded)
+	if len(fields) != 5 {
+		return Schedule{}, ParseError{Spec: spec, Reason: fmt.Sprintf("expected 5 fields, got %d", len(fields))}
+	}
+
+	var (
+		schedule Schedule
+		err      error
+	)
+	if schedule.minute, err = minuteField.parse(fields[0]); err != nil {
+		return Schedule{}, ParseError{Spec: spec, Reason: err.Error()}
+	}
+	if schedule.hour, err = hourField.parse(fields[1]); err != nil {
+		return Schedule{}, ParseError{Spec: spec, Reason: err.Error()}
+	}
+	if schedule.dayOfMonth, err = dayOfMonthField.parse(fields[2]); err != nil {
+		return Schedule{}, ParseError{Spec: spec, Reason: err.Error()}
+	}
+	if schedule.month, err = monthField.parse(fields[3]); err != nil {
+		return Schedule{}, ParseError{Spec: spec, Reason: err.Error()}
+	}
+	if schedule.dayOfWeek, err = dayOfWeekField.parse(fields[4]); err != nil {
+		return Schedule{}, ParseError{Spec: spec, Reason: err.Error()}
+	}
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+
+	schedule.restrictedDayOfMonth = !strings.HasPrefix(fields[2], "*")
+	schedule.restrictedDayOfWeek = !strings.HasPrefix(fields[4], "*")
+
+	return schedule, nil
+}
+
+// Next returns the first time after t that the schedule fires at, truncated
+// to the minute. It returns the zero time if the schedule never fires, such
+// as '0 0 30 2 *'.
+func (schedule Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// Every schedule that can fire does so within the next leap year cycle.
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !has(schedule.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !schedule.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(schedule.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(schedule.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (schedule Schedule) matchesDay(t time.Time) bool {
+	dayOfMonth := has(schedule.dayOfMonth, t.Day())
+	dayOfWeek := has(schedule.dayOfWeek, int(t.Weekday()))
+
+	if schedule.restrictedDayOfMonth && schedule.restrictedDayOfWeek {
+		return dayOfMonth || dayOfWeek
+	}
+	return dayOfMonth && dayOfWeek
+}
+
+func has(set uint64, value int) bool {
+	return set&(1<<uint(value)) != 0
+}
+
+// parse returns the set of values the field matches as a bit set.
+func (f field) parse(spec string) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(spec, ",") {
+		start, end, step, err := f.parseRange(part)
+		if err != nil {
+			return 0, err
+		}
+		for value := start; value <= end; value += step {
+			set |= 1 << uint(value)
+		}
+	}
+	return set, nil
+}
+
+func (f field) parseRange(part string) (int, int, int, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step < 1 {
+			return 0, 0, 0, fmt.Errorf("invalid step '%s' in %s field", stepPart, f.name)
+		}
+	}
+
+	if rangePart == "*" {
+		return f.min, f.max, step, nil
+	}
+
+	startPart, endPart, isRange := strings.Cut(rangePart, "-")
+	start, err := f.parseValue(startPart)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	end := start
+	if isRange {
+		end, err = f.parseValue(endPart)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if end < start {
+			return 0, 0, 0, fmt.Errorf("invalid range '%s' in %s field", rangePart, f.name)
+		}
+	} else if hasStep {
+		// '5/15' means every 15 starting at 5.
+		end = f.max
+	}
+
+	return start, end, step, nil
+}
+
+func (f field) parseValue(value string) (int, error) {
+	if number, ok := f.names[strings.ToLower(value)]; ok {
+		return number, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < f.min || number > f.max {
+		return 0, fmt.Errorf("invalid value '%s' in %s field, expected %d-%d", value, f.name, f.min, f.max)
+	}
+	return number, nil
+}
@@ -0,0 +1,67 @@
+package cron_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/cron"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Schedule", func() {
+	var start time.Time
+
+	BeforeEach(func() {
+		// A Wednesday
+		start = time.Date(2024, time.January, 3, 10, 17, 42, 0, time.UTC)
+	})
+
+	next := func(spec string, from time.Time) time.Time {
+		schedule, err := Parse(spec)
+		Expect(err).ToNot(HaveOccurred())
+		return schedule.Next(from)
+	}
+
+	DescribeTable("Next",
+		func(spec string, expected time.Time) {
+			Expect(next(spec, start)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2024, time.January, 3, 10, 18, 0, 0, time.UTC)),
+		Entry("steps", "*/15 * * * *", time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC)),
+		Entry("steps with a start", "5/20 * * * *", time.Date(2024, time.January, 3, 10, 25, 0, 0, time.UTC)),
+		Entry("lists", "0 9,18 * * *", time.Date(2024, time.January, 3, 18, 0, 0, 0, time.UTC)),
+		Entry("ranges", "30 8-9 * * *", time.Date(2024, time.January, 4, 8, 30, 0, 0, time.UTC)),
+		Entry("day names", "0 0 * * fri", time.Date(2024, time.January, 5, 0, 0, 0, 0, time.UTC)),
+		Entry("month names", "0 0 1 mar *", time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("7 as Sunday", "0 0 * * 7", time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)),
+		Entry("leap days", "0 0 29 2 *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)),
+		Entry("either day of month or day of week", "0 0 15 * mon", time.Date(2024, time.January, 8, 0, 0, 0, 0, time.UTC)),
+		Entry("@hourly", "@hourly", time.Date(2024, time.January, 3, 11, 0, 0, 0, time.UTC)),
+		Entry("@daily", "@daily", time.Date(2024, time.January, 4, 0, 0, 0, 0, time.UTC)),
+		Entry("@weekly", "@weekly", time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)),
+		Entry("@monthly", "@monthly", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("@yearly", "@yearly", time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	)
+
+	It("returns a time strictly after the given time", func() {
+		onTheMinute := time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC)
+		Expect(next("*/15 * * * *", onTheMinute)).To(Equal(time.Date(2024, time.January, 3, 10, 45, 0, 0, time.UTC)))
+	})
+
+	It("returns the zero time for schedules that never fire", func() {
+		Expect(next("0 0 30 2 *", start)).To(BeZero())
+	})
+
+	DescribeTable("invalid schedules",
+		func(spec string, reason string) {
+			_, err := Parse(spec)
+			Expect(err).To(MatchError(ParseError{Spec: spec, Reason: reason}))
+		},
+		Entry("too few fields", "* * * *", "expected 5 fields, got 4"),
+		Entry("unknown shorthands", "@sometimes", "unknown shorthand"),
+		Entry("out of range values", "60 * * * *", "invalid value '60' in minute field, expected 0-59"),
+		Entry("unknown names", "0 0 * * someday", "invalid value 'someday' in day of week field, expected 0-7"),
+		Entry("backwards ranges", "0 5-1 * * *", "invalid range '5-1' in hour field"),
+		Entry("invalid steps", "*/0 * * * *", "invalid step '0' in minute field"),
+	)
+})
@@ -2,6 +2,7 @@ package manifestparser
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
@@ -16,22 +17,30 @@ type Docker struct {
 // add a field for the CLI to extract from the manifest, just add it to this
 // struct.
 type Application struct {
-	Name                    string                   `yaml:"name"`
-	DiskQuota               string                   `yaml:"disk-quota,omitempty"`
-	Docker                  *Docker                  `yaml:"docker,omitempty"`
-	HealthCheckType         constant.HealthCheckType `yaml:"health-check-type,omitempty"`
-	HealthCheckEndpoint     string                   `yaml:"health-check-http-endpoint,omitempty"`
-	HealthCheckTimeout      int64                    `yaml:"timeout,omitempty"`
-	Instances               *int                     `yaml:"instances,omitempty"`
-	Path                    string                   `yaml:"path,omitempty"`
-	Processes               []Process                `yaml:"processes,omitempty"`
-	Memory                  string                   `yaml:"memory,omitempty"`
-	NoRoute                 bool                     `yaml:"no-route,omitempty"`
-	RandomRoute             bool                     `yaml:"random-route,omitempty"`
-	DefaultRoute            bool                     `yaml:"default-route,omitempty"`
-	Stack                   string                   `yaml:"stack,omitempty"`
-	LogRateLimit            string                   `yaml:"log-rate-limit-per-second,omitempty"`
-	RemainingManifestFields map[string]interface{}   `yaml:"-,inline"`
+	Name                                  string                    `yaml:"name"`
+	DependsOn                             []string                  `yaml:"depends-on,omitempty"`
+	DiskQuota                             string                    `yaml:"disk-quota,omitempty"`
+	Docker                                *Docker                   `yaml:"docker,omitempty"`
+	HealthCheckType                       constant.HealthCheckType  `yaml:"health-check-type,omitempty"`
+	HealthCheckEndpoint                   string                    `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckTimeout                    int64                     `yaml:"timeout,omitempty"`
+	Instances                             *int                      `yaml:"instances,omitempty"`
+	Lifecycle                             constant.AppLifecycleType `yaml:"lifecycle,omitempty"`
+	Path                                  string                    `yaml:"path,omitempty"`
+	Processes                             []Process                 `yaml:"processes,omitempty"`
+	ReadinessHealthCheckType              constant.HealthCheckType  `yaml:"readiness-health-check-type,omitempty"`
+	ReadinessHealthCheckEndpoint          string                    `yaml:"readiness-health-check-http-endpoint,omitempty"`
+	ReadinessHealthCheckInvocationTimeout int64                     `yaml:"readiness-health-check-invocation-timeout,omitempty"`
+	ReadinessHealthCheckInterval          int64                     `yaml:"readiness-health-check-interval,omitempty"`
+	Memory                                string                    `yaml:"memory,omitempty"`
+	NoRoute                               bool                      `yaml:"no-route,omitempty"`
+	Routes                                []Route                   `yaml:"routes,omitempty"`
+	RandomRoute                           bool                      `yaml:"random-route,omitempty"`
+	DefaultRoute                          bool                      `yaml:"default-route,omitempty"`
+	Stack                                 string                    `yaml:"stack,omitempty"`
+	LogRateLimit                          string                    `yaml:"log-rate-limit-per-second,omitempty"`
+	Tasks                                 []Task                    `yaml:"tasks,omitempty"`
+	RemainingManifestFields               map[string]interface{}    `yaml:"-,inline"`
 }
 
 func (application Application) HasBuildpacks() bool {
@@ -39,6 +48,21 @@ func (application Application) HasBuildpacks() bool {
 	return ok
 }
 
+// Buildpacks returns the buildpacks listed for the app, whether they were
+// read from the manifest or set with SetBuildpacks.
+func (application Application) Buildpacks() []string {
+	var buildpacks []string
+	switch typedBuildpacks := application.RemainingManifestFields["buildpacks"].(type) {
+	case []string:
+		buildpacks = typedBuildpacks
+	case []interface{}:
+		for _, buildpack := range typedBuildpacks {
+			buildpacks = append(buildpacks, fmt.Sprint(buildpack))
+		}
+	}
+	return buildpacks
+}
+
 func (application *Application) SetBuildpacks(buildpacks []string) {
 	if application.RemainingManifestFields == nil {
 		application.RemainingManifestFields = map[string]interface{}{}
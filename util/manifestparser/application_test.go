@@ -1,6 +1,7 @@
 package manifestparser_test
 
 import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "code.cloudfoundry.org/cli/util/manifestparser"
 	"gopkg.in/yaml.v2"
 
@@ -188,6 +189,104 @@ stack: cflinuxfs3
 			})
 		})
 
+		Context("when lifecycle is provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+lifecycle: cnb
+`)
+			})
+
+			It("unmarshals the lifecycle property", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.Lifecycle).To(Equal(constant.AppLifecycleTypeCNB))
+				Expect(application.RemainingManifestFields).ToNot(HaveKey("lifecycle"))
+			})
+		})
+
+		Context("when a readiness health check is provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+readiness-health-check-type: port
+readiness-health-check-invocation-timeout: 5
+readiness-health-check-interval: 10
+`)
+			})
+
+			It("unmarshals the readiness health check", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.ReadinessHealthCheckType).To(Equal(constant.Port))
+				Expect(application.ReadinessHealthCheckInvocationTimeout).To(BeEquivalentTo(5))
+				Expect(application.ReadinessHealthCheckInterval).To(BeEquivalentTo(10))
+				Expect(application.RemainingManifestFields).To(BeEmpty())
+			})
+		})
+
+		Context("when routes are provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+name: some-app
+routes:
+- route: example.com
+- route: tcp.example.com:1024
+  protocol: tcp
+- route: lb.example.com
+  options:
+    loadbalancing: least-connection
+`)
+			})
+
+			It("unmarshals the routes with their options", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.Routes).To(Equal([]Route{
+					{Route: "example.com"},
+					{Route: "tcp.example.com:1024", Protocol: "tcp"},
+					{Route: "lb.example.com", Options: map[string]string{"loadbalancing": "least-connection"}},
+				}))
+				Expect(application.RemainingManifestFields).ToNot(HaveKey("routes"))
+			})
+
+			It("marshals the route options back", func() {
+				marshalled, err := yaml.Marshal(&application)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(marshalled).To(MatchYAML(rawYAML))
+			})
+		})
+
+		Context("when depends-on is provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+depends-on: [backend, worker]
+`)
+			})
+
+			It("unmarshals the dependencies", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.DependsOn).To(Equal([]string{"backend", "worker"}))
+				Expect(application.RemainingManifestFields).ToNot(HaveKey("depends-on"))
+			})
+		})
+
+		Context("when tasks are provided", func() {
+			BeforeEach(func() {
+				rawYAML = []byte(`---
+tasks:
+- name: nightly-report
+  command: bin/report
+  memory: 256M
+  disk_quota: 1G
+  schedule: "0 2 * * *"
+`)
+			})
+
+			It("unmarshals the tasks", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(application.Tasks).To(Equal([]Task{
+					{Name: "nightly-report", Command: "bin/report", Memory: "256M", DiskQuota: "1G", Schedule: "0 2 * * *"},
+				}))
+				Expect(application.RemainingManifestFields).ToNot(HaveKey("tasks"))
+			})
+		})
+
 		Context("when an unknown field is provided", func() {
 			BeforeEach(func() {
 				rawYAML = []byte(`---
@@ -316,6 +415,31 @@ processes:
 				})
 			})
 
+			Context("when a readiness health check is provided", func() {
+				BeforeEach(func() {
+					rawYAML = []byte(`---
+processes:
+- readiness-health-check-type: http
+  readiness-health-check-http-endpoint: /ready
+  readiness-health-check-invocation-timeout: 5
+  readiness-health-check-interval: 10
+`)
+				})
+
+				It("unmarshals the processes property with the readiness health check", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(application.Processes).To(Equal([]Process{
+						{
+							ReadinessHealthCheckType:              "http",
+							ReadinessHealthCheckEndpoint:          "/ready",
+							ReadinessHealthCheckInvocationTimeout: 5,
+							ReadinessHealthCheckInterval:          10,
+							RemainingManifestFields:               emptyMap,
+						},
+					}))
+				})
+			})
+
 			Context("when a memory limit is provided", func() {
 				BeforeEach(func() {
 					rawYAML = []byte(`---
@@ -534,4 +658,39 @@ log-rate-limit-per-second: 5K
 			})
 		})
 	})
+
+	Describe("Buildpacks", func() {
+		var app Application
+
+		When("the buildpacks were set", func() {
+			BeforeEach(func() {
+				app = Application{}
+				app.SetBuildpacks([]string{"bp1", "docker://example.com/bp2"})
+			})
+
+			It("returns them", func() {
+				Expect(app.Buildpacks()).To(Equal([]string{"bp1", "docker://example.com/bp2"}))
+			})
+		})
+
+		When("the buildpacks were read from the manifest", func() {
+			BeforeEach(func() {
+				app = Application{RemainingManifestFields: map[string]interface{}{"buildpacks": []interface{}{"bp1", "bp2"}}}
+			})
+
+			It("returns them as strings", func() {
+				Expect(app.Buildpacks()).To(Equal([]string{"bp1", "bp2"}))
+			})
+		})
+
+		When("the app does not have buildpacks", func() {
+			BeforeEach(func() {
+				app = Application{}
+			})
+
+			It("returns nothing", func() {
+				Expect(app.Buildpacks()).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,18 @@
+package manifestparser
+
+import "fmt"
+
+// InvalidAppDependencyError is returned when an app in the manifest depends on
+// an app that is not in the manifest, or the dependencies form a cycle.
+type InvalidAppDependencyError struct {
+	AppName    string
+	Dependency string
+	Cycle      bool
+}
+
+func (e InvalidAppDependencyError) Error() string {
+	if e.Cycle {
+		return fmt.Sprintf("App '%s' depends on '%s', which creates a dependency cycle", e.AppName, e.Dependency)
+	}
+	return fmt.Sprintf("App '%s' depends on '%s', which is not in the manifest", e.AppName, e.Dependency)
+}
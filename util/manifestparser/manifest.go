@@ -49,3 +49,54 @@ func (m Manifest) HasAppWithNoName() bool {
 	}
 	return false
 }
+
+// ValidateDependencies checks that every app listed in depends-on is in the
+// manifest and that the dependencies do not form a cycle.
+func (m Manifest) ValidateDependencies() error {
+	dependencies := map[string][]string{}
+	for _, app := range m.Applications {
+		dependencies[app.Name] = app.DependsOn
+	}
+
+	for _, app := range m.Applications {
+		for _, dependency := range app.DependsOn {
+			if _, ok := dependencies[dependency]; !ok {
+				return InvalidAppDependencyError{AppName: app.Name, Dependency: dependency}
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		state[name] = visiting
+		for _, dependency := range dependencies[name] {
+			switch state[dependency] {
+			case visiting:
+				return InvalidAppDependencyError{AppName: name, Dependency: dependency, Cycle: true}
+			case unvisited:
+				if err := visit(dependency); err != nil {
+					return err
+				}
+			}
+		}
+		state[name] = visited
+		return nil
+	}
+
+	for _, app := range m.Applications {
+		if state[app.Name] == unvisited {
+			if err := visit(app.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -116,6 +116,39 @@ var _ = Describe("Manifest", func() {
 		})
 	})
 
+	Describe("ValidateDependencies", func() {
+		It("returns nil when every dependency is in the manifest", func() {
+			manifest.Applications = []Application{
+				{Name: "frontend", DependsOn: []string{"backend", "worker"}},
+				{Name: "backend", DependsOn: []string{"worker"}},
+				{Name: "worker"},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(Succeed())
+		})
+
+		It("returns an error when a dependency is not in the manifest", func() {
+			manifest.Applications = []Application{
+				{Name: "frontend", DependsOn: []string{"backend"}},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(MatchError(InvalidAppDependencyError{
+				AppName:    "frontend",
+				Dependency: "backend",
+			}))
+		})
+
+		It("returns an error when the dependencies form a cycle", func() {
+			manifest.Applications = []Application{
+				{Name: "frontend", DependsOn: []string{"backend"}},
+				{Name: "backend", DependsOn: []string{"database"}},
+				{Name: "database", DependsOn: []string{"frontend"}},
+			}
+
+			Expect(manifest.ValidateDependencies()).To(MatchError("App 'database' depends on 'frontend', which creates a dependency cycle"))
+		})
+	})
+
 	Describe("GetFirstAppWebProcess", func() {
 		BeforeEach(func() {
 			manifest.Applications = []Application{
@@ -9,15 +9,19 @@ import (
 )
 
 type Process struct {
-	DiskQuota               string                   `yaml:"disk_quota,omitempty"`
-	HealthCheckEndpoint     string                   `yaml:"health-check-http-endpoint,omitempty"`
-	HealthCheckType         constant.HealthCheckType `yaml:"health-check-type,omitempty"`
-	HealthCheckTimeout      int64                    `yaml:"timeout,omitempty"`
-	Instances               *int                     `yaml:"instances,omitempty"`
-	Memory                  string                   `yaml:"memory,omitempty"`
-	Type                    string                   `yaml:"type"`
-	LogRateLimit            string                   `yaml:"log-rate-limit-per-second,omitempty"`
-	RemainingManifestFields map[string]interface{}   `yaml:"-,inline"`
+	DiskQuota                             string                   `yaml:"disk_quota,omitempty"`
+	HealthCheckEndpoint                   string                   `yaml:"health-check-http-endpoint,omitempty"`
+	HealthCheckType                       constant.HealthCheckType `yaml:"health-check-type,omitempty"`
+	HealthCheckTimeout                    int64                    `yaml:"timeout,omitempty"`
+	Instances                             *int                     `yaml:"instances,omitempty"`
+	Memory                                string                   `yaml:"memory,omitempty"`
+	ReadinessHealthCheckType              constant.HealthCheckType `yaml:"readiness-health-check-type,omitempty"`
+	ReadinessHealthCheckEndpoint          string                   `yaml:"readiness-health-check-http-endpoint,omitempty"`
+	ReadinessHealthCheckInvocationTimeout int64                    `yaml:"readiness-health-check-invocation-timeout,omitempty"`
+	ReadinessHealthCheckInterval          int64                    `yaml:"readiness-health-check-interval,omitempty"`
+	Type                                  string                   `yaml:"type"`
+	LogRateLimit                          string                   `yaml:"log-rate-limit-per-second,omitempty"`
+	RemainingManifestFields               map[string]interface{}   `yaml:"-,inline"`
 }
 
 func (process *Process) SetStartCommand(command string) {
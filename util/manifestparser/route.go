@@ -0,0 +1,9 @@
+package manifestparser
+
+// Route is a route of an application in the manifest. Options are the
+// per-route options, such as loadbalancing: least-connection.
+type Route struct {
+	Route    string            `yaml:"route"`
+	Protocol string            `yaml:"protocol,omitempty"`
+	Options  map[string]string `yaml:"options,omitempty"`
+}
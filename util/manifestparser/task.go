@@ -0,0 +1,11 @@
+package manifestparser
+
+// Task is a task that is run on a schedule by run-scheduled-tasks. Schedule is
+// a cron schedule, evaluated in the local time zone of the runner.
+type Task struct {
+	Name      string `yaml:"name"`
+	Command   string `yaml:"command"`
+	DiskQuota string `yaml:"disk_quota,omitempty"`
+	Memory    string `yaml:"memory,omitempty"`
+	Schedule  string `yaml:"schedule"`
+}
@@ -0,0 +1,31 @@
+package progressbar
+
+import (
+	"io"
+
+	pb "gopkg.in/cheggaaa/pb.v1"
+)
+
+// FileProgressBar displays a progress bar for each file of a multi-file
+// transfer, one file at a time.
+type FileProgressBar struct {
+	bar *pb.ProgressBar
+}
+
+func NewFileProgressBar() *FileProgressBar {
+	return &FileProgressBar{}
+}
+
+func (p *FileProgressBar) Wrap(name string, reader io.Reader, size int64) io.Reader {
+	p.bar = pb.New64(size).SetUnits(pb.U_BYTES).Prefix(name + " ")
+	p.bar.ShowTimeLeft = false
+	p.bar.Start()
+	return p.bar.NewProxyReader(reader)
+}
+
+func (p *FileProgressBar) Done() {
+	if p.bar != nil {
+		p.bar.Finish()
+		p.bar = nil
+	}
+}
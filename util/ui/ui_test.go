@@ -218,6 +218,35 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayYAML", func() {
+		It("displays the YAML document", func() {
+			obj := struct {
+				Str  string            `yaml:"str"`
+				Bool bool              `yaml:"bool"`
+				Map  map[string]string `yaml:"map"`
+				Arr  []string          `yaml:"arr"`
+			}{
+				Str:  "hello",
+				Bool: true,
+				Map:  map[string]string{"key": "value"},
+				Arr:  []string{"a", "b"},
+			}
+
+			err := ui.DisplayYAML(obj)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(out).To(SatisfyAll(
+				Say("str: hello\n"),
+				Say("bool: true\n"),
+				Say("map:\n"),
+				Say("  key: value\n"),
+				Say("arr:\n"),
+				Say("- a\n"),
+				Say("- b\n"),
+			))
+		})
+	})
+
 	Describe("DeferText", func() {
 		It("defers the template with map values substituted into ui.Out with a newline", func() {
 			ui.DeferText(